@@ -34,6 +34,21 @@ var ErrGetRolesForAccount = errors.New("get roles for account error")
 // ErrGetESDTNFTData signals an error in getting esdt nft data for given address, tokenID and nonce
 var ErrGetESDTNFTData = errors.New("get esdt nft data for account error")
 
+// ErrGetTransactionsByAddress signals an error in getting the transactions of an address
+var ErrGetTransactionsByAddress = errors.New("get transactions for account error")
+
+// ErrInvalidPageSize signals that an invalid page size was provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrGetAccountsBulk signals an error in getting the accounts of a bulk query
+var ErrGetAccountsBulk = errors.New("get accounts bulk error")
+
+// ErrEmptyAddresses signals that no address was provided
+var ErrEmptyAddresses = errors.New("no address provided")
+
+// ErrTooManyAddresses signals that too many addresses were provided
+var ErrTooManyAddresses = errors.New("too many addresses")
+
 // ErrEmptyAddress signals that an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -88,6 +103,39 @@ var ErrGetEpochStartData = errors.New("error getting epoch start data for epoch"
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
+// ErrGetStorageStatistics signals an error happening when trying to fetch the storage statistics
+var ErrGetStorageStatistics = errors.New("getting storage statistics failed")
+
+// ErrGetEquivocationEvidences signals an error happening when trying to fetch the consensus equivocation evidences
+var ErrGetEquivocationEvidences = errors.New("getting equivocation evidences failed")
+
+// ErrGetConsensusRounds signals an error happening when trying to fetch the timeline of the last consensus rounds
+var ErrGetConsensusRounds = errors.New("getting consensus rounds failed")
+
+// ErrGetP2PFaultRules signals an error happening when trying to fetch the network faults injection rules
+var ErrGetP2PFaultRules = errors.New("getting p2p fault rules failed")
+
+// ErrSetP2PFaultRules signals an error happening when trying to replace the network faults injection rules
+var ErrSetP2PFaultRules = errors.New("setting p2p fault rules failed")
+
+// ErrGetP2PTraffic signals an error happening when trying to fetch the p2p traffic per topic and per peer
+var ErrGetP2PTraffic = errors.New("getting p2p traffic failed")
+
+// ErrGetStateDiff signals an error happening when trying to compute a state diff
+var ErrGetStateDiff = errors.New("getting state diff failed")
+
+// ErrStartDbIntegrityCheck signals an error happening when trying to start a database integrity check
+var ErrStartDbIntegrityCheck = errors.New("starting database integrity check failed")
+
+// ErrGetDbIntegrityReport signals an error happening when trying to fetch the database integrity report
+var ErrGetDbIntegrityReport = errors.New("getting database integrity report failed")
+
+// ErrStartDataTriesAnalysis signals an error happening when trying to start a data tries analysis
+var ErrStartDataTriesAnalysis = errors.New("starting data tries analysis failed")
+
+// ErrGetDataTriesReport signals an error happening when trying to fetch the data tries report
+var ErrGetDataTriesReport = errors.New("getting data tries report failed")
+
 // ErrValidationEmptyRootHash signals that an empty root hash was provided
 var ErrValidationEmptyRootHash = errors.New("rootHash is empty")
 
@@ -141,3 +189,6 @@ var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps ca
 
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
+
+// ErrInvalidNumOfTransactionsToSimulate signals that either no transaction or too many transactions were provided for simulation
+var ErrInvalidNumOfTransactionsToSimulate = errors.New("invalid number of transactions to simulate")
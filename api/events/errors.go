@@ -0,0 +1,15 @@
+package events
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrNilSubscriptionsHandler signals that a nil subscriptions handler has been provided
+var ErrNilSubscriptionsHandler = errors.New("nil subscriptions handler")
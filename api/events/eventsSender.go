@@ -0,0 +1,149 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	disconnectMessage = -1
+	errorMessageType  = "error"
+	subscribedType    = "subscribed"
+	subscriptionEnded = "subscription ended"
+)
+
+// ArgsEventsSender defines the arguments needed for the events sender creation
+type ArgsEventsSender struct {
+	Marshalizer          marshal.Marshalizer
+	Conn                 wsConn
+	SubscriptionsHandler subscriptions.SubscriptionsHandler
+	Log                  logger.Logger
+}
+
+type eventsSender struct {
+	marshalizer          marshal.Marshalizer
+	conn                 wsConn
+	subscriptionsHandler subscriptions.SubscriptionsHandler
+	log                  logger.Logger
+}
+
+// NewEventsSender returns a new component that is able to push the node events to a websocket client.
+// The client has to send the subscription filter as the first message, after which it will receive
+// all the events matching that filter
+func NewEventsSender(args ArgsEventsSender) (*eventsSender, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if args.Conn == nil {
+		return nil, ErrNilWsConn
+	}
+	if check.IfNil(args.SubscriptionsHandler) {
+		return nil, ErrNilSubscriptionsHandler
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+
+	return &eventsSender{
+		marshalizer:          args.Marshalizer,
+		conn:                 args.Conn,
+		subscriptionsHandler: args.SubscriptionsHandler,
+		log:                  args.Log,
+	}, nil
+}
+
+// StartSendingBlocking waits for the subscription filter, registers the subscription and then sends
+// the events until either the client disconnects or the subscription ends
+func (es *eventsSender) StartSendingBlocking() {
+	defer func() {
+		_ = es.conn.Close()
+	}()
+
+	subscription, err := es.subscribe()
+	if err != nil {
+		es.log.Debug("websocket subscription failed", "error", err.Error())
+		es.sendMessage(&subscriptions.Message{Type: errorMessageType, Data: err.Error()})
+		return
+	}
+
+	defer es.subscriptionsHandler.Unsubscribe(subscription.ID())
+
+	ok := es.sendMessage(&subscriptions.Message{Type: subscribedType, Data: subscription.ID()})
+	if !ok {
+		return
+	}
+
+	go es.monitorConnection(subscription.ID())
+	es.doSendContinuously(subscription)
+}
+
+func (es *eventsSender) subscribe() (subscriptions.Subscription, error) {
+	_, message, err := es.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := subscriptions.Filter{}
+	err = es.marshalizer.Unmarshal(&filter, message)
+	if err != nil {
+		return nil, err
+	}
+
+	return es.subscriptionsHandler.Subscribe(filter)
+}
+
+// monitorConnection will end the subscription as soon as the client disconnects, which will also stop
+// the sending loop
+func (es *eventsSender) monitorConnection(subscriptionID uint64) {
+	defer es.subscriptionsHandler.Unsubscribe(subscriptionID)
+
+	for {
+		mt, _, err := es.conn.ReadMessage()
+		es.log.Trace("message type", "value", mt)
+		if mt == websocket.CloseMessage || mt == disconnectMessage {
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) doSendContinuously(subscription subscriptions.Subscription) {
+	for message := range subscription.Messages() {
+		ok := es.sendMessage(message)
+		if !ok {
+			return
+		}
+	}
+
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, subscriptionEnded)
+	_ = es.conn.WriteMessage(websocket.CloseMessage, closeMessage)
+}
+
+func (es *eventsSender) sendMessage(message *subscriptions.Message) bool {
+	data, err := es.marshalizer.Marshal(message)
+	if err != nil {
+		es.log.Error("cannot marshal subscription message", "type", message.Type, "error", err.Error())
+		return false
+	}
+
+	err = es.conn.WriteMessage(websocket.TextMessage, data)
+	if err != nil {
+		isConnectionClosed := strings.Contains(err.Error(), "websocket: close sent")
+		if !isConnectionClosed {
+			es.log.Error("web socket error", "error", err.Error())
+		} else {
+			es.log.Debug("web socket", "connection", "closed")
+		}
+
+		return false
+	}
+
+	return true
+}
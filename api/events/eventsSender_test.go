@@ -0,0 +1,207 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type subscriptionStub struct {
+	messages chan *subscriptions.Message
+}
+
+func (ss *subscriptionStub) ID() uint64 {
+	return 7
+}
+
+func (ss *subscriptionStub) Messages() <-chan *subscriptions.Message {
+	return ss.messages
+}
+
+func (ss *subscriptionStub) IsInterfaceNil() bool {
+	return ss == nil
+}
+
+// wsConnStub does not hold any lock while blocked in ReadMessage, as the real connection allows
+// concurrent reads and writes
+type wsConnStub struct {
+	mutRead        sync.Mutex
+	numReads       int
+	firstMessage   []byte
+	chanDisconnect chan struct{}
+
+	mutWritten sync.Mutex
+	written    []int
+	payloads   [][]byte
+}
+
+func (wcs *wsConnStub) Close() error {
+	return nil
+}
+
+func (wcs *wsConnStub) ReadMessage() (int, []byte, error) {
+	wcs.mutRead.Lock()
+	wcs.numReads++
+	isFirst := wcs.numReads == 1
+	wcs.mutRead.Unlock()
+
+	if isFirst {
+		return websocket.TextMessage, wcs.firstMessage, nil
+	}
+
+	<-wcs.chanDisconnect
+	return websocket.CloseMessage, nil, nil
+}
+
+func (wcs *wsConnStub) WriteMessage(messageType int, data []byte) error {
+	wcs.mutWritten.Lock()
+	defer wcs.mutWritten.Unlock()
+
+	wcs.written = append(wcs.written, messageType)
+	wcs.payloads = append(wcs.payloads, data)
+
+	return nil
+}
+
+func createMockArgsEventsSender() events.ArgsEventsSender {
+	return events.ArgsEventsSender{
+		Marshalizer:          &marshal.JsonMarshalizer{},
+		Conn:                 &mock.WsConnStub{},
+		SubscriptionsHandler: &testscommon.SubscriptionsHandlerStub{},
+		Log:                  &mock.LoggerStub{},
+	}
+}
+
+func TestNewEventsSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSender()
+		args.Marshalizer = nil
+		es, err := events.NewEventsSender(args)
+		assert.Nil(t, es)
+		assert.Equal(t, events.ErrNilMarshalizer, err)
+	})
+	t.Run("nil connection should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSender()
+		args.Conn = nil
+		es, err := events.NewEventsSender(args)
+		assert.Nil(t, es)
+		assert.Equal(t, events.ErrNilWsConn, err)
+	})
+	t.Run("nil subscriptions handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSender()
+		args.SubscriptionsHandler = nil
+		es, err := events.NewEventsSender(args)
+		assert.Nil(t, es)
+		assert.Equal(t, events.ErrNilSubscriptionsHandler, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSender()
+		args.Log = nil
+		es, err := events.NewEventsSender(args)
+		assert.Nil(t, es)
+		assert.Equal(t, events.ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		es, err := events.NewEventsSender(createMockArgsEventsSender())
+		assert.NotNil(t, es)
+		assert.Nil(t, err)
+	})
+}
+
+func TestEventsSender_StartSendingBlockingSubscribeErrorShouldSendError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsEventsSender()
+	conn := &mock.WsConnStub{}
+	conn.SetReadMessageHandler(func() (int, []byte, error) {
+		return websocket.TextMessage, []byte(`{"events":["block"]}`), nil
+	})
+	written := make([][]byte, 0)
+	conn.SetWriteMessageHandler(func(messageType int, data []byte) error {
+		written = append(written, data)
+		return nil
+	})
+	closeCalled := false
+	conn.SetCloseHandler(func() error {
+		closeCalled = true
+		return nil
+	})
+	args.Conn = conn
+	args.SubscriptionsHandler = &testscommon.SubscriptionsHandlerStub{
+		SubscribeCalled: func(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+			assert.Equal(t, []string{subscriptions.EventBlock}, filter.Events)
+			return nil, expectedErr
+		},
+	}
+
+	es, _ := events.NewEventsSender(args)
+	es.StartSendingBlocking()
+
+	require.Equal(t, 1, len(written))
+	assert.Contains(t, string(written[0]), expectedErr.Error())
+	assert.True(t, closeCalled)
+}
+
+func TestEventsSender_StartSendingBlockingShouldSendEventsUntilSubscriptionEnds(t *testing.T) {
+	t.Parallel()
+
+	sub := &subscriptionStub{messages: make(chan *subscriptions.Message, 10)}
+	sub.messages <- &subscriptions.Message{Type: subscriptions.EventFinalized, Data: &subscriptions.FinalizedEvent{Hash: "aa"}}
+	close(sub.messages)
+
+	args := createMockArgsEventsSender()
+	chanDisconnect := make(chan struct{})
+	defer close(chanDisconnect)
+
+	conn := &wsConnStub{
+		chanDisconnect: chanDisconnect,
+		firstMessage:   []byte(`{}`),
+	}
+	args.Conn = conn
+
+	numUnsubscribeCalls := 0
+	args.SubscriptionsHandler = &testscommon.SubscriptionsHandlerStub{
+		SubscribeCalled: func(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+			return sub, nil
+		},
+		UnsubscribeCalled: func(id uint64) {
+			assert.Equal(t, uint64(7), id)
+			numUnsubscribeCalls++
+		},
+	}
+
+	es, _ := events.NewEventsSender(args)
+	es.StartSendingBlocking()
+
+	conn.mutWritten.Lock()
+	defer conn.mutWritten.Unlock()
+
+	require.Equal(t, []int{websocket.TextMessage, websocket.TextMessage, websocket.CloseMessage}, conn.written)
+	received := &subscriptions.Message{}
+	_ = json.Unmarshal(conn.payloads[1], received)
+	assert.Equal(t, subscriptions.EventFinalized, received.Type)
+	assert.Equal(t, 1, numUnsubscribeCalls)
+}
@@ -181,6 +181,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	subscribeGroup, err := groups.NewSubscribeGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["subscribe"] = subscribeGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,12 +29,22 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
+	getAccountsBulkPath       = "/bulk"
 	urlParamOnFinalBlock      = "onFinalBlock"
 	urlParamOnStartOfEpoch    = "onStartOfEpoch"
 	urlParamBlockNonce        = "blockNonce"
 	urlParamBlockHash         = "blockHash"
 	urlParamBlockRootHash     = "blockRootHash"
 	urlParamHintEpoch         = "hintEpoch"
+	urlParamFrom              = "from"
+	urlParamSize              = "size"
+)
+
+const (
+	defaultTransactionsPageSize = 20
+	maxTransactionsPageSize     = 100
+	maxAddressesInBulk          = 100
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -48,6 +59,8 @@ type addressFacadeHandler interface {
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetTransactionsByAddress(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error)
+	GetAccountsBulk(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error)
 	IsInterfaceNil() bool
 }
 
@@ -76,6 +89,14 @@ type esdtNFTTokenData struct {
 	Attributes      []byte   `json:"attributes,omitempty"`
 }
 
+type accountBulkData struct {
+	Address string                       `json:"address"`
+	Account *api.AccountResponse         `json:"account,omitempty"`
+	ESDTs   map[string]*esdtNFTTokenData `json:"esdts,omitempty"`
+	Values  map[string]string            `json:"values,omitempty"`
+	Error   string                       `json:"error,omitempty"`
+}
+
 // NewAddressGroup returns a new instance of addressGroup
 func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 	if check.IfNil(facade) {
@@ -143,6 +164,16 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+		},
+		{
+			Path:    getAccountsBulkPath,
+			Method:  http.MethodPost,
+			Handler: ag.getAccountsBulk,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -458,6 +489,129 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"esdts": formattedTokens, "blockInfo": blockInfo})
 }
 
+// getTransactions returns a page of transactions of the given address, ordered from the newest to the oldest one
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsByAddress, errors.ErrEmptyAddress)
+		return
+	}
+
+	from, size, err := extractTransactionsPageParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsByAddress, err)
+		return
+	}
+
+	response, err := ag.getFacade().GetTransactionsByAddress(addr, from, size)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTransactionsByAddress, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"transactions":    response.Transactions,
+		"numTransactions": response.NumTransactions,
+		"from":            from,
+		"size":            size,
+	})
+}
+
+// getAccountsBulk returns the accounts, ESDT tokens and storage values requested for the provided addresses
+func (ag *addressGroup) getAccountsBulk(c *gin.Context) {
+	var query common.AccountsBulkQuery
+	err := c.ShouldBindJSON(&query)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkAccountsBulkQuery(query)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountsBulk, err)
+		return
+	}
+
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountsBulk, err)
+		return
+	}
+
+	results, blockInfo, err := ag.getFacade().GetAccountsBulk(query, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountsBulk, err)
+		return
+	}
+
+	accounts := make([]*accountBulkData, 0, len(results))
+	for _, result := range results {
+		accounts = append(accounts, buildAccountBulkDataApiResponse(result))
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"accounts": accounts, "blockInfo": blockInfo})
+}
+
+func checkAccountsBulkQuery(query common.AccountsBulkQuery) error {
+	if len(query.Addresses) == 0 {
+		return errors.ErrEmptyAddresses
+	}
+	if len(query.Addresses) > maxAddressesInBulk {
+		return fmt.Errorf("%w: at most %d addresses can be provided", errors.ErrTooManyAddresses, maxAddressesInBulk)
+	}
+	for _, tokenIdentifier := range query.TokenIdentifiers {
+		if tokenIdentifier == "" {
+			return errors.ErrEmptyTokenIdentifier
+		}
+	}
+	for _, key := range query.Keys {
+		if key == "" {
+			return errors.ErrEmptyKey
+		}
+	}
+
+	return nil
+}
+
+func buildAccountBulkDataApiResponse(result *common.AccountBulkResult) *accountBulkData {
+	accountData := &accountBulkData{
+		Address: result.Address,
+		Account: result.Account,
+		Values:  result.Values,
+		Error:   result.Error,
+	}
+	if len(result.ESDTs) == 0 {
+		return accountData
+	}
+
+	accountData.ESDTs = make(map[string]*esdtNFTTokenData, len(result.ESDTs))
+	for tokenID, esdtData := range result.ESDTs {
+		accountData.ESDTs[tokenID] = buildTokenDataApiResponse(tokenID, esdtData)
+	}
+
+	return accountData
+}
+
+func extractTransactionsPageParams(c *gin.Context) (uint64, uint64, error) {
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	size, err := parseUint64UrlParam(c, urlParamSize)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !size.HasValue {
+		return from.Value, defaultTransactionsPageSize, nil
+	}
+	if size.Value == 0 || size.Value > maxTransactionsPageSize {
+		return 0, 0, fmt.Errorf("%w: should be between 1 and %d", errors.ErrInvalidPageSize, maxTransactionsPageSize)
+	}
+
+	return from.Value, size.Value, nil
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
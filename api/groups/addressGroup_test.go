@@ -12,10 +12,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -139,6 +141,39 @@ type usernameResponse struct {
 	Code  string               `json:"code"`
 }
 
+type transactionsByAddressResponseData struct {
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions"`
+	NumTransactions uint64                              `json:"numTransactions"`
+	From            uint64                              `json:"from"`
+	Size            uint64                              `json:"size"`
+}
+
+type transactionsByAddressResponse struct {
+	Data  transactionsByAddressResponseData `json:"data"`
+	Error string                            `json:"error"`
+	Code  string                            `json:"code"`
+}
+
+type accountBulkResponseData struct {
+	Address string               `json:"address"`
+	Account *api.AccountResponse `json:"account"`
+	ESDTs   map[string]struct {
+		TokenIdentifier string `json:"tokenIdentifier"`
+		Balance         string `json:"balance"`
+	} `json:"esdts"`
+	Values map[string]string `json:"values"`
+	Error  string            `json:"error"`
+}
+
+type accountsBulkResponse struct {
+	Data struct {
+		Accounts  []accountBulkResponseData `json:"accounts"`
+		BlockInfo api.BlockInfo             `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 func TestNewAddressGroup(t *testing.T) {
 	t.Parallel()
 
@@ -979,6 +1014,228 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(_ string, _ uint64, _ uint64) (*common.TransactionsByAddressApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsByAddress.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_InvalidPageParamsShouldError(t *testing.T) {
+	t.Parallel()
+
+	addrGroup, err := groups.NewAddressGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	testInvalidParams := func(query string, expectedErr error) {
+		req, _ := http.NewRequest("GET", "/address/address/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+
+	testInvalidParams("from=abc", apiErrors.ErrBadUrlParams)
+	testInvalidParams("size=abc", apiErrors.ErrBadUrlParams)
+	testInvalidParams("size=0", apiErrors.ErrInvalidPageSize)
+	testInvalidParams("size=101", apiErrors.ErrInvalidPageSize)
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default page params", func(t *testing.T) {
+		t.Parallel()
+
+		testGetTransactions(t, "", 0, 20)
+	})
+	t.Run("provided page params", func(t *testing.T) {
+		t.Parallel()
+
+		testGetTransactions(t, "?from=40&size=10", 40, 10)
+	})
+}
+
+func testGetTransactions(t *testing.T, query string, expectedFrom uint64, expectedSize uint64) {
+	testAddress := "address"
+	transactions := []*transaction.ApiTransactionResult{
+		{Hash: "hash2", Type: string(transaction.TxTypeReward)},
+		{Hash: "hash1", Type: string(transaction.TxTypeNormal)},
+	}
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, expectedFrom, offset)
+			assert.Equal(t, expectedSize, maxTransactions)
+
+			return &common.TransactionsByAddressApiResponse{
+				Transactions:    transactions,
+				NumTransactions: 77,
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions%s", testAddress, query), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsByAddressResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, transactions, response.Data.Transactions)
+	assert.Equal(t, uint64(77), response.Data.NumTransactions)
+	assert.Equal(t, expectedFrom, response.Data.From)
+	assert.Equal(t, expectedSize, response.Data.Size)
+}
+
+func TestGetAccountsBulk_InvalidQueryShouldError(t *testing.T) {
+	t.Parallel()
+
+	addrGroup, err := groups.NewAddressGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	testInvalidQuery := func(body string, urlParams string, expectedErr error) {
+		req, _ := http.NewRequest("POST", "/address/bulk"+urlParams, strings.NewReader(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+
+	tooManyAddresses := make([]string, 101)
+	for i := range tooManyAddresses {
+		tooManyAddresses[i] = fmt.Sprintf("address%d", i)
+	}
+	tooManyAddressesBody, _ := json.Marshal(common.AccountsBulkQuery{Addresses: tooManyAddresses})
+
+	testInvalidQuery("not a json", "", apiErrors.ErrValidation)
+	testInvalidQuery(`{"addresses": []}`, "", apiErrors.ErrEmptyAddresses)
+	testInvalidQuery(string(tooManyAddressesBody), "", apiErrors.ErrTooManyAddresses)
+	testInvalidQuery(`{"addresses": ["alice"], "tokens": [""]}`, "", apiErrors.ErrEmptyTokenIdentifier)
+	testInvalidQuery(`{"addresses": ["alice"], "keys": [""]}`, "", apiErrors.ErrEmptyKey)
+	testInvalidQuery(`{"addresses": ["alice"]}`, "?blockNonce=1&blockHash=abcd", apiErrors.ErrBadUrlParams)
+}
+
+func TestGetAccountsBulk_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetAccountsBulkCalled: func(_ common.AccountsBulkQuery, _ api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+			return nil, api.BlockInfo{}, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/address/bulk", strings.NewReader(`{"addresses": ["alice"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAccountsBulk.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAccountsBulk_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := common.AccountsBulkQuery{
+		Addresses:        []string{"alice", "bob"},
+		TokenIdentifiers: []string{"TKN-abcdef"},
+		Keys:             []string{"aa"},
+	}
+	facade := mock.FacadeStub{
+		GetAccountsBulkCalled: func(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+			assert.Equal(t, expectedQuery, query)
+			assert.True(t, options.OnFinalBlock)
+
+			return []*common.AccountBulkResult{
+				{
+					Address: "alice",
+					Account: &api.AccountResponse{Address: "alice", Balance: "100"},
+					ESDTs: map[string]*esdt.ESDigitalToken{
+						"TKN-abcdef": {Value: big.NewInt(37)},
+					},
+					Values: map[string]string{"aa": "bb"},
+				},
+				{
+					Address: "bob",
+					Error:   "account error",
+				},
+			}, api.BlockInfo{Nonce: 7, RootHash: "cc"}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	body, _ := json.Marshal(expectedQuery)
+	req, _ := http.NewRequest("POST", "/address/bulk?onFinalBlock=true", strings.NewReader(string(body)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsBulkResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, api.BlockInfo{Nonce: 7, RootHash: "cc"}, response.Data.BlockInfo)
+	require.Len(t, response.Data.Accounts, 2)
+
+	alice := response.Data.Accounts[0]
+	assert.Equal(t, "alice", alice.Address)
+	assert.Equal(t, "100", alice.Account.Balance)
+	assert.Equal(t, "TKN-abcdef", alice.ESDTs["TKN-abcdef"].TokenIdentifier)
+	assert.Equal(t, "37", alice.ESDTs["TKN-abcdef"].Balance)
+	assert.Equal(t, map[string]string{"aa": "bb"}, alice.Values)
+	assert.Empty(t, alice.Error)
+
+	bob := response.Data.Accounts[1]
+	assert.Equal(t, "bob", bob.Address)
+	assert.Nil(t, bob.Account)
+	assert.Equal(t, "account error", bob.Error)
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -1042,6 +1299,8 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/bulk", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,70 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+
+	customErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/gin-gonic/gin"
+)
+
+// extractBlockCoordinates parses the optional block coordinates (blockNonce, blockHash or blockRootHash) used for
+// running smart contract queries and cost estimations against the state of a past block
+func extractBlockCoordinates(c *gin.Context) (process.BlockCoordinates, error) {
+	coordinates, err := parseBlockCoordinates(c)
+	if err != nil {
+		return process.BlockCoordinates{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	err = checkBlockCoordinates(coordinates)
+	if err != nil {
+		return process.BlockCoordinates{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return coordinates, nil
+}
+
+func parseBlockCoordinates(c *gin.Context) (process.BlockCoordinates, error) {
+	blockNonce, err := parseUint64UrlParam(c, urlParamBlockNonce)
+	if err != nil {
+		return process.BlockCoordinates{}, err
+	}
+
+	blockHash, err := parseHexBytesUrlParam(c, urlParamBlockHash)
+	if err != nil {
+		return process.BlockCoordinates{}, err
+	}
+
+	blockRootHash, err := parseHexBytesUrlParam(c, urlParamBlockRootHash)
+	if err != nil {
+		return process.BlockCoordinates{}, err
+	}
+
+	coordinates := process.BlockCoordinates{
+		Nonce:    blockNonce,
+		Hash:     blockHash,
+		RootHash: blockRootHash,
+	}
+	return coordinates, nil
+}
+
+func checkBlockCoordinates(coordinates process.BlockCoordinates) error {
+	numSpecifiedBlockCoordinates := 0
+
+	if coordinates.Nonce.HasValue {
+		numSpecifiedBlockCoordinates++
+	}
+	if len(coordinates.Hash) > 0 {
+		numSpecifiedBlockCoordinates++
+	}
+	if len(coordinates.RootHash) > 0 {
+		numSpecifiedBlockCoordinates++
+	}
+
+	if numSpecifiedBlockCoordinates > 1 {
+		return errors.New("only one block coordinate (blockNonce vs. blockHash vs. blockRootHash) can be specified at a time")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package groups
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractBlockCoordinates(t *testing.T) {
+	t.Run("good options", func(t *testing.T) {
+		coordinates, err := extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery(""))
+		require.Nil(t, err)
+		require.False(t, coordinates.IsSet())
+
+		coordinates, err = extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery("blockNonce=42"))
+		require.Nil(t, err)
+		require.Equal(t, core.OptionalUint64{Value: 42, HasValue: true}, coordinates.Nonce)
+
+		coordinates, err = extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa"))
+		require.Nil(t, err)
+		require.Equal(t, []byte{0xaa, 0xaa}, coordinates.Hash)
+
+		coordinates, err = extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery("blockRootHash=bbbb"))
+		require.Nil(t, err)
+		require.Equal(t, []byte{0xbb, 0xbb}, coordinates.RootHash)
+	})
+
+	t.Run("bad options", func(t *testing.T) {
+		coordinates, err := extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery("blockNonce=42&blockHash=aaaa"))
+		require.ErrorContains(t, err, "only one block coordinate")
+		require.Equal(t, process.BlockCoordinates{}, coordinates)
+
+		coordinates, err = extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery("blockNonce=foo"))
+		require.ErrorContains(t, err, "invalid syntax")
+		require.Equal(t, process.BlockCoordinates{}, coordinates)
+
+		coordinates, err = extractBlockCoordinates(testscommon.CreateGinContextWithRawQuery("blockRootHash=xyz"))
+		require.ErrorContains(t, err, "invalid byte")
+		require.Equal(t, process.BlockCoordinates{}, coordinates)
+	})
+}
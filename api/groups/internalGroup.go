@@ -1,6 +1,7 @@
 package groups
 
 import (
+	errorsGo "errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -12,6 +13,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/gin-gonic/gin"
 )
 
@@ -32,6 +35,21 @@ const (
 	getJSONShardBlockByRoundPath     = "/json/shardblock/by-round/:round"
 	getRawMiniBlockByHashPath        = "/raw/miniblock/by-hash/:hash/epoch/:epoch"
 	getJSONMiniBlockByHashPath       = "/json/miniblock/by-hash/:hash/epoch/:epoch"
+	getStateDiffPath                 = "/state-diff"
+	urlParamTo                       = "to"
+	startDbIntegrityCheckPath        = "/db-integrity/start"
+	getDbIntegrityReportPath         = "/db-integrity/report"
+	urlParamNumEpochs                = "numEpochs"
+	urlParamRefetch                  = "refetch"
+	defaultDbIntegrityNumEpochs      = 1
+	startDataTriesAnalysisPath       = "/data-tries/start"
+	getDataTriesReportPath           = "/data-tries/report"
+	urlParamRootHash                 = "rootHash"
+	urlParamNumLargest               = "numLargest"
+	urlParamSortBy                   = "sortBy"
+	defaultDataTriesNumLargest       = 20
+	maxDataTriesNumLargest           = 1000
+	p2pFaultsPath                    = "/p2p/faults"
 )
 
 // internalBlockFacadeHandler defines the methods to be implemented by a facade for handling block requests
@@ -44,9 +62,21 @@ type internalBlockFacadeHandler interface {
 	GetInternalMetaBlockByRound(format common.ApiOutputFormat, round uint64) (interface{}, error)
 	GetInternalMiniBlockByHash(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
 	GetInternalStartOfEpochMetaBlock(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetStateDiff(fromRootHash string, toRootHash string) (*common.StateDiffApiResponse, error)
+	StartDbIntegrityCheck(numEpochs uint32, refetch bool) error
+	GetDbIntegrityReport() (*common.DbIntegrityReport, error)
+	StartDataTriesAnalysis(rootHash string, numLargest uint32, sortBy string) error
+	GetDataTriesReport() (*common.DataTriesReport, error)
+	GetP2PFaultRules() ([]common.P2PFaultRuleStatus, error)
+	SetP2PFaultRules(rules []common.P2PFaultRule) error
 	IsInterfaceNil() bool
 }
 
+// P2PFaultRulesRequest represents the structure on which user input for replacing the p2p fault rules will validate against
+type P2PFaultRulesRequest struct {
+	Rules []common.P2PFaultRule `json:"rules"`
+}
+
 type internalBlockGroup struct {
 	*baseGroup
 	facade    internalBlockFacadeHandler
@@ -145,6 +175,41 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMiniBlockByHash,
 		},
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: ib.getStateDiff,
+		},
+		{
+			Path:    startDbIntegrityCheckPath,
+			Method:  http.MethodPost,
+			Handler: ib.startDbIntegrityCheck,
+		},
+		{
+			Path:    getDbIntegrityReportPath,
+			Method:  http.MethodGet,
+			Handler: ib.getDbIntegrityReport,
+		},
+		{
+			Path:    startDataTriesAnalysisPath,
+			Method:  http.MethodPost,
+			Handler: ib.startDataTriesAnalysis,
+		},
+		{
+			Path:    getDataTriesReportPath,
+			Method:  http.MethodGet,
+			Handler: ib.getDataTriesReport,
+		},
+		{
+			Path:    p2pFaultsPath,
+			Method:  http.MethodGet,
+			Handler: ib.getP2PFaultRules,
+		},
+		{
+			Path:    p2pFaultsPath,
+			Method:  http.MethodPost,
+			Handler: ib.setP2PFaultRules,
+		},
 	}
 	ib.endpoints = endpoints
 
@@ -450,6 +515,131 @@ func (ib *internalBlockGroup) getJSONMiniBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"miniblock": miniBlock}, "", shared.ReturnCodeSuccess)
 }
 
+func (ib *internalBlockGroup) getStateDiff(c *gin.Context) {
+	fromRootHash := c.Query(urlParamFrom)
+	if fromRootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, errors.ErrValidationEmptyRootHash)
+		return
+	}
+	toRootHash := c.Query(urlParamTo)
+	if toRootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	start := time.Now()
+	stateDiff, err := ib.getFacade().GetStateDiff(fromRootHash, toRootHash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetStateDiff")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"stateDiff": stateDiff}, "", shared.ReturnCodeSuccess)
+}
+
+func (ib *internalBlockGroup) startDbIntegrityCheck(c *gin.Context) {
+	numEpochs, err := parseUint32UrlParam(c, urlParamNumEpochs)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrStartDbIntegrityCheck, errors.ErrBadUrlParams)
+		return
+	}
+	if !numEpochs.HasValue {
+		numEpochs.Value = defaultDbIntegrityNumEpochs
+	}
+	refetch, err := parseBoolUrlParam(c, urlParamRefetch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrStartDbIntegrityCheck, errors.ErrBadUrlParams)
+		return
+	}
+
+	err = ib.getFacade().StartDbIntegrityCheck(numEpochs.Value, refetch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrStartDbIntegrityCheck, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"started": true}, "", shared.ReturnCodeSuccess)
+}
+
+func (ib *internalBlockGroup) getDbIntegrityReport(c *gin.Context) {
+	report, err := ib.getFacade().GetDbIntegrityReport()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDbIntegrityReport, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"report": report}, "", shared.ReturnCodeSuccess)
+}
+
+func (ib *internalBlockGroup) startDataTriesAnalysis(c *gin.Context) {
+	numLargest, err := parseUint32UrlParam(c, urlParamNumLargest)
+	if err != nil || numLargest.Value > maxDataTriesNumLargest {
+		shared.RespondWithValidationError(c, errors.ErrStartDataTriesAnalysis, errors.ErrBadUrlParams)
+		return
+	}
+	if !numLargest.HasValue || numLargest.Value == 0 {
+		numLargest.Value = defaultDataTriesNumLargest
+	}
+	sortBy := c.Query(urlParamSortBy)
+	if sortBy == "" {
+		sortBy = string(state.SortByNumNodes)
+	}
+	if sortBy != string(state.SortByNumNodes) && sortBy != string(state.SortBySizeInBytes) {
+		shared.RespondWithValidationError(c, errors.ErrStartDataTriesAnalysis, errors.ErrBadUrlParams)
+		return
+	}
+
+	err = ib.getFacade().StartDataTriesAnalysis(c.Query(urlParamRootHash), numLargest.Value, sortBy)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrStartDataTriesAnalysis, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"started": true}, "", shared.ReturnCodeSuccess)
+}
+
+func (ib *internalBlockGroup) getDataTriesReport(c *gin.Context) {
+	report, err := ib.getFacade().GetDataTriesReport()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDataTriesReport, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"report": report}, "", shared.ReturnCodeSuccess)
+}
+
+func (ib *internalBlockGroup) getP2PFaultRules(c *gin.Context) {
+	rules, err := ib.getFacade().GetP2PFaultRules()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetP2PFaultRules, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"rules": rules}, "", shared.ReturnCodeSuccess)
+}
+
+func (ib *internalBlockGroup) setP2PFaultRules(c *gin.Context) {
+	var request P2PFaultRulesRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ib.getFacade().SetP2PFaultRules(request.Rules)
+	if errorsGo.Is(err, p2p.ErrInvalidFaultRule) {
+		shared.RespondWithValidationError(c, errors.ErrSetP2PFaultRules, err)
+		return
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrSetP2PFaultRules, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"numRules": len(request.Rules)}, "", shared.ReturnCodeSuccess)
+}
+
 func (ib *internalBlockGroup) getFacade() internalBlockFacadeHandler {
 	ib.mutFacade.RLock()
 	defer ib.mutFacade.RUnlock()
@@ -3,6 +3,7 @@ package groups_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,8 +13,10 @@ import (
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1492,6 +1495,558 @@ func TestGetInternalMiniBlockByHash_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedOutput, response.Data.Block)
 }
 
+type stateDiffResponseData struct {
+	StateDiff common.StateDiffApiResponse `json:"stateDiff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestGetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing to root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewInternalBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/state-diff?from=aaaa", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stateDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyRootHash.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(_ string, _ string) (*common.StateDiffApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/state-diff?from=aaaa&to=bbbb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stateDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedOutput := common.StateDiffApiResponse{
+			Added:    []common.StateDiffKeyApiResponse{{Address: "erd1added", NewValue: "01"}},
+			Removed:  []common.StateDiffKeyApiResponse{{Address: "erd1removed", Key: "6b6579", OldValue: "02"}},
+			Modified: []common.StateDiffKeyApiResponse{},
+		}
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(fromRootHash string, toRootHash string) (*common.StateDiffApiResponse, error) {
+				assert.Equal(t, "aaaa", fromRootHash)
+				assert.Equal(t, "bbbb", toRootHash)
+				return &expectedOutput, nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/state-diff?from=aaaa&to=bbbb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stateDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedOutput, response.Data.StateDiff)
+	})
+}
+
+type dbIntegrityReportResponseData struct {
+	Report common.DbIntegrityReport `json:"report"`
+}
+
+type dbIntegrityReportResponse struct {
+	Data  dbIntegrityReportResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+func TestStartDbIntegrityCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid num epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewInternalBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/db-integrity/start?numEpochs=invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StartDbIntegrityCheckCalled: func(_ uint32, _ bool) error {
+				return expectedErr
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/db-integrity/start", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			StartDbIntegrityCheckCalled: func(numEpochs uint32, refetch bool) error {
+				wasCalled = true
+				assert.Equal(t, uint32(3), numEpochs)
+				assert.True(t, refetch)
+				return nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/db-integrity/start?numEpochs=3&refetch=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestGetDbIntegrityReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetDbIntegrityReportCalled: func() (*common.DbIntegrityReport, error) {
+				return nil, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/db-integrity/report", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := dbIntegrityReportResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReport := common.DbIntegrityReport{
+			FirstEpoch: 1,
+			LastEpoch:  2,
+			NumHeaders: 10,
+			MissingKeys: []common.DbIntegrityMissingKey{
+				{Unit: "MiniBlockUnit", Key: "aaaa", Epoch: 2, HeaderNonce: 7},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDbIntegrityReportCalled: func() (*common.DbIntegrityReport, error) {
+				return &expectedReport, nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/db-integrity/report", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := dbIntegrityReportResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedReport, response.Data.Report)
+	})
+}
+
+type dataTriesReportResponseData struct {
+	Report common.DataTriesReport `json:"report"`
+}
+
+type dataTriesReportResponse struct {
+	Data  dataTriesReportResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+func TestStartDataTriesAnalysis(t *testing.T) {
+	t.Parallel()
+
+	testInvalidUrlParams := func(t *testing.T, urlParams string) {
+		blockGroup, err := groups.NewInternalBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/data-tries/start?"+urlParams, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	}
+
+	t.Run("invalid num largest should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidUrlParams(t, "numLargest=invalid")
+	})
+	t.Run("too many largest data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidUrlParams(t, "numLargest=1001")
+	})
+	t.Run("invalid sort criterion should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidUrlParams(t, "sortBy=invalid")
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StartDataTriesAnalysisCalled: func(_ string, _ uint32, _ string) error {
+				return expectedErr
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/data-tries/start", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should use the default values", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			StartDataTriesAnalysisCalled: func(rootHash string, numLargest uint32, sortBy string) error {
+				wasCalled = true
+				assert.Equal(t, "", rootHash)
+				assert.Equal(t, uint32(20), numLargest)
+				assert.Equal(t, "numNodes", sortBy)
+				return nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/data-tries/start", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			StartDataTriesAnalysisCalled: func(rootHash string, numLargest uint32, sortBy string) error {
+				wasCalled = true
+				assert.Equal(t, "aabb", rootHash)
+				assert.Equal(t, uint32(5), numLargest)
+				assert.Equal(t, "sizeInBytes", sortBy)
+				return nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/data-tries/start?rootHash=aabb&numLargest=5&sortBy=sizeInBytes", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestGetDataTriesReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetDataTriesReportCalled: func() (*common.DataTriesReport, error) {
+				return nil, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/data-tries/report", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := dataTriesReportResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReport := common.DataTriesReport{
+			RootHash:      "aabb",
+			SortBy:        "numNodes",
+			NumAccounts:   10,
+			NumDataTries:  2,
+			TotalNumNodes: 30,
+			Largest: []common.DataTrieStatisticsApiResponse{
+				{Address: "erd1", RootHash: "ccdd", NumNodes: 20, NumLeaves: 12, Depth: 4, SizeInBytes: 2000},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDataTriesReportCalled: func() (*common.DataTriesReport, error) {
+				return &expectedReport, nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/data-tries/report", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := dataTriesReportResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedReport, response.Data.Report)
+	})
+}
+
+type p2pFaultRulesResponseData struct {
+	Rules []common.P2PFaultRuleStatus `json:"rules"`
+}
+
+type p2pFaultRulesResponse struct {
+	Data  p2pFaultRulesResponseData `json:"data"`
+	Error string                    `json:"error"`
+	Code  string                    `json:"code"`
+}
+
+func TestGetP2PFaultRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetP2PFaultRulesCalled: func() ([]common.P2PFaultRuleStatus, error) {
+				return nil, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/p2p/faults", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := p2pFaultRulesResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetP2PFaultRules.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRules := []common.P2PFaultRuleStatus{
+			{
+				Rule: common.P2PFaultRule{
+					Name:        "drop transactions",
+					Topic:       "transactions*",
+					Direction:   "inbound",
+					Fault:       "drop",
+					Probability: 0.5,
+				},
+				NumApplied: 7,
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetP2PFaultRulesCalled: func() ([]common.P2PFaultRuleStatus, error) {
+				return expectedRules, nil
+			},
+		}
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/internal/p2p/faults", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := p2pFaultRulesResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedRules, response.Data.Rules)
+	})
+}
+
+func TestSetP2PFaultRules(t *testing.T) {
+	t.Parallel()
+
+	sendRequest := func(facade *mock.FacadeStub, body string) (*httptest.ResponseRecorder, shared.GenericAPIResponse) {
+		blockGroup, err := groups.NewInternalBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/internal/p2p/faults", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		return resp, response
+	}
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp, response := sendRequest(&mock.FacadeStub{}, "invalid")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("invalid rule should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SetP2PFaultRulesCalled: func(_ []common.P2PFaultRule) error {
+				return fmt.Errorf("%w, empty rule name", p2p.ErrInvalidFaultRule)
+			},
+		}
+		resp, response := sendRequest(facade, `{"rules":[{"fault":"drop","probability":1}]}`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrSetP2PFaultRules.Error()))
+		assert.True(t, strings.Contains(response.Error, p2p.ErrInvalidFaultRule.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			SetP2PFaultRulesCalled: func(_ []common.P2PFaultRule) error {
+				return expectedErr
+			},
+		}
+		resp, response := sendRequest(facade, `{"rules":[]}`)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedRules []common.P2PFaultRule
+		facade := &mock.FacadeStub{
+			SetP2PFaultRulesCalled: func(rules []common.P2PFaultRule) error {
+				providedRules = rules
+				return nil
+			},
+		}
+		body := `{"rules":[{"name":"slow peer","peer":"16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk","fault":"delay","probability":1,"delayInMilliseconds":500}]}`
+		resp, _ := sendRequest(facade, body)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		expectedRules := []common.P2PFaultRule{
+			{
+				Name:                "slow peer",
+				Peer:                "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk",
+				Fault:               "delay",
+				Probability:         1,
+				DelayInMilliseconds: 500,
+			},
+		}
+		assert.Equal(t, expectedRules, providedRules)
+	})
+}
+
 func getInternalBlockRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -1513,6 +2068,12 @@ func getInternalBlockRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/json/shardblock/by-hash/:hash", Open: true},
 					{Name: "/json/shardblock/by-round/:round", Open: true},
 					{Name: "/json/miniblock/by-hash/:hash/epoch/:epoch", Open: true},
+					{Name: "/state-diff", Open: true},
+					{Name: "/db-integrity/start", Open: true},
+					{Name: "/db-integrity/report", Open: true},
+					{Name: "/data-tries/start", Open: true},
+					{Name: "/data-tries/report", Open: true},
+					{Name: "/p2p/faults", Open: true},
 				},
 			},
 		},
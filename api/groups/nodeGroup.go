@@ -3,6 +3,7 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -25,6 +26,11 @@ const (
 	peerInfoPath           = "/peerinfo"
 	statusPath             = "/status"
 	epochStartDataForEpoch = "/epoch-start/:epoch"
+	storageStatsPath       = "/storage-stats"
+	urlParamWithNumKeys    = "withNumKeys"
+	equivocationsPath      = "/consensus/equivocations"
+	consensusRoundsPath    = "/consensus/rounds"
+	p2pTrafficPath         = "/p2p/traffic"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -33,6 +39,10 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetStorageStatistics(withNumKeys bool) (*common.StorageStatsApiResponse, error)
+	GetEquivocationEvidences() ([]*common.EquivocationEvidence, error)
+	GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error)
+	GetP2PTraffic() (*common.P2PTrafficReport, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	IsInterfaceNil() bool
 }
@@ -96,6 +106,26 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.epochStartDataForEpoch,
 		},
+		{
+			Path:    storageStatsPath,
+			Method:  http.MethodGet,
+			Handler: ng.storageStatistics,
+		},
+		{
+			Path:    equivocationsPath,
+			Method:  http.MethodGet,
+			Handler: ng.equivocationEvidences,
+		},
+		{
+			Path:    consensusRoundsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRounds,
+		},
+		{
+			Path:    p2pTrafficPath,
+			Method:  http.MethodGet,
+			Handler: ng.p2pTraffic,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -266,6 +296,56 @@ func (ng *nodeGroup) epochStartDataForEpoch(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochStart": epochStartData})
 }
 
+// storageStatistics returns the disk usage, the number of keys and the cache hit ratio of each storage unit
+func (ng *nodeGroup) storageStatistics(c *gin.Context) {
+	withNumKeys, err := parseBoolUrlParam(c, urlParamWithNumKeys)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	storageStats, err := ng.getFacade().GetStorageStatistics(withNumKeys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStorageStatistics, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"storageStats": storageStats})
+}
+
+// equivocationEvidences returns the evidences of the validators caught signing conflicting consensus messages
+func (ng *nodeGroup) equivocationEvidences(c *gin.Context) {
+	evidences, err := ng.getFacade().GetEquivocationEvidences()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetEquivocationEvidences, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"evidences": evidences})
+}
+
+// consensusRounds returns the timeline of the subrounds and of the received consensus messages for the last rounds
+func (ng *nodeGroup) consensusRounds(c *gin.Context) {
+	rounds, err := ng.getFacade().GetConsensusRounds()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetConsensusRounds, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rounds": rounds})
+}
+
+// p2pTraffic returns the bytes and messages received and sent by the node, per topic and per peer
+func (ng *nodeGroup) p2pTraffic(c *gin.Context) {
+	traffic, err := ng.getFacade().GetP2PTraffic()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetP2PTraffic, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"traffic": traffic})
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
@@ -281,12 +361,90 @@ func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 		return
 	}
 
+	// the p2p traffic is not available while the node is starting, in which case only the status metrics are returned
+	traffic, err := ng.getFacade().GetP2PTraffic()
+	if err == nil {
+		metrics += p2pTrafficPrometheusString(traffic, ng.getShardID())
+	}
+
 	c.String(
 		http.StatusOK,
 		metrics,
 	)
 }
 
+// getShardID returns the shard ID from the status metrics, the same one labeling all the other prometheus metrics
+func (ng *nodeGroup) getShardID() uint64 {
+	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsMapWithoutP2P()
+	if err != nil {
+		return 0
+	}
+
+	shardID, _ := metrics[common.MetricShardId].(uint64)
+
+	return shardID
+}
+
+// p2pTrafficPrometheusString returns the p2p traffic of all the topics and of the top talkers in the prometheus format
+func p2pTrafficPrometheusString(traffic *common.P2PTrafficReport, shardID uint64) string {
+	if traffic == nil {
+		return ""
+	}
+
+	stringBuilder := strings.Builder{}
+	for _, topicTraffic := range traffic.Topics {
+		label := fmt.Sprintf("%s=\"%d\",topic=%q", common.MetricShardId, shardID, topicTraffic.Name)
+		writeP2PTrafficStatistics(&stringBuilder, topicTraffic, label,
+			common.MetricNetworkTopicBytes, common.MetricNetworkTopicMessages,
+			common.MetricNetworkTopicBytesInWindow, common.MetricNetworkTopicMessagesInWindow)
+	}
+
+	topTalkers := make(map[string]struct{}, len(traffic.TopTalkers))
+	for _, pid := range traffic.TopTalkers {
+		topTalkers[pid] = struct{}{}
+	}
+	for _, peerTraffic := range traffic.Peers {
+		_, isTopTalker := topTalkers[peerTraffic.Name]
+		if !isTopTalker {
+			continue
+		}
+
+		label := fmt.Sprintf("%s=\"%d\",peer=%q", common.MetricShardId, shardID, peerTraffic.Name)
+		writeP2PTrafficStatistics(&stringBuilder, peerTraffic, label,
+			common.MetricNetworkPeerBytes, common.MetricNetworkPeerMessages,
+			common.MetricNetworkPeerBytesInWindow, common.MetricNetworkPeerMessagesInWindow)
+	}
+
+	return stringBuilder.String()
+}
+
+func writeP2PTrafficStatistics(
+	stringBuilder *strings.Builder,
+	statistics common.P2PTrafficStatistics,
+	label string,
+	bytesMetric string,
+	messagesMetric string,
+	bytesInWindowMetric string,
+	messagesInWindowMetric string,
+) {
+	directions := []struct {
+		name     string
+		counters common.P2PTrafficCounters
+		inWindow common.P2PTrafficCounters
+	}{
+		{name: "inbound", counters: statistics.Inbound, inWindow: statistics.InboundInWindow},
+		{name: "outbound", counters: statistics.Outbound, inWindow: statistics.OutboundInWindow},
+	}
+
+	for _, direction := range directions {
+		labels := fmt.Sprintf("%s,direction=%q", label, direction.name)
+		stringBuilder.WriteString(fmt.Sprintf("%s{%s} %d\n", bytesMetric, labels, direction.counters.Bytes))
+		stringBuilder.WriteString(fmt.Sprintf("%s{%s} %d\n", messagesMetric, labels, direction.counters.Messages))
+		stringBuilder.WriteString(fmt.Sprintf("%s{%s} %d\n", bytesInWindowMetric, labels, direction.inWindow.Bytes))
+		stringBuilder.WriteString(fmt.Sprintf("%s{%s} %d\n", messagesInWindowMetric, labels, direction.inWindow.Messages))
+	}
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
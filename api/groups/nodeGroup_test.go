@@ -68,6 +68,34 @@ type epochStartResponse struct {
 	generalResponse
 }
 
+type storageStatsResponse struct {
+	Data struct {
+		StorageStats common.StorageStatsApiResponse `json:"storageStats"`
+	} `json:"data"`
+	generalResponse
+}
+
+type equivocationEvidencesResponse struct {
+	Data struct {
+		Evidences []*common.EquivocationEvidence `json:"evidences"`
+	} `json:"data"`
+	generalResponse
+}
+
+type consensusRoundsResponse struct {
+	Data struct {
+		Rounds []*common.ConsensusRoundTimeline `json:"rounds"`
+	} `json:"data"`
+	generalResponse
+}
+
+type p2pTrafficResponse struct {
+	Data struct {
+		Traffic *common.P2PTrafficReport `json:"traffic"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -455,6 +483,336 @@ func TestEpochStartData_ShouldWork(t *testing.T) {
 	require.Equal(t, *expectedEpochStartData, response.Data.EpochStartDataAPI)
 }
 
+func TestStorageStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url param should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetStorageStatisticsCalled: func(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/storage-stats?withNumKeys=invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetStorageStatisticsCalled: func(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/storage-stats", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStats := &common.StorageStatsApiResponse{
+			SizeInBytes:    1024,
+			NumCacheHits:   3,
+			NumCacheMisses: 1,
+			Units: []common.StorageUnitStats{
+				{
+					Unit:          "TransactionUnit",
+					SizeInBytes:   1024,
+					NumKeys:       7,
+					CacheHitRatio: 0.75,
+					Databases:     []common.StorageDatabaseStats{},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetStorageStatisticsCalled: func(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+				assert.True(t, withNumKeys)
+				return expectedStats, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/storage-stats?withNumKeys=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &storageStatsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, *expectedStats, response.Data.StorageStats)
+	})
+}
+
+func TestEquivocationEvidences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetEquivocationEvidencesCalled: func() ([]*common.EquivocationEvidence, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/equivocations", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvidences := []*common.EquivocationEvidence{
+			{
+				PublicKey: []byte("public key"),
+				ShardID:   1,
+				Round:     37,
+				Kind:      "signature",
+				FirstMessage: common.SignedConsensusMessage{
+					MessageType: "(SIGNATURE)",
+					HeaderHash:  []byte("hash1"),
+					Payload:     []byte("payload1"),
+				},
+				SecondMessage: common.SignedConsensusMessage{
+					MessageType: "(SIGNATURE)",
+					HeaderHash:  []byte("hash2"),
+					Payload:     []byte("payload2"),
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetEquivocationEvidencesCalled: func() ([]*common.EquivocationEvidence, error) {
+				return expectedEvidences, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/equivocations", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &equivocationEvidencesResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, expectedEvidences, response.Data.Evidences)
+	})
+}
+
+func TestConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetConsensusRoundsCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRounds := []*common.ConsensusRoundTimeline{
+			{
+				Round: 12,
+				Subrounds: []common.ConsensusSubroundTimeline{
+					{
+						Name:       "(BLOCK)",
+						StartedAt:  1000,
+						EndedAt:    1250,
+						IsFinished: true,
+						Messages: []common.ConsensusMessageTimeline{
+							{
+								MessageType: "(BLOCK_BODY_AND_HEADER)",
+								PublicKey:   "aabb",
+								PeerID:      "pid",
+								ReceivedAt:  1100,
+								IsValid:     true,
+							},
+						},
+					},
+				},
+				BlockProcessingDurationMs: 120,
+			},
+		}
+		facade := mock.FacadeStub{
+			GetConsensusRoundsCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+				return expectedRounds, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &consensusRoundsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, expectedRounds, response.Data.Rounds)
+	})
+}
+
+func createP2PTrafficReport() *common.P2PTrafficReport {
+	return &common.P2PTrafficReport{
+		WindowInSeconds: 60,
+		Topics: []common.P2PTrafficStatistics{
+			{
+				Name:             "transactions_0",
+				Inbound:          common.P2PTrafficCounters{Bytes: 1000, Messages: 10},
+				Outbound:         common.P2PTrafficCounters{Bytes: 500, Messages: 5},
+				InboundInWindow:  common.P2PTrafficCounters{Bytes: 100, Messages: 1},
+				OutboundInWindow: common.P2PTrafficCounters{Bytes: 50, Messages: 1},
+			},
+		},
+		Peers: []common.P2PTrafficStatistics{
+			{
+				Name:            "pid1",
+				Inbound:         common.P2PTrafficCounters{Bytes: 700, Messages: 7},
+				InboundInWindow: common.P2PTrafficCounters{Bytes: 100, Messages: 1},
+			},
+			{
+				Name:    "pid2",
+				Inbound: common.P2PTrafficCounters{Bytes: 300, Messages: 3},
+			},
+		},
+		TopTalkers: []string{"pid1"},
+	}
+}
+
+func TestP2PTraffic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetP2PTrafficCalled: func() (*common.P2PTrafficReport, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/p2p/traffic", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetP2PTraffic.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTraffic := createP2PTrafficReport()
+		facade := mock.FacadeStub{
+			GetP2PTrafficCalled: func() (*common.P2PTrafficReport, error) {
+				return expectedTraffic, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/p2p/traffic", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &p2pTrafficResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, expectedTraffic, response.Data.Traffic)
+	})
+}
+
 func TestPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
 	expectedErr := errors.New("i am an error")
 
@@ -513,6 +871,43 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestPrometheusMetrics_ShouldIncludeTheP2PTraffic(t *testing.T) {
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	statusMetricsProvider.SetUInt64Value("test-key", 37)
+	statusMetricsProvider.SetUInt64Value(common.MetricShardId, 1)
+
+	facade := mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return statusMetricsProvider
+		},
+		GetP2PTrafficCalled: func() (*common.P2PTrafficReport, error) {
+			return createP2PTrafficReport(), nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	assert.True(t, strings.Contains(respStr, "test-key"))
+	assert.True(t, strings.Contains(respStr, `erd_network_topic_bytes{erd_shard_id="1",topic="transactions_0",direction="inbound"} 1000`+"\n"))
+	assert.True(t, strings.Contains(respStr, `erd_network_topic_messages{erd_shard_id="1",topic="transactions_0",direction="outbound"} 5`+"\n"))
+	assert.True(t, strings.Contains(respStr, `erd_network_topic_bytes_in_window{erd_shard_id="1",topic="transactions_0",direction="outbound"} 50`+"\n"))
+	assert.True(t, strings.Contains(respStr, `erd_network_peer_bytes{erd_shard_id="1",peer="pid1",direction="inbound"} 700`+"\n"))
+	assert.True(t, strings.Contains(respStr, `erd_network_peer_messages_in_window{erd_shard_id="1",peer="pid1",direction="inbound"} 1`+"\n"))
+	// only the top talkers are exported
+	assert.False(t, strings.Contains(respStr, "pid2"))
+}
+
 func loadResponseAsString(rsp io.Reader, response *statusResponse) {
 	buff, err := ioutil.ReadAll(rsp)
 	if err != nil {
@@ -535,6 +930,10 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
 					{Name: "/epoch-start/:epoch", Open: true},
+					{Name: "/storage-stats", Open: true},
+					{Name: "/consensus/equivocations", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/p2p/traffic", Open: true},
 				},
 			},
 		},
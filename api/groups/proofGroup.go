@@ -20,10 +20,23 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/root-hash/:roothash/keys"
+	getMultiProofDataTrieEndpoint   = "/proof/root-hash/:roothash/address/:address/keys"
+	getRangeProofDataTrieEndpoint   = "/proof/root-hash/:roothash/address/:address/range"
+	verifyMultiProofEndpoint        = "/proof/verify-multi"
+	verifyRangeProofEndpoint        = "/proof/verify-range"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/root-hash/:roothash/keys"
+	getMultiProofDataTriePath       = "/root-hash/:roothash/address/:address/keys"
+	getRangeProofDataTriePath       = "/root-hash/:roothash/address/:address/range"
+	verifyMultiProofPath            = "/verify-multi"
+	verifyRangeProofPath            = "/verify-range"
+	urlParamStartKey                = "startKey"
+	urlParamEndKey                  = "endKey"
+	urlParamMaxLeaves               = "maxLeaves"
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +45,11 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error)
+	VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +116,61 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getMultiProofDataTriePath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getRangeProofDataTriePath,
+			Method:  http.MethodGet,
+			Handler: pg.getRangeProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getRangeProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyRangeProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyRangeProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyRangeProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +184,32 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// MultiProofRequest represents the keys for which a single Merkle proof is requested
+type MultiProofRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// VerifyMultiProofRequest represents the parameters needed to verify a multi key Merkle proof
+type VerifyMultiProofRequest struct {
+	RootHash string   `json:"roothash"`
+	Keys     []string `json:"keys"`
+	Proof    []string `json:"proof"`
+}
+
+// VerifyRangeProofRequest represents the parameters needed to verify a range Merkle proof
+type VerifyRangeProofRequest struct {
+	RootHash string   `json:"roothash"`
+	StartKey string   `json:"startKey"`
+	EndKey   string   `json:"endKey"`
+	Proof    []string `json:"proof"`
+}
+
+// TrieLeafResponse represents a trie leaf returned along with a Merkle proof
+type TrieLeafResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -353,6 +452,199 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	)
 }
 
+// getMultiProof will receive a rootHash and a list of addresses from the client, and it will return a single Merkle
+// proof for all of them, proving which addresses exist in the state
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	var request = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(rootHash, request.Keys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":       bytesToHex(response.Proof),
+		"leaves":      leavesToResponse(response.Leaves),
+		"missingKeys": bytesToHex(response.MissingKeys),
+		"rootHash":    response.RootHash,
+	})
+}
+
+// getMultiProofDataTrie will receive a rootHash, an address and a list of keys from the client, and it will return the
+// Merkle proof for the address and a single Merkle proof for all the keys, proving which keys exist in the data trie
+func (pg *proofGroup) getMultiProofDataTrie(c *gin.Context) {
+	rootHash, address, ok := getRootHashAndAddressParams(c)
+	if !ok {
+		return
+	}
+
+	var request = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetMultiProofDataTrie(rootHash, address, request.Keys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs": gin.H{
+			"mainProof":     bytesToHex(mainTrieResponse.Proof),
+			"dataTrieProof": bytesToHex(dataTrieResponse.Proof),
+		},
+		"leaves":           leavesToResponse(dataTrieResponse.Leaves),
+		"missingKeys":      bytesToHex(dataTrieResponse.MissingKeys),
+		"dataTrieRootHash": dataTrieResponse.RootHash,
+	})
+}
+
+// getRangeProofDataTrie will receive a rootHash, an address and an optional range of keys from the client, and it will
+// return the Merkle proof for the address and a single Merkle proof for all the data trie keys found in the range. The
+// range holds the keys between the start key, included, and the end key, excluded, in key bytes order
+func (pg *proofGroup) getRangeProofDataTrie(c *gin.Context) {
+	rootHash, address, ok := getRootHashAndAddressParams(c)
+	if !ok {
+		return
+	}
+
+	maxLeaves, err := parseUint32UrlParam(c, urlParamMaxLeaves)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	startKey := c.Request.URL.Query().Get(urlParamStartKey)
+	endKey := c.Request.URL.Query().Get(urlParamEndKey)
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetRangeProofDataTrie(rootHash, address, startKey, endKey, int(maxLeaves.Value))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs": gin.H{
+			"mainProof":     bytesToHex(mainTrieResponse.Proof),
+			"dataTrieProof": bytesToHex(dataTrieResponse.Proof),
+		},
+		"leaves":           leavesToResponse(dataTrieResponse.Leaves),
+		"endKey":           hex.EncodeToString(dataTrieResponse.EndKey),
+		"dataTrieRootHash": dataTrieResponse.RootHash,
+	})
+}
+
+// verifyMultiProof will receive a rootHash, a list of keys and a Merkle proof from the client, and it will return the
+// keys proven to exist, with their values, and the keys proven to be missing
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	var request = &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proof, err := hexToBytes(request.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().VerifyMultiProof(request.RootHash, request.Keys, proof)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"leaves":      leavesToResponse(response.Leaves),
+		"missingKeys": bytesToHex(response.MissingKeys),
+	})
+}
+
+// verifyRangeProof will receive a rootHash, a range of keys and a Merkle proof from the client, and it will return all
+// the leaves proven to be in the range
+func (pg *proofGroup) verifyRangeProof(c *gin.Context) {
+	var request = &VerifyRangeProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proof, err := hexToBytes(request.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().VerifyRangeProof(request.RootHash, request.StartKey, request.EndKey, proof)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"leaves": leavesToResponse(response.Leaves),
+	})
+}
+
+func getRootHashAndAddressParams(c *gin.Context) (string, string, bool) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return "", "", false
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return "", "", false
+	}
+
+	return rootHash, address, true
+}
+
+func hexToBytes(hexValues []string) ([][]byte, error) {
+	bytesValues := make([][]byte, 0, len(hexValues))
+	for _, hexValue := range hexValues {
+		bytesValue, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, err
+		}
+
+		bytesValues = append(bytesValues, bytesValue)
+	}
+
+	return bytesValues, nil
+}
+
+func leavesToResponse(leaves []common.TrieLeafData) []TrieLeafResponse {
+	response := make([]TrieLeafResponse, 0, len(leaves))
+	for _, leaf := range leaves {
+		response = append(response, TrieLeafResponse{
+			Key:   hex.EncodeToString(leaf.Key),
+			Value: hex.EncodeToString(leaf.Value),
+		})
+	}
+
+	return response
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -379,6 +379,268 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/keys", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/keys", bytes.NewBuffer([]byte(`{"keys":["addr"]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, []string{"addr1", "addr2"}, addresses)
+				return &common.GetMultiProofResponse{
+					Proof:       [][]byte{[]byte("proof")},
+					Leaves:      []common.TrieLeafData{{Key: []byte("addr1"), Value: []byte("account")}},
+					MissingKeys: [][]byte{[]byte("addr2")},
+					RootHash:    "roothash",
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/keys", bytes.NewBuffer([]byte(`{"keys":["addr1","addr2"]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		responseMap := response.Data.(map[string]interface{})
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("proof"))}, responseMap["proof"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("addr2"))}, responseMap["missingKeys"])
+		leaves := responseMap["leaves"].([]interface{})
+		require.Equal(t, 1, len(leaves))
+		assert.Equal(t, hex.EncodeToString([]byte("account")), leaves[0].(map[string]interface{})["value"])
+	})
+}
+
+func TestGetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetMultiProofDataTrieCalled: func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, []string{"6b6579"}, keys)
+			return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+				&common.GetMultiProofResponse{
+					Proof:    [][]byte{[]byte("data")},
+					Leaves:   []common.TrieLeafData{{Key: []byte("key"), Value: []byte("value")}},
+					RootHash: "dataTrieRootHash",
+				}, nil
+		},
+	}
+	proofGroup, _ := groups.NewProofGroup(facade)
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/keys", bytes.NewBuffer([]byte(`{"keys":["6b6579"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	responseMap := response.Data.(map[string]interface{})
+	proofs := responseMap["proofs"].(map[string]interface{})
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("main"))}, proofs["mainProof"])
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("data"))}, proofs["dataTrieProof"])
+	assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+}
+
+func TestGetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range?maxLeaves=abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRangeProofDataTrieCalled: func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "addr", address)
+				assert.Equal(t, "aa", startKey)
+				assert.Equal(t, "", endKey)
+				assert.Equal(t, 10, maxLeaves)
+				return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+					&common.GetMultiProofResponse{
+						Proof:  [][]byte{[]byte("data")},
+						EndKey: []byte("end"),
+					}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range?startKey=aa&maxLeaves=10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		responseMap := response.Data.(map[string]interface{})
+		assert.Equal(t, hex.EncodeToString([]byte("end")), responseMap["endKey"])
+		assert.Equal(t, []interface{}{}, responseMap["leaves"])
+	})
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		body := `{"roothash":"aa","keys":["bb"],"proof":["not hex"]}`
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+				assert.Equal(t, "aa", rootHash)
+				assert.Equal(t, []string{"bb", "cc"}, keys)
+				assert.Equal(t, [][]byte{{0xdd}}, proof)
+				return &common.VerifyMultiProofResponse{
+					Leaves:      []common.TrieLeafData{{Key: []byte{0xbb}, Value: []byte{0xee}}},
+					MissingKeys: [][]byte{{0xcc}},
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		body := `{"roothash":"aa","keys":["bb","cc"],"proof":["dd"]}`
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		responseMap := response.Data.(map[string]interface{})
+		assert.Equal(t, []interface{}{"cc"}, responseMap["missingKeys"])
+		leaves := responseMap["leaves"].([]interface{})
+		require.Equal(t, 1, len(leaves))
+		assert.Equal(t, "ee", leaves[0].(map[string]interface{})["value"])
+	})
+}
+
+func TestVerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			VerifyRangeProofCalled: func(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		body := `{"roothash":"aa","proof":["dd"]}`
+		req, _ := http.NewRequest("POST", "/proof/verify-range", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyRangeProofCalled: func(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+				assert.Equal(t, "aa", rootHash)
+				assert.Equal(t, "01", startKey)
+				assert.Equal(t, "02", endKey)
+				return &common.VerifyMultiProofResponse{
+					Leaves: []common.TrieLeafData{{Key: []byte{0x01}, Value: []byte{0xee}}},
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		body := `{"roothash":"aa","startKey":"01","endKey":"02","proof":["dd"]}`
+		req, _ := http.NewRequest("POST", "/proof/verify-range", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		leaves := response.Data.(map[string]interface{})["leaves"].([]interface{})
+		require.Equal(t, 1, len(leaves))
+		assert.Equal(t, "01", leaves[0].(map[string]interface{})["key"])
+	})
+}
+
 func getProofRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -388,6 +650,11 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/root-hash/:roothash/keys", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/keys", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/range", Open: true},
+					{Name: "/verify-multi", Open: true},
+					{Name: "/verify-range", Open: true},
 				},
 			},
 		},
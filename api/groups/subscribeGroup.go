@@ -0,0 +1,115 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const eventsPath = "/events"
+
+// subscribeFacadeHandler defines the methods to be implemented by a facade for handling websocket subscriptions
+type subscribeFacadeHandler interface {
+	Subscribe(filter subscriptions.Filter) (subscriptions.Subscription, error)
+	Unsubscribe(id uint64)
+	IsInterfaceNil() bool
+}
+
+type subscribeGroup struct {
+	*baseGroup
+	facade      subscribeFacadeHandler
+	mutFacade   sync.RWMutex
+	marshalizer marshal.Marshalizer
+	upgrader    websocket.Upgrader
+}
+
+// NewSubscribeGroup returns a new instance of subscribeGroup
+func NewSubscribeGroup(facade subscribeFacadeHandler) (*subscribeGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for subscribe group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &subscribeGroup{
+		facade:      facade,
+		baseGroup:   &baseGroup{},
+		marshalizer: &marshal.JsonMarshalizer{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    eventsPath,
+			Method:  http.MethodGet,
+			Handler: sg.events,
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// events upgrades the connection to a websocket and pushes the committed blocks, transactions and logs
+// matching the filter sent by the client as its first message
+func (sg *subscribeGroup) events(c *gin.Context) {
+	conn, err := sg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("cannot upgrade the subscribe connection", "error", err.Error())
+		return
+	}
+
+	sender, err := events.NewEventsSender(events.ArgsEventsSender{
+		Marshalizer:          sg.marshalizer,
+		Conn:                 conn,
+		SubscriptionsHandler: sg.getFacade(),
+		Log:                  log,
+	})
+	if err != nil {
+		log.Error("cannot create the events sender", "error", err.Error())
+		_ = conn.Close()
+		return
+	}
+
+	sender.StartSendingBlocking()
+}
+
+func (sg *subscribeGroup) getFacade() subscribeFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *subscribeGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(subscribeFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *subscribeGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
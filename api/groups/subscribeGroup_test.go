@@ -0,0 +1,140 @@
+package groups_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type subscriptionMock struct {
+	messages chan *subscriptions.Message
+}
+
+func (sm *subscriptionMock) ID() uint64 {
+	return 1
+}
+
+func (sm *subscriptionMock) Messages() <-chan *subscriptions.Message {
+	return sm.messages
+}
+
+func (sm *subscriptionMock) IsInterfaceNil() bool {
+	return sm == nil
+}
+
+func getSubscribeRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscribe": {
+				Routes: []config.RouteConfig{
+					{Name: "/events", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func dialSubscribeEvents(t *testing.T, facade *mock.FacadeStub) *websocket.Conn {
+	subscribeGroup, err := groups.NewSubscribeGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(subscribeGroup, "subscribe", getSubscribeRoutesConfig())
+	server := httptest.NewServer(ws)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscribe/events"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func TestNewSubscribeGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSubscribeGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSubscribeGroup_EventsSubscribeFailsShouldSendError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		SubscribeCalled: func(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+			return nil, expectedErr
+		},
+	}
+
+	conn := dialSubscribeEvents(t, facade)
+	err := conn.WriteMessage(websocket.TextMessage, []byte(`{}`))
+	require.NoError(t, err)
+
+	_, payload, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), expectedErr.Error())
+}
+
+func TestSubscribeGroup_EventsShouldPushMessages(t *testing.T) {
+	t.Parallel()
+
+	sub := &subscriptionMock{messages: make(chan *subscriptions.Message, 1)}
+	chanUnsubscribed := make(chan struct{})
+	facade := &mock.FacadeStub{
+		SubscribeCalled: func(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+			assert.Equal(t, []string{"erd1"}, filter.Addresses)
+			return sub, nil
+		},
+		UnsubscribeCalled: func(id uint64) {
+			select {
+			case <-chanUnsubscribed:
+			default:
+				close(chanUnsubscribed)
+				close(sub.messages)
+			}
+		},
+	}
+
+	conn := dialSubscribeEvents(t, facade)
+	err := conn.WriteMessage(websocket.TextMessage, []byte(`{"addresses":["erd1"]}`))
+	require.NoError(t, err)
+
+	received := &subscriptions.Message{}
+	err = conn.ReadJSON(received)
+	require.NoError(t, err)
+	assert.Equal(t, "subscribed", received.Type)
+
+	sub.messages <- &subscriptions.Message{Type: subscriptions.EventFinalized, Data: &subscriptions.FinalizedEvent{Hash: "aa"}}
+
+	_, payload, err := conn.ReadMessage()
+	require.NoError(t, err)
+	received = &subscriptions.Message{}
+	_ = json.Unmarshal(payload, received)
+	assert.Equal(t, subscriptions.EventFinalized, received.Type)
+
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	<-chanUnsubscribed
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/gin-gonic/gin"
 )
@@ -25,11 +26,13 @@ const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
+	simulateMultipleTxsEndpoint      = "/transaction/simulate-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
+	simulateMultiplePath             = "/simulate-multiple"
 	getTransactionPath               = "/:txhash"
 	getTransactionsPool              = "/pool"
 
@@ -39,6 +42,10 @@ const (
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
+	queryParamStopOnFailure  = "stopOnFailure"
+	queryParamTrace          = "trace"
+
+	maxNumOfTxsToSimulate = 100
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -49,12 +56,14 @@ type transactionFacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsExecution(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -100,6 +109,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateMultiplePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateMultipleTransactions,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateMultipleTxsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
@@ -215,6 +235,19 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := parseBoolUrlParam(c, queryParamTrace)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
 	tx, txHash, err := tg.getFacade().CreateTransaction(
 		gtx.Nonce,
@@ -275,6 +308,9 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	executionResults.Hash = hex.EncodeToString(txHash)
+	if !withTrace {
+		executionResults.Trace = nil
+	}
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
@@ -285,6 +321,161 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateMultipleTransactions will receive an ordered list of transactions from the client and will simulate their
+// execution on the same throwaway state, each transaction seeing the changes of the previous ones, returning the results
+func (tg *transactionGroup) simulateMultipleTransactions(c *gin.Context) {
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtxs) == 0 || len(gtxs) > maxNumOfTxsToSimulate {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s, maximum %d", errors.ErrValidation.Error(), errors.ErrInvalidNumOfTransactionsToSimulate.Error(), maxNumOfTxsToSimulate),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	stopOnFailure, err := parseBoolUrlParam(c, queryParamStopOnFailure)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withTrace, err := parseBoolUrlParam(c, queryParamTrace)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	txsHashes := make([]string, 0, len(gtxs))
+	for idx, receivedTx := range gtxs {
+		start := time.Now()
+		tx, txHash, errCreate := tg.getFacade().CreateTransaction(
+			receivedTx.Nonce,
+			receivedTx.Value,
+			receivedTx.Receiver,
+			receivedTx.ReceiverUsername,
+			receivedTx.Sender,
+			receivedTx.SenderUsername,
+			receivedTx.GasPrice,
+			receivedTx.GasLimit,
+			receivedTx.Data,
+			receivedTx.Signature,
+			receivedTx.ChainID,
+			receivedTx.Version,
+			receivedTx.Options,
+		)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction with index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		start = time.Now()
+		errValidate := tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+		if errValidate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction with index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errValidate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().SimulateTransactionsExecution(txs, stopOnFailure)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, executionResult := range executionResults {
+		if executionResult == nil {
+			continue
+		}
+		if idx < len(txsHashes) {
+			executionResult.Hash = txsHashes[idx]
+		}
+		if !withTrace {
+			executionResult.Trace = nil
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var gtx = SendTxRequest{}
@@ -523,6 +714,19 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
+	coordinates, err := extractBlockCoordinates(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
 	tx, _, err := tg.getFacade().CreateTransaction(
 		gtx.Nonce,
@@ -553,7 +757,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	}
 
 	start = time.Now()
-	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx)
+	cost, err := tg.computeTransactionCost(tx, coordinates)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ComputeTransactionGasLimit")
 	if err != nil {
 		c.JSON(
@@ -577,6 +781,14 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+func (tg *transactionGroup) computeTransactionCost(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	if coordinates.IsSet() {
+		return tg.getFacade().ComputeTransactionGasLimitInBlock(tx, coordinates)
+	}
+
+	return tg.getFacade().ComputeTransactionGasLimit(tx)
+}
+
 // getTransactionsPool returns the transactions details in the pool
 func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	// extract and validate query parameters
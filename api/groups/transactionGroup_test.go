@@ -20,6 +20,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -556,6 +558,58 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	assert.Equal(t, expectedGasLimit, txCostResp.Data.Cost)
 }
 
+func TestComputeTransactionGasLimit_WithBlockCoordinates(t *testing.T) {
+	t.Parallel()
+
+	expectedGasLimit := uint64(37)
+
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, nil, nil
+		},
+		ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			require.Fail(t, "should have called the historical cost estimation")
+			return nil, nil
+		},
+		ComputeTransactionGasLimitInBlockHandler: func(tx *dataTx.Transaction, coordinates process.BlockCoordinates) (*dataTx.CostResponse, error) {
+			require.Equal(t, []byte{0xbb, 0xbb}, coordinates.RootHash)
+			return &dataTx.CostResponse{
+				GasUnits: expectedGasLimit,
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+
+	t.Run("should work", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/cost?blockRootHash=bbbb", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txCostResp := transactionCostResponse{}
+		loadResponse(resp.Body, &txCostResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedGasLimit, txCostResp.Data.Cost)
+	})
+	t.Run("bad coordinates should error", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/cost?blockRootHash=bbbb&blockNonce=7", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txCostResp := transactionCostResponse{}
+		loadResponse(resp.Body, &txCostResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, txCostResp.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+}
+
 func TestSimulateTransaction_BadRequestShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -864,6 +918,65 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_TraceQueryParameter(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+			return &txSimData.SimulationResults{
+				Status: "success",
+				Trace: &tracing.ExecutionTrace{
+					Root: &tracing.CallFrame{Function: "function"},
+				},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+	simulate := func(url string) (int, map[string]interface{}) {
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		if response.Data == nil {
+			return resp.Code, nil
+		}
+
+		result := response.Data.(map[string]interface{})["result"].(map[string]interface{})
+		return resp.Code, result
+	}
+
+	t.Run("trace is omitted by default", func(t *testing.T) {
+		code, result := simulate("/transaction/simulate")
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotContains(t, result, "trace")
+	})
+	t.Run("trace is returned when requested", func(t *testing.T) {
+		code, result := simulate("/transaction/simulate?trace=true")
+		assert.Equal(t, http.StatusOK, code)
+		require.Contains(t, result, "trace")
+		root := result["trace"].(map[string]interface{})["root"].(map[string]interface{})
+		assert.Equal(t, "function", root["function"])
+	})
+	t.Run("invalid trace parameter should error", func(t *testing.T) {
+		code, _ := simulate("/transaction/simulate?trace=not-a-bool")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
 func TestGetTransactionsPoolShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -1178,8 +1291,191 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-multiple", Open: true},
 				},
 			},
 		},
 	}
 }
+
+type simulateMultipleTxsResponseData struct {
+	Results []*txSimData.SimulationResults `json:"results"`
+}
+
+type simulateMultipleTxsResponse struct {
+	Data  simulateMultipleTxsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestSimulateMultipleTransactions(t *testing.T) {
+	t.Parallel()
+
+	createTxsRequestBody := func(numTxs int) []byte {
+		txs := make([]groups.SendTxRequest, 0, numTxs)
+		for i := 0; i < numTxs; i++ {
+			txs = append(txs, groups.SendTxRequest{
+				Sender:   "sender1",
+				Receiver: "receiver1",
+				Value:    "100",
+				Nonce:    uint64(i),
+			})
+		}
+
+		jsonBytes, _ := json.Marshal(txs)
+		return jsonBytes
+	}
+	createTransactionHandler := func(nonce uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _ uint32, _ uint32) (*dataTx.Transaction, []byte, error) {
+		return &dataTx.Transaction{Nonce: nonce}, []byte(fmt.Sprintf("hash%d", nonce)), nil
+	}
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateMultipleTxsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("invalid number of transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		for _, numTxs := range []int{0, 101} {
+			req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer(createTxsRequestBody(numTxs)))
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := simulateMultipleTxsResponse{}
+			loadResponse(resp.Body, &response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.Contains(t, response.Error, apiErrors.ErrInvalidNumOfTransactionsToSimulate.Error())
+		}
+	})
+	t.Run("invalid stopOnFailure parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-multiple?stopOnFailure=tttt", bytes.NewBuffer(createTxsRequestBody(2)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateMultipleTxsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("invalid transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: createTransactionHandler,
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, _ bool) error {
+				if tx.Nonce == 1 {
+					return expectedErr
+				}
+				return nil
+			},
+			SimulateTransactionsExecutionHandler: func(_ []*dataTx.Transaction, _ bool) ([]*txSimData.SimulationResults, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer(createTxsRequestBody(2)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateMultipleTxsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "index 1")
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("simulation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: createTransactionHandler,
+			ValidateTransactionForSimulationHandler: func(_ *dataTx.Transaction, _ bool) error {
+				return nil
+			},
+			SimulateTransactionsExecutionHandler: func(_ []*dataTx.Transaction, _ bool) ([]*txSimData.SimulationResults, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer(createTxsRequestBody(2)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateMultipleTxsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: createTransactionHandler,
+			ValidateTransactionForSimulationHandler: func(_ *dataTx.Transaction, checkSignature bool) error {
+				assert.False(t, checkSignature)
+				return nil
+			},
+			SimulateTransactionsExecutionHandler: func(txs []*dataTx.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error) {
+				assert.True(t, stopOnFailure)
+				require.Len(t, txs, 3)
+
+				return []*txSimData.SimulationResults{
+					{Status: dataTx.TxStatusSuccess, GasUsed: 10},
+					{Status: dataTx.TxStatusFail, FailReason: "failed"},
+				}, nil
+			},
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-multiple?checkSignature=false&stopOnFailure=true", bytes.NewBuffer(createTxsRequestBody(3)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateMultipleTxsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, response.Data.Results, 2)
+		assert.Equal(t, hex.EncodeToString([]byte("hash0")), response.Data.Results[0].Hash)
+		assert.Equal(t, uint64(10), response.Data.Results[0].GasUsed)
+		assert.Equal(t, hex.EncodeToString([]byte("hash1")), response.Data.Results[1].Hash)
+		assert.Equal(t, "failed", response.Data.Results[1].FailReason)
+	})
+}
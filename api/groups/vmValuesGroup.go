@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
 )
@@ -21,11 +22,14 @@ const (
 	stringPath = "/string"
 	intPath    = "/int"
 	queryPath  = "/query"
+
+	urlParamTrace = "trace"
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueryWithTrace(*process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -118,6 +122,16 @@ func (vvg *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnDat
 
 // executeQuery returns the data as string
 func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
+	withTrace, err := parseBoolUrlParam(context, urlParamTrace)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQuery", fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+		return
+	}
+	if withTrace {
+		vvg.executeQueryWithTrace(context)
+		return
+	}
+
 	vmOutput, execErrMsg, err := vvg.doExecuteQuery(context)
 	if err != nil {
 		vvg.returnBadRequest(context, "executeQuery", err)
@@ -127,29 +141,69 @@ func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
 	vvg.returnOkResponse(context, vmOutput, execErrMsg)
 }
 
+func (vvg *vmValuesGroup) executeQueryWithTrace(context *gin.Context) {
+	command, err := vvg.createSCQueryFromRequest(context)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQuery", err)
+		return
+	}
+
+	vmOutputApi, trace, err := vvg.getFacade().ExecuteSCQueryWithTrace(command)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQuery", err)
+		return
+	}
+
+	context.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"data": vmOutputApi, "trace": trace},
+			Error: getVMExecutionErrorMessage(vmOutputApi),
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, error) {
+	command, err := vvg.createSCQueryFromRequest(context)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vmOutputApi, err := vvg.getFacade().ExecuteSCQuery(command)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return vmOutputApi, getVMExecutionErrorMessage(vmOutputApi), nil
+}
+
+func (vvg *vmValuesGroup) createSCQueryFromRequest(context *gin.Context) (*process.SCQuery, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
 	if err != nil {
-		return nil, "", errors.ErrInvalidJSONRequest
+		return nil, errors.ErrInvalidJSONRequest
 	}
 
 	command, err := vvg.createSCQuery(&request)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	vmOutputApi, err := vvg.getFacade().ExecuteSCQuery(command)
+	command.BlockCoordinates, err = extractBlockCoordinates(context)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	vmExecErrMsg := ""
+	return command, nil
+}
+
+func getVMExecutionErrorMessage(vmOutputApi *vm.VMOutputApi) string {
 	if len(vmOutputApi.ReturnCode) > 0 && vmOutputApi.ReturnCode != vmcommon.Ok.String() {
-		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
+		return vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
 	}
 
-	return vmOutputApi, vmExecErrMsg, nil
+	return ""
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
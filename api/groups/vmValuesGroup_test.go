@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
@@ -17,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -48,6 +50,12 @@ type vmOutputResponse struct {
 	Error string             `json:"error"`
 }
 
+type vmOutputWithTraceResponse struct {
+	Data  *vmcommon.VMOutput      `json:"data"`
+	Trace *tracing.ExecutionTrace `json:"trace"`
+	Error string                  `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -163,6 +171,114 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithBlockCoordinatesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			require.Equal(t, core.OptionalUint64{Value: 42, HasValue: true}, query.BlockCoordinates.Nonce)
+
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query?blockNonce=42", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+}
+
+func TestQuery_WithTraceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := &tracing.ExecutionTrace{
+		Root: &tracing.CallFrame{
+			CallType: "DirectCall",
+			Function: "function",
+			GasUsed:  37,
+		},
+		StorageReads: []*tracing.StorageAccess{{Address: "address", Key: "6b6579", Value: "76616c"}},
+	}
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		ExecuteSCQueryWithTraceHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, expectedTrace, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputWithTraceResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query?trace=true", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+	require.Equal(t, expectedTrace.Root.Function, response.Trace.Root.Function)
+	require.Equal(t, expectedTrace.Root.GasUsed, response.Trace.Root.GasUsed)
+	require.Equal(t, expectedTrace.StorageReads, response.Trace.StorageReads)
+}
+
+func TestQuery_WithInvalidTraceParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ExecuteSCQueryWithTraceHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query?trace=not-a-bool", request, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+}
+
+func TestAllRoutes_WhenBadBlockCoordinatesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			require.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query?blockNonce=42&blockHash=aaaa", request, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
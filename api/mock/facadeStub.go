@@ -13,7 +13,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
@@ -25,6 +27,7 @@ type FacadeStub struct {
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
 	GetBalanceCalled           func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
 	GetAccountCalled           func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountsBulkCalled      func(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -33,18 +36,25 @@ type FacadeStub struct {
 	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueryWithTraceHandler              func(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*state.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitInBlockHandler    func(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error)
 	NodeConfigCalled                            func() map[string]interface{}
 	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetStorageStatisticsCalled                  func(withNumKeys bool) (*common.StorageStatsApiResponse, error)
+	GetEquivocationEvidencesCalled              func() ([]*common.EquivocationEvidence, error)
+	GetConsensusRoundsCalled                    func() ([]*common.ConsensusRoundTimeline, error)
+	GetP2PTrafficCalled                         func() (*common.P2PTrafficReport, error)
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsExecutionHandler        func(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -69,6 +79,18 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                         func(string, []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrieCalled                 func(string, string, []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrieCalled                 func(string, string, string, string, int) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                      func(string, []string, [][]byte) (*common.VerifyMultiProofResponse, error)
+	VerifyRangeProofCalled                      func(string, string, string, [][]byte) (*common.VerifyMultiProofResponse, error)
+	GetStateDiffCalled                          func(fromRootHash string, toRootHash string) (*common.StateDiffApiResponse, error)
+	StartDbIntegrityCheckCalled                 func(numEpochs uint32, refetch bool) error
+	GetDbIntegrityReportCalled                  func() (*common.DbIntegrityReport, error)
+	StartDataTriesAnalysisCalled                func(rootHash string, numLargest uint32, sortBy string) error
+	GetDataTriesReportCalled                    func() (*common.DataTriesReport, error)
+	GetP2PFaultRulesCalled                      func() ([]common.P2PFaultRuleStatus, error)
+	SetP2PFaultRulesCalled                      func(rules []common.P2PFaultRule) error
 	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
@@ -76,7 +98,10 @@ type FacadeStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddressCalled              func(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
+	SubscribeCalled                             func(filter subscriptions.Filter) (subscriptions.Subscription, error)
+	UnsubscribeCalled                           func(id uint64)
 }
 
 // GetTokenSupply -
@@ -124,6 +149,114 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetMultiProofDataTrie -
+func (f *FacadeStub) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if f.GetMultiProofDataTrieCalled != nil {
+		return f.GetMultiProofDataTrieCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProofDataTrie -
+func (f *FacadeStub) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if f.GetRangeProofDataTrieCalled != nil {
+		return f.GetRangeProofDataTrieCalled(rootHash, address, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return nil, nil
+}
+
+// VerifyRangeProof -
+func (f *FacadeStub) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	if f.VerifyRangeProofCalled != nil {
+		return f.VerifyRangeProofCalled(rootHash, startKey, endKey, proof)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(fromRootHash string, toRootHash string) (*common.StateDiffApiResponse, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// StartDbIntegrityCheck -
+func (f *FacadeStub) StartDbIntegrityCheck(numEpochs uint32, refetch bool) error {
+	if f.StartDbIntegrityCheckCalled != nil {
+		return f.StartDbIntegrityCheckCalled(numEpochs, refetch)
+	}
+
+	return nil
+}
+
+// GetDbIntegrityReport -
+func (f *FacadeStub) GetDbIntegrityReport() (*common.DbIntegrityReport, error) {
+	if f.GetDbIntegrityReportCalled != nil {
+		return f.GetDbIntegrityReportCalled()
+	}
+
+	return nil, nil
+}
+
+// StartDataTriesAnalysis -
+func (f *FacadeStub) StartDataTriesAnalysis(rootHash string, numLargest uint32, sortBy string) error {
+	if f.StartDataTriesAnalysisCalled != nil {
+		return f.StartDataTriesAnalysisCalled(rootHash, numLargest, sortBy)
+	}
+
+	return nil
+}
+
+// GetDataTriesReport -
+func (f *FacadeStub) GetDataTriesReport() (*common.DataTriesReport, error) {
+	if f.GetDataTriesReportCalled != nil {
+		return f.GetDataTriesReportCalled()
+	}
+
+	return nil, nil
+}
+
+// GetP2PFaultRules -
+func (f *FacadeStub) GetP2PFaultRules() ([]common.P2PFaultRuleStatus, error) {
+	if f.GetP2PFaultRulesCalled != nil {
+		return f.GetP2PFaultRulesCalled()
+	}
+
+	return nil, nil
+}
+
+// SetP2PFaultRules -
+func (f *FacadeStub) SetP2PFaultRules(rules []common.P2PFaultRule) error {
+	if f.SetP2PFaultRulesCalled != nil {
+		return f.SetP2PFaultRulesCalled(rules)
+	}
+
+	return nil
+}
+
 // GetUsername -
 func (f *FacadeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if f.GetUsernameCalled != nil {
@@ -244,6 +377,15 @@ func (f *FacadeStub) GetAccount(address string, options api.AccountQueryOptions)
 	return f.GetAccountCalled(address, options)
 }
 
+// GetAccountsBulk -
+func (f *FacadeStub) GetAccountsBulk(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+	if f.GetAccountsBulkCalled != nil {
+		return f.GetAccountsBulkCalled(query, options)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
 func (f *FacadeStub) CreateTransaction(
 	nonce uint64,
@@ -273,6 +415,15 @@ func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// SimulateTransactionsExecution is the mock implementation of a handler's SimulateTransactionsExecution method
+func (f *FacadeStub) SimulateTransactionsExecution(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error) {
+	if f.SimulateTransactionsExecutionHandler != nil {
+		return f.SimulateTransactionsExecutionHandler(txs, stopOnFailure)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -298,6 +449,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, er
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueryWithTrace is a mock implementation.
+func (f *FacadeStub) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+	if f.ExecuteSCQueryWithTraceHandler != nil {
+		return f.ExecuteSCQueryWithTraceHandler(query)
+	}
+
+	return nil, nil, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
@@ -323,6 +483,15 @@ func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*t
 	return f.ComputeTransactionGasLimitHandler(tx)
 }
 
+// ComputeTransactionGasLimitInBlock -
+func (f *FacadeStub) ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	if f.ComputeTransactionGasLimitInBlockHandler != nil {
+		return f.ComputeTransactionGasLimitInBlockHandler(tx, coordinates)
+	}
+
+	return nil, nil
+}
+
 // NodeConfig -
 func (f *FacadeStub) NodeConfig() map[string]interface{} {
 	return f.NodeConfigCalled()
@@ -348,6 +517,42 @@ func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetStorageStatistics -
+func (f *FacadeStub) GetStorageStatistics(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+	if f.GetStorageStatisticsCalled != nil {
+		return f.GetStorageStatisticsCalled(withNumKeys)
+	}
+
+	return nil, nil
+}
+
+// GetEquivocationEvidences -
+func (f *FacadeStub) GetEquivocationEvidences() ([]*common.EquivocationEvidence, error) {
+	if f.GetEquivocationEvidencesCalled != nil {
+		return f.GetEquivocationEvidencesCalled()
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRounds -
+func (f *FacadeStub) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	if f.GetConsensusRoundsCalled != nil {
+		return f.GetConsensusRoundsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetP2PTraffic -
+func (f *FacadeStub) GetP2PTraffic() (*common.P2PTrafficReport, error) {
+	if f.GetP2PTrafficCalled != nil {
+		return f.GetP2PTrafficCalled()
+	}
+
+	return nil, nil
+}
+
 // GetEpochStartDataAPI -
 func (f *FacadeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	return f.GetEpochStartDataAPICalled(epoch)
@@ -488,6 +693,15 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (f *FacadeStub) GetTransactionsByAddress(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, offset, maxTransactions)
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -497,6 +711,22 @@ func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return nil, nil
 }
 
+// Subscribe -
+func (f *FacadeStub) Subscribe(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+	if f.SubscribeCalled != nil {
+		return f.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// Unsubscribe -
+func (f *FacadeStub) Unsubscribe(id uint64) {
+	if f.UnsubscribeCalled != nil {
+		f.UnsubscribeCalled(id)
+	}
+}
+
 // Trigger -
 func (f *FacadeStub) Trigger(_ uint32, _ bool) error {
 	return nil
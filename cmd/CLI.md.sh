@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForStateSnapshot
+    generateForDbMigrator
+    generateForDbIntegrity
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForStateSnapshot() {
+    HELP="
+# Elrond State snapshot CLI
+
+The **State snapshot Tool** exposes the following Command Line Interface:
+$(code)
+\$ statesnapshot --help
+
+$(./statesnapshot/statesnapshot --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./statesnapshot/CLI.md
+}
+
+generateForDbMigrator() {
+    HELP="
+# Elrond DB migrator CLI
+
+The **DB migrator Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
+generateForDbIntegrity() {
+    HELP="
+# Elrond DB integrity CLI
+
+The **Database integrity Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbintegrity --help
+
+$(./dbintegrity/dbintegrity --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbintegrity/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity/disabled"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+const filePathPlaceholder = "[path]"
+
+var (
+	dbIntegrityHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `" + filePathPlaceholder + "` for the main configuration file of the node. This TOML file " +
+			"contains the databases, hasher and marshalizer configurations",
+		Value: "./config/config.toml",
+	}
+	// dbPath defines a flag for the path to the databases of the node
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `" + filePathPlaceholder + "` to the databases directory of the node, including the chain ID. " +
+			"Example: ./db/1",
+		Value: "./db/1",
+	}
+	// shard defines a flag for the shard of the node
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The shard of the node. Available options: 0, 1, 2, ..., metachain",
+		Value: "0",
+	}
+	// numEpochs defines a flag for the number of epochs to be checked
+	numEpochs = cli.UintFlag{
+		Name:  "num-epochs",
+		Usage: "The number of epochs to be checked, including the epoch of the start header",
+		Value: 1,
+	}
+	// startHeader defines a flag for the hash of the header the check starts with
+	startHeader = cli.StringFlag{
+		Name: "start-header",
+		Usage: "The hex encoded hash of the newest header to be checked. If not provided, the last header saved in " +
+			"the bootstrap storage is used",
+	}
+	// checkAllRootHashes defines a flag that enables checking the state of each header
+	checkAllRootHashes = cli.BoolFlag{
+		Name: "check-all-root-hashes",
+		Usage: "Boolean option for checking the state root hash of each header. It should be set only for nodes " +
+			"that do not prune the tries, otherwise only the start header and the start of epoch headers are checked",
+	}
+	// reportFile defines a flag for the path of the generated report
+	reportFile = cli.StringFlag{
+		Name:  "report",
+		Usage: "The `" + filePathPlaceholder + "` of the JSON file in which the report is written",
+		Value: "./db-integrity-report.json",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("dbintegrity")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbIntegrityHelpTemplate
+	app.Name = "Database integrity Tool"
+	app.Usage = "This tool checks that the headers of the last epochs of a stopped node have all their miniblocks, " +
+		"transactions, receipts and state trie nodes stored. The missing keys can be refetched, once the node is " +
+		"started, through the /internal/db-integrity/start route"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		dbPath,
+		shard,
+		numEpochs,
+		startHeader,
+		checkAllRootHashes,
+		reportFile,
+		logLevel,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Action = checkDatabases
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func checkDatabases(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return fmt.Errorf("%w while creating the hasher", err)
+	}
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return fmt.Errorf("%w while creating the marshalizer", err)
+	}
+
+	shardIDString := ctx.GlobalString(shard.Name)
+	shardID, err := core.ConvertShardIDToUint32(shardIDString)
+	if err != nil {
+		return fmt.Errorf("%w while parsing the shard %s", err, shardIDString)
+	}
+
+	databasePath := ctx.GlobalString(dbPath.Name)
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(databasePath)
+	if err != nil {
+		return err
+	}
+	epochs, err := storageFactory.GetEpochsFromDbPath(databasePath)
+	if err != nil {
+		return err
+	}
+
+	opener := &storersOpener{
+		pathManager: pathManager,
+		shardID:     shardIDString,
+		epochs:      epochs,
+	}
+	defer opener.closeAll()
+
+	store, err := opener.createStorageService(generalConfig, shardID)
+	if err != nil {
+		return err
+	}
+	userAccountsTrieStorage, err := opener.openEpochsStorer(generalConfig.AccountsTrieStorage.DB)
+	if err != nil {
+		return err
+	}
+	peerAccountsTrieStorage, err := opener.openEpochsStorer(generalConfig.PeerAccountsTrieStorage.DB)
+	if err != nil {
+		return err
+	}
+
+	startHeaderHash, err := getStartHeaderHash(ctx, marshalizer, store)
+	if err != nil {
+		return err
+	}
+
+	checker, err := dbIntegrity.NewDbIntegrityChecker(dbIntegrity.ArgsDbIntegrityChecker{
+		StorageService:           store,
+		UserAccountsTrieStorage:  userAccountsTrieStorage,
+		PeerAccountsTrieStorage:  peerAccountsTrieStorage,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		Refetcher:                disabled.NewDisabledMissingDataRefetcher(),
+		ShardID:                  shardID,
+	})
+	if err != nil {
+		return err
+	}
+
+	checkCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cancelOnSignal(cancel)
+
+	report, err := checker.Check(checkCtx, dbIntegrity.ArgsCheck{
+		StartHeaderHash:    startHeaderHash,
+		NumEpochs:          uint32(ctx.GlobalUint(numEpochs.Name)),
+		CheckAllRootHashes: ctx.GlobalBool(checkAllRootHashes.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	reportBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	reportPath := ctx.GlobalString(reportFile.Name)
+	log.Info("writing the report", "path", reportPath, "num missing keys", len(report.MissingKeys))
+
+	return ioutil.WriteFile(reportPath, reportBytes, core.FileModeReadWrite)
+}
+
+func getStartHeaderHash(ctx *cli.Context, marshalizer marshal.Marshalizer, store dataRetriever.StorageService) ([]byte, error) {
+	if ctx.GlobalIsSet(startHeader.Name) {
+		startHeaderHash, err := hex.DecodeString(ctx.GlobalString(startHeader.Name))
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding the start header hash", err)
+		}
+
+		return startHeaderHash, nil
+	}
+
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(marshalizer, store.GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapData, err := bootStorer.Get(bootStorer.GetHighestRound())
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the last header from the bootstrap storage", err)
+	}
+
+	log.Info("the check starts with the last header saved in the bootstrap storage",
+		"nonce", bootstrapData.LastHeader.Nonce, "epoch", bootstrapData.LastHeader.Epoch, "hash", bootstrapData.LastHeader.Hash)
+
+	return bootstrapData.LastHeader.Hash, nil
+}
+
+func cancelOnSignal(cancel func()) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	log.Info("interrupting the check")
+	cancel()
+}
+
+// storersOpener opens the read only storers of a stopped node and keeps track of them, so they can be closed at the end
+type storersOpener struct {
+	pathManager *pathmanager.PathManager
+	shardID     string
+	epochs      []uint32
+	storers     []*storageFactory.ReadOnlyEpochsStorer
+}
+
+func (so *storersOpener) createStorageService(generalConfig *config.Config, shardID uint32) (dataRetriever.StorageService, error) {
+	epochUnits := map[dataRetriever.UnitType]config.DBConfig{
+		dataRetriever.BlockHeaderUnit:         generalConfig.BlockHeaderStorage.DB,
+		dataRetriever.MetaBlockUnit:           generalConfig.MetaBlockStorage.DB,
+		dataRetriever.MiniBlockUnit:           generalConfig.MiniBlocksStorage.DB,
+		dataRetriever.TransactionUnit:         generalConfig.TxStorage.DB,
+		dataRetriever.UnsignedTransactionUnit: generalConfig.UnsignedTransactionStorage.DB,
+		dataRetriever.RewardTransactionUnit:   generalConfig.RewardTxStorage.DB,
+		dataRetriever.ReceiptsUnit:            generalConfig.ReceiptsStorage.DB,
+		dataRetriever.BootstrapUnit:           generalConfig.BootstrapStorage.DB,
+	}
+
+	store := dataRetriever.NewChainStorer()
+	for unit, dbConfig := range epochUnits {
+		storer, err := so.openEpochsStorer(dbConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%w while opening the %s databases", err, unit.String())
+		}
+		store.AddStorer(unit, storer)
+	}
+
+	nonceHashUnit := dataRetriever.MetaHdrNonceHashDataUnit
+	nonceHashPath := so.pathManager.PathForStatic(so.shardID, generalConfig.MetaHdrNonceHashStorage.DB.FilePath)
+	nonceHashConfig := generalConfig.MetaHdrNonceHashStorage.DB
+	if shardID != core.MetachainShardId {
+		nonceHashUnit = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+		nonceHashPath = so.pathManager.PathForStatic(so.shardID, generalConfig.ShardHdrNonceHashStorage.DB.FilePath) + so.shardID
+		nonceHashConfig = generalConfig.ShardHdrNonceHashStorage.DB
+	}
+
+	nonceHashStorer, err := storageFactory.NewReadOnlyStaticStorer(nonceHashPath, nonceHashConfig)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening the %s database", err, nonceHashUnit.String())
+	}
+	so.storers = append(so.storers, nonceHashStorer)
+	store.AddStorer(nonceHashUnit, nonceHashStorer)
+
+	return store, nil
+}
+
+func (so *storersOpener) openEpochsStorer(dbConfig config.DBConfig) (*storageFactory.ReadOnlyEpochsStorer, error) {
+	storer, err := storageFactory.NewReadOnlyEpochsStorer(so.pathManager, so.shardID, so.epochs, dbConfig)
+	if err != nil {
+		return nil, err
+	}
+	so.storers = append(so.storers, storer)
+
+	return storer, nil
+}
+
+func (so *storersOpener) closeAll() {
+	for _, storer := range so.storers {
+		log.LogIfError(storer.Close())
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/dbmigration"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+	levelDBMarkerFile   = "CURRENT"
+	batchDelaySeconds   = 2
+	maxOpenFiles        = 10
+)
+
+var (
+	dbMigratorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// source defines a flag for the path of the database to be copied
+	source = cli.StringFlag{
+		Name:  "source",
+		Usage: "The `" + filePathPlaceholder + "` of the database to be copied",
+	}
+	// sourceType defines a flag for the type of the database to be copied
+	sourceType = cli.StringFlag{
+		Name:  "source-type",
+		Usage: "The type of the database to be copied. Available options: LvlDB, LvlDBSerial, BadgerDB",
+		Value: string(storageUnit.LvlDBSerial),
+	}
+	// destination defines a flag for the path of the new database
+	destination = cli.StringFlag{
+		Name:  "destination",
+		Usage: "The `" + filePathPlaceholder + "` of the new database. It should not exist or should be empty",
+	}
+	// destinationType defines a flag for the type of the new database
+	destinationType = cli.StringFlag{
+		Name:  "destination-type",
+		Usage: "The type of the new database. Available options: LvlDB, LvlDBSerial, BadgerDB",
+		Value: string(storageUnit.BadgerDB),
+	}
+	// recursive defines a flag that copies all the LevelDB databases found under the source directory
+	recursive = cli.BoolFlag{
+		Name: "recursive",
+		Usage: "Boolean option for copying all the LevelDB databases found under the source directory, such as the " +
+			"whole databases directory of a node. The relative paths are kept under the destination directory",
+	}
+	// maxBatchSize defines a flag for the number of keys written at once in the new database
+	maxBatchSize = cli.IntFlag{
+		Name:  "max-batch-size",
+		Usage: "The number of keys written at once in the new database",
+		Value: 10000,
+	}
+	// skipVerify defines a flag that disables the verification of the copied data
+	skipVerify = cli.BoolFlag{
+		Name:  "skip-verify",
+		Usage: "Boolean option for skipping the verification of each copied key, done after the copy is finished",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("dbmigrator")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbMigratorHelpTemplate
+	app.Name = "DB migrator Tool"
+	app.Usage = "This tool copies the databases of a stopped node into databases of another type, such as copying " +
+		"LevelDB databases into BadgerDB ones"
+	app.Flags = []cli.Flag{
+		source,
+		sourceType,
+		destination,
+		destinationType,
+		recursive,
+		maxBatchSize,
+		skipVerify,
+		logLevel,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Action = migrate
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func migrate(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	sourcePath := ctx.GlobalString(source.Name)
+	destinationPath := ctx.GlobalString(destination.Name)
+	if len(sourcePath) == 0 || len(destinationPath) == 0 {
+		return fmt.Errorf("both the --%s and the --%s flags should be provided", source.Name, destination.Name)
+	}
+
+	sourceFactory := createPersisterFactory(ctx.GlobalString(sourceType.Name), ctx.GlobalInt(maxBatchSize.Name))
+	destinationFactory := createPersisterFactory(ctx.GlobalString(destinationType.Name), ctx.GlobalInt(maxBatchSize.Name))
+	verify := !ctx.GlobalBool(skipVerify.Name)
+
+	if !ctx.GlobalBool(recursive.Name) {
+		return migrateDB(sourceFactory, destinationFactory, sourcePath, destinationPath, verify)
+	}
+
+	relativePaths, err := findLevelDBDirectories(sourcePath)
+	if err != nil {
+		return err
+	}
+	if len(relativePaths) == 0 {
+		return fmt.Errorf("no LevelDB database found in %s", sourcePath)
+	}
+
+	for _, relativePath := range relativePaths {
+		err = migrateDB(
+			sourceFactory,
+			destinationFactory,
+			filepath.Join(sourcePath, relativePath),
+			filepath.Join(destinationPath, relativePath),
+			verify,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("all databases copied", "num databases", len(relativePaths))
+
+	return nil
+}
+
+func createPersisterFactory(dbType string, batchSize int) *storageFactory.PersisterFactory {
+	return storageFactory.NewPersisterFactory(config.DBConfig{
+		Type:              dbType,
+		BatchDelaySeconds: batchDelaySeconds,
+		MaxBatchSize:      batchSize,
+		MaxOpenFiles:      maxOpenFiles,
+	})
+}
+
+func migrateDB(
+	sourceFactory *storageFactory.PersisterFactory,
+	destinationFactory *storageFactory.PersisterFactory,
+	sourcePath string,
+	destinationPath string,
+	verify bool,
+) error {
+	if !directoryExists(sourcePath) {
+		return fmt.Errorf("source database %s does not exist", sourcePath)
+	}
+	isEmpty, err := isMissingOrEmptyDirectory(destinationPath)
+	if err != nil {
+		return err
+	}
+	if !isEmpty {
+		return fmt.Errorf("destination %s is not empty", destinationPath)
+	}
+
+	sourceDB, err := sourceFactory.Create(sourcePath)
+	if err != nil {
+		return fmt.Errorf("%w while opening the source database %s", err, sourcePath)
+	}
+	defer func() {
+		log.LogIfError(sourceDB.Close())
+	}()
+
+	destinationDB, err := destinationFactory.Create(destinationPath)
+	if err != nil {
+		return fmt.Errorf("%w while creating the destination database %s", err, destinationPath)
+	}
+
+	log.Info("copying database", "source", sourcePath, "destination", destinationPath)
+	numCopied, err := dbmigration.CopyPersister(sourceDB, destinationDB)
+	errClose := destinationDB.Close()
+	if err != nil {
+		return fmt.Errorf("%w while copying the database %s", err, sourcePath)
+	}
+	if errClose != nil {
+		return fmt.Errorf("%w while closing the destination database %s", errClose, destinationPath)
+	}
+	log.Info("database copied", "source", sourcePath, "num keys", numCopied)
+
+	if !verify {
+		return nil
+	}
+
+	return verifyDB(sourceDB, destinationFactory, destinationPath)
+}
+
+func verifyDB(sourceDB storage.Persister, destinationFactory *storageFactory.PersisterFactory, destinationPath string) error {
+	destinationDB, err := destinationFactory.Create(destinationPath)
+	if err != nil {
+		return fmt.Errorf("%w while reopening the destination database %s", err, destinationPath)
+	}
+	defer func() {
+		log.LogIfError(destinationDB.Close())
+	}()
+
+	numVerified, err := dbmigration.VerifyCopy(sourceDB, destinationDB)
+	if err != nil {
+		return fmt.Errorf("%w while verifying the destination database %s", err, destinationPath)
+	}
+	log.Info("database verified", "destination", destinationPath, "num keys", numVerified)
+
+	return nil
+}
+
+// findLevelDBDirectories returns the paths, relative to the root directory, of all the LevelDB databases found under it
+func findLevelDBDirectories(root string) ([]string, error) {
+	relativePaths := make([]string, 0)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || info.Name() != levelDBMarkerFile {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		relativePaths = append(relativePaths, relativePath)
+
+		return nil
+	})
+
+	return relativePaths, err
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.IsDir()
+}
+
+func isMissingOrEmptyDirectory(path string) (bool, error) {
+	files, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return len(files) == 0, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -301,6 +302,18 @@ var (
 		Value: 0,
 		Usage: "This flag will specify the start in epoch value in import-db process",
 	}
+	// outportReplayStartNonce defines a flag that specifies the first block nonce re-emitted to the outport drivers
+	outportReplayStartNonce = cli.Uint64Flag{
+		Name:  "outport-replay-start-nonce",
+		Value: 1,
+		Usage: "This flag specifies the first block nonce re-emitted to the outport drivers. Can be used only if the outport-replay-end-nonce was set",
+	}
+	// outportReplayEndNonce defines a flag that, if set, will make the node re-emit the stored blocks to the outport drivers and then stop
+	outportReplayEndNonce = cli.Uint64Flag{
+		Name: "outport-replay-end-nonce",
+		Usage: "This flag, if set, will make the node read the blocks up to the provided nonce from its storage and re-emit them " +
+			"to the configured outport drivers, without taking part in consensus. The node stops once all blocks were sent",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -382,6 +395,8 @@ func getFlags() []cli.Flag {
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
 		importDbStartInEpoch,
+		outportReplayStartNonce,
+		outportReplayEndNonce,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -456,8 +471,14 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 		ImportDbSaveTrieEpochRootHash: ctx.GlobalBool(importDbSaveEpochRootHash.Name),
 		ImportDBStartInEpoch:          uint32(ctx.GlobalUint64(importDbStartInEpoch.Name)),
 	}
+	outportReplayConfig := &config.OutportReplayConfig{
+		IsOutportReplayMode: ctx.IsSet(outportReplayEndNonce.Name),
+		StartNonce:          ctx.GlobalUint64(outportReplayStartNonce.Name),
+		EndNonce:            ctx.GlobalUint64(outportReplayEndNonce.Name),
+	}
 	cfgs.FlagsConfig = flagsConfig
 	cfgs.ImportDbConfig = importDBConfigs
+	cfgs.OutportReplayConfig = outportReplayConfig
 	err := applyCompatibleConfigs(log, cfgs)
 	if err != nil {
 		return err
@@ -493,9 +514,17 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 	importDbFlags.ImportDbSaveTrieEpochRootHash = importDbFlags.ImportDbSaveTrieEpochRootHash && importDbFlags.IsImportDBMode
 
 	if importDbFlags.IsImportDBMode {
+		if configs.OutportReplayConfig.IsOutportReplayMode {
+			return errors.New("import-db and outport replay modes can not be used together")
+		}
+
 		return processConfigImportDBMode(log, configs)
 	}
 
+	if configs.OutportReplayConfig.IsOutportReplayMode {
+		return processConfigOutportReplayMode(log, configs)
+	}
+
 	// if FullArchive is enabled, we override the conflicting StoragePruning settings and StartInEpoch as well
 	if configs.PreferencesConfig.Preferences.FullArchive {
 		return processConfigFullArchiveMode(log, configs)
@@ -550,6 +579,30 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 	return nil
 }
 
+func processConfigOutportReplayMode(log logger.Logger, configs *config.Configs) error {
+	replayConfig := configs.OutportReplayConfig
+	generalConfigs := configs.GeneralConfig
+
+	if replayConfig.StartNonce > replayConfig.EndNonce {
+		return fmt.Errorf("invalid outport replay nonce range: start nonce %d is greater than end nonce %d",
+			replayConfig.StartNonce, replayConfig.EndNonce)
+	}
+
+	// the blocks are read from the local storage only, so the old epochs data must not be removed
+	generalConfigs.GeneralSettings.StartInEpochEnabled = false
+	generalConfigs.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfigs.StoragePruning.ObserverCleanOldEpochsData = false
+
+	log.Warn("the node is in outport replay mode! Will auto-set some config values",
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
+		"start nonce", replayConfig.StartNonce,
+		"end nonce", replayConfig.EndNonce,
+	)
+	return nil
+}
+
 func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) error {
 	generalConfigs := configs.GeneralConfig
 
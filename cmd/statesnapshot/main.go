@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state/portableSnapshot"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder    = "[path]"
+	importStagingDirectory = "SnapshotImportStaging"
+)
+
+var (
+	stateSnapshotHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} [global options] command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `" + filePathPlaceholder + "` for the main configuration file of the node. This TOML file " +
+			"contains the trie databases, hasher and marshalizer configurations",
+		Value: "./config/config.toml",
+	}
+	// dbPath defines a flag for the path to the databases of the node
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `" + filePathPlaceholder + "` to the databases directory of the node, including the chain ID. " +
+			"Example: ./db/1",
+		Value: "./db/1",
+	}
+	// shard defines a flag for the shard of the node
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The shard of the node. Available options: 0, 1, 2, ..., metachain",
+		Value: "0",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// rootHash defines a flag for the hex encoded state root hash
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded root hash of the accounts state",
+	}
+	// snapshotFile defines a flag for the path to the portable snapshot file
+	snapshotFile = cli.StringFlag{
+		Name:  "file",
+		Usage: "The `" + filePathPlaceholder + "` of the portable state snapshot file",
+		Value: "./state.snapshot",
+	}
+	// numNodesPerChunk defines a flag for the number of trie nodes written in a chunk of the snapshot file
+	numNodesPerChunk = cli.IntFlag{
+		Name:  "nodes-per-chunk",
+		Usage: "The number of trie nodes written in each compressed chunk of the snapshot file",
+		Value: 100000,
+	}
+	// epoch defines a flag for the epoch in which the snapshot is imported
+	epoch = cli.UintFlag{
+		Name:  "epoch",
+		Usage: "The epoch database in which the trie nodes are imported. It should be the start epoch of the new node",
+	}
+)
+
+var log = logger.GetOrCreate("statesnapshot")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = stateSnapshotHelpTemplate
+	app.Name = "State snapshot Tool"
+	app.Usage = "This tool exports the accounts state of a stopped node in a portable snapshot file and imports " +
+		"such a file in the databases of a new node, before its start-up"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		dbPath,
+		shard,
+		logLevel,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "export",
+			Usage:  "writes the accounts state identified by the root hash in a portable snapshot file",
+			Flags:  []cli.Flag{rootHash, snapshotFile, numNodesPerChunk},
+			Action: exportSnapshot,
+		},
+		{
+			Name:   "import",
+			Usage:  "loads a portable snapshot file in the accounts trie database, verifying every trie node",
+			Flags:  []cli.Flag{rootHash, snapshotFile, epoch},
+			Action: importSnapshot,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+type toolComponents struct {
+	generalConfig *config.Config
+	args          portableSnapshot.ArgsAccountsAdapter
+	shardID       string
+}
+
+func createToolComponents(ctx *cli.Context) (*toolComponents, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the hasher", err)
+	}
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the marshalizer", err)
+	}
+
+	shardID := ctx.GlobalString(shard.Name)
+	_, err = core.ConvertShardIDToUint32(shardID)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the shard %s", err, shardID)
+	}
+
+	return &toolComponents{
+		generalConfig: generalConfig,
+		args: portableSnapshot.ArgsAccountsAdapter{
+			Marshalizer: marshalizer,
+			Hasher:      hasher,
+		},
+		shardID: shardID,
+	}, nil
+}
+
+func exportSnapshot(ctx *cli.Context) error {
+	components, err := createToolComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	stateRootHash, err := hex.DecodeString(ctx.String(rootHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+	if len(stateRootHash) == 0 {
+		return portableSnapshot.ErrEmptyRootHash
+	}
+
+	databasePath := ctx.GlobalString(dbPath.Name)
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(databasePath)
+	if err != nil {
+		return err
+	}
+	epochs, err := storageFactory.GetEpochsFromDbPath(databasePath)
+	if err != nil {
+		return err
+	}
+
+	mainStorer, err := storageFactory.NewReadOnlyEpochsStorer(pathManager, components.shardID, epochs, components.generalConfig.AccountsTrieStorage.DB)
+	if err != nil {
+		return err
+	}
+	checkpointsStorer, err := storageFactory.NewReadOnlyEpochsStorer(pathManager, components.shardID, epochs, components.generalConfig.AccountsTrieCheckpointsStorage.DB)
+	if err != nil {
+		log.LogIfError(mainStorer.Close())
+		return err
+	}
+
+	args := components.args
+	args.MainStorer = mainStorer
+	args.CheckpointsStorer = checkpointsStorer
+	accounts, err := portableSnapshot.CreateAccountsAdapter(args)
+	if err != nil {
+		log.LogIfError(mainStorer.Close())
+		log.LogIfError(checkpointsStorer.Close())
+		return err
+	}
+	defer func() {
+		log.LogIfError(accounts.Close())
+		log.LogIfError(mainStorer.Close())
+		log.LogIfError(checkpointsStorer.Close())
+	}()
+
+	exporter, err := portableSnapshot.NewSnapshotExporter(portableSnapshot.ArgsSnapshotExporter{
+		Accounts:         accounts,
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		NumNodesPerChunk: ctx.Int(numNodesPerChunk.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	filePath := ctx.String(snapshotFile.Name)
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+
+	_, err = exporter.Export(stateRootHash, file)
+	if err != nil {
+		log.LogIfError(file.Close())
+		log.LogIfError(os.Remove(filePath))
+		return err
+	}
+
+	return file.Close()
+}
+
+func importSnapshot(ctx *cli.Context) error {
+	components, err := createToolComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	expectedRootHash, err := hex.DecodeString(ctx.String(rootHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(ctx.GlobalString(dbPath.Name))
+	if err != nil {
+		return err
+	}
+
+	dbConfig := components.generalConfig.AccountsTrieStorage.DB
+	path := pathManager.PathForEpoch(components.shardID, uint32(ctx.Uint(epoch.Name)), dbConfig.FilePath)
+	persisterFactory := storageFactory.NewPersisterFactory(dbConfig)
+	storer, err := persisterFactory.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(storer.Close())
+	}()
+
+	stagingPath := filepath.Join(filepath.Dir(path), importStagingDirectory)
+	defer func() {
+		log.LogIfError(os.RemoveAll(stagingPath))
+	}()
+
+	importer, err := portableSnapshot.NewSnapshotImporter(portableSnapshot.ArgsSnapshotImporter{
+		Storer:           storer,
+		PersisterFactory: persisterFactory,
+		StagingPath:      stagingPath,
+		Marshalizer:      components.args.Marshalizer,
+		Hasher:           components.args.Hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(ctx.String(snapshotFile.Name))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(file.Close())
+	}()
+
+	importedRootHash, err := importer.Import(file, expectedRootHash)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot loaded", "path", path, "root hash", importedRootHash)
+
+	return nil
+}
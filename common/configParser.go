@@ -134,3 +134,18 @@ func LoadRoundConfig(filePath string) (*config.RoundConfig, error) {
 
 	return cfg, nil
 }
+
+type p2pFaultRulesFile struct {
+	Rules []P2PFaultRule
+}
+
+// LoadP2PFaultRules returns the network faults injection rules by reading from the provided file
+func LoadP2PFaultRules(filePath string) ([]P2PFaultRule, error) {
+	rulesFile := &p2pFaultRulesFile{}
+	err := core.LoadTomlFile(rulesFile, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return rulesFile.Rules, nil
+}
@@ -89,3 +89,54 @@ func TestLoadRoundConfig(t *testing.T) {
 
 	require.Equal(t, &config.RoundConfig{}, roundActivationConfig)
 }
+
+func TestLoadP2PFaultRules(t *testing.T) {
+	t.Parallel()
+
+	testString := `
+[[Rules]]
+    Name = "drop transactions"
+    Topic = "transactions*"
+    Direction = "inbound"
+    Fault = "drop"
+    Probability = 0.1
+
+[[Rules]]
+    Name = "slow peer"
+    Peer = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+    Fault = "delay"
+    Probability = 1.0
+    DelayInMilliseconds = 500
+`
+	file, err := os.Create("testP2PFaultRules.toml")
+	require.Nil(t, err)
+
+	_, _ = file.WriteString(testString)
+	_ = file.Close()
+
+	rules, err := common.LoadP2PFaultRules("testP2PFaultRules.toml")
+	_ = os.Remove("testP2PFaultRules.toml")
+	require.Nil(t, err)
+
+	expectedRules := []common.P2PFaultRule{
+		{
+			Name:        "drop transactions",
+			Topic:       "transactions*",
+			Direction:   "inbound",
+			Fault:       "drop",
+			Probability: 0.1,
+		},
+		{
+			Name:                "slow peer",
+			Peer:                "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk",
+			Fault:               "delay",
+			Probability:         1.0,
+			DelayInMilliseconds: 500,
+		},
+	}
+	assert.Equal(t, expectedRules, rules)
+
+	rules, err = common.LoadP2PFaultRules("missingP2PFaultRules.toml")
+	assert.Nil(t, rules)
+	assert.NotNil(t, err)
+}
@@ -174,6 +174,56 @@ const MetricNetworkRecvBytesInCurrentEpochPerHost = "erd_network_recv_bytes_in_e
 // MetricNetworkSendBytesInCurrentEpochPerHost is the metric for monitoring network send bytes in current epoch per host
 const MetricNetworkSendBytesInCurrentEpochPerHost = "erd_network_sent_bytes_in_epoch_per_host"
 
+// MetricNetworkTopicBytes is the metric for monitoring the number of bytes received or sent on a topic
+const MetricNetworkTopicBytes = "erd_network_topic_bytes"
+
+// MetricNetworkTopicMessages is the metric for monitoring the number of messages received or sent on a topic
+const MetricNetworkTopicMessages = "erd_network_topic_messages"
+
+// MetricNetworkTopicBytesInWindow is the metric for monitoring the number of bytes received or sent on a topic in the
+// last traffic accounting window
+const MetricNetworkTopicBytesInWindow = "erd_network_topic_bytes_in_window"
+
+// MetricNetworkTopicMessagesInWindow is the metric for monitoring the number of messages received or sent on a topic
+// in the last traffic accounting window
+const MetricNetworkTopicMessagesInWindow = "erd_network_topic_messages_in_window"
+
+// MetricNetworkPeerBytes is the metric for monitoring the number of bytes received from or sent to a top talker peer
+const MetricNetworkPeerBytes = "erd_network_peer_bytes"
+
+// MetricNetworkPeerMessages is the metric for monitoring the number of messages received from or sent to a top talker
+// peer
+const MetricNetworkPeerMessages = "erd_network_peer_messages"
+
+// MetricNetworkPeerBytesInWindow is the metric for monitoring the number of bytes received from or sent to a top
+// talker peer in the last traffic accounting window
+const MetricNetworkPeerBytesInWindow = "erd_network_peer_bytes_in_window"
+
+// MetricNetworkPeerMessagesInWindow is the metric for monitoring the number of messages received from or sent to a top
+// talker peer in the last traffic accounting window
+const MetricNetworkPeerMessagesInWindow = "erd_network_peer_messages_in_window"
+
+// MetricStorageSizeInBytes is the metric for monitoring the disk size of all the storage units, in bytes
+const MetricStorageSizeInBytes = "erd_storage_size_in_bytes"
+
+// MetricStorageNumCacheHits is the metric for monitoring the number of storage units lookups served by the caches
+const MetricStorageNumCacheHits = "erd_storage_num_cache_hits"
+
+// MetricStorageNumCacheMisses is the metric for monitoring the number of storage units lookups not served by the caches
+const MetricStorageNumCacheMisses = "erd_storage_num_cache_misses"
+
+// MetricTrieSnapshotInProgress is the metric that signals if the state trie snapshot is in progress
+const MetricTrieSnapshotInProgress = "erd_trie_snapshot_in_progress"
+
+// MetricTrieSnapshotResumed is the metric that signals if the current state trie snapshot was resumed after a restart
+const MetricTrieSnapshotResumed = "erd_trie_snapshot_resumed"
+
+// MetricTrieSnapshotProgressPercent is the metric for monitoring the estimated progress of the state trie snapshot [%]
+const MetricTrieSnapshotProgressPercent = "erd_trie_snapshot_progress_percent"
+
+// MetricTrieSnapshotNumNodesCopied is the metric for monitoring the number of state trie nodes copied by the snapshot
+const MetricTrieSnapshotNumNodesCopied = "erd_trie_snapshot_num_nodes_copied"
+
 // MetricNetworkSentPercent is the metric for monitoring network sent load [%]
 const MetricNetworkSentPercent = "erd_network_sent_percent"
 
@@ -658,6 +708,14 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricEventNotifierQueueDepth is the metric that outputs the number of events waiting in the event notifier's
+// persistent queue to be delivered
+const MetricEventNotifierQueueDepth = "erd_event_notifier_queue_depth"
+
+// MetricEventNotifierQueueLag is the metric that outputs the age, in seconds, of the oldest event waiting in the
+// event notifier's persistent queue to be delivered
+const MetricEventNotifierQueueLag = "erd_event_notifier_queue_lag"
+
 // MetricAreVMQueriesReady will hold the string representation of the boolean that indicated if the node is ready
 // to process VM queries
 const MetricAreVMQueriesReady = "erd_are_vm_queries_ready"
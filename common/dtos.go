@@ -1,5 +1,11 @@
 package common
 
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
 	Proof    [][]byte
@@ -7,6 +13,30 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// TrieLeafData holds the key and the value of a trie leaf
+type TrieLeafData struct {
+	Key   []byte
+	Value []byte
+}
+
+// GetMultiProofResponse is a struct that stores the response of a multi key or a range proof API request. The proof
+// holds each trie node only once. For range proofs, EndKey is the excluded end of the proven range, which is the first
+// key left out if the range was truncated
+type GetMultiProofResponse struct {
+	Proof       [][]byte
+	Leaves      []TrieLeafData
+	MissingKeys [][]byte
+	EndKey      []byte
+	RootHash    string
+}
+
+// VerifyMultiProofResponse is a struct that stores the leaves proven to exist and the keys proven to be missing by a
+// multi key or a range proof
+type VerifyMultiProofResponse struct {
+	Leaves      []TrieLeafData
+	MissingKeys [][]byte
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []Transaction `json:"regularTransactions"`
@@ -24,6 +54,31 @@ type TransactionsPoolForSenderApiResponse struct {
 	Transactions []Transaction `json:"transactions"`
 }
 
+// TransactionsByAddressApiResponse is a struct that holds the data to be returned when getting the (historical)
+// transactions of an address from an API call
+type TransactionsByAddressApiResponse struct {
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions"`
+	NumTransactions uint64                              `json:"numTransactions"`
+}
+
+// AccountsBulkQuery holds the addresses to be fetched in a single API call, along with the token identifiers and
+// the (hex encoded) storage keys to be fetched for each of them
+type AccountsBulkQuery struct {
+	Addresses        []string `json:"addresses"`
+	TokenIdentifiers []string `json:"tokens"`
+	Keys             []string `json:"keys"`
+}
+
+// AccountBulkResult holds the data fetched for one of the addresses of an AccountsBulkQuery. If the data could not
+// be fetched, Error will hold the cause
+type AccountBulkResult struct {
+	Address string
+	Account *api.AccountResponse
+	ESDTs   map[string]*esdt.ESDigitalToken
+	Values  map[string]string
+	Error   string
+}
+
 // NonceGapApiResponse is a struct that holds a nonce gap from transactions pool
 // From - first unknown nonce
 // To   - last unknown nonce
@@ -66,3 +121,216 @@ type EpochStartDataAPI struct {
 	AccumulatedFees   string `json:"accumulatedFees,omitempty"`
 	DeveloperFees     string `json:"developerFees,omitempty"`
 }
+
+// StateDiffEntry holds a key that changed between two states. An empty old value marks an added key and an empty new
+// value marks a removed key. The key is empty for the changes of an account and holds the data trie key for the
+// changes of the account storage
+type StateDiffEntry struct {
+	Address  []byte
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
+
+// StateDiffKeyApiResponse is a struct that holds a changed key to be returned when getting a state diff from an API call
+type StateDiffKeyApiResponse struct {
+	Address  string `json:"address"`
+	Key      string `json:"key,omitempty"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// StateDiffApiResponse is a struct that holds the data to be returned when getting a state diff from an API call
+type StateDiffApiResponse struct {
+	Added     []StateDiffKeyApiResponse `json:"added"`
+	Removed   []StateDiffKeyApiResponse `json:"removed"`
+	Modified  []StateDiffKeyApiResponse `json:"modified"`
+	Truncated bool                      `json:"truncated"`
+}
+
+// DbIntegrityMissingKey is a struct that holds a key found missing by the database integrity check
+type DbIntegrityMissingKey struct {
+	Unit        string `json:"unit"`
+	Key         string `json:"key"`
+	Epoch       uint32 `json:"epoch"`
+	HeaderNonce uint64 `json:"headerNonce"`
+	Refetched   bool   `json:"refetched"`
+}
+
+// DbIntegrityReport is a struct that holds the result of a database integrity check
+type DbIntegrityReport struct {
+	InProgress      bool                    `json:"inProgress"`
+	Error           string                  `json:"error,omitempty"`
+	FirstEpoch      uint32                  `json:"firstEpoch"`
+	LastEpoch       uint32                  `json:"lastEpoch"`
+	FirstNonce      uint64                  `json:"firstNonce"`
+	LastNonce       uint64                  `json:"lastNonce"`
+	NumHeaders      uint64                  `json:"numHeaders"`
+	NumMiniBlocks   uint64                  `json:"numMiniBlocks"`
+	NumTransactions uint64                  `json:"numTransactions"`
+	NumRootHashes   uint64                  `json:"numRootHashes"`
+	NumTrieNodes    uint64                  `json:"numTrieNodes"`
+	NumRefetched    uint64                  `json:"numRefetched"`
+	MissingKeys     []DbIntegrityMissingKey `json:"missingKeys"`
+	Truncated       bool                    `json:"truncated"`
+}
+
+// DataTrieStatisticsApiResponse is a struct that holds the statistics of the data trie of an account
+type DataTrieStatisticsApiResponse struct {
+	Address       string `json:"address"`
+	RootHash      string `json:"rootHash"`
+	NumNodes      uint64 `json:"numNodes"`
+	NumLeaves     int    `json:"numLeaves"`
+	NumExtensions int    `json:"numExtensions"`
+	NumBranches   int    `json:"numBranches"`
+	Depth         int    `json:"depth"`
+	SizeInBytes   uint64 `json:"sizeInBytes"`
+}
+
+// DataTriesReport is a struct that holds the result of a data tries analysis
+type DataTriesReport struct {
+	InProgress       bool                            `json:"inProgress"`
+	Error            string                          `json:"error,omitempty"`
+	RootHash         string                          `json:"rootHash"`
+	SortBy           string                          `json:"sortBy"`
+	NumAccounts      uint64                          `json:"numAccounts"`
+	NumDataTries     uint64                          `json:"numDataTries"`
+	TotalNumNodes    uint64                          `json:"totalNumNodes"`
+	TotalSizeInBytes uint64                          `json:"totalSizeInBytes"`
+	Largest          []DataTrieStatisticsApiResponse `json:"largest"`
+}
+
+// StorageDatabaseStats is a struct that holds the usage statistics of one database of a storage unit
+type StorageDatabaseStats struct {
+	Epoch       uint32 `json:"epoch"`
+	Path        string `json:"path"`
+	SizeInBytes uint64 `json:"sizeInBytes"`
+	NumKeys     uint64 `json:"numKeys"`
+}
+
+// StorageUnitStats is a struct that holds the usage statistics of a storage unit
+type StorageUnitStats struct {
+	Unit           string                 `json:"unit"`
+	IsStatic       bool                   `json:"isStatic"`
+	SizeInBytes    uint64                 `json:"sizeInBytes"`
+	NumKeys        uint64                 `json:"numKeys"`
+	NumPuts        uint64                 `json:"numPuts"`
+	NumCacheHits   uint64                 `json:"numCacheHits"`
+	NumCacheMisses uint64                 `json:"numCacheMisses"`
+	CacheHitRatio  float64                `json:"cacheHitRatio"`
+	Databases      []StorageDatabaseStats `json:"databases"`
+}
+
+// StorageStatsApiResponse is a struct that holds the data to be returned when getting the storage statistics from an
+// API call
+type StorageStatsApiResponse struct {
+	SizeInBytes    uint64             `json:"sizeInBytes"`
+	NumCacheHits   uint64             `json:"numCacheHits"`
+	NumCacheMisses uint64             `json:"numCacheMisses"`
+	Units          []StorageUnitStats `json:"units"`
+}
+
+// SnapshotProgress holds the progress of the resumable trie snapshot
+type SnapshotProgress struct {
+	InProgress     bool
+	Resumed        bool
+	RootHash       []byte
+	NumNodesCopied uint64
+	Percent        uint64
+}
+
+// SignedConsensusMessage holds a consensus message exactly as it was received from the network. The payload is the
+// marshalled consensus message (carrying the BLS signature of the originator peer ID) and is itself signed with the
+// p2p key of the originator, so that the message can be attributed to its public key by any third party
+type SignedConsensusMessage struct {
+	MessageType  string `json:"messageType"`
+	HeaderHash   []byte `json:"headerHash"`
+	Payload      []byte `json:"payload"`
+	Originator   string `json:"originator"`
+	P2PKey       []byte `json:"p2pKey"`
+	P2PSignature []byte `json:"p2pSignature"`
+	SeqNo        []byte `json:"seqNo"`
+	Topic        string `json:"topic"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+// EquivocationEvidence holds two conflicting consensus messages signed by the same validator in the same round
+type EquivocationEvidence struct {
+	PublicKey     []byte                 `json:"publicKey"`
+	ShardID       uint32                 `json:"shardID"`
+	Round         int64                  `json:"round"`
+	Kind          string                 `json:"kind"`
+	DetectedAt    int64                  `json:"detectedAt"`
+	FirstMessage  SignedConsensusMessage `json:"firstMessage"`
+	SecondMessage SignedConsensusMessage `json:"secondMessage"`
+}
+
+// ConsensusMessageTimeline holds the moment a consensus message was received and the outcome of its validation
+type ConsensusMessageTimeline struct {
+	MessageType string `json:"messageType"`
+	PublicKey   string `json:"publicKey"`
+	PeerID      string `json:"peerID"`
+	ReceivedAt  int64  `json:"receivedAt"`
+	IsValid     bool   `json:"isValid"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ConsensusSubroundTimeline holds the moments a consensus subround started and ended, together with the messages
+// received for it. The moments are unix timestamps in milliseconds, 0 meaning that the event did not happen
+type ConsensusSubroundTimeline struct {
+	Name       string                     `json:"name"`
+	StartedAt  int64                      `json:"startedAt"`
+	EndedAt    int64                      `json:"endedAt"`
+	IsFinished bool                       `json:"isFinished"`
+	Messages   []ConsensusMessageTimeline `json:"messages"`
+}
+
+// ConsensusRoundTimeline holds what happened, and when, in a consensus round
+type ConsensusRoundTimeline struct {
+	Round                     int64                       `json:"round"`
+	Subrounds                 []ConsensusSubroundTimeline `json:"subrounds"`
+	BlockProcessingDurationMs int64                       `json:"blockProcessingDurationMs"`
+	BlockProcessingError      string                      `json:"blockProcessingError,omitempty"`
+}
+
+// P2PFaultRule defines a fault injected on the p2p messages matching the topic, the peer and the direction. An empty
+// topic, peer or direction matches all the messages, while a topic ending in * matches all the topics with that prefix
+type P2PFaultRule struct {
+	Name                string  `json:"name"`
+	Topic               string  `json:"topic"`
+	Peer                string  `json:"peer"`
+	Direction           string  `json:"direction"`
+	Fault               string  `json:"fault"`
+	Probability         float64 `json:"probability"`
+	DelayInMilliseconds uint64  `json:"delayInMilliseconds"`
+}
+
+// P2PFaultRuleStatus holds a p2p fault rule together with the number of times it was applied
+type P2PFaultRuleStatus struct {
+	Rule       P2PFaultRule `json:"rule"`
+	NumApplied uint64       `json:"numApplied"`
+}
+
+// P2PTrafficCounters holds the number of bytes and the number of messages sent or received
+type P2PTrafficCounters struct {
+	Bytes    uint64 `json:"bytes"`
+	Messages uint64 `json:"messages"`
+}
+
+// P2PTrafficStatistics holds the cumulative and the windowed traffic of a topic or of a peer
+type P2PTrafficStatistics struct {
+	Name             string             `json:"name"`
+	Inbound          P2PTrafficCounters `json:"inbound"`
+	Outbound         P2PTrafficCounters `json:"outbound"`
+	InboundInWindow  P2PTrafficCounters `json:"inboundInWindow"`
+	OutboundInWindow P2PTrafficCounters `json:"outboundInWindow"`
+}
+
+// P2PTrafficReport holds the p2p traffic per topic and per peer. The peers are sorted descending by their traffic in
+// the last window and the top talkers are the first of them
+type P2PTrafficReport struct {
+	WindowInSeconds uint64                 `json:"windowInSeconds"`
+	Topics          []P2PTrafficStatistics `json:"topics"`
+	Peers           []P2PTrafficStatistics `json:"peers"`
+	TopTalkers      []string               `json:"topTalkers"`
+}
@@ -18,6 +18,17 @@ type NumNodesDTO struct {
 	MaxLevel   int
 }
 
+// TrieStatisticsDTO represents the DTO structure that will hold the number of nodes of a trie loaded from the storage,
+// split by category, along with the cumulated size of the serialized nodes
+type TrieStatisticsDTO struct {
+	NumNodesDTO
+	SizeInBytes uint64
+}
+
+// TrieLeavesDiffHandler is called for each key that differs between two tries. The old value is empty for an added
+// key and the new value is empty for a removed key
+type TrieLeavesDiffHandler func(key []byte, oldValue []byte, newValue []byte) error
+
 // Trie is an interface for Merkle Trees implementations
 type Trie interface {
 	Get(key []byte) ([]byte, error)
@@ -38,6 +49,10 @@ type Trie interface {
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, map[string][]byte, error)
+	GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error)
+	GetLeavesDiff(ctx context.Context, fromRootHash []byte, toRootHash []byte, handler TrieLeavesDiffHandler) error
+	GetTrieStatistics(ctx context.Context, rootHash []byte) (TrieStatisticsDTO, error)
 	GetStorageManager() StorageManager
 	MarkStorerAsSyncedAndActive()
 	Close() error
@@ -109,6 +124,9 @@ type Locker interface {
 // MerkleProofVerifier is used to verify merkle proofs
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (map[string][]byte, error)
+	VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]core.KeyValueHolder, error)
+	IsInterfaceNil() bool
 }
 
 // SizeSyncStatisticsHandler extends the SyncStatisticsHandler interface by allowing setting up the trie node size
@@ -132,6 +150,18 @@ type SnapshotStatisticsHandler interface {
 	WaitForSnapshotsToFinish()
 }
 
+// SnapshotLeavesBarrier is written on the leaves channel of a resumable snapshot each time a part of the trie has been
+// fully copied. The channel consumer must call Release after all the work started for the previous leaves has finished
+type SnapshotLeavesBarrier interface {
+	core.KeyValueHolder
+	Release()
+}
+
+// SnapshotProgressHandler defines the component able to report the progress of the resumable trie snapshot
+type SnapshotProgressHandler interface {
+	GetSnapshotProgress() SnapshotProgress
+}
+
 // ProcessStatusHandler defines the behavior of a component able to hold the current status of the node and
 // able to tell if the node is idle or processing/committing a block
 type ProcessStatusHandler interface {
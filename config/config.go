@@ -41,6 +41,19 @@ type TrieSyncStorageConfig struct {
 	DB          DBConfig
 }
 
+// TxPoolOverflowConfig will map the configuration of the disk-backed overflow of the transactions pool
+type TxPoolOverflowConfig struct {
+	Enabled           bool
+	MaxNumTxsPerCache uint32
+	DB                DBConfig
+}
+
+// TxPoolPersistenceConfig will map the configuration of the storage where the transactions pool is saved upon a clean shutdown
+type TxPoolPersistenceConfig struct {
+	Enabled bool
+	DB      DBConfig
+}
+
 // PubkeyConfig will map the public key configuration
 type PubkeyConfig struct {
 	Length          int
@@ -62,7 +75,8 @@ type MarshalizerConfig struct {
 
 // ConsensusConfig holds the consensus configuration parameters
 type ConsensusConfig struct {
-	Type string
+	Type                string
+	NumRoundsInTimeline uint32
 }
 
 // NTPConfig will hold the configuration for NTP queries
@@ -137,6 +151,7 @@ type Config struct {
 	ShardHdrNonceHashStorage        StorageConfig
 	MetaHdrNonceHashStorage         StorageConfig
 	StatusMetricsStorage            StorageConfig
+	EquivocationEvidenceStorage     StorageConfig
 	ReceiptsStorage                 StorageConfig
 	ScheduledSCRsStorage            StorageConfig
 	SmartContractsStorage           StorageConfig
@@ -159,6 +174,8 @@ type Config struct {
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolOverflow              TxPoolOverflowConfig
+	TxPoolPersistence           TxPoolPersistenceConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
@@ -457,6 +474,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	TransactionsByAddressEnabled       bool
+	TransactionsByAddressStorageConfig StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -556,6 +575,7 @@ type Configs struct {
 	P2pConfig                *P2PConfig
 	FlagsConfig              *ContextFlagsConfig
 	ImportDbConfig           *ImportDbConfig
+	OutportReplayConfig      *OutportReplayConfig
 	ConfigurationPathsHolder *ConfigurationPathsHolder
 	EpochConfig              *EpochConfig
 	RoundConfig              *RoundConfig
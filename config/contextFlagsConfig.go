@@ -34,3 +34,10 @@ type ImportDbConfig struct {
 	ImportDbNoSigCheckFlag        bool
 	ImportDbSaveTrieEpochRootHash bool
 }
+
+// OutportReplayConfig will hold the outport replay parameters
+type OutportReplayConfig struct {
+	IsOutportReplayMode bool
+	StartNonce          uint64
+	EndNonce            uint64
+}
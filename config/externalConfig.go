@@ -5,6 +5,8 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	CovalentConnector      CovalentConfig
+	SubscriptionsConnector SubscriptionsConfig
+	FileDriverConnector    FileDriverConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -26,6 +28,15 @@ type EventNotifierConfig struct {
 	ProxyUrl         string
 	Username         string
 	Password         string
+	PersistentQueue  EventNotifierQueueConfig
+}
+
+// EventNotifierQueueConfig will hold the configuration for the disk-backed queue of the events notifier driver
+type EventNotifierQueueConfig struct {
+	Enabled                            bool
+	InitialRetryIntervalInMilliseconds uint64
+	MaxRetryIntervalInSeconds          uint64
+	Storage                            StorageConfig
 }
 
 // CovalentConfig will hold the configurations for covalent indexer
@@ -35,3 +46,20 @@ type CovalentConfig struct {
 	RouteSendData        string
 	RouteAcknowledgeData string
 }
+
+// SubscriptionsConfig will hold the configuration for the websocket subscriptions hub driver
+type SubscriptionsConfig struct {
+	Enabled              bool
+	SubscriberBufferSize int
+	MaxSubscribers       int
+}
+
+// FileDriverConfig will hold the configuration for the outport driver that writes records to local files or a named pipe
+type FileDriverConfig struct {
+	Enabled                 bool
+	Path                    string
+	UseNamedPipe            bool
+	Format                  string
+	MaxFileSizeInMB         uint64
+	RemoveAcknowledgedFiles bool
+}
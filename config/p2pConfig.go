@@ -5,6 +5,8 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	FaultInjection      FaultInjectionConfig
+	TrafficAccounting   TrafficAccountingConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -44,3 +46,16 @@ type ShardingConfig struct {
 type AdditionalConnectionsConfig struct {
 	MaxFullHistoryObservers uint32
 }
+
+// FaultInjectionConfig will hold the network faults injection settings. The faults are meant to be injected only on
+// test and staging networks
+type FaultInjectionConfig struct {
+	Enabled   bool
+	RulesFile string
+}
+
+// TrafficAccountingConfig will hold the settings of the p2p traffic accounting done per topic and per peer
+type TrafficAccountingConfig struct {
+	WindowInSeconds uint32
+	NumTopTalkers   uint32
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -147,3 +148,13 @@ type ScheduledProcessor interface {
 	IsProcessedOKWithTimeout() bool
 	IsInterfaceNil() bool
 }
+
+// ConsensusTimelineRecorder keeps the timeline of the subrounds and of the received messages for the last consensus rounds
+type ConsensusTimelineRecorder interface {
+	RecordSubroundStart(round int64, subroundId int)
+	RecordSubroundEnd(round int64, subroundId int, isFinished bool)
+	RecordMessage(cnsMsg *Message, pid core.PeerID, validationErr error)
+	RecordBlockProcessing(round int64, duration time.Duration, err error)
+	GetRounds() []*common.ConsensusRoundTimeline
+	IsInterfaceNil() bool
+}
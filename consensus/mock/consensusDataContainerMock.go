@@ -37,6 +37,7 @@ type ConsensusCoreMock struct {
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
 	scheduledProcessor      consensus.ScheduledProcessor
+	timelineRecorder        consensus.ConsensusTimelineRecorder
 }
 
 // GetAntiFloodHandler -
@@ -224,6 +225,16 @@ func (ccm *ConsensusCoreMock) ScheduledProcessor() consensus.ScheduledProcessor
 	return ccm.scheduledProcessor
 }
 
+// ConsensusTimelineRecorder -
+func (ccm *ConsensusCoreMock) ConsensusTimelineRecorder() consensus.ConsensusTimelineRecorder {
+	return ccm.timelineRecorder
+}
+
+// SetConsensusTimelineRecorder -
+func (ccm *ConsensusCoreMock) SetConsensusTimelineRecorder(timelineRecorder consensus.ConsensusTimelineRecorder) {
+	ccm.timelineRecorder = timelineRecorder
+}
+
 // SetNodeRedundancyHandler -
 func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) {
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	ProcessMessageCalled          func(cnsMsg *consensus.Message, message p2p.MessageP2P)
+	RemoveMessagesOlderThanCalled func(round int64)
+	CloseCalled                   func() error
+}
+
+// ProcessMessage -
+func (stub *EquivocationDetectorStub) ProcessMessage(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+	if stub.ProcessMessageCalled != nil {
+		stub.ProcessMessageCalled(cnsMsg, message)
+	}
+}
+
+// RemoveMessagesOlderThan -
+func (stub *EquivocationDetectorStub) RemoveMessagesOlderThan(round int64) {
+	if stub.RemoveMessagesOlderThanCalled != nil {
+		stub.RemoveMessagesOlderThanCalled(round)
+	}
+}
+
+// Close -
+func (stub *EquivocationDetectorStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
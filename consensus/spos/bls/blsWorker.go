@@ -55,6 +55,11 @@ func (wrk *worker) GetSubroundName(subroundId int) string {
 	return getSubroundName(subroundId)
 }
 
+//GetSubroundForMessage gets the id of the subround the provided messageType belongs to
+func (wrk *worker) GetSubroundForMessage(msgType consensus.MessageType) int {
+	return getSubroundForMessage(msgType)
+}
+
 //IsMessageWithBlockBodyAndHeader returns if the current messageType is about block body and header
 func (wrk *worker) IsMessageWithBlockBodyAndHeader(msgType consensus.MessageType) bool {
 	return msgType == MtBlockBodyAndHeader
@@ -254,6 +254,19 @@ func TestWorker_GetSubroundName(t *testing.T) {
 	assert.Equal(t, "Undefined subround", r)
 }
 
+func TestWorker_GetSubroundForMessage(t *testing.T) {
+	t.Parallel()
+
+	service, _ := bls.NewConsensusService()
+
+	assert.Equal(t, bls.SrBlock, service.GetSubroundForMessage(bls.MtBlockBodyAndHeader))
+	assert.Equal(t, bls.SrBlock, service.GetSubroundForMessage(bls.MtBlockBody))
+	assert.Equal(t, bls.SrBlock, service.GetSubroundForMessage(bls.MtBlockHeader))
+	assert.Equal(t, bls.SrSignature, service.GetSubroundForMessage(bls.MtSignature))
+	assert.Equal(t, bls.SrEndRound, service.GetSubroundForMessage(bls.MtBlockHeaderFinalInfo))
+	assert.Equal(t, -1, service.GetSubroundForMessage(bls.MtUnknown))
+}
+
 func TestWorker_GetStringValue(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,9 @@ const (
 	SrEndRound
 )
 
+// srUnknown defines the ID returned for the messages which do not belong to any Subround
+const srUnknown = -1
+
 const (
 	// MtUnknown defines ID of a message that has unknown data inside
 	MtUnknown consensus.MessageType = iota
@@ -121,3 +124,17 @@ func getSubroundName(subroundId int) string {
 		return "Undefined subround"
 	}
 }
+
+// getSubroundForMessage returns the ID of the Subround in which a given message type is sent
+func getSubroundForMessage(msgType consensus.MessageType) int {
+	switch msgType {
+	case MtBlockBodyAndHeader, MtBlockBody, MtBlockHeader:
+		return SrBlock
+	case MtSignature:
+		return SrSignature
+	case MtBlockHeaderFinalInfo:
+		return SrEndRound
+	default:
+		return srUnknown
+	}
+}
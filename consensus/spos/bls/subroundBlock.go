@@ -162,10 +162,12 @@ func (sr *subroundBlock) createBlock(header data.HeaderHandler) (data.HeaderHand
 		return sr.RoundHandler().RemainingTime(startTime, maxTime) > 0
 	}
 
+	creationStartTime := time.Now()
 	finalHeader, blockBody, err := sr.BlockProcessor().CreateBlock(
 		header,
 		haveTimeInCurrentSubround,
 	)
+	sr.ConsensusTimelineRecorder().RecordBlockProcessing(sr.RoundIndex, time.Since(creationStartTime), err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -536,6 +538,7 @@ func (sr *subroundBlock) processReceivedBlock(ctx context.Context, cnsDta *conse
 		sr.Body,
 		remainingTimeInCurrentRound,
 	)
+	sr.ConsensusTimelineRecorder().RecordBlockProcessing(cnsDta.RoundIndex, time.Since(metricStatTime), err)
 
 	if cnsDta.RoundIndex < sr.RoundHandler().Index() {
 		log.Debug("canceled round, round index has been changed",
@@ -37,6 +37,7 @@ type ConsensusCore struct {
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
 	scheduledProcessor            consensus.ScheduledProcessor
+	consensusTimelineRecorder     consensus.ConsensusTimelineRecorder
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -62,6 +63,7 @@ type ConsensusCoreArgs struct {
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
 	ScheduledProcessor            consensus.ScheduledProcessor
+	ConsensusTimelineRecorder     consensus.ConsensusTimelineRecorder
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -90,6 +92,7 @@ func NewConsensusCore(
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
 		scheduledProcessor:            args.ScheduledProcessor,
+		consensusTimelineRecorder:     args.ConsensusTimelineRecorder,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -205,6 +208,11 @@ func (cc *ConsensusCore) ScheduledProcessor() consensus.ScheduledProcessor {
 	return cc.scheduledProcessor
 }
 
+// ConsensusTimelineRecorder will return the recorder of the consensus rounds timeline
+func (cc *ConsensusCore) ConsensusTimelineRecorder() consensus.ConsensusTimelineRecorder {
+	return cc.consensusTimelineRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -64,6 +64,9 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.NodeRedundancyHandler()) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(container.ConsensusTimelineRecorder()) {
+		return ErrNilConsensusTimelineRecorder
+	}
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
@@ -31,27 +32,29 @@ func initConsensusDataContainer() *ConsensusCore {
 	headerSigVerifier := &mock.HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	consensusTimelineRecorder := &consensus.ConsensusTimelineRecorderStub{}
 
 	return &ConsensusCore{
-		blockChain:              blockChain,
-		blockProcessor:          blockProcessorMock,
-		bootstrapper:            bootstrapperMock,
-		broadcastMessenger:      broadcastMessengerMock,
-		chronologyHandler:       chronologyHandlerMock,
-		hasher:                  hasherMock,
-		marshalizer:             marshalizerMock,
-		blsPrivateKey:           blsPrivateKeyMock,
-		blsSingleSigner:         blsSingleSignerMock,
-		multiSigner:             multiSignerMock,
-		roundHandler:            roundHandlerMock,
-		shardCoordinator:        shardCoordinatorMock,
-		syncTimer:               syncTimerMock,
-		nodesCoordinator:        validatorGroupSelector,
-		antifloodHandler:        antifloodHandler,
-		peerHonestyHandler:      peerHonestyHandler,
-		headerSigVerifier:       headerSigVerifier,
-		fallbackHeaderValidator: fallbackHeaderValidator,
-		nodeRedundancyHandler:   nodeRedundancyHandler,
+		blockChain:                blockChain,
+		blockProcessor:            blockProcessorMock,
+		bootstrapper:              bootstrapperMock,
+		broadcastMessenger:        broadcastMessengerMock,
+		chronologyHandler:         chronologyHandlerMock,
+		hasher:                    hasherMock,
+		marshalizer:               marshalizerMock,
+		blsPrivateKey:             blsPrivateKeyMock,
+		blsSingleSigner:           blsSingleSignerMock,
+		multiSigner:               multiSignerMock,
+		roundHandler:              roundHandlerMock,
+		shardCoordinator:          shardCoordinatorMock,
+		syncTimer:                 syncTimerMock,
+		nodesCoordinator:          validatorGroupSelector,
+		antifloodHandler:          antifloodHandler,
+		peerHonestyHandler:        peerHonestyHandler,
+		headerSigVerifier:         headerSigVerifier,
+		fallbackHeaderValidator:   fallbackHeaderValidator,
+		nodeRedundancyHandler:     nodeRedundancyHandler,
+		consensusTimelineRecorder: consensusTimelineRecorder,
 	}
 }
 
@@ -231,6 +234,17 @@ func TestConsensusContainerValidator_ValidateNilNodeRedundancyHandlerShouldFail(
 	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilConsensusTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.consensusTimelineRecorder = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilConsensusTimelineRecorder, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
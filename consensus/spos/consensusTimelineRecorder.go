@@ -0,0 +1,238 @@
+package spos
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+// maxMessagesInSubroundTimeline bounds the number of messages kept for a subround, so that a flood of messages can
+// not grow the timeline indefinitely
+const maxMessagesInSubroundTimeline = 2000
+
+// ArgsConsensusTimelineRecorder holds the arguments needed to create a consensus timeline recorder
+type ArgsConsensusTimelineRecorder struct {
+	ConsensusService ConsensusService
+	SyncTimer        ntp.SyncTimer
+	RoundHandler     consensus.RoundHandler
+	NumRounds        uint32
+}
+
+type roundTimeline struct {
+	round                   int64
+	subrounds               map[int]*common.ConsensusSubroundTimeline
+	blockProcessingDuration time.Duration
+	blockProcessingError    string
+}
+
+type consensusTimelineRecorder struct {
+	consensusService ConsensusService
+	syncTimer        ntp.SyncTimer
+	roundHandler     consensus.RoundHandler
+
+	mutRounds sync.RWMutex
+	rounds    []*roundTimeline
+}
+
+// NewConsensusTimelineRecorder creates a component which keeps, in a ring buffer, the timeline of the last consensus
+// rounds: when each subround started and ended, when each consensus message was received and how long the block
+// processing took
+func NewConsensusTimelineRecorder(args ArgsConsensusTimelineRecorder) (*consensusTimelineRecorder, error) {
+	if check.IfNil(args.ConsensusService) {
+		return nil, ErrNilConsensusService
+	}
+	if check.IfNil(args.SyncTimer) {
+		return nil, ErrNilSyncTimer
+	}
+	if check.IfNil(args.RoundHandler) {
+		return nil, ErrNilRoundHandler
+	}
+	if args.NumRounds == 0 {
+		return nil, ErrInvalidNumRoundsInTimeline
+	}
+
+	return &consensusTimelineRecorder{
+		consensusService: args.ConsensusService,
+		syncTimer:        args.SyncTimer,
+		roundHandler:     args.RoundHandler,
+		rounds:           make([]*roundTimeline, args.NumRounds),
+	}, nil
+}
+
+// RecordSubroundStart records the moment the provided subround started in the provided round
+func (ctr *consensusTimelineRecorder) RecordSubroundStart(round int64, subroundId int) {
+	ctr.mutRounds.Lock()
+	defer ctr.mutRounds.Unlock()
+
+	subround := ctr.getOrCreateSubround(round, subroundId)
+	if subround == nil {
+		return
+	}
+
+	subround.StartedAt = ctr.currentTimestamp()
+}
+
+// RecordSubroundEnd records the moment the provided subround ended in the provided round and whether it was finished
+// or it timed out
+func (ctr *consensusTimelineRecorder) RecordSubroundEnd(round int64, subroundId int, isFinished bool) {
+	ctr.mutRounds.Lock()
+	defer ctr.mutRounds.Unlock()
+
+	subround := ctr.getOrCreateSubround(round, subroundId)
+	if subround == nil {
+		return
+	}
+
+	subround.EndedAt = ctr.currentTimestamp()
+	subround.IsFinished = isFinished
+}
+
+// RecordMessage records the moment the provided consensus message was received, under the subround it belongs to
+func (ctr *consensusTimelineRecorder) RecordMessage(cnsMsg *consensus.Message, pid core.PeerID, validationErr error) {
+	if cnsMsg == nil {
+		return
+	}
+
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	messageTimeline := common.ConsensusMessageTimeline{
+		MessageType: ctr.consensusService.GetStringValue(msgType),
+		PublicKey:   hex.EncodeToString(cnsMsg.PubKey),
+		PeerID:      pid.Pretty(),
+		IsValid:     validationErr == nil,
+	}
+	if validationErr != nil {
+		messageTimeline.Error = validationErr.Error()
+	}
+
+	ctr.mutRounds.Lock()
+	defer ctr.mutRounds.Unlock()
+
+	subround := ctr.getOrCreateSubround(cnsMsg.RoundIndex, ctr.consensusService.GetSubroundForMessage(msgType))
+	if subround == nil || len(subround.Messages) >= maxMessagesInSubroundTimeline {
+		return
+	}
+
+	messageTimeline.ReceivedAt = ctr.currentTimestamp()
+	subround.Messages = append(subround.Messages, messageTimeline)
+}
+
+// RecordBlockProcessing records how long the processing of the block proposed in the provided round took
+func (ctr *consensusTimelineRecorder) RecordBlockProcessing(round int64, duration time.Duration, err error) {
+	ctr.mutRounds.Lock()
+	defer ctr.mutRounds.Unlock()
+
+	timeline := ctr.getOrCreateRound(round)
+	if timeline == nil {
+		return
+	}
+
+	timeline.blockProcessingDuration = duration
+	timeline.blockProcessingError = ""
+	if err != nil {
+		timeline.blockProcessingError = err.Error()
+	}
+}
+
+// getOrCreateRound returns the timeline of the provided round, replacing the oldest one if needed. Returns nil if the
+// provided round is older than the rounds kept in the timeline or if it is ahead of the next round
+func (ctr *consensusTimelineRecorder) getOrCreateRound(round int64) *roundTimeline {
+	maxRound := ctr.roundHandler.Index() + 1
+	if round < 0 || round > maxRound {
+		return nil
+	}
+
+	position := round % int64(len(ctr.rounds))
+	timeline := ctr.rounds[position]
+	if timeline != nil && timeline.round == round {
+		return timeline
+	}
+	// a slot holding a round ahead of the next round is stale, so it is always replaced
+	if timeline != nil && timeline.round > round && timeline.round <= maxRound {
+		return nil
+	}
+
+	timeline = &roundTimeline{
+		round:     round,
+		subrounds: make(map[int]*common.ConsensusSubroundTimeline),
+	}
+	ctr.rounds[position] = timeline
+
+	return timeline
+}
+
+func (ctr *consensusTimelineRecorder) getOrCreateSubround(round int64, subroundId int) *common.ConsensusSubroundTimeline {
+	timeline := ctr.getOrCreateRound(round)
+	if timeline == nil {
+		return nil
+	}
+
+	subround, ok := timeline.subrounds[subroundId]
+	if !ok {
+		subround = &common.ConsensusSubroundTimeline{
+			Name:     ctr.consensusService.GetSubroundName(subroundId),
+			Messages: make([]common.ConsensusMessageTimeline, 0),
+		}
+		timeline.subrounds[subroundId] = subround
+	}
+
+	return subround
+}
+
+func (ctr *consensusTimelineRecorder) currentTimestamp() int64 {
+	return ctr.syncTimer.CurrentTime().UnixNano() / int64(time.Millisecond)
+}
+
+// GetRounds returns the timeline of the kept rounds, most recent rounds first
+func (ctr *consensusTimelineRecorder) GetRounds() []*common.ConsensusRoundTimeline {
+	ctr.mutRounds.RLock()
+	defer ctr.mutRounds.RUnlock()
+
+	rounds := make([]*common.ConsensusRoundTimeline, 0, len(ctr.rounds))
+	for _, timeline := range ctr.rounds {
+		if timeline == nil {
+			continue
+		}
+
+		rounds = append(rounds, timeline.toApiTimeline())
+	}
+
+	sort.Slice(rounds, func(i, j int) bool {
+		return rounds[i].Round > rounds[j].Round
+	})
+
+	return rounds
+}
+
+func (rt *roundTimeline) toApiTimeline() *common.ConsensusRoundTimeline {
+	subroundIds := make([]int, 0, len(rt.subrounds))
+	for subroundId := range rt.subrounds {
+		subroundIds = append(subroundIds, subroundId)
+	}
+	sort.Ints(subroundIds)
+
+	subrounds := make([]common.ConsensusSubroundTimeline, 0, len(subroundIds))
+	for _, subroundId := range subroundIds {
+		subround := *rt.subrounds[subroundId]
+		subround.Messages = append(make([]common.ConsensusMessageTimeline, 0, len(subround.Messages)), subround.Messages...)
+		subrounds = append(subrounds, subround)
+	}
+
+	return &common.ConsensusRoundTimeline{
+		Round:                     rt.round,
+		Subrounds:                 subrounds,
+		BlockProcessingDurationMs: rt.blockProcessingDuration.Milliseconds(),
+		BlockProcessingError:      rt.blockProcessingError,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ctr *consensusTimelineRecorder) IsInterfaceNil() bool {
+	return ctr == nil
+}
@@ -0,0 +1,209 @@
+package spos_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsConsensusTimelineRecorder() spos.ArgsConsensusTimelineRecorder {
+	blsService, _ := bls.NewConsensusService()
+
+	return spos.ArgsConsensusTimelineRecorder{
+		ConsensusService: blsService,
+		SyncTimer:        &mock.SyncTimerMock{},
+		RoundHandler:     &mock.RoundHandlerMock{RoundIndex: 10},
+		NumRounds:        3,
+	}
+}
+
+func TestNewConsensusTimelineRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil consensus service should error", func(t *testing.T) {
+		args := createMockArgsConsensusTimelineRecorder()
+		args.ConsensusService = nil
+		recorder, err := spos.NewConsensusTimelineRecorder(args)
+		assert.True(t, check.IfNil(recorder))
+		assert.Equal(t, spos.ErrNilConsensusService, err)
+	})
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		args := createMockArgsConsensusTimelineRecorder()
+		args.SyncTimer = nil
+		recorder, err := spos.NewConsensusTimelineRecorder(args)
+		assert.True(t, check.IfNil(recorder))
+		assert.Equal(t, spos.ErrNilSyncTimer, err)
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		args := createMockArgsConsensusTimelineRecorder()
+		args.RoundHandler = nil
+		recorder, err := spos.NewConsensusTimelineRecorder(args)
+		assert.True(t, check.IfNil(recorder))
+		assert.Equal(t, spos.ErrNilRoundHandler, err)
+	})
+	t.Run("zero rounds should error", func(t *testing.T) {
+		args := createMockArgsConsensusTimelineRecorder()
+		args.NumRounds = 0
+		recorder, err := spos.NewConsensusTimelineRecorder(args)
+		assert.True(t, check.IfNil(recorder))
+		assert.Equal(t, spos.ErrInvalidNumRoundsInTimeline, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		recorder, err := spos.NewConsensusTimelineRecorder(createMockArgsConsensusTimelineRecorder())
+		assert.False(t, check.IfNil(recorder))
+		assert.Nil(t, err)
+		assert.Empty(t, recorder.GetRounds())
+	})
+}
+
+func TestConsensusTimelineRecorder_RecordRound(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	args := createMockArgsConsensusTimelineRecorder()
+	args.SyncTimer = &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return currentTime
+		},
+	}
+	recorder, _ := spos.NewConsensusTimelineRecorder(args)
+
+	recorder.RecordSubroundStart(7, bls.SrStartRound)
+	currentTime = currentTime.Add(time.Millisecond * 10)
+	recorder.RecordSubroundEnd(7, bls.SrStartRound, true)
+	recorder.RecordSubroundStart(7, bls.SrBlock)
+
+	currentTime = currentTime.Add(time.Millisecond * 20)
+	header := &consensus.Message{RoundIndex: 7, PubKey: []byte("leader"), MsgType: int64(bls.MtBlockBodyAndHeader)}
+	recorder.RecordMessage(header, "leader pid", nil)
+	recorder.RecordBlockProcessing(7, time.Millisecond*150, nil)
+
+	currentTime = currentTime.Add(time.Millisecond * 200)
+	recorder.RecordSubroundEnd(7, bls.SrBlock, true)
+	recorder.RecordSubroundStart(7, bls.SrSignature)
+	expectedErr := errors.New("expected error")
+	signature := &consensus.Message{RoundIndex: 7, PubKey: []byte("validator"), MsgType: int64(bls.MtSignature)}
+	recorder.RecordMessage(signature, "validator pid", expectedErr)
+	recorder.RecordSubroundEnd(7, bls.SrSignature, false)
+
+	rounds := recorder.GetRounds()
+	require.Len(t, rounds, 1)
+	round := rounds[0]
+	assert.Equal(t, int64(7), round.Round)
+	assert.Equal(t, int64(150), round.BlockProcessingDurationMs)
+	assert.Empty(t, round.BlockProcessingError)
+	require.Len(t, round.Subrounds, 3)
+
+	startRound := round.Subrounds[0]
+	assert.Equal(t, "(START_ROUND)", startRound.Name)
+	assert.Equal(t, int64(1000000), startRound.StartedAt)
+	assert.Equal(t, int64(1000010), startRound.EndedAt)
+	assert.True(t, startRound.IsFinished)
+	assert.Empty(t, startRound.Messages)
+
+	blockSubround := round.Subrounds[1]
+	assert.Equal(t, "(BLOCK)", blockSubround.Name)
+	assert.Equal(t, int64(1000010), blockSubround.StartedAt)
+	assert.Equal(t, int64(1000230), blockSubround.EndedAt)
+	require.Len(t, blockSubround.Messages, 1)
+	assert.Equal(t, bls.BlockBodyAndHeaderStringValue, blockSubround.Messages[0].MessageType)
+	assert.Equal(t, hex.EncodeToString([]byte("leader")), blockSubround.Messages[0].PublicKey)
+	assert.Equal(t, core.PeerID("leader pid").Pretty(), blockSubround.Messages[0].PeerID)
+	assert.Equal(t, int64(1000030), blockSubround.Messages[0].ReceivedAt)
+	assert.True(t, blockSubround.Messages[0].IsValid)
+
+	signatureSubround := round.Subrounds[2]
+	assert.Equal(t, "(SIGNATURE)", signatureSubround.Name)
+	assert.False(t, signatureSubround.IsFinished)
+	require.Len(t, signatureSubround.Messages, 1)
+	assert.False(t, signatureSubround.Messages[0].IsValid)
+	assert.Equal(t, expectedErr.Error(), signatureSubround.Messages[0].Error)
+}
+
+func TestConsensusTimelineRecorder_ShouldKeepTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := spos.NewConsensusTimelineRecorder(createMockArgsConsensusTimelineRecorder())
+	for round := int64(0); round < 5; round++ {
+		recorder.RecordSubroundStart(round, bls.SrStartRound)
+	}
+
+	// messages for rounds older than the kept ones and for negative rounds are dropped
+	recorder.RecordMessage(&consensus.Message{RoundIndex: 1, MsgType: int64(bls.MtSignature)}, "pid", nil)
+	recorder.RecordBlockProcessing(-1, time.Second, nil)
+	recorder.RecordBlockProcessing(3, time.Second, errors.New("processing error"))
+
+	rounds := recorder.GetRounds()
+	require.Len(t, rounds, 3)
+	assert.Equal(t, int64(4), rounds[0].Round)
+	assert.Equal(t, int64(3), rounds[1].Round)
+	assert.Equal(t, int64(2), rounds[2].Round)
+	assert.Equal(t, "processing error", rounds[1].BlockProcessingError)
+	assert.Equal(t, int64(1000), rounds[1].BlockProcessingDurationMs)
+}
+
+func TestConsensusTimelineRecorder_ShouldIgnoreRoundsAheadOfTheNextRound(t *testing.T) {
+	t.Parallel()
+
+	roundHandler := &mock.RoundHandlerMock{RoundIndex: 10}
+	args := createMockArgsConsensusTimelineRecorder()
+	args.RoundHandler = roundHandler
+	recorder, _ := spos.NewConsensusTimelineRecorder(args)
+
+	// round 1000003 maps on the same slot as round 10
+	recorder.RecordMessage(&consensus.Message{RoundIndex: 1000003, MsgType: int64(bls.MtSignature)}, "pid", nil)
+	recorder.RecordSubroundStart(1000003, bls.SrStartRound)
+	recorder.RecordBlockProcessing(1000003, time.Second, nil)
+	assert.Empty(t, recorder.GetRounds())
+
+	recorder.RecordSubroundStart(10, bls.SrStartRound)
+	recorder.RecordMessage(&consensus.Message{RoundIndex: 11, MsgType: int64(bls.MtSignature)}, "pid", nil)
+	recorder.RecordMessage(&consensus.Message{RoundIndex: 12, MsgType: int64(bls.MtSignature)}, "pid", nil)
+	rounds := recorder.GetRounds()
+	require.Len(t, rounds, 2)
+	assert.Equal(t, int64(11), rounds[0].Round)
+	assert.Equal(t, int64(10), rounds[1].Round)
+}
+
+func TestConsensusTimelineRecorder_ShouldReplaceRoundsAheadOfTheNextRound(t *testing.T) {
+	t.Parallel()
+
+	roundHandler := &mock.RoundHandlerMock{RoundIndex: 1000002}
+	args := createMockArgsConsensusTimelineRecorder()
+	args.RoundHandler = roundHandler
+	recorder, _ := spos.NewConsensusTimelineRecorder(args)
+	recorder.RecordSubroundStart(1000003, bls.SrStartRound)
+
+	roundHandler.RoundIndex = 10
+	recorder.RecordSubroundStart(10, bls.SrStartRound)
+
+	rounds := recorder.GetRounds()
+	require.Len(t, rounds, 1)
+	assert.Equal(t, int64(10), rounds[0].Round)
+}
+
+func TestConsensusTimelineRecorder_GetRoundsShouldReturnCopies(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := spos.NewConsensusTimelineRecorder(createMockArgsConsensusTimelineRecorder())
+	recorder.RecordMessage(&consensus.Message{RoundIndex: 1, MsgType: int64(bls.MtSignature)}, "pid", nil)
+
+	rounds := recorder.GetRounds()
+	require.Len(t, rounds[0].Subrounds, 1)
+	rounds[0].Subrounds[0].Messages[0].IsValid = false
+
+	recorder.RecordMessage(&consensus.Message{RoundIndex: 1, MsgType: int64(bls.MtSignature)}, "pid", nil)
+	rounds = recorder.GetRounds()
+	require.Len(t, rounds[0].Subrounds[0].Messages, 2)
+	assert.True(t, rounds[0].Subrounds[0].Messages[0].IsValid)
+}
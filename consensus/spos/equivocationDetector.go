@@ -0,0 +1,285 @@
+package spos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	// EquivocationKindProposal marks two different headers proposed by the same leader in the same round
+	EquivocationKindProposal = "proposal"
+	// EquivocationKindSignature marks two signature shares over different header hashes given in the same round
+	EquivocationKindSignature = "signature"
+	// EquivocationKindFinalInfo marks two final info messages over different header hashes sent in the same round
+	EquivocationKindFinalInfo = "finalInfo"
+)
+
+// outportEvidencesQueueSize is the number of evidences waiting to be pushed to the outport drivers. The evidences
+// detected while the queue is full are only persisted
+const outportEvidencesQueueSize = 100
+
+// ArgsEquivocationDetector holds the arguments needed to create an equivocation detector
+type ArgsEquivocationDetector struct {
+	ConsensusService ConsensusService
+	Hasher           hashing.Hasher
+	Storer           storage.Storer
+	OutportHandler   outport.OutportHandler
+	ShardID          uint32
+}
+
+type signedMessageRecord struct {
+	round    int64
+	message  common.SignedConsensusMessage
+	reported bool
+}
+
+type equivocationDetector struct {
+	consensusService ConsensusService
+	hasher           hashing.Hasher
+	storer           storage.Storer
+	outportHandler   outport.OutportHandler
+	shardID          uint32
+
+	mutRecords sync.Mutex
+	records    map[string]*signedMessageRecord
+
+	outportEvidences chan *common.EquivocationEvidence
+	cancelFunc       context.CancelFunc
+}
+
+// NewEquivocationDetector creates a component which catches the validators signing two different headers, or two
+// different header hashes, in the same round. Each evidence is persisted and pushed to the outport drivers
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.ConsensusService) {
+		return nil, ErrNilConsensusService
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.OutportHandler) {
+		return nil, ErrNilOutportHandler
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	ed := &equivocationDetector{
+		consensusService: args.ConsensusService,
+		hasher:           args.Hasher,
+		storer:           args.Storer,
+		outportHandler:   args.OutportHandler,
+		shardID:          args.ShardID,
+		records:          make(map[string]*signedMessageRecord),
+		outportEvidences: make(chan *common.EquivocationEvidence, outportEvidencesQueueSize),
+		cancelFunc:       cancelFunc,
+	}
+
+	go ed.sendEvidencesToOutport(ctx)
+
+	return ed, nil
+}
+
+// ProcessMessage records the provided consensus message and reports an equivocation if its public key already signed
+// a conflicting message of the same kind in the same round. The message should already be checked to be originated
+// by the public key it carries
+func (ed *equivocationDetector) ProcessMessage(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+	if cnsMsg == nil || check.IfNil(message) {
+		return
+	}
+
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	kind, ok := ed.getEquivocationKind(msgType)
+	if !ok {
+		return
+	}
+
+	signedMessage := common.SignedConsensusMessage{
+		MessageType:  ed.consensusService.GetStringValue(msgType),
+		HeaderHash:   ed.getHeaderHash(cnsMsg, kind),
+		Payload:      message.Data(),
+		Originator:   message.Peer().Pretty(),
+		P2PKey:       message.Key(),
+		P2PSignature: message.Signature(),
+		SeqNo:        message.SeqNo(),
+		Topic:        message.Topic(),
+		Timestamp:    message.Timestamp(),
+	}
+	key := createEquivocationKey(cnsMsg.PubKey, cnsMsg.RoundIndex, kind)
+
+	ed.mutRecords.Lock()
+	record, exists := ed.records[key]
+	if !exists {
+		ed.records[key] = &signedMessageRecord{
+			round:   cnsMsg.RoundIndex,
+			message: signedMessage,
+		}
+		ed.mutRecords.Unlock()
+		return
+	}
+
+	isConflicting := !bytes.Equal(record.message.HeaderHash, signedMessage.HeaderHash)
+	if !isConflicting || record.reported {
+		ed.mutRecords.Unlock()
+		return
+	}
+	record.reported = true
+	firstMessage := record.message
+	ed.mutRecords.Unlock()
+
+	evidence := &common.EquivocationEvidence{
+		PublicKey:     cnsMsg.PubKey,
+		ShardID:       ed.shardID,
+		Round:         cnsMsg.RoundIndex,
+		Kind:          kind,
+		DetectedAt:    time.Now().Unix(),
+		FirstMessage:  firstMessage,
+		SecondMessage: signedMessage,
+	}
+
+	log.Warn("equivocation detected in consensus",
+		"public key", cnsMsg.PubKey,
+		"round", cnsMsg.RoundIndex,
+		"kind", kind,
+		"first header hash", firstMessage.HeaderHash,
+		"second header hash", signedMessage.HeaderHash,
+	)
+
+	ed.saveEvidence([]byte(key), evidence)
+}
+
+func (ed *equivocationDetector) getEquivocationKind(msgType consensus.MessageType) (string, bool) {
+	if ed.consensusService.IsMessageWithBlockBodyAndHeader(msgType) || ed.consensusService.IsMessageWithBlockHeader(msgType) {
+		return EquivocationKindProposal, true
+	}
+	if ed.consensusService.IsMessageWithSignature(msgType) {
+		return EquivocationKindSignature, true
+	}
+	if ed.consensusService.IsMessageWithFinalInfo(msgType) {
+		return EquivocationKindFinalInfo, true
+	}
+
+	return "", false
+}
+
+// the hash of a proposed header is computed locally, so that the evidence does not rely on the hash claimed by the leader
+func (ed *equivocationDetector) getHeaderHash(cnsMsg *consensus.Message, kind string) []byte {
+	if kind == EquivocationKindProposal {
+		return ed.hasher.Compute(string(cnsMsg.Header))
+	}
+
+	return cnsMsg.BlockHeaderHash
+}
+
+func createEquivocationKey(pubKey []byte, round int64, kind string) string {
+	return fmt.Sprintf("%s_%d_%s", string(pubKey), round, kind)
+}
+
+func (ed *equivocationDetector) saveEvidence(key []byte, evidence *common.EquivocationEvidence) {
+	buff, err := json.Marshal(evidence)
+	if err != nil {
+		log.Error("equivocationDetector.saveEvidence: cannot marshal the evidence", "error", err)
+		return
+	}
+
+	err = ed.storer.Put(key, buff)
+	if err != nil {
+		log.Error("equivocationDetector.saveEvidence: cannot persist the evidence", "error", err)
+	}
+
+	// the outport retries until the drivers accept the event, so the evidences are pushed by a single go routine in
+	// order not to block the processing of the messages
+	select {
+	case ed.outportEvidences <- evidence:
+	default:
+		log.Warn("equivocationDetector.saveEvidence: outport queue is full, the evidence is only persisted",
+			"public key", evidence.PublicKey,
+			"round", evidence.Round,
+			"kind", evidence.Kind,
+		)
+	}
+}
+
+func (ed *equivocationDetector) sendEvidencesToOutport(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing equivocationDetector's outport go routine")
+			return
+		case evidence := <-ed.outportEvidences:
+			ed.outportHandler.SaveEquivocationEvidence(evidence)
+		}
+	}
+}
+
+// RemoveMessagesOlderThan drops the messages recorded for the rounds older than the provided one, as the messages
+// for the past rounds are not accepted anymore
+func (ed *equivocationDetector) RemoveMessagesOlderThan(round int64) {
+	ed.mutRecords.Lock()
+	defer ed.mutRecords.Unlock()
+
+	for key, record := range ed.records {
+		if record.round < round {
+			delete(ed.records, key)
+		}
+	}
+}
+
+// Close stops the go routine pushing the evidences to the outport drivers
+func (ed *equivocationDetector) Close() error {
+	ed.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
+
+// LoadEquivocationEvidences returns the equivocation evidences saved in the provided storer, most recent rounds first
+func LoadEquivocationEvidences(storer storage.Storer) ([]*common.EquivocationEvidence, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+
+	var errFound error
+	evidences := make([]*common.EquivocationEvidence, 0)
+	storer.RangeKeys(func(key []byte, val []byte) bool {
+		evidence := &common.EquivocationEvidence{}
+		errFound = json.Unmarshal(val, evidence)
+		if errFound != nil {
+			errFound = fmt.Errorf("%w for equivocation evidence %s", errFound, string(key))
+			return false
+		}
+
+		evidences = append(evidences, evidence)
+		return true
+	})
+	if errFound != nil {
+		return nil, errFound
+	}
+
+	sort.SliceStable(evidences, func(i, j int) bool {
+		if evidences[i].Round != evidences[j].Round {
+			return evidences[i].Round > evidences[j].Round
+		}
+
+		return bytes.Compare(evidences[i].PublicKey, evidences[j].PublicKey) < 0
+	})
+
+	return evidences, nil
+}
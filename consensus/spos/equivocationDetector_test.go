@@ -0,0 +1,309 @@
+package spos_test
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEquivocationDetector() spos.ArgsEquivocationDetector {
+	blsService, _ := bls.NewConsensusService()
+
+	return spos.ArgsEquivocationDetector{
+		ConsensusService: blsService,
+		Hasher:           &hashingMocks.HasherMock{},
+		Storer:           genericMocks.NewStorerMock(),
+		OutportHandler:   &testscommon.OutportStub{},
+		ShardID:          1,
+	}
+}
+
+func createSignedConsensusMessage(pubKey string, round int64, msgType consensus.MessageType, headerHash []byte, header []byte) (*consensus.Message, *mock.P2PMessageMock) {
+	cnsMsg := &consensus.Message{
+		BlockHeaderHash: headerHash,
+		Header:          header,
+		PubKey:          []byte(pubKey),
+		MsgType:         int64(msgType),
+		RoundIndex:      round,
+	}
+	buff, _ := mock.MarshalizerMock{}.Marshal(cnsMsg)
+
+	return cnsMsg, &mock.P2PMessageMock{
+		DataField:      buff,
+		PeerField:      core.PeerID("pid-" + pubKey),
+		SignatureField: []byte("p2p signature"),
+		KeyField:       []byte("p2p key"),
+		TopicField:     "consensus_1",
+	}
+}
+
+func TestNewEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil consensus service should error", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		args.ConsensusService = nil
+		detector, err := spos.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, spos.ErrNilConsensusService, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		args.Hasher = nil
+		detector, err := spos.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, spos.ErrNilHasher, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		args.Storer = nil
+		detector, err := spos.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, spos.ErrNilStorer, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		args.OutportHandler = nil
+		detector, err := spos.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, spos.ErrNilOutportHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		detector, err := spos.NewEquivocationDetector(createMockArgsEquivocationDetector())
+		assert.False(t, check.IfNil(detector))
+		assert.Nil(t, err)
+	})
+}
+
+func TestEquivocationDetector_ProcessMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same header hash should not report", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		storer := genericMocks.NewStorerMock()
+		args.Storer = storer
+		detector, _ := spos.NewEquivocationDetector(args)
+
+		hash := bytes.Repeat([]byte{1}, 32)
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtSignature, hash, nil))
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtSignature, hash, nil))
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtBlockBody, nil, nil))
+
+		evidences, err := spos.LoadEquivocationEvidences(storer)
+		require.Nil(t, err)
+		assert.Empty(t, evidences)
+	})
+	t.Run("different rounds or public keys should not report", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		storer := genericMocks.NewStorerMock()
+		args.Storer = storer
+		detector, _ := spos.NewEquivocationDetector(args)
+
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtSignature, []byte("hash1"), nil))
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 11, bls.MtSignature, []byte("hash2"), nil))
+		detector.ProcessMessage(createSignedConsensusMessage("pk2", 10, bls.MtSignature, []byte("hash2"), nil))
+
+		evidences, err := spos.LoadEquivocationEvidences(storer)
+		require.Nil(t, err)
+		assert.Empty(t, evidences)
+	})
+	t.Run("conflicting signatures should report once", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		storer := genericMocks.NewStorerMock()
+		args.Storer = storer
+		mutOutport := sync.Mutex{}
+		outportEvidences := make([]*common.EquivocationEvidence, 0)
+		args.OutportHandler = &testscommon.OutportStub{
+			SaveEquivocationEvidenceCalled: func(evidence *common.EquivocationEvidence) {
+				mutOutport.Lock()
+				outportEvidences = append(outportEvidences, evidence)
+				mutOutport.Unlock()
+			},
+		}
+		detector, _ := spos.NewEquivocationDetector(args)
+
+		firstMsg, firstP2PMsg := createSignedConsensusMessage("pk", 10, bls.MtSignature, []byte("hash1"), nil)
+		detector.ProcessMessage(firstMsg, firstP2PMsg)
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtSignature, []byte("hash2"), nil))
+		detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtSignature, []byte("hash3"), nil))
+
+		evidences, err := spos.LoadEquivocationEvidences(storer)
+		require.Nil(t, err)
+		require.Len(t, evidences, 1)
+		evidence := evidences[0]
+		assert.Equal(t, []byte("pk"), evidence.PublicKey)
+		assert.Equal(t, int64(10), evidence.Round)
+		assert.Equal(t, uint32(1), evidence.ShardID)
+		assert.Equal(t, spos.EquivocationKindSignature, evidence.Kind)
+		assert.Equal(t, []byte("hash1"), evidence.FirstMessage.HeaderHash)
+		assert.Equal(t, firstP2PMsg.DataField, evidence.FirstMessage.Payload)
+		assert.Equal(t, firstP2PMsg.SignatureField, evidence.FirstMessage.P2PSignature)
+		assert.Equal(t, firstP2PMsg.PeerField.Pretty(), evidence.FirstMessage.Originator)
+		assert.Equal(t, []byte("hash2"), evidence.SecondMessage.HeaderHash)
+
+		require.Eventually(t, func() bool {
+			mutOutport.Lock()
+			defer mutOutport.Unlock()
+
+			return len(outportEvidences) == 1
+		}, time.Second, time.Millisecond*10)
+		assert.Equal(t, evidence.SecondMessage, outportEvidences[0].SecondMessage)
+	})
+	t.Run("different proposed headers should report", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		storer := genericMocks.NewStorerMock()
+		args.Storer = storer
+		detector, _ := spos.NewEquivocationDetector(args)
+
+		// the header hashes are computed from the headers, not taken from the messages
+		sameClaimedHash := []byte("claimed hash")
+		detector.ProcessMessage(createSignedConsensusMessage("leader", 10, bls.MtBlockBodyAndHeader, sameClaimedHash, []byte("header1")))
+		detector.ProcessMessage(createSignedConsensusMessage("leader", 10, bls.MtBlockHeader, sameClaimedHash, []byte("header1")))
+		detector.ProcessMessage(createSignedConsensusMessage("leader", 10, bls.MtBlockHeader, sameClaimedHash, []byte("header2")))
+
+		evidences, err := spos.LoadEquivocationEvidences(storer)
+		require.Nil(t, err)
+		require.Len(t, evidences, 1)
+		hasher := &hashingMocks.HasherMock{}
+		assert.Equal(t, spos.EquivocationKindProposal, evidences[0].Kind)
+		assert.Equal(t, hasher.Compute("header1"), evidences[0].FirstMessage.HeaderHash)
+		assert.Equal(t, hasher.Compute("header2"), evidences[0].SecondMessage.HeaderHash)
+	})
+}
+
+func TestEquivocationDetector_BlockingOutportShouldNotBlockNorPileUpGoRoutines(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	storer := genericMocks.NewStorerMock()
+	args.Storer = storer
+	unblockOutport := make(chan struct{})
+	numOutportCalls := uint32(0)
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveEquivocationEvidenceCalled: func(_ *common.EquivocationEvidence) {
+			atomic.AddUint32(&numOutportCalls, 1)
+			<-unblockOutport
+		},
+	}
+	detector, _ := spos.NewEquivocationDetector(args)
+	defer func() {
+		_ = detector.Close()
+	}()
+
+	reportEquivocation := func(index int) {
+		pubKey := fmt.Sprintf("pk%d", index)
+		detector.ProcessMessage(createSignedConsensusMessage(pubKey, 10, bls.MtSignature, []byte("hash1"), nil))
+		detector.ProcessMessage(createSignedConsensusMessage(pubKey, 10, bls.MtSignature, []byte("hash2"), nil))
+	}
+
+	// the first evidence keeps the outport go routine blocked
+	reportEquivocation(0)
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&numOutportCalls) == 1
+	}, time.Second, time.Millisecond*10)
+
+	numGoRoutinesBefore := runtime.NumGoroutine()
+	numEvidences := spos.OutportEvidencesQueueSize * 2
+	chDone := make(chan struct{})
+	go func() {
+		for i := 1; i < numEvidences; i++ {
+			reportEquivocation(i)
+		}
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "processing the messages should not block on the outport")
+	}
+
+	// all the evidences are persisted, but only one is in flight towards the blocked outport
+	evidences, err := spos.LoadEquivocationEvidences(storer)
+	require.Nil(t, err)
+	assert.Len(t, evidences, numEvidences)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numOutportCalls))
+	assert.LessOrEqual(t, runtime.NumGoroutine(), numGoRoutinesBefore+1)
+
+	// the evidences which did not fit in the queue were dropped
+	close(unblockOutport)
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&numOutportCalls) == spos.OutportEvidencesQueueSize+1
+	}, time.Second, time.Millisecond*10)
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(spos.OutportEvidencesQueueSize+1), atomic.LoadUint32(&numOutportCalls))
+}
+
+func TestEquivocationDetector_RemoveMessagesOlderThan(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	storer := genericMocks.NewStorerMock()
+	args.Storer = storer
+	detector, _ := spos.NewEquivocationDetector(args)
+
+	detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtBlockHeaderFinalInfo, []byte("hash1"), nil))
+	detector.ProcessMessage(createSignedConsensusMessage("pk", 11, bls.MtBlockHeaderFinalInfo, []byte("hash1"), nil))
+	detector.RemoveMessagesOlderThan(11)
+	detector.ProcessMessage(createSignedConsensusMessage("pk", 10, bls.MtBlockHeaderFinalInfo, []byte("hash2"), nil))
+	detector.ProcessMessage(createSignedConsensusMessage("pk", 11, bls.MtBlockHeaderFinalInfo, []byte("hash2"), nil))
+
+	evidences, err := spos.LoadEquivocationEvidences(storer)
+	require.Nil(t, err)
+	require.Len(t, evidences, 1)
+	assert.Equal(t, int64(11), evidences[0].Round)
+	assert.Equal(t, spos.EquivocationKindFinalInfo, evidences[0].Kind)
+}
+
+func TestLoadEquivocationEvidences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		evidences, err := spos.LoadEquivocationEvidences(nil)
+		assert.Nil(t, evidences)
+		assert.Equal(t, spos.ErrNilStorer, err)
+	})
+	t.Run("corrupted evidence should error", func(t *testing.T) {
+		storer := genericMocks.NewStorerMock()
+		_ = storer.Put([]byte("key"), []byte("not an evidence"))
+
+		evidences, err := spos.LoadEquivocationEvidences(storer)
+		assert.Nil(t, evidences)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the most recent rounds first", func(t *testing.T) {
+		args := createMockArgsEquivocationDetector()
+		storer := genericMocks.NewStorerMock()
+		args.Storer = storer
+		detector, _ := spos.NewEquivocationDetector(args)
+
+		for _, round := range []int64{5, 12, 7} {
+			detector.ProcessMessage(createSignedConsensusMessage("pk", round, bls.MtSignature, []byte("hash1"), nil))
+			detector.ProcessMessage(createSignedConsensusMessage("pk", round, bls.MtSignature, []byte("hash2"), nil))
+		}
+
+		evidences, err := spos.LoadEquivocationEvidences(storer)
+		require.Nil(t, err)
+		require.Len(t, evidences, 3)
+		assert.Equal(t, int64(12), evidences[0].Round)
+		assert.Equal(t, int64(7), evidences[1].Round)
+		assert.Equal(t, int64(5), evidences[2].Round)
+	})
+}
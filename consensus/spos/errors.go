@@ -219,3 +219,18 @@ var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
 
 // ErrNilScheduledProcessor signals that the provided scheduled processor is nil
 var ErrNilScheduledProcessor = errors.New("nil scheduled processor")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrNilConsensusTimelineRecorder signals that a nil consensus timeline recorder has been provided
+var ErrNilConsensusTimelineRecorder = errors.New("nil consensus timeline recorder")
+
+// ErrInvalidNumRoundsInTimeline signals that an invalid number of rounds to be kept in the consensus timeline has been provided
+var ErrInvalidNumRoundsInTimeline = errors.New("invalid number of rounds in the consensus timeline")
@@ -252,3 +252,6 @@ func (cmv *consensusMessageValidator) GetNumOfMessageTypeForPublicKey(pk []byte,
 func (cmv *consensusMessageValidator) ResetConsensusMessages() {
 	cmv.resetConsensusMessages()
 }
+
+// OutportEvidencesQueueSize -
+const OutportEvidencesQueueSize = outportEvidencesQueueSize
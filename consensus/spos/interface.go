@@ -62,6 +62,8 @@ type ConsensusCoreHandler interface {
 	NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	// ScheduledProcessor returns the scheduled txs processor
 	ScheduledProcessor() consensus.ScheduledProcessor
+	// ConsensusTimelineRecorder returns the recorder of the consensus rounds timeline
+	ConsensusTimelineRecorder() consensus.ConsensusTimelineRecorder
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -75,6 +77,8 @@ type ConsensusService interface {
 	GetStringValue(consensus.MessageType) string
 	// GetSubroundName gets the subround name for the subround id provided
 	GetSubroundName(int) string
+	// GetSubroundForMessage gets the id of the subround the provided messageType belongs to
+	GetSubroundForMessage(consensus.MessageType) int
 	// GetMessageRange provides the MessageType range used in checks by the consensus
 	GetMessageRange() []consensus.MessageType
 	// CanProceed returns if the current messageType can proceed further if previous subrounds finished
@@ -150,6 +154,15 @@ type HeaderSigVerifier interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetector defines the behaviour of a component able to catch the validators that sign conflicting
+// consensus messages in the same round
+type EquivocationDetector interface {
+	ProcessMessage(cnsMsg *consensus.Message, message p2p.MessageP2P)
+	RemoveMessagesOlderThan(round int64)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ConsensusDataIndexer defines the actions that a consensus data indexer has to do
 type ConsensusDataIndexer interface {
 	SaveRoundsInfo(roundsInfos []*indexer.RoundInfo)
@@ -132,8 +132,13 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 	startTime := roundHandler.TimeStamp()
 	maxTime := roundHandler.TimeDuration() * MaxThresholdPercent / 100
 
+	timelineRecorder := sr.ConsensusTimelineRecorder()
+	roundIndex := roundHandler.Index()
+	timelineRecorder.RecordSubroundStart(roundIndex, sr.current)
+
 	sr.Job(ctx)
 	if sr.Check() {
+		timelineRecorder.RecordSubroundEnd(roundIndex, sr.current, true)
 		return true
 	}
 
@@ -141,6 +146,7 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 		select {
 		case <-sr.consensusStateChangedChannel:
 			if sr.Check() {
+				timelineRecorder.RecordSubroundEnd(roundIndex, sr.current, true)
 				return true
 			}
 		case <-time.After(roundHandler.RemainingTime(startTime, maxTime)):
@@ -149,6 +155,7 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 				sr.Extend(sr.current)
 			}
 
+			timelineRecorder.RecordSubroundEnd(roundIndex, sr.current, false)
 			return false
 		}
 	}
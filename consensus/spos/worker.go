@@ -74,6 +74,8 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	equivocationDetector      EquivocationDetector
+	consensusTimelineRecorder consensus.ConsensusTimelineRecorder
 	closer                    core.SafeCloser
 }
 
@@ -103,6 +105,8 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	EquivocationDetector     EquivocationDetector
+	TimelineRecorder         consensus.ConsensusTimelineRecorder
 }
 
 // NewWorker creates a new Worker object
@@ -128,28 +132,30 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 	}
 
 	wrk := Worker{
-		consensusService:         args.ConsensusService,
-		blockChain:               args.BlockChain,
-		blockProcessor:           args.BlockProcessor,
-		scheduledProcessor:       args.ScheduledProcessor,
-		bootstrapper:             args.Bootstrapper,
-		broadcastMessenger:       args.BroadcastMessenger,
-		consensusState:           args.ConsensusState,
-		forkDetector:             args.ForkDetector,
-		marshalizer:              args.Marshalizer,
-		hasher:                   args.Hasher,
-		roundHandler:             args.RoundHandler,
-		shardCoordinator:         args.ShardCoordinator,
-		peerSignatureHandler:     args.PeerSignatureHandler,
-		syncTimer:                args.SyncTimer,
-		headerSigVerifier:        args.HeaderSigVerifier,
-		headerIntegrityVerifier:  args.HeaderIntegrityVerifier,
-		appStatusHandler:         args.AppStatusHandler,
-		networkShardingCollector: args.NetworkShardingCollector,
-		antifloodHandler:         args.AntifloodHandler,
-		poolAdder:                args.PoolAdder,
-		nodeRedundancyHandler:    args.NodeRedundancyHandler,
-		closer:                   closing.NewSafeChanCloser(),
+		consensusService:          args.ConsensusService,
+		blockChain:                args.BlockChain,
+		blockProcessor:            args.BlockProcessor,
+		scheduledProcessor:        args.ScheduledProcessor,
+		bootstrapper:              args.Bootstrapper,
+		broadcastMessenger:        args.BroadcastMessenger,
+		consensusState:            args.ConsensusState,
+		forkDetector:              args.ForkDetector,
+		marshalizer:               args.Marshalizer,
+		hasher:                    args.Hasher,
+		roundHandler:              args.RoundHandler,
+		shardCoordinator:          args.ShardCoordinator,
+		peerSignatureHandler:      args.PeerSignatureHandler,
+		syncTimer:                 args.SyncTimer,
+		headerSigVerifier:         args.HeaderSigVerifier,
+		headerIntegrityVerifier:   args.HeaderIntegrityVerifier,
+		appStatusHandler:          args.AppStatusHandler,
+		networkShardingCollector:  args.NetworkShardingCollector,
+		antifloodHandler:          args.AntifloodHandler,
+		poolAdder:                 args.PoolAdder,
+		nodeRedundancyHandler:     args.NodeRedundancyHandler,
+		equivocationDetector:      args.EquivocationDetector,
+		consensusTimelineRecorder: args.TimelineRecorder,
+		closer:                    closing.NewSafeChanCloser(),
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -247,6 +253,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
+	if check.IfNil(args.TimelineRecorder) {
+		return ErrNilConsensusTimelineRecorder
+	}
 
 	return nil
 }
@@ -377,10 +389,16 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	)
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
+	wrk.consensusTimelineRecorder.RecordMessage(cnsMsg, message.Peer(), err)
+	if errors.Is(err, ErrMessageTypeLimitReached) {
+		wrk.checkEquivocation(cnsMsg, message)
+	}
 	if err != nil {
 		return err
 	}
 
+	wrk.equivocationDetector.ProcessMessage(cnsMsg, message)
+
 	wrk.networkShardingCollector.UpdatePeerIDInfo(message.Peer(), cnsMsg.PubKey, wrk.shardCoordinator.SelfId())
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
@@ -415,6 +433,19 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	return nil
 }
 
+// checkEquivocation hands to the equivocation detector a message rejected because its public key already sent a message
+// of the same type in the current round. As the validator stops before checking the originator of such a message, the
+// check is done here so that no evidence can be forged by other peers
+func (wrk *Worker) checkEquivocation(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+	err := wrk.consensusMessageValidator.checkMessageOriginator(cnsMsg, message.Peer())
+	if err != nil {
+		log.Trace("checkEquivocation.checkMessageOriginator", "error", err.Error())
+		return
+	}
+
+	wrk.equivocationDetector.ProcessMessage(cnsMsg, message)
+}
+
 func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 	if err == nil ||
 		errors.Is(err, ErrMessageForPastRound) ||
@@ -671,12 +702,13 @@ func (wrk *Worker) Close() error {
 
 	wrk.cleanChannels()
 
-	return nil
+	return wrk.equivocationDetector.Close()
 }
 
 // ResetConsensusMessages resets at the start of each round all the previous consensus messages received
 func (wrk *Worker) ResetConsensusMessages() {
 	wrk.consensusMessageValidator.resetConsensusMessages()
+	wrk.equivocationDetector.RemoveMessagesOlderThan(wrk.consensusState.RoundIndex)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
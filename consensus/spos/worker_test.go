@@ -1,6 +1,7 @@
 package spos_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -21,10 +22,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const roundTimeDuration = 100 * time.Millisecond
@@ -110,6 +113,8 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		PublicKeySize:            PublicKeySize,
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		EquivocationDetector:     &mock.EquivocationDetectorStub{},
+		TimelineRecorder:         &consensusMocks.ConsensusTimelineRecorderStub{},
 	}
 
 	return workerArgs
@@ -364,6 +369,28 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerNilEquivocationDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func TestWorker_NewWorkerNilTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.TimelineRecorder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilConsensusTimelineRecorder, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -822,6 +849,93 @@ func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
 }
 
+func TestWorker_ProcessReceivedMessageShouldCheckEquivocations(t *testing.T) {
+	t.Parallel()
+
+	processedHashes := make([][]byte, 0)
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		ProcessMessageCalled: func(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+			processedHashes = append(processedHashes, cnsMsg.BlockHeaderHash)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	createSignatureMessage := func(headerHash []byte) []byte {
+		cnsMsg := consensus.NewConsensusMessage(
+			headerHash,
+			signature,
+			nil,
+			nil,
+			[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+			signature,
+			int(bls.MtSignature),
+			0,
+			chainID,
+			nil,
+			nil,
+			nil,
+			currentPid,
+		)
+		buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+		return buff
+	}
+	firstHash := bytes.Repeat([]byte{1}, HashSize)
+	secondHash := bytes.Repeat([]byte{2}, HashSize)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createSignatureMessage(firstHash), PeerField: currentPid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	// a second message of the same type, even if rejected, is handed to the detector once its originator is checked
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createSignatureMessage(secondHash), PeerField: currentPid}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createSignatureMessage(secondHash), PeerField: "other originator"}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	assert.Equal(t, [][]byte{firstHash, secondHash}, processedHashes)
+}
+
+func TestWorker_ProcessReceivedMessageShouldRecordMessagesInTimeline(t *testing.T) {
+	t.Parallel()
+
+	recordedErrors := make([]error, 0)
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	workerArgs.TimelineRecorder = &consensusMocks.ConsensusTimelineRecorderStub{
+		RecordMessageCalled: func(cnsMsg *consensus.Message, pid core.PeerID, validationErr error) {
+			assert.Equal(t, currentPid, pid)
+			recordedErrors = append(recordedErrors, validationErr)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		bytes.Repeat([]byte{1}, HashSize),
+		signature,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	require.Equal(t, 2, len(recordedErrors))
+	assert.Nil(t, recordedErrors[0])
+	assert.True(t, errors.Is(recordedErrors[1], spos.ErrMessageTypeLimitReached))
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -2,6 +2,7 @@ package dataPool
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/closing"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -169,6 +170,16 @@ func (dp *dataPool) Close() error {
 		}
 	}
 
+	transactionsCloser, ok := dp.transactions.(closing.Closer)
+	if ok {
+		log.Debug("closing transactions data pool....")
+		err := transactionsCloser.Close()
+		if err != nil {
+			log.Error("failed to close transactions data pool", "error", err.Error())
+			lastError = err
+		}
+	}
+
 	if !check.IfNil(dp.peerAuthentications) {
 		log.Debug("closing peer authentications data pool....")
 		err := dp.peerAuthentications.Close()
@@ -247,3 +247,6 @@ var ErrNilPayloadValidator = errors.New("nil payload validator")
 
 // ErrWrongTypeAssertion signals that an type assertion failed
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilEmptyTxCreator signals that a nil empty transaction creator was provided
+var ErrNilEmptyTxCreator = errors.New("nil empty transaction creator")
@@ -7,6 +7,8 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -62,11 +64,26 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 
 	mainConfig := args.Config
 
+	txPoolOverflowDB, err := createTxPoolOverflowDB(args)
+	if err != nil {
+		return nil, err
+	}
+
+	txPoolPersistedTxsDB, err := createTxPoolPersistedTxsDB(args)
+	if err != nil {
+		return nil, err
+	}
+
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
-		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
-		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
-		SelfShardID:    args.ShardCoordinator.SelfId(),
-		TxGasHandler:   args.EconomicsData,
+		Config:             factory.GetCacherFromConfig(mainConfig.TxDataPool),
+		NumberOfShards:     args.ShardCoordinator.NumberOfShards(),
+		SelfShardID:        args.ShardCoordinator.SelfId(),
+		TxGasHandler:       args.EconomicsData,
+		OverflowPersister:  txPoolOverflowDB,
+		OverflowMaxNumTxs:  mainConfig.TxPoolOverflow.MaxNumTxsPerCache,
+		OverflowNewEmptyTx: createEmptyTransaction,
+		Marshalizer:        args.Marshalizer,
+		PersistedTxsStorer: txPoolPersistedTxsDB,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
@@ -162,6 +179,63 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	return dataPool.NewDataPool(dataPoolArgs)
 }
 
+func createEmptyTransaction() data.TransactionHandler {
+	return &transaction.Transaction{}
+}
+
+// createTxPoolOverflowDB returns a nil persister if the overflow is disabled
+func createTxPoolOverflowDB(args ArgsDataPool) (storage.Persister, error) {
+	if !args.Config.TxPoolOverflow.Enabled {
+		log.Debug("no overflow DB for the transactions pool")
+		return nil, nil
+	}
+
+	db, err := createTxPoolDB(args, args.Config.TxPoolOverflow.DB, "txPoolOverflow")
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the overflow db for the transactions pool", err)
+	}
+
+	return db, nil
+}
+
+// createTxPoolPersistedTxsDB returns a nil persister if the persistence of the transactions pool is disabled
+func createTxPoolPersistedTxsDB(args ArgsDataPool) (storage.Persister, error) {
+	if !args.Config.TxPoolPersistence.Enabled {
+		log.Debug("no persistence DB for the transactions pool")
+		return nil, nil
+	}
+
+	db, err := createTxPoolDB(args, args.Config.TxPoolPersistence.DB, "txPoolPersistence")
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the persistence db for the transactions pool", err)
+	}
+
+	return db, nil
+}
+
+func createTxPoolDB(args ArgsDataPool, dbConfig config.DBConfig, tmpDirPattern string) (storage.Persister, error) {
+	dbCfg := factory.GetDBFromConfig(dbConfig)
+	shardId := core.GetShardIDString(args.ShardCoordinator.SelfId())
+	argDB := storageUnit.ArgDB{
+		DBType:            dbCfg.Type,
+		Path:              args.PathManager.PathForStatic(shardId, dbConfig.FilePath),
+		BatchDelaySeconds: dbCfg.BatchDelaySeconds,
+		MaxBatchSize:      dbCfg.MaxBatchSize,
+		MaxOpenFiles:      dbCfg.MaxOpenFiles,
+	}
+
+	if dbConfig.UseTmpAsFilePath {
+		filePath, errTempDir := ioutil.TempDir("", tmpDirPattern)
+		if errTempDir != nil {
+			return nil, errTempDir
+		}
+
+		argDB.Path = filePath
+	}
+
+	return storageUnit.NewDB(argDB)
+}
+
 func createTrieSyncDB(args ArgsDataPool) (storage.Persister, error) {
 	mainConfig := args.Config
 
@@ -45,6 +45,10 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case TransactionsByAddressUnit:
+		return "TransactionsByAddressUnit"
+	case EquivocationEvidenceUnit:
+		return "EquivocationEvidenceUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +109,10 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// TransactionsByAddressUnit is the transactions by address storage unit identifier
+	TransactionsByAddressUnit UnitType = 25
+	// EquivocationEvidenceUnit is the consensus equivocation evidences storage unit identifier
+	EquivocationEvidenceUnit UnitType = 26
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	// TODO: Add only unit types lower than 100
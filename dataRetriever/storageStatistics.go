@@ -0,0 +1,73 @@
+package dataRetriever
+
+import (
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// GetStorageStatistics returns the usage statistics of the storers that are able to provide them, sorted by unit name.
+// Counting the keys iterates over all the databases, so withNumKeys should be set only on demand
+func GetStorageStatistics(store StorageService, withNumKeys bool) *common.StorageStatsApiResponse {
+	response := &common.StorageStatsApiResponse{
+		Units: make([]common.StorageUnitStats, 0),
+	}
+	if check.IfNil(store) {
+		return response
+	}
+
+	for unit, storer := range store.GetAllStorers() {
+		statsProvider, ok := storer.(storage.StatisticsProvider)
+		if !ok || check.IfNil(storer) {
+			continue
+		}
+
+		unitStats := convertStorerStatistics(unit, statsProvider.GetStatistics(withNumKeys))
+		response.SizeInBytes += unitStats.SizeInBytes
+		response.NumCacheHits += unitStats.NumCacheHits
+		response.NumCacheMisses += unitStats.NumCacheMisses
+		response.Units = append(response.Units, unitStats)
+	}
+
+	sort.Slice(response.Units, func(i, j int) bool {
+		return response.Units[i].Unit < response.Units[j].Unit
+	})
+
+	return response
+}
+
+func convertStorerStatistics(unit UnitType, stats storage.StorerStatistics) common.StorageUnitStats {
+	unitStats := common.StorageUnitStats{
+		Unit:           unit.String(),
+		IsStatic:       stats.IsStatic,
+		NumPuts:        stats.NumPuts,
+		NumCacheHits:   stats.NumCacheHits,
+		NumCacheMisses: stats.NumCacheMisses,
+		CacheHitRatio:  computeCacheHitRatio(stats.NumCacheHits, stats.NumCacheMisses),
+		Databases:      make([]common.StorageDatabaseStats, 0, len(stats.Databases)),
+	}
+
+	for _, dbStats := range stats.Databases {
+		unitStats.SizeInBytes += dbStats.SizeInBytes
+		unitStats.NumKeys += dbStats.NumKeys
+		unitStats.Databases = append(unitStats.Databases, common.StorageDatabaseStats{
+			Epoch:       dbStats.Epoch,
+			Path:        dbStats.Path,
+			SizeInBytes: dbStats.SizeInBytes,
+			NumKeys:     dbStats.NumKeys,
+		})
+	}
+
+	return unitStats
+}
+
+func computeCacheHitRatio(numHits uint64, numMisses uint64) float64 {
+	numLookups := numHits + numMisses
+	if numLookups == 0 {
+		return 0
+	}
+
+	return float64(numHits) / float64(numLookups)
+}
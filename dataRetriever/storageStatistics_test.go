@@ -0,0 +1,69 @@
+package dataRetriever_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMemoryStorageUnit(t *testing.T) *storageUnit.Unit {
+	cache, _ := lrucache.NewCache(10)
+	unit, err := storageUnit.NewStorageUnit(cache, memorydb.New())
+	require.Nil(t, err)
+
+	return unit
+}
+
+func TestGetStorageStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should return empty statistics", func(t *testing.T) {
+		t.Parallel()
+
+		stats := dataRetriever.GetStorageStatistics(nil, true)
+		assert.Equal(t, 0, len(stats.Units))
+	})
+	t.Run("should aggregate the statistics of the providers", func(t *testing.T) {
+		t.Parallel()
+
+		txUnit := createMemoryStorageUnit(t)
+		_ = txUnit.Put([]byte("tx1"), []byte("tx1"))
+		_ = txUnit.Put([]byte("tx2"), []byte("tx2"))
+		_, _ = txUnit.Get([]byte("tx1"))
+		_, _ = txUnit.Get([]byte("tx3"))
+		_, _ = txUnit.Get([]byte("tx4"))
+
+		miniBlockUnit := createMemoryStorageUnit(t)
+		_ = miniBlockUnit.Put([]byte("mb"), []byte("mb"))
+		_, _ = miniBlockUnit.Get([]byte("mb"))
+
+		store := dataRetriever.NewChainStorer()
+		store.AddStorer(dataRetriever.TransactionUnit, txUnit)
+		store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
+		store.AddStorer(dataRetriever.ReceiptsUnit, &storageStubs.StorerStub{})
+
+		stats := dataRetriever.GetStorageStatistics(store, true)
+		require.Equal(t, 2, len(stats.Units))
+		assert.Equal(t, uint64(2), stats.NumCacheHits)
+		assert.Equal(t, uint64(2), stats.NumCacheMisses)
+
+		miniBlockStats := stats.Units[0]
+		assert.Equal(t, dataRetriever.MiniBlockUnit.String(), miniBlockStats.Unit)
+		assert.Equal(t, uint64(1), miniBlockStats.NumKeys)
+		assert.Equal(t, float64(1), miniBlockStats.CacheHitRatio)
+
+		txStats := stats.Units[1]
+		assert.Equal(t, dataRetriever.TransactionUnit.String(), txStats.Unit)
+		assert.True(t, txStats.IsStatic)
+		assert.Equal(t, uint64(2), txStats.NumKeys)
+		assert.Equal(t, uint64(2), txStats.NumPuts)
+		assert.InDelta(t, 1.0/3, txStats.CacheHitRatio, 0.0001)
+		assert.Equal(t, 1, len(txStats.Databases))
+	})
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
@@ -16,6 +19,15 @@ type ArgShardedTxPool struct {
 	TxGasHandler   txcache.TxGasHandler
 	NumberOfShards uint32
 	SelfShardID    uint32
+
+	// OverflowPersister is optional: when provided, the transactions evicted from the caches are spilled to it
+	OverflowPersister  storage.Persister              `json:"-"`
+	OverflowMaxNumTxs  uint32                         `json:"-"`
+	OverflowNewEmptyTx func() data.TransactionHandler `json:"-"`
+	Marshalizer        marshal.Marshalizer            `json:"-"`
+
+	// PersistedTxsStorer is optional: when provided, the transactions are saved to it upon Close(), to be reloaded on start-up
+	PersistedTxsStorer storage.Persister `json:"-"`
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -46,6 +58,29 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
+	if !check.IfNil(args.OverflowPersister) {
+		err := args.verifyOverflow()
+		if err != nil {
+			return err
+		}
+	}
+	if !check.IfNil(args.PersistedTxsStorer) && check.IfNil(args.Marshalizer) {
+		return fmt.Errorf("%w: Marshalizer is not valid", dataRetriever.ErrNilMarshalizer)
+	}
+
+	return nil
+}
+
+func (args *ArgShardedTxPool) verifyOverflow() error {
+	if args.OverflowMaxNumTxs == 0 {
+		return fmt.Errorf("%w: OverflowMaxNumTxs is not valid", dataRetriever.ErrCacheConfigInvalidSize)
+	}
+	if args.OverflowNewEmptyTx == nil {
+		return fmt.Errorf("%w: OverflowNewEmptyTx is not valid", dataRetriever.ErrNilEmptyTxCreator)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return fmt.Errorf("%w: Marshalizer is not valid", dataRetriever.ErrNilMarshalizer)
+	}
 
 	return nil
 }
@@ -16,4 +16,6 @@ type txCache interface {
 	NumBytes() int
 	Diagnose(deep bool)
 	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
+	SetOverflowHandler(handler txcache.OverflowHandler) error
+	RefillFromOverflow() int
 }
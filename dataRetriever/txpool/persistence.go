@@ -0,0 +1,74 @@
+package txpool
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// saveTxs writes all the transactions held by the pool (including the ones spilled to the overflows) to the persisted txs storer
+func (txPool *shardedTxPool) saveTxs() {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	numSaved := 0
+	for _, shard := range txPool.backingMap {
+		shard.Cache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+			if txPool.saveTx(txHash, tx) {
+				numSaved++
+			}
+		})
+
+		for _, txHash := range shard.Overflow.Keys() {
+			tx, ok := shard.Overflow.GetByTxHash(txHash)
+			if ok && txPool.saveTx(txHash, tx) {
+				numSaved++
+			}
+		}
+	}
+
+	log.Debug("shardedTxPool.saveTxs()", "numSaved", numSaved)
+}
+
+func (txPool *shardedTxPool) saveTx(txHash []byte, tx *txcache.WrappedTransaction) bool {
+	txBuff, err := txPool.marshalizer.Marshal(tx.Tx)
+	if err != nil {
+		log.Debug("shardedTxPool.saveTx()", "tx", txHash, "err", err)
+		return false
+	}
+
+	err = txPool.persistedTxsStorer.Put(txHash, txBuff)
+	if err != nil {
+		log.Debug("shardedTxPool.saveTx()", "tx", txHash, "err", err)
+		return false
+	}
+
+	return true
+}
+
+// ReloadPersistedTxs calls the provided handler for each transaction saved upon the last Close(), then removes it from storage.
+// The handler is responsible for revalidating the transaction and adding it back in the pool. It returns the number of transactions found.
+func (txPool *shardedTxPool) ReloadPersistedTxs(handler func(txHash []byte, txBuff []byte)) int {
+	if check.IfNil(txPool.persistedTxsStorer) || handler == nil {
+		return 0
+	}
+
+	txHashes := make([][]byte, 0)
+	txBuffs := make([][]byte, 0)
+	txPool.persistedTxsStorer.RangeKeys(func(key []byte, val []byte) bool {
+		txHashes = append(txHashes, append([]byte{}, key...))
+		txBuffs = append(txBuffs, append([]byte{}, val...))
+		return true
+	})
+
+	for i, txHash := range txHashes {
+		handler(txHash, txBuffs[i])
+
+		err := txPool.persistedTxsStorer.Remove(txHash)
+		if err != nil {
+			log.Debug("shardedTxPool.ReloadPersistedTxs()", "tx", txHash, "err", err)
+		}
+	}
+
+	log.Debug("shardedTxPool.ReloadPersistedTxs()", "numFound", len(txHashes))
+	return len(txHashes)
+}
@@ -1,12 +1,16 @@
 package txpool
 
 import (
+	"math"
 	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/counting"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -28,11 +32,18 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	overflowPersister            storage.Persister
+	overflowMaxNumTxs            uint32
+	overflowNewEmptyTx           func() data.TransactionHandler
+	marshalizer                  marshal.Marshalizer
+	persistedTxsStorer           storage.Persister
 }
 
 type txPoolShard struct {
-	CacheID string
-	Cache   txCache
+	CacheID            string
+	Cache              txCache
+	Overflow           txcache.OverflowHandler
+	isRefillInProgress atomic.Flag
 }
 
 // NewShardedTxPool creates a new sharded tx pool
@@ -78,6 +89,11 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		overflowPersister:            args.OverflowPersister,
+		overflowMaxNumTxs:            args.OverflowMaxNumTxs,
+		overflowNewEmptyTx:           args.OverflowNewEmptyTx,
+		marshalizer:                  args.Marshalizer,
+		persistedTxsStorer:           args.PersistedTxsStorer,
 	}
 
 	return shardedTxPoolObject, nil
@@ -118,8 +134,9 @@ func (txPool *shardedTxPool) createShard(cacheID string) *txPoolShard {
 	if !ok {
 		cache := txPool.createTxCache(cacheID)
 		shard = &txPoolShard{
-			CacheID: cacheID,
-			Cache:   cache,
+			CacheID:  cacheID,
+			Cache:    cache,
+			Overflow: txPool.createOverflow(cacheID, cache),
 		}
 
 		txPool.backingMap[cacheID] = shard
@@ -154,6 +171,32 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 	return cache
 }
 
+func (txPool *shardedTxPool) createOverflow(cacheID string, cache txCache) txcache.OverflowHandler {
+	if check.IfNil(txPool.overflowPersister) {
+		return txcache.NewDisabledOverflow()
+	}
+
+	overflow, err := txcache.NewTxOverflow(txcache.ArgsTxOverflow{
+		Name:        cacheID,
+		Persister:   txPool.overflowPersister,
+		Marshalizer: txPool.marshalizer,
+		MaxNumTxs:   txPool.overflowMaxNumTxs,
+		NewEmptyTx:  txPool.overflowNewEmptyTx,
+	})
+	if err != nil {
+		log.Error("shardedTxPool.createOverflow()", "err", err)
+		return txcache.NewDisabledOverflow()
+	}
+
+	err = cache.SetOverflowHandler(overflow)
+	if err != nil {
+		log.Error("shardedTxPool.createOverflow()", "err", err)
+		return txcache.NewDisabledOverflow()
+	}
+
+	return overflow
+}
+
 // ImmunizeSetOfDataAgainstEviction marks the items as non-evictable
 func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
@@ -252,6 +295,30 @@ func (txPool *shardedTxPool) removeTxBulk(txHashes [][]byte, cacheID string) {
 	}
 
 	log.Trace("shardedTxPool.removeTxBulk()", "name", cacheID, "numToRemove", len(txHashes), "numRemoved", numRemoved)
+
+	if numRemoved > 0 {
+		txPool.refillFromOverflow(cacheID)
+	}
+}
+
+// refillFromOverflow pulls back (in the background) the spilled transactions, now that there is room for them in the cache
+func (txPool *shardedTxPool) refillFromOverflow(cacheID string) {
+	shard := txPool.getOrCreateShard(cacheID)
+	if shard.Overflow.CountTx() == 0 {
+		return
+	}
+
+	isRefillInProgress := shard.isRefillInProgress.SetReturningPrevious()
+	if isRefillInProgress {
+		return
+	}
+
+	go func() {
+		defer shard.isRefillInProgress.Reset()
+
+		numRefilled := shard.Cache.RefillFromOverflow()
+		log.Trace("shardedTxPool.refillFromOverflow()", "name", shard.CacheID, "numRefilled", numRefilled)
+	}()
 }
 
 // RemoveDataFromAllShards removes the transaction from the pool (it searches in all shards)
@@ -282,6 +349,11 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 		txPool.addTx(tx, destCacheID)
 	})
 
+	spilledTxs := sourceShard.Overflow.PopTxs(math.MaxInt32, math.MaxInt32)
+	for _, tx := range spilledTxs {
+		txPool.addTx(tx, destCacheID)
+	}
+
 	txPool.mutexBackingMap.Lock()
 	delete(txPool.backingMap, sourceCacheID)
 	txPool.mutexBackingMap.Unlock()
@@ -290,6 +362,9 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 // Clear clears everything in the pool
 func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
+	for _, shard := range txPool.backingMap {
+		shard.Overflow.Clear()
+	}
 	txPool.backingMap = make(map[string]*txPoolShard)
 	txPool.mutexBackingMap.Unlock()
 }
@@ -312,7 +387,7 @@ func (txPool *shardedTxPool) RegisterOnAdded(handler func(key []byte, value inte
 	txPool.mutexAddCallbacks.Unlock()
 }
 
-// GetCounts returns the total number of transactions in the pool
+// GetCounts returns the total number of transactions in the pool (including the ones spilled to the overflow)
 func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	txPool.mutexBackingMap.RLock()
 	defer txPool.mutexBackingMap.RUnlock()
@@ -321,7 +396,9 @@ func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 
 	for cacheID, shard := range txPool.backingMap {
 		cache := shard.Cache
-		counts.PutCounts(cacheID, int64(cache.Len()), int64(cache.NumBytes()))
+		numTxs := int64(cache.Len()) + int64(shard.Overflow.CountTx())
+		numBytes := int64(cache.NumBytes()) + int64(shard.Overflow.NumBytes())
+		counts.PutCounts(cacheID, numTxs, numBytes)
 	}
 
 	return counts
@@ -353,6 +430,25 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// Close saves the transactions (if persistence is enabled), then closes the persisters, if any
+func (txPool *shardedTxPool) Close() error {
+	var lastError error
+	if !check.IfNil(txPool.persistedTxsStorer) {
+		txPool.saveTxs()
+
+		lastError = txPool.persistedTxsStorer.Close()
+	}
+
+	if !check.IfNil(txPool.overflowPersister) {
+		err := txPool.overflowPersister.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -1,17 +1,23 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -93,6 +99,41 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.Nil(t, pool)
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidSharding.Error())
+
+	goodArgs.OverflowPersister = memorydb.New()
+	goodArgs.OverflowMaxNumTxs = 100
+	goodArgs.OverflowNewEmptyTx = createEmptyTx
+	goodArgs.Marshalizer = &marshal.GogoProtoMarshalizer{}
+
+	args = goodArgs
+	args.OverflowMaxNumTxs = 0
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrCacheConfigInvalidSize))
+
+	args = goodArgs
+	args.OverflowNewEmptyTx = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrNilEmptyTxCreator))
+
+	args = goodArgs
+	args.Marshalizer = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrNilMarshalizer))
+
+	args = goodArgs
+	args.OverflowPersister = nil
+	args.PersistedTxsStorer = memorydb.New()
+	args.Marshalizer = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrNilMarshalizer))
+
+	pool, err = NewShardedTxPool(goodArgs)
+	require.Nil(t, err)
+	require.NotNil(t, pool)
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
@@ -333,6 +374,82 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_OverflowSpillsEvictedTransactionsAndRefills(t *testing.T) {
+	persister := memorydb.New()
+	poolAsInterface, _ := newTxPoolWithOverflowToTest(persister)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	numTxs := 80
+	for i := 0; i < numTxs; i++ {
+		pool.AddData([]byte(fmt.Sprintf("hash-%d", i)), createTx(fmt.Sprintf("sender-%d", i), 1), 100, "0")
+	}
+
+	shard := pool.getOrCreateShard("0")
+	numInMemory := shard.Cache.Len()
+	require.True(t, numInMemory < numTxs)
+	numSpilled := int(shard.Overflow.CountTx())
+	require.Equal(t, numTxs-numInMemory, numSpilled)
+	require.Equal(t, int64(numTxs), pool.GetCounts().GetTotal())
+	require.Len(t, pool.Keys(), numTxs)
+
+	spilledHash := shard.Overflow.Keys()[0]
+	tx, ok := pool.SearchFirstData(spilledHash)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), tx.(data.TransactionHandler).GetNonce())
+
+	inMemoryHashes := make([][]byte, 0)
+	shard.Cache.ForEachTransaction(func(txHash []byte, _ *txcache.WrappedTransaction) {
+		inMemoryHashes = append(inMemoryHashes, txHash)
+	})
+	pool.RemoveSetOfDataFromPool(inMemoryHashes, "0")
+
+	// The spilled transactions are pulled back, as long as they fit in the cache
+	capacity := int(pool.configPrototypeSourceMe.CountThreshold)
+	require.Eventually(t, func() bool {
+		return shard.Cache.Len() == core.MinInt(numSpilled, capacity)
+	}, time.Second, time.Millisecond*10)
+	require.Equal(t, uint64(core.MaxInt(numSpilled-capacity, 0)), shard.Overflow.CountTx())
+	require.Equal(t, int64(numSpilled), pool.GetCounts().GetTotal())
+
+	require.Nil(t, pool.Close())
+}
+
+func Test_PersistedTxsAreSavedOnCloseAndReloaded(t *testing.T) {
+	persistedTxsStorer := memorydb.New()
+	args := createArgsWithOverflowToTest(memorydb.New())
+	args.PersistedTxsStorer = persistedTxsStorer
+	args.OverflowMaxNumTxs = 1000
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	numTxs := 120
+	for i := 0; i < numTxs; i++ {
+		pool.AddData([]byte(fmt.Sprintf("hash-%d", i)), createTx(fmt.Sprintf("sender-%d", i), uint64(i)), 100, "0")
+	}
+	pool.AddData([]byte("hash-cross"), createTx("alice", 7), 100, "0_1")
+	require.True(t, pool.getOrCreateShard("0").Overflow.CountTx() > 0)
+	require.Nil(t, pool.Close())
+
+	args.OverflowPersister = memorydb.New()
+	reloadedPool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	reloaded := make(map[string]uint64)
+	numFound := reloadedPool.ReloadPersistedTxs(func(txHash []byte, txBuff []byte) {
+		tx := &transaction.Transaction{}
+		require.Nil(t, args.Marshalizer.Unmarshal(tx, txBuff))
+		reloaded[string(txHash)] = tx.Nonce
+	})
+	require.Equal(t, numTxs+1, numFound)
+	require.Len(t, reloaded, numTxs+1)
+	require.Equal(t, uint64(42), reloaded["hash-42"])
+	require.Equal(t, uint64(7), reloaded["hash-cross"])
+
+	// Saved transactions are handed out only once
+	require.Equal(t, 0, reloadedPool.ReloadPersistedTxs(func(_ []byte, _ []byte) {}))
+	require.Equal(t, 0, reloadedPool.ReloadPersistedTxs(nil))
+}
+
 func Test_Keys(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -401,6 +518,37 @@ func waitABit() {
 type thisIsNotATransaction struct {
 }
 
+func createEmptyTx() data.TransactionHandler {
+	return &transaction.Transaction{}
+}
+
+func newTxPoolWithOverflowToTest(persister storage.Persister) (dataRetriever.ShardedDataCacherNotifier, error) {
+	return NewShardedTxPool(createArgsWithOverflowToTest(persister))
+}
+
+func createArgsWithOverflowToTest(persister storage.Persister) ArgShardedTxPool {
+	return ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards:     4,
+		SelfShardID:        0,
+		OverflowPersister:  persister,
+		OverflowMaxNumTxs:  100,
+		OverflowNewEmptyTx: createEmptyTx,
+		Marshalizer:        &marshal.GogoProtoMarshalizer{},
+	}
+}
+
 func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 	config := storageUnit.CacheConfig{
 		Capacity:             100,
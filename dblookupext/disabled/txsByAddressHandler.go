@@ -0,0 +1,39 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/txsByAddress"
+)
+
+var errDisabledTransactionsByAddressIndex = errors.New("transactions by address index is disabled")
+
+type txsByAddressHandler struct {
+}
+
+// NewDisabledTransactionsByAddressHandler returns a transactions by address handler that does not index anything
+func NewDisabledTransactionsByAddressHandler() *txsByAddressHandler {
+	return &txsByAddressHandler{}
+}
+
+// RecordBlock does nothing
+func (handler *txsByAddressHandler) RecordBlock(_ []byte, _ data.HeaderHandler, _ *block.Body, _ map[string]data.TransactionHandler, _ []*block.MiniBlock) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (handler *txsByAddressHandler) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetTransactionsByAddress returns a disabled index error
+func (handler *txsByAddressHandler) GetTransactionsByAddress(_ []byte, _ uint64, _ uint64) (*txsByAddress.TransactionsPage, error) {
+	return nil, errDisabledTransactionsByAddressIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *txsByAddressHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -11,13 +11,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/disabled"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/txsByAddress"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
 // new instances
 type ArgsHistoryRepositoryFactory struct {
 	SelfShardID              uint32
+	ShardCoordinator         sharding.Coordinator
 	Config                   config.DbLookupExtensionsConfig
 	Store                    dataRetriever.StorageService
 	Marshalizer              marshal.Marshalizer
@@ -27,6 +30,7 @@ type ArgsHistoryRepositoryFactory struct {
 
 type historyRepositoryFactory struct {
 	selfShardID              uint32
+	shardCoordinator         sharding.Coordinator
 	dbLookupExtensionsConfig config.DbLookupExtensionsConfig
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
@@ -48,9 +52,13 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
+		shardCoordinator:         args.ShardCoordinator,
 		dbLookupExtensionsConfig: args.Config,
 		store:                    args.Store,
 		marshalizer:              args.Marshalizer,
@@ -74,6 +82,11 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	txsByAddressHandler, err := hpf.createTransactionsByAddressHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -85,10 +98,27 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		TxsByAddressHandler:         txsByAddressHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createTransactionsByAddressHandler() (dblookupext.TransactionsByAddressHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.TransactionsByAddressEnabled {
+		return disabled.NewDisabledTransactionsByAddressHandler(), nil
+	}
+
+	return txsByAddress.NewTransactionsByAddressProcessor(txsByAddress.ArgsTransactionsByAddressProcessor{
+		Marshalizer:                hpf.marshalizer,
+		Hasher:                     hpf.hasher,
+		ShardCoordinator:           hpf.shardCoordinator,
+		TxsByAddressStorer:         hpf.store.GetStorer(dataRetriever.TransactionsByAddressUnit),
+		TransactionsStorer:         hpf.store.GetStorer(dataRetriever.TransactionUnit),
+		UnsignedTransactionsStorer: hpf.store.GetStorer(dataRetriever.UnsignedTransactionUnit),
+		RewardTransactionsStorer:   hpf.store.GetStorer(dataRetriever.RewardTransactionUnit),
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/txsByAddress"
 	epochStartMocks "github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -37,6 +38,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		TxsByAddressHandler:         &txsByAddressHandlerStub{},
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +90,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.TxsByAddressHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilTransactionsByAddressHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -152,6 +160,76 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockShouldRecordTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	headerHash := []byte("headerHash")
+	blockHeader := &block.Header{Nonce: 4}
+	blockBody := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txA")}}}}
+	scrsFromPool := map[string]data.TransactionHandler{"scr": nil}
+	intraShardMiniBlocks := []*block.MiniBlock{{TxHashes: [][]byte{[]byte("scr")}}}
+
+	args := createMockHistoryRepoArgs(0)
+	args.TxsByAddressHandler = &txsByAddressHandlerStub{
+		RecordBlockCalled: func(blockHeaderHash []byte, header data.HeaderHandler, body *block.Body, scrResultsFromPool map[string]data.TransactionHandler, createdIntraShardMiniBlocks []*block.MiniBlock) error {
+			require.Equal(t, headerHash, blockHeaderHash)
+			require.Equal(t, blockHeader, header)
+			require.Equal(t, blockBody, body)
+			require.Equal(t, scrsFromPool, scrResultsFromPool)
+			require.Equal(t, intraShardMiniBlocks, createdIntraShardMiniBlocks)
+
+			return expectedErr
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	err := repo.RecordBlock(headerHash, blockHeader, blockBody, scrsFromPool, nil, intraShardMiniBlocks, nil)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestHistoryRepository_RevertBlockShouldRevertTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	revertCalled := false
+	blockHeader := &block.Header{Nonce: 4}
+	args := createMockHistoryRepoArgs(0)
+	args.TxsByAddressHandler = &txsByAddressHandlerStub{
+		RevertBlockCalled: func(header data.HeaderHandler) error {
+			require.Equal(t, blockHeader, header)
+			revertCalled = true
+
+			return nil
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	err := repo.RevertBlock(blockHeader, &block.Body{})
+	require.Nil(t, err)
+	require.True(t, revertCalled)
+}
+
+func TestHistoryRepository_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedPage := &txsByAddress.TransactionsPage{NumRecords: 37}
+	args := createMockHistoryRepoArgs(0)
+	args.TxsByAddressHandler = &txsByAddressHandlerStub{
+		GetTransactionsByAddressCalled: func(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error) {
+			require.Equal(t, []byte("address"), address)
+			require.Equal(t, uint64(10), offset)
+			require.Equal(t, uint64(5), maxRecords)
+
+			return expectedPage, nil
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	page, err := repo.GetTransactionsByAddress([]byte("address"), 10, 5)
+	require.Nil(t, err)
+	require.Equal(t, expectedPage, page)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -741,3 +819,37 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 	require.Equal(t, 4001, int(metadata.NotarizedAtDestinationInMetaNonce))
 	require.Equal(t, []byte("metablockFoo"), metadata.NotarizedAtDestinationInMetaHash)
 }
+
+type txsByAddressHandlerStub struct {
+	RecordBlockCalled              func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody *block.Body, scrResultsFromPool map[string]data.TransactionHandler, createdIntraShardMiniBlocks []*block.MiniBlock) error
+	RevertBlockCalled              func(blockHeader data.HeaderHandler) error
+	GetTransactionsByAddressCalled func(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error)
+}
+
+func (stub *txsByAddressHandlerStub) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody *block.Body, scrResultsFromPool map[string]data.TransactionHandler, createdIntraShardMiniBlocks []*block.MiniBlock) error {
+	if stub.RecordBlockCalled != nil {
+		return stub.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, createdIntraShardMiniBlocks)
+	}
+
+	return nil
+}
+
+func (stub *txsByAddressHandlerStub) RevertBlock(blockHeader data.HeaderHandler) error {
+	if stub.RevertBlockCalled != nil {
+		return stub.RevertBlockCalled(blockHeader)
+	}
+
+	return nil
+}
+
+func (stub *txsByAddressHandlerStub) GetTransactionsByAddress(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error) {
+	if stub.GetTransactionsByAddressCalled != nil {
+		return stub.GetTransactionsByAddressCalled(address, offset, maxRecords)
+	}
+
+	return &txsByAddress.TransactionsPage{}, nil
+}
+
+func (stub *txsByAddressHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
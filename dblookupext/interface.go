@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/txsByAddress"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -27,6 +28,7 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetTransactionsByAddress(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +49,15 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// TransactionsByAddressHandler defines the interface of a component able to index the transactions by the involved addresses
+type TransactionsByAddressHandler interface {
+	RecordBlock(blockHeaderHash []byte,
+		blockHeader data.HeaderHandler,
+		blockBody *block.Body,
+		scrResultsFromPool map[string]data.TransactionHandler,
+		createdIntraShardMiniBlocks []*block.MiniBlock) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetTransactionsByAddress(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error)
+	IsInterfaceNil() bool
+}
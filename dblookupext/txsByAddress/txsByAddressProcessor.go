@@ -0,0 +1,433 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressTransaction.proto
+
+package txsByAddress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common/logging"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dblookupext/txsByAddress")
+
+const (
+	counterKeyPrefix = "c"
+	recordKeyPrefix  = "r"
+	blockKeyPrefix   = "b"
+	sizeOfUint64     = 8
+)
+
+// ArgsTransactionsByAddressProcessor holds the arguments needed for creating a new transactions by address processor
+type ArgsTransactionsByAddressProcessor struct {
+	Marshalizer                marshal.Marshalizer
+	Hasher                     hashing.Hasher
+	ShardCoordinator           sharding.Coordinator
+	TxsByAddressStorer         storage.Storer
+	TransactionsStorer         storage.Storer
+	UnsignedTransactionsStorer storage.Storer
+	RewardTransactionsStorer   storage.Storer
+}
+
+// TransactionsPage holds a page of transaction records of an address, ordered from the newest to the oldest one
+type TransactionsPage struct {
+	Records    []*AddressTransaction
+	NumRecords uint64
+}
+
+type txsByAddressProcessor struct {
+	marshalizer                marshal.Marshalizer
+	hasher                     hashing.Hasher
+	shardCoordinator           sharding.Coordinator
+	txsByAddressStorer         storage.Storer
+	transactionsStorer         storage.Storer
+	unsignedTransactionsStorer storage.Storer
+	rewardTransactionsStorer   storage.Storer
+	mutex                      sync.RWMutex
+}
+
+// NewTransactionsByAddressProcessor will create a new instance of the transactions by address processor
+func NewTransactionsByAddressProcessor(args ArgsTransactionsByAddressProcessor) (*txsByAddressProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.TxsByAddressStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.TransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.UnsignedTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.RewardTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	return &txsByAddressProcessor{
+		marshalizer:                args.Marshalizer,
+		hasher:                     args.Hasher,
+		shardCoordinator:           args.ShardCoordinator,
+		txsByAddressStorer:         args.TxsByAddressStorer,
+		transactionsStorer:         args.TransactionsStorer,
+		unsignedTransactionsStorer: args.UnsignedTransactionsStorer,
+		rewardTransactionsStorer:   args.RewardTransactionsStorer,
+	}, nil
+}
+
+// RecordBlock will append the transactions of the provided block to the index of each involved (own shard) address.
+// The transactions themselves should have been already saved in their storers.
+func (tap *txsByAddressProcessor) RecordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	blockBody *block.Body,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	createdIntraShardMiniBlocks []*block.MiniBlock,
+) error {
+	if check.IfNil(blockHeader) || blockBody == nil {
+		return nil
+	}
+
+	tap.mutex.Lock()
+	defer tap.mutex.Unlock()
+
+	blockKey := buildBlockKey(blockHeaderHash)
+	if tap.txsByAddressStorer.Has(blockKey) == nil {
+		log.Debug("txsByAddressProcessor.RecordBlock: block already recorded", "hash", blockHeaderHash)
+		return nil
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(blockBody.MiniBlocks)+len(createdIntraShardMiniBlocks))
+	miniBlocks = append(miniBlocks, blockBody.MiniBlocks...)
+	miniBlocks = append(miniBlocks, createdIntraShardMiniBlocks...)
+
+	addresses, recordsByAddress := tap.createRecords(blockHeaderHash, blockHeader, miniBlocks, scrResultsFromPool)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	// the block entry is saved first so that a partially recorded block can still be reverted
+	err := tap.putMarshalized(blockKey, &AddressesByBlock{Addresses: addresses})
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = tap.appendRecords(address, recordsByAddress[string(address)])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tap *txsByAddressProcessor) createRecords(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlocks []*block.MiniBlock,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) ([][]byte, map[string][]*AddressTransaction) {
+	addresses := make([][]byte, 0)
+	recordsByAddress := make(map[string][]*AddressTransaction)
+	indexedPairs := make(map[string]struct{})
+
+	for _, miniBlock := range miniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, err := tap.getTransaction(txHash, miniBlock.Type, scrResultsFromPool)
+			if err != nil {
+				logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "txsByAddressProcessor.createRecords: cannot get transaction",
+					"txHash", txHash, "miniblock type", miniBlock.Type, "err", err)
+				continue
+			}
+			if check.IfNil(tx) {
+				continue
+			}
+
+			for _, address := range [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()} {
+				if !tap.shouldIndexAddress(address) {
+					continue
+				}
+
+				pairKey := string(address) + string(txHash)
+				_, alreadyIndexed := indexedPairs[pairKey]
+				if alreadyIndexed {
+					continue
+				}
+				indexedPairs[pairKey] = struct{}{}
+
+				_, addressExists := recordsByAddress[string(address)]
+				if !addressExists {
+					addresses = append(addresses, address)
+				}
+
+				recordsByAddress[string(address)] = append(recordsByAddress[string(address)], &AddressTransaction{
+					TxHash:        txHash,
+					MiniblockType: int32(miniBlock.Type),
+					HeaderHash:    blockHeaderHash,
+					HeaderNonce:   blockHeader.GetNonce(),
+					Round:         blockHeader.GetRound(),
+					Epoch:         blockHeader.GetEpoch(),
+				})
+			}
+		}
+	}
+
+	return addresses, recordsByAddress
+}
+
+func (tap *txsByAddressProcessor) shouldIndexAddress(address []byte) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	return tap.shardCoordinator.ComputeId(address) == tap.shardCoordinator.SelfId()
+}
+
+func (tap *txsByAddressProcessor) getTransaction(
+	txHash []byte,
+	miniBlockType block.Type,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) (data.TransactionHandler, error) {
+	scr, found := scrResultsFromPool[string(txHash)]
+	if found {
+		return scr, nil
+	}
+
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return tap.getTransactionFromStorer(txHash, tap.transactionsStorer, &transaction.Transaction{})
+	case block.SmartContractResultBlock:
+		return tap.getTransactionFromStorer(txHash, tap.unsignedTransactionsStorer, &smartContractResult.SmartContractResult{})
+	case block.RewardsBlock:
+		return tap.getTransactionFromStorer(txHash, tap.rewardTransactionsStorer, &rewardTx.RewardTx{})
+	default:
+		return nil, nil
+	}
+}
+
+func (tap *txsByAddressProcessor) getTransactionFromStorer(
+	txHash []byte,
+	storer storage.Storer,
+	tx data.TransactionHandler,
+) (data.TransactionHandler, error) {
+	txBytes, err := storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tap.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (tap *txsByAddressProcessor) appendRecords(address []byte, records []*AddressTransaction) error {
+	numRecords, err := tap.getNumRecords(address)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		err = tap.putMarshalized(buildRecordKey(address, numRecords), record)
+		if err != nil {
+			return err
+		}
+
+		numRecords++
+	}
+
+	return tap.putNumRecords(address, numRecords)
+}
+
+// RevertBlock will remove the records added by the provided block
+func (tap *txsByAddressProcessor) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	blockHeaderHash, err := core.CalculateHash(tap.marshalizer, tap.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	tap.mutex.Lock()
+	defer tap.mutex.Unlock()
+
+	blockKey := buildBlockKey(blockHeaderHash)
+	addressesBytes, err := tap.txsByAddressStorer.Get(blockKey)
+	if err != nil {
+		// nothing was recorded for this block
+		return nil
+	}
+
+	addressesByBlock := &AddressesByBlock{}
+	err = tap.marshalizer.Unmarshal(addressesByBlock, addressesBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addressesByBlock.Addresses {
+		err = tap.removeTrailingRecords(address, blockHeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tap.txsByAddressStorer.Remove(blockKey)
+}
+
+func (tap *txsByAddressProcessor) removeTrailingRecords(address []byte, blockHeaderHash []byte) error {
+	numRecords, err := tap.getNumRecords(address)
+	if err != nil {
+		return err
+	}
+
+	for numRecords > 0 {
+		recordKey := buildRecordKey(address, numRecords-1)
+		record, errGet := tap.getRecord(recordKey)
+		if errGet != nil {
+			return errGet
+		}
+		if !bytes.Equal(record.HeaderHash, blockHeaderHash) {
+			break
+		}
+
+		err = tap.txsByAddressStorer.Remove(recordKey)
+		if err != nil {
+			return err
+		}
+
+		numRecords--
+	}
+
+	return tap.putNumRecords(address, numRecords)
+}
+
+// GetTransactionsByAddress returns at most maxRecords transaction records of the provided address, starting
+// from the given offset. The records are ordered from the newest to the oldest one.
+func (tap *txsByAddressProcessor) GetTransactionsByAddress(address []byte, offset uint64, maxRecords uint64) (*TransactionsPage, error) {
+	tap.mutex.RLock()
+	defer tap.mutex.RUnlock()
+
+	numRecords, err := tap.getNumRecords(address)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TransactionsPage{
+		Records:    make([]*AddressTransaction, 0),
+		NumRecords: numRecords,
+	}
+	if offset >= numRecords {
+		return page, nil
+	}
+
+	for index := numRecords - offset; index > 0 && uint64(len(page.Records)) < maxRecords; index-- {
+		record, errGet := tap.getRecord(buildRecordKey(address, index-1))
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		page.Records = append(page.Records, record)
+	}
+
+	return page, nil
+}
+
+func (tap *txsByAddressProcessor) getNumRecords(address []byte) (uint64, error) {
+	counterBytes, err := tap.txsByAddressStorer.Get(buildCounterKey(address))
+	if err != nil {
+		if storage.IsNotFoundInStorageErr(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+	if len(counterBytes) != sizeOfUint64 {
+		return 0, nil
+	}
+
+	return binary.BigEndian.Uint64(counterBytes), nil
+}
+
+func (tap *txsByAddressProcessor) putNumRecords(address []byte, numRecords uint64) error {
+	counterBytes := make([]byte, sizeOfUint64)
+	binary.BigEndian.PutUint64(counterBytes, numRecords)
+
+	return tap.txsByAddressStorer.Put(buildCounterKey(address), counterBytes)
+}
+
+func (tap *txsByAddressProcessor) getRecord(recordKey []byte) (*AddressTransaction, error) {
+	recordBytes, err := tap.txsByAddressStorer.Get(recordKey)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &AddressTransaction{}
+	err = tap.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (tap *txsByAddressProcessor) putMarshalized(key []byte, value interface{}) error {
+	buff, err := tap.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return tap.txsByAddressStorer.Put(key, buff)
+}
+
+func buildCounterKey(address []byte) []byte {
+	return append([]byte(counterKeyPrefix), address...)
+}
+
+func buildRecordKey(address []byte, index uint64) []byte {
+	key := make([]byte, 0, len(recordKeyPrefix)+len(address)+sizeOfUint64)
+	key = append(key, recordKeyPrefix...)
+	key = append(key, address...)
+
+	indexBytes := make([]byte, sizeOfUint64)
+	binary.BigEndian.PutUint64(indexBytes, index)
+
+	return append(key, indexBytes...)
+}
+
+func buildBlockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(blockKeyPrefix), blockHeaderHash...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tap *txsByAddressProcessor) IsInterfaceNil() bool {
+	return tap == nil
+}
@@ -0,0 +1,239 @@
+package txsByAddress
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addressAlice   = []byte("alice")
+	addressBob     = []byte("bob")
+	addressCarol   = []byte("carol")
+	addressOutside = []byte("outside")
+)
+
+func createMockArgsTransactionsByAddressProcessor() ArgsTransactionsByAddressProcessor {
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(addressOutside) {
+			return 1
+		}
+		return 0
+	}
+
+	return ArgsTransactionsByAddressProcessor{
+		Marshalizer:                &testscommon.MarshalizerMock{},
+		Hasher:                     &hashingMocks.HasherMock{},
+		ShardCoordinator:           shardCoordinator,
+		TxsByAddressStorer:         testscommon.CreateMemUnit(),
+		TransactionsStorer:         genericMocks.NewStorerMock(),
+		UnsignedTransactionsStorer: genericMocks.NewStorerMock(),
+		RewardTransactionsStorer:   genericMocks.NewStorerMock(),
+	}
+}
+
+func TestNewTransactionsByAddressProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsTransactionsByAddressProcessor()
+		args.Marshalizer = nil
+
+		proc, err := NewTransactionsByAddressProcessor(args)
+		require.Nil(t, proc)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsTransactionsByAddressProcessor()
+		args.Hasher = nil
+
+		proc, err := NewTransactionsByAddressProcessor(args)
+		require.Nil(t, proc)
+		require.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		args := createMockArgsTransactionsByAddressProcessor()
+		args.ShardCoordinator = nil
+
+		proc, err := NewTransactionsByAddressProcessor(args)
+		require.Nil(t, proc)
+		require.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil storers should error", func(t *testing.T) {
+		args := createMockArgsTransactionsByAddressProcessor()
+		args.TxsByAddressStorer = nil
+		_, err := NewTransactionsByAddressProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+
+		args = createMockArgsTransactionsByAddressProcessor()
+		args.TransactionsStorer = nil
+		_, err = NewTransactionsByAddressProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+
+		args = createMockArgsTransactionsByAddressProcessor()
+		args.UnsignedTransactionsStorer = nil
+		_, err = NewTransactionsByAddressProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+
+		args = createMockArgsTransactionsByAddressProcessor()
+		args.RewardTransactionsStorer = nil
+		_, err = NewTransactionsByAddressProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		proc, err := NewTransactionsByAddressProcessor(createMockArgsTransactionsByAddressProcessor())
+		require.Nil(t, err)
+		require.False(t, proc.IsInterfaceNil())
+	})
+}
+
+func TestTxsByAddressProcessor_RecordBlockAndGetTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionsByAddressProcessor()
+	marshalizer := args.Marshalizer
+	_ = args.TransactionsStorer.Put([]byte("tx1"), marshalizeOrPanic(marshalizer, &transaction.Transaction{SndAddr: addressAlice, RcvAddr: addressBob}))
+	_ = args.TransactionsStorer.Put([]byte("tx2"), marshalizeOrPanic(marshalizer, &transaction.Transaction{SndAddr: addressAlice, RcvAddr: addressOutside}))
+	_ = args.UnsignedTransactionsStorer.Put([]byte("scr1"), marshalizeOrPanic(marshalizer, &smartContractResult.SmartContractResult{SndAddr: addressBob, RcvAddr: addressCarol}))
+	_ = args.RewardTransactionsStorer.Put([]byte("reward1"), marshalizeOrPanic(marshalizer, &rewardTx.RewardTx{RcvAddr: addressAlice}))
+
+	proc, _ := NewTransactionsByAddressProcessor(args)
+
+	header := &block.Header{Nonce: 7, Round: 8, Epoch: 1}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}},
+			{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr1")}},
+			{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward1")}},
+			{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peer")}},
+		},
+	}
+	intraShardMiniBlocks := []*block.MiniBlock{
+		{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scrFromPool")}},
+	}
+	scrsPool := map[string]data.TransactionHandler{
+		"scrFromPool": &smartContractResult.SmartContractResult{SndAddr: addressCarol, RcvAddr: addressCarol},
+	}
+
+	err := proc.RecordBlock([]byte("headerHash"), header, body, scrsPool, intraShardMiniBlocks)
+	require.Nil(t, err)
+
+	page, err := proc.GetTransactionsByAddress(addressAlice, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), page.NumRecords)
+	require.Equal(t, [][]byte{[]byte("reward1"), []byte("tx2"), []byte("tx1")}, extractTxHashes(page))
+	require.Equal(t, &AddressTransaction{
+		TxHash:        []byte("reward1"),
+		MiniblockType: int32(block.RewardsBlock),
+		HeaderHash:    []byte("headerHash"),
+		HeaderNonce:   7,
+		Round:         8,
+		Epoch:         1,
+	}, page.Records[0])
+
+	page, _ = proc.GetTransactionsByAddress(addressBob, 0, 10)
+	require.Equal(t, [][]byte{[]byte("scr1"), []byte("tx1")}, extractTxHashes(page))
+
+	page, _ = proc.GetTransactionsByAddress(addressCarol, 0, 10)
+	require.Equal(t, [][]byte{[]byte("scrFromPool"), []byte("scr1")}, extractTxHashes(page))
+
+	page, _ = proc.GetTransactionsByAddress(addressOutside, 0, 10)
+	require.Equal(t, uint64(0), page.NumRecords)
+	require.Empty(t, page.Records)
+
+	t.Run("pagination", func(t *testing.T) {
+		page, _ = proc.GetTransactionsByAddress(addressAlice, 1, 1)
+		require.Equal(t, uint64(3), page.NumRecords)
+		require.Equal(t, [][]byte{[]byte("tx2")}, extractTxHashes(page))
+
+		page, _ = proc.GetTransactionsByAddress(addressAlice, 2, 5)
+		require.Equal(t, [][]byte{[]byte("tx1")}, extractTxHashes(page))
+
+		page, _ = proc.GetTransactionsByAddress(addressAlice, 3, 5)
+		require.Empty(t, page.Records)
+	})
+}
+
+func TestTxsByAddressProcessor_RecordBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionsByAddressProcessor()
+	_ = args.TransactionsStorer.Put([]byte("tx1"), marshalizeOrPanic(args.Marshalizer, &transaction.Transaction{SndAddr: addressAlice, RcvAddr: addressAlice}))
+	proc, _ := NewTransactionsByAddressProcessor(args)
+
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1")}}}}
+	_ = proc.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 1}, body, nil, nil)
+	_ = proc.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 1}, body, nil, nil)
+
+	page, err := proc.GetTransactionsByAddress(addressAlice, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), page.NumRecords)
+}
+
+func TestTxsByAddressProcessor_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionsByAddressProcessor()
+	_ = args.TransactionsStorer.Put([]byte("tx1"), marshalizeOrPanic(args.Marshalizer, &transaction.Transaction{SndAddr: addressAlice, RcvAddr: addressBob}))
+	_ = args.TransactionsStorer.Put([]byte("tx2"), marshalizeOrPanic(args.Marshalizer, &transaction.Transaction{SndAddr: addressAlice, RcvAddr: addressCarol}))
+	proc, _ := NewTransactionsByAddressProcessor(args)
+
+	firstHeader := &block.Header{Nonce: 1}
+	firstHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, firstHeader)
+	secondHeader := &block.Header{Nonce: 2}
+	secondHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, secondHeader)
+
+	firstBody := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1")}}}}
+	secondBody := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx2")}}}}
+	_ = proc.RecordBlock(firstHeaderHash, firstHeader, firstBody, nil, nil)
+	_ = proc.RecordBlock(secondHeaderHash, secondHeader, secondBody, nil, nil)
+
+	err := proc.RevertBlock(secondHeader)
+	require.Nil(t, err)
+
+	page, _ := proc.GetTransactionsByAddress(addressAlice, 0, 10)
+	require.Equal(t, [][]byte{[]byte("tx1")}, extractTxHashes(page))
+	page, _ = proc.GetTransactionsByAddress(addressCarol, 0, 10)
+	require.Equal(t, uint64(0), page.NumRecords)
+	page, _ = proc.GetTransactionsByAddress(addressBob, 0, 10)
+	require.Equal(t, [][]byte{[]byte("tx1")}, extractTxHashes(page))
+
+	// reverting an unknown block does nothing
+	err = proc.RevertBlock(&block.Header{Nonce: 3})
+	require.Nil(t, err)
+
+	// the reverted block can be recorded again
+	_ = proc.RecordBlock(secondHeaderHash, secondHeader, secondBody, nil, nil)
+	page, _ = proc.GetTransactionsByAddress(addressAlice, 0, 10)
+	require.Equal(t, [][]byte{[]byte("tx2"), []byte("tx1")}, extractTxHashes(page))
+}
+
+func marshalizeOrPanic(marshalizer marshal.Marshalizer, obj interface{}) []byte {
+	buff, err := marshalizer.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+
+	return buff
+}
+
+func extractTxHashes(page *TransactionsPage) [][]byte {
+	hashes := make([][]byte, 0, len(page.Records))
+	for _, record := range page.Records {
+		hashes = append(hashes, record.TxHash)
+	}
+
+	return hashes
+}
@@ -109,6 +109,7 @@ func createMockEpochStartBootstrapArgs(
 			ShardHdrNonceHashStorage:           generalCfg.ShardHdrNonceHashStorage,
 			MetaHdrNonceHashStorage:            generalCfg.MetaHdrNonceHashStorage,
 			StatusMetricsStorage:               generalCfg.StatusMetricsStorage,
+			EquivocationEvidenceStorage:        generalCfg.EquivocationEvidenceStorage,
 			ReceiptsStorage:                    generalCfg.ReceiptsStorage,
 			SmartContractsStorage:              generalCfg.SmartContractsStorage,
 			SmartContractsStorageForSCQuery:    generalCfg.SmartContractsStorageForSCQuery,
@@ -40,3 +40,6 @@ var ErrNilGenesisBalances = errors.New("nil genesis balances slice")
 
 // ErrEmptyGasConfigs signals that the provided gas configs map is empty
 var ErrEmptyGasConfigs = errors.New("empty gas configs")
+
+// ErrNilSubscriptionsHandler signals that a nil subscriptions handler has been provided
+var ErrNilSubscriptionsHandler = errors.New("nil subscriptions handler")
@@ -14,7 +14,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
@@ -58,6 +60,66 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetMultiProofDataTrie -
+func (inf *initialNodeFacade) GetMultiProofDataTrie(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// GetRangeProofDataTrie -
+func (inf *initialNodeFacade) GetRangeProofDataTrie(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ string, _ []string, _ [][]byte) (*common.VerifyMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyRangeProof -
+func (inf *initialNodeFacade) VerifyRangeProof(_ string, _ string, _ string, _ [][]byte) (*common.VerifyMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStateDiff returns nil and error
+func (inf *initialNodeFacade) GetStateDiff(_ string, _ string) (*common.StateDiffApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// StartDbIntegrityCheck returns error
+func (inf *initialNodeFacade) StartDbIntegrityCheck(_ uint32, _ bool) error {
+	return errNodeStarting
+}
+
+// GetDbIntegrityReport returns nil and error
+func (inf *initialNodeFacade) GetDbIntegrityReport() (*common.DbIntegrityReport, error) {
+	return nil, errNodeStarting
+}
+
+// StartDataTriesAnalysis returns error
+func (inf *initialNodeFacade) StartDataTriesAnalysis(_ string, _ uint32, _ string) error {
+	return errNodeStarting
+}
+
+// GetDataTriesReport returns nil and error
+func (inf *initialNodeFacade) GetDataTriesReport() (*common.DataTriesReport, error) {
+	return nil, errNodeStarting
+}
+
+// GetP2PFaultRules returns nil and error
+func (inf *initialNodeFacade) GetP2PFaultRules() ([]common.P2PFaultRuleStatus, error) {
+	return nil, errNodeStarting
+}
+
+// SetP2PFaultRules returns error
+func (inf *initialNodeFacade) SetP2PFaultRules(_ []common.P2PFaultRule) error {
+	return errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -151,6 +213,11 @@ func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transa
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionsExecution returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsExecution(_ []*transaction.Transaction, _ bool) ([]*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -161,11 +228,21 @@ func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transact
 	return nil, errNodeStarting
 }
 
+// ComputeTransactionGasLimitInBlock returns nil and error
+func (inf *initialNodeFacade) ComputeTransactionGasLimitInBlock(_ *transaction.Transaction, _ process.BlockCoordinates) (*transaction.CostResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetAccount returns nil and error
 func (inf *initialNodeFacade) GetAccount(_ string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	return api.AccountResponse{}, api.BlockInfo{}, errNodeStarting
 }
 
+// GetAccountsBulk returns nil and error
+func (inf *initialNodeFacade) GetAccountsBulk(_ common.AccountsBulkQuery, _ api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // GetCode returns nil and error
 func (inf *initialNodeFacade) GetCode(_ []byte, _ api.AccountQueryOptions) []byte {
 	return nil
@@ -196,6 +273,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, errNodeStarting
 }
 
+// ExecuteSCQueryWithTrace returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueryWithTrace(_ *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+	return nil, nil, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -231,6 +313,26 @@ func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetStorageStatistics returns nil and error
+func (inf *initialNodeFacade) GetStorageStatistics(_ bool) (*common.StorageStatsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetEquivocationEvidences returns nil and error
+func (inf *initialNodeFacade) GetEquivocationEvidences() ([]*common.EquivocationEvidence, error) {
+	return nil, errNodeStarting
+}
+
+// GetConsensusRounds returns nil and error
+func (inf *initialNodeFacade) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	return nil, errNodeStarting
+}
+
+// GetP2PTraffic returns nil and error
+func (inf *initialNodeFacade) GetP2PTraffic() (*common.P2PTrafficReport, error) {
+	return nil, errNodeStarting
+}
+
 // GetEpochStartDataAPI returns nil and error
 func (inf *initialNodeFacade) GetEpochStartDataAPI(_ uint32) (*common.EpochStartDataAPI, error) {
 	return nil, errNodeStarting
@@ -361,6 +463,11 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionsByAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsByAddress(_ string, _ uint64, _ uint64) (*common.TransactionsByAddressApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -371,6 +478,15 @@ func (inf *initialNodeFacade) GetGasConfigs() (map[string]map[string]uint64, err
 	return nil, errNodeStarting
 }
 
+// Subscribe returns nil and error
+func (inf *initialNodeFacade) Subscribe(_ subscriptions.Filter) (subscriptions.Subscription, error) {
+	return nil, errNodeStarting
+}
+
+// Unsubscribe does nothing
+func (inf *initialNodeFacade) Unsubscribe(_ uint64) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (inf *initialNodeFacade) IsInterfaceNil() bool {
 	return inf == nil
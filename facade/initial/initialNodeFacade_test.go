@@ -69,6 +69,10 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	u3, err := inf.SimulateTransactionsExecution(nil, false)
+	assert.Nil(t, u3)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
@@ -92,6 +96,11 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	vo, trace, err := inf.ExecuteSCQueryWithTrace(nil)
+	assert.Nil(t, vo)
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -8,14 +8,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryWithTraceHandler              func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitInBlockHandler    func(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -37,6 +40,7 @@ type ApiResolverStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsByAddressCalled              func(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
 }
 
@@ -85,6 +89,15 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nil, nil
 }
 
+// ExecuteSCQueryWithTrace -
+func (ars *ApiResolverStub) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if ars.ExecuteSCQueryWithTraceHandler != nil {
+		return ars.ExecuteSCQueryWithTraceHandler(query)
+	}
+
+	return nil, nil, nil
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	if ars.StatusMetricsHandler != nil {
@@ -103,6 +116,15 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nil, nil
 }
 
+// ComputeTransactionGasLimitInBlock -
+func (ars *ApiResolverStub) ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	if ars.ComputeTransactionGasLimitInBlockHandler != nil {
+		return ars.ComputeTransactionGasLimitInBlockHandler(tx, coordinates)
+	}
+
+	return nil, nil
+}
+
 // GetTotalStakedValue -
 func (ars *ApiResolverStub) GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error) {
 	if ars.GetTotalStakedValueHandler != nil {
@@ -198,6 +220,15 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string)
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (ars *ApiResolverStub) GetTransactionsByAddress(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error) {
+	if ars.GetTransactionsByAddressCalled != nil {
+		return ars.GetTransactionsByAddressCalled(address, offset, maxTransactions)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
@@ -26,6 +26,7 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountCalled                               func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountsBulkCalled                          func(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error)
 	GetCodeCalled                                  func(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -38,6 +39,10 @@ type NodeStub struct {
 	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetStorageStatisticsCalled                     func(withNumKeys bool) (*common.StorageStatsApiResponse, error)
+	GetEquivocationEvidencesCalled                 func() ([]*common.EquivocationEvidence, error)
+	GetConsensusRoundsCalled                       func() ([]*common.ConsensusRoundTimeline, error)
+	GetP2PTrafficCalled                            func() (*common.P2PTrafficReport, error)
 	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
@@ -49,6 +54,18 @@ type NodeStub struct {
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrieCalled                    func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrieCalled                    func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                         func(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error)
+	VerifyRangeProofCalled                         func(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error)
+	GetStateDiffCalled                             func(fromRootHash string, toRootHash string, ctx context.Context) (*common.StateDiffApiResponse, error)
+	StartDbIntegrityCheckCalled                    func(numEpochs uint32, refetch bool) error
+	GetDbIntegrityReportCalled                     func() (*common.DbIntegrityReport, error)
+	StartDataTriesAnalysisCalled                   func(rootHash string, numLargest uint32, sortBy string) error
+	GetDataTriesReportCalled                       func() (*common.DataTriesReport, error)
+	GetP2PFaultRulesCalled                         func() ([]common.P2PFaultRuleStatus, error)
+	SetP2PFaultRulesCalled                         func(rules []common.P2PFaultRule) error
 }
 
 // GetProof -
@@ -78,6 +95,114 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetMultiProofDataTrie -
+func (ns *NodeStub) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofDataTrieCalled != nil {
+		return ns.GetMultiProofDataTrieCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProofDataTrie -
+func (ns *NodeStub) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if ns.GetRangeProofDataTrieCalled != nil {
+		return ns.GetRangeProofDataTrieCalled(rootHash, address, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return nil, nil
+}
+
+// VerifyRangeProof -
+func (ns *NodeStub) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	if ns.VerifyRangeProofCalled != nil {
+		return ns.VerifyRangeProofCalled(rootHash, startKey, endKey, proof)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(fromRootHash string, toRootHash string, ctx context.Context) (*common.StateDiffApiResponse, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(fromRootHash, toRootHash, ctx)
+	}
+
+	return nil, nil
+}
+
+// StartDbIntegrityCheck -
+func (ns *NodeStub) StartDbIntegrityCheck(numEpochs uint32, refetch bool) error {
+	if ns.StartDbIntegrityCheckCalled != nil {
+		return ns.StartDbIntegrityCheckCalled(numEpochs, refetch)
+	}
+
+	return nil
+}
+
+// GetDbIntegrityReport -
+func (ns *NodeStub) GetDbIntegrityReport() (*common.DbIntegrityReport, error) {
+	if ns.GetDbIntegrityReportCalled != nil {
+		return ns.GetDbIntegrityReportCalled()
+	}
+
+	return nil, nil
+}
+
+// StartDataTriesAnalysis -
+func (ns *NodeStub) StartDataTriesAnalysis(rootHash string, numLargest uint32, sortBy string) error {
+	if ns.StartDataTriesAnalysisCalled != nil {
+		return ns.StartDataTriesAnalysisCalled(rootHash, numLargest, sortBy)
+	}
+
+	return nil
+}
+
+// GetDataTriesReport -
+func (ns *NodeStub) GetDataTriesReport() (*common.DataTriesReport, error) {
+	if ns.GetDataTriesReportCalled != nil {
+		return ns.GetDataTriesReportCalled()
+	}
+
+	return nil, nil
+}
+
+// GetP2PFaultRules -
+func (ns *NodeStub) GetP2PFaultRules() ([]common.P2PFaultRuleStatus, error) {
+	if ns.GetP2PFaultRulesCalled != nil {
+		return ns.GetP2PFaultRulesCalled()
+	}
+
+	return nil, nil
+}
+
+// SetP2PFaultRules -
+func (ns *NodeStub) SetP2PFaultRules(rules []common.P2PFaultRule) error {
+	if ns.SetP2PFaultRulesCalled != nil {
+		return ns.SetP2PFaultRulesCalled(rules)
+	}
+
+	return nil
+}
+
 // GetUsername -
 func (ns *NodeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetUsernameCalled != nil {
@@ -147,6 +272,15 @@ func (ns *NodeStub) GetAccount(address string, options api.AccountQueryOptions)
 	return ns.GetAccountCalled(address, options)
 }
 
+// GetAccountsBulk -
+func (ns *NodeStub) GetAccountsBulk(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+	if ns.GetAccountsBulkCalled != nil {
+		return ns.GetAccountsBulkCalled(query, options)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // GetCode -
 func (ns *NodeStub) GetCode(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo) {
 	if ns.GetCodeCalled != nil {
@@ -194,6 +328,42 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetStorageStatistics -
+func (ns *NodeStub) GetStorageStatistics(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+	if ns.GetStorageStatisticsCalled != nil {
+		return ns.GetStorageStatisticsCalled(withNumKeys)
+	}
+
+	return nil, nil
+}
+
+// GetEquivocationEvidences -
+func (ns *NodeStub) GetEquivocationEvidences() ([]*common.EquivocationEvidence, error) {
+	if ns.GetEquivocationEvidencesCalled != nil {
+		return ns.GetEquivocationEvidencesCalled()
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRounds -
+func (ns *NodeStub) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	if ns.GetConsensusRoundsCalled != nil {
+		return ns.GetConsensusRoundsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetP2PTraffic -
+func (ns *NodeStub) GetP2PTraffic() (*common.P2PTrafficReport, error) {
+	if ns.GetP2PTrafficCalled != nil {
+		return ns.GetP2PTrafficCalled()
+	}
+
+	return nil, nil
+}
+
 // GetEpochStartDataAPI -
 func (ns *NodeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if ns.GetEpochStartDataAPICalled != nil {
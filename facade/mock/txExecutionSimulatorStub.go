@@ -7,7 +7,8 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled  func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxsCalled func(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +20,15 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSi
 	return &txSimData.SimulationResults{}, nil
 }
 
+// ProcessTxs -
+func (t *TxExecutionSimulatorStub) ProcessTxs(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error) {
+	if t.ProcessTxsCalled != nil {
+		return t.ProcessTxsCalled(txs, stopOnFailure)
+	}
+
+	return make([]*txSimData.SimulationResults, 0), nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
@@ -23,7 +23,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -50,6 +52,7 @@ type ArgNodeFacade struct {
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
 	Blockchain             chainData.ChainHandler
+	SubscriptionsHandler   subscriptions.SubscriptionsHandler
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -66,6 +69,7 @@ type nodeFacade struct {
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	subscriptionsHandler   subscriptions.SubscriptionsHandler
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -105,6 +109,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	if check.IfNil(arg.SubscriptionsHandler) {
+		return nil, ErrNilSubscriptionsHandler
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -120,6 +127,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		subscriptionsHandler:   arg.SubscriptionsHandler,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -289,6 +297,12 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.txSimulatorProc.ProcessTx(tx)
 }
 
+// SimulateTransactionsExecution will simulate, in order, the execution of the provided transactions, each one of them
+// seeing the state changes of the previous ones, and will return the results
+func (nf *nodeFacade) SimulateTransactionsExecution(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxs(txs, stopOnFailure)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.apiResolver.GetTransaction(hash, withResults)
@@ -314,11 +328,21 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// GetTransactionsByAddress will return a page of (historical) transactions of the provided address, newest first
+func (nf *nodeFacade) GetTransactionsByAddress(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error) {
+	return nf.apiResolver.GetTransactionsByAddress(address, offset, maxTransactions)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
+// ComputeTransactionGasLimitInBlock will estimate how many gas a transaction would have consumed at the block selected by the coordinates
+func (nf *nodeFacade) ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	return nf.apiResolver.ComputeTransactionGasLimitInBlock(tx, coordinates)
+}
+
 // GetAccount returns a response containing information about the account correlated with provided address
 func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOptions) (apiData.AccountResponse, apiData.BlockInfo, error) {
 	accountResponse, blockInfo, err := nf.node.GetAccount(address, options)
@@ -332,6 +356,11 @@ func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOpt
 	return accountResponse, blockInfo, nil
 }
 
+// GetAccountsBulk returns the accounts, ESDT tokens and storage values requested by the query, all loaded from the same state
+func (nf *nodeFacade) GetAccountsBulk(query common.AccountsBulkQuery, options apiData.AccountQueryOptions) ([]*common.AccountBulkResult, apiData.BlockInfo, error) {
+	return nf.node.GetAccountsBulk(query, options)
+}
+
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
 func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	hbStatus := nf.node.GetHeartbeats()
@@ -381,6 +410,16 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, e
 	return nf.convertVmOutputToApiResponse(vmOutput), nil
 }
 
+// ExecuteSCQueryWithTrace retrieves data from existing SC trie, together with the trace of the execution
+func (nf *nodeFacade) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+	vmOutput, trace, err := nf.apiResolver.ExecuteSCQueryWithTrace(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nf.convertVmOutputToApiResponse(vmOutput), trace, nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -411,6 +450,26 @@ func (nf *nodeFacade) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return nf.node.GetQueryHandler(name)
 }
 
+// GetStorageStatistics returns the usage statistics of the storage units
+func (nf *nodeFacade) GetStorageStatistics(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+	return nf.node.GetStorageStatistics(withNumKeys)
+}
+
+// GetEquivocationEvidences returns the evidences of the validators caught signing conflicting consensus messages
+func (nf *nodeFacade) GetEquivocationEvidences() ([]*common.EquivocationEvidence, error) {
+	return nf.node.GetEquivocationEvidences()
+}
+
+// GetConsensusRounds returns the timeline of the last consensus rounds
+func (nf *nodeFacade) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	return nf.node.GetConsensusRounds()
+}
+
+// GetP2PTraffic returns the p2p traffic accounted per topic and per peer
+func (nf *nodeFacade) GetP2PTraffic() (*common.P2PTrafficReport, error) {
+	return nf.node.GetP2PTraffic()
+}
+
 // GetEpochStartDataAPI returns epoch start data of the provided epoch
 func (nf *nodeFacade) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	return nf.node.GetEpochStartDataAPI(epoch)
@@ -522,6 +581,71 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for the given addresses
+func (nf *nodeFacade) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProof(rootHash, addresses)
+}
+
+// GetMultiProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for the given keys
+// of its data trie
+func (nf *nodeFacade) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProofDataTrie(rootHash, address, keys)
+}
+
+// GetRangeProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for a range of keys
+// of its data trie
+func (nf *nodeFacade) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nf.node.GetRangeProofDataTrie(rootHash, address, startKey, endKey, maxLeaves)
+}
+
+// VerifyMultiProof verifies the given multi key Merkle proof
+func (nf *nodeFacade) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	return nf.node.VerifyMultiProof(rootHash, keys, proof)
+}
+
+// VerifyRangeProof verifies the given range Merkle proof
+func (nf *nodeFacade) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	return nf.node.VerifyRangeProof(rootHash, startKey, endKey, proof)
+}
+
+// GetStateDiff returns the accounts and the accounts storage keys changed between the two root hashes
+func (nf *nodeFacade) GetStateDiff(fromRootHash string, toRootHash string) (*common.StateDiffApiResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetStateDiff(fromRootHash, toRootHash, ctx)
+}
+
+// StartDbIntegrityCheck starts, in background, a database integrity check for the last numEpochs epochs
+func (nf *nodeFacade) StartDbIntegrityCheck(numEpochs uint32, refetch bool) error {
+	return nf.node.StartDbIntegrityCheck(numEpochs, refetch)
+}
+
+// GetDbIntegrityReport returns the report of the last database integrity check
+func (nf *nodeFacade) GetDbIntegrityReport() (*common.DbIntegrityReport, error) {
+	return nf.node.GetDbIntegrityReport()
+}
+
+// StartDataTriesAnalysis starts, in background, the computation of the statistics of all the data tries of a state
+func (nf *nodeFacade) StartDataTriesAnalysis(rootHash string, numLargest uint32, sortBy string) error {
+	return nf.node.StartDataTriesAnalysis(rootHash, numLargest, sortBy)
+}
+
+// GetDataTriesReport returns the report of the last data tries analysis
+func (nf *nodeFacade) GetDataTriesReport() (*common.DataTriesReport, error) {
+	return nf.node.GetDataTriesReport()
+}
+
+// GetP2PFaultRules returns the network faults injection rules together with the number of times each was applied
+func (nf *nodeFacade) GetP2PFaultRules() ([]common.P2PFaultRuleStatus, error) {
+	return nf.node.GetP2PFaultRules()
+}
+
+// SetP2PFaultRules replaces the network faults injection rules
+func (nf *nodeFacade) SetP2PFaultRules(rules []common.P2PFaultRule) error {
+	return nf.node.SetP2PFaultRules(rules)
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
@@ -637,6 +761,16 @@ func (nf *nodeFacade) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return gasConfigs, nil
 }
 
+// Subscribe registers a new subscriber for the committed blocks, transactions and logs matching the filter
+func (nf *nodeFacade) Subscribe(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+	return nf.subscriptionsHandler.Subscribe(filter)
+}
+
+// Unsubscribe removes the subscriber with the provided id
+func (nf *nodeFacade) Unsubscribe(id uint64) {
+	nf.subscriptionsHandler.Unsubscribe(id)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
@@ -26,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
@@ -69,11 +70,23 @@ func createMockArguments() ArgNodeFacade {
 				return []byte("root hash")
 			},
 		},
+		SubscriptionsHandler: &testscommon.SubscriptionsHandlerStub{},
 	}
 }
 
 // ------- NewNodeFacade
 
+func TestNewNodeFacade_WithNilSubscriptionsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.SubscriptionsHandler = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.Nil(t, nf)
+	assert.Equal(t, ErrNilSubscriptionsHandler, err)
+}
+
 func TestNewNodeFacade_WithNilNodeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -297,6 +310,29 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	assert.True(t, getAccountCalled)
 }
 
+func TestNodeFacade_GetAccountsBulk(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := common.AccountsBulkQuery{Addresses: []string{"alice", "bob"}}
+	expectedResults := []*common.AccountBulkResult{{Address: "alice"}, {Address: "bob"}}
+	node := &mock.NodeStub{}
+	node.GetAccountsBulkCalled = func(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+		assert.Equal(t, expectedQuery, query)
+		assert.True(t, options.OnFinalBlock)
+
+		return expectedResults, api.BlockInfo{Nonce: 7}, nil
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	results, blockInfo, err := nf.GetAccountsBulk(expectedQuery, api.AccountQueryOptions{OnFinalBlock: true})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResults, results)
+	assert.Equal(t, uint64(7), blockInfo.Nonce)
+}
+
 func TestNodeFacade_GetUsername(t *testing.T) {
 	t.Parallel()
 
@@ -1020,6 +1056,35 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	require.Equal(t, hex.EncodeToString(expectedAddress), outputAccount.Address)
 }
 
+func TestNodeFacade_ExecuteSCQueryWithTrace(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	expectedTrace := &tracing.ExecutionTrace{}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryWithTraceHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+			if len(query.FuncName) == 0 {
+				return nil, nil, expectedErr
+			}
+
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, expectedTrace, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	apiVmOutput, trace, err := nf.ExecuteSCQueryWithTrace(&process.SCQuery{})
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, apiVmOutput)
+	require.Nil(t, trace)
+
+	apiVmOutput, trace, err = nf.ExecuteSCQueryWithTrace(&process.SCQuery{FuncName: "function"})
+	require.NoError(t, err)
+	require.Equal(t, vmcommon.UserError.String(), apiVmOutput.ReturnCode)
+	require.True(t, expectedTrace == trace)
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -7,9 +7,11 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
@@ -26,12 +28,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
@@ -81,6 +88,8 @@ type scQueryElementArgs struct {
 	allowVMQueriesChan  chan struct{}
 	workingDir          string
 	index               int
+	accountsAdapter     state.AccountsAdapter
+	blockChain          data.ChainHandler
 }
 
 // CreateApiResolver is able to create an ApiResolver instance that will solve the REST API requests through the node facade
@@ -163,6 +172,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		args.ProcessComponents.ShardCoordinator(),
 		args.CoreComponents.EpochNotifier(),
 		args.Configs.EpochConfig.EnableEpochs.CleanUpInformativeSCRsEnableEpoch,
+		scQueryService,
 	)
 	if err != nil {
 		return nil, err
@@ -278,7 +288,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 
 func createScQueryService(
 	args *scQueryServiceArgs,
-) (process.SCQueryService, error) {
+) (SCQueryServiceWithHistory, error) {
 	numConcurrentVms := args.generalConfig.VirtualMachine.Querying.NumConcurrentVMs
 	if numConcurrentVms < 1 {
 		return nil, fmt.Errorf("VirtualMachine.Querying.NumConcurrentVms should be a positive number more than 1")
@@ -298,6 +308,8 @@ func createScQueryService(
 		bootstrapper:        args.bootstrapper,
 		allowVMQueriesChan:  args.allowVMQueriesChan,
 		index:               0,
+		accountsAdapter:     args.stateComponents.AccountsAdapterAPI(),
+		blockChain:          args.dataComponents.Blockchain(),
 	}
 
 	var err error
@@ -319,7 +331,62 @@ func createScQueryService(
 		return nil, err
 	}
 
-	return sqQueryDispatcher, nil
+	// the historical queries are executed by a dedicated VM, whose blockchain hook and accounts adapter follow
+	// a private blockchain instance that gets moved on the block requested by each query
+	shardID := args.processComponents.ShardCoordinator().SelfId()
+	historicalBlockChain, err := createHistoricalBlockChain(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	argsQueryElem.index = numConcurrentVms
+	argsQueryElem.blockChain = historicalBlockChain
+	argsQueryElem.accountsAdapter, err = createHistoricalAccountsAdapter(args, historicalBlockChain)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalQueryService, err := createScQueryElement(argsQueryElem)
+	if err != nil {
+		return nil, err
+	}
+
+	argsQueryWithHistory := smartContract.ArgsNewSCQueryServiceWithHistory{
+		CurrentQueryService:          sqQueryDispatcher,
+		HistoricalQueryService:       historicalQueryService,
+		HistoricalBlockChain:         historicalBlockChain,
+		MainBlockChain:               args.dataComponents.Blockchain(),
+		StorageService:               args.dataComponents.StorageService(),
+		Marshaller:                   args.coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter:     args.coreComponents.Uint64ByteSliceConverter(),
+		HistoryRepository:            args.processComponents.HistoryRepository(),
+		ScheduledTxsExecutionHandler: args.processComponents.ScheduledTxsExecutionHandler(),
+		ShardID:                      shardID,
+	}
+
+	return smartContract.NewSCQueryServiceWithHistory(argsQueryWithHistory)
+}
+
+func createHistoricalBlockChain(shardID uint32) (data.ChainHandler, error) {
+	if shardID == core.MetachainShardId {
+		return blockchain.NewMetaChain(statusHandler.NewNilStatusHandler())
+	}
+
+	return blockchain.NewBlockChain(statusHandler.NewNilStatusHandler())
+}
+
+func createHistoricalAccountsAdapter(args *scQueryServiceArgs, historicalBlockChain data.ChainHandler) (state.AccountsAdapter, error) {
+	argsAccountsDB := state.ArgsAccountsDB{
+		Trie:                  args.stateComponents.TriesContainer().Get([]byte(trieFactory.UserAccountTrie)),
+		Hasher:                args.coreComponents.Hasher(),
+		Marshaller:            args.coreComponents.InternalMarshalizer(),
+		AccountFactory:        factoryState.NewAccountCreator(),
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  args.coreComponents.ProcessStatusHandler(),
+	}
+
+	return factoryState.CreateAccountsAdapterAPIOnCurrent(argsAccountsDB, historicalBlockChain)
 }
 
 func createScQueryElement(
@@ -338,7 +405,7 @@ func createScQueryElement(
 	builtInFuncFactory, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapterAPI(),
+		args.accountsAdapter,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
@@ -366,10 +433,10 @@ func createScQueryElement(
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:              args.stateComponents.AccountsAdapterAPI(),
+		Accounts:              args.accountsAdapter,
 		PubkeyConv:            args.coreComponents.AddressPubKeyConverter(),
 		StorageService:        args.dataComponents.StorageService(),
-		BlockChain:            args.dataComponents.Blockchain(),
+		BlockChain:            args.blockChain,
 		ShardCoordinator:      args.processComponents.ShardCoordinator(),
 		Marshalizer:           args.coreComponents.InternalMarshalizer(),
 		Uint64Converter:       args.coreComponents.Uint64ByteSliceConverter(),
@@ -385,11 +452,16 @@ func createScQueryElement(
 		NilCompiledSCStore:    true,
 	}
 
+	executionTracer, err := tracing.NewExecutionTracer(pkConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	maxGasForVmQueries := args.generalConfig.VirtualMachine.GasConfig.ShardMaxGasPerVmQuery
 	if args.processComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
 		maxGasForVmQueries = args.generalConfig.VirtualMachine.GasConfig.MetaMaxGasPerVmQuery
 
-		blockChainHookImpl, errBlockChainHook := hooks.NewBlockChainHookImpl(argsHook)
+		blockChainHookImpl, errBlockChainHook := createTracingBlockChainHook(argsHook, executionTracer)
 		if errBlockChainHook != nil {
 			return nil, errBlockChainHook
 		}
@@ -421,7 +493,7 @@ func createScQueryElement(
 			return nil, err
 		}
 
-		blockChainHookImpl, errBlockChainHook := hooks.NewBlockChainHookImpl(argsHook)
+		blockChainHookImpl, errBlockChainHook := createTracingBlockChainHook(argsHook, executionTracer)
 		if errBlockChainHook != nil {
 			return nil, errBlockChainHook
 		}
@@ -464,16 +536,26 @@ func createScQueryElement(
 		VmContainer:              vmContainer,
 		EconomicsFee:             args.coreComponents.EconomicsData(),
 		BlockChainHook:           vmFactory.BlockChainHookImpl(),
-		BlockChain:               args.dataComponents.Blockchain(),
+		BlockChain:               args.blockChain,
 		ArwenChangeLocker:        args.coreComponents.ArwenChangeLocker(),
 		Bootstrapper:             args.bootstrapper,
 		AllowExternalQueriesChan: args.allowVMQueriesChan,
+		ExecutionTracer:          executionTracer,
 		MaxGasLimitPerQuery:      maxGasForVmQueries,
 	}
 
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
 }
 
+func createTracingBlockChainHook(argsHook hooks.ArgBlockChainHook, tracer process.ExecutionTracer) (process.BlockChainHookHandler, error) {
+	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(argsHook)
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks.NewTracingBlockChainHook(blockChainHookImpl, tracer)
+}
+
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -27,11 +28,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	disabledStoragePruning "github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
@@ -138,6 +143,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		pcf.config.SmartContractsStorage,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -469,6 +475,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		pcf.config.SmartContractsStorage,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -896,11 +903,23 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	arwenChangeLocker common.Locker,
 	mapDNSAddresses map[string]struct{},
 ) (process.VirtualMachinesContainerFactory, error) {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	scratchAccountsDB, err := pcf.createSimulationScratchAccountsDB()
 	if err != nil {
 		return nil, err
 	}
 
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDBWithSessions(pcf.state.AccountsAdapterAPI(), scratchAccountsDB)
+	if err != nil {
+		return nil, err
+	}
+	txSimulatorProcessorArgs.SessionHandler = readOnlyAccountsDB
+
+	executionTracer, err := tracing.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+	txSimulatorProcessorArgs.ExecutionTracer = executionTracer
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		pcf.bootstrapComponents.ShardCoordinator(),
 		pcf.coreData.InternalMarshalizer(),
@@ -928,6 +947,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		smartContractStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		executionTracer,
 	)
 	if err != nil {
 		return nil, err
@@ -988,6 +1008,22 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	return vmFactory, nil
 }
 
+// createSimulationScratchAccountsDB creates the accounts adapter on which the transactions simulator applies the state
+// changes of a multiple transactions simulation. It is never committed and shares the trie storage with the main one
+func (pcf *processComponentsFactory) createSimulationScratchAccountsDB() (state.AccountsAdapter, error) {
+	argsScratchAccountsDB := state.ArgsAccountsDB{
+		Trie:                  pcf.state.TriesContainer().Get([]byte(trieFactory.UserAccountTrie)),
+		Hasher:                pcf.coreData.Hasher(),
+		Marshaller:            pcf.coreData.InternalMarshalizer(),
+		AccountFactory:        factoryState.NewAccountCreator(),
+		StoragePruningManager: disabledStoragePruning.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  pcf.coreData.ProcessStatusHandler(),
+	}
+
+	return state.NewAccountsDB(argsScratchAccountsDB)
+}
+
 func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
@@ -1028,11 +1064,23 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	scratchAccountsDB, err := pcf.createSimulationScratchAccountsDB()
 	if err != nil {
 		return nil, err
 	}
 
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDBWithSessions(pcf.state.AccountsAdapterAPI(), scratchAccountsDB)
+	if err != nil {
+		return nil, err
+	}
+	txSimulatorProcessorArgs.SessionHandler = readOnlyAccountsDB
+
+	executionTracer, err := tracing.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+	txSimulatorProcessorArgs.ExecutionTracer = executionTracer
+
 	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(readOnlyAccountsDB, make(map[string]struct{}))
 	if err != nil {
 		return nil, err
@@ -1044,6 +1092,7 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 		pcf.config.SmartContractsStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		executionTracer,
 	)
 	if err != nil {
 		return nil, err
@@ -1094,6 +1143,7 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 	configSCStorage config.StorageConfig,
 	nftStorageHandler vmcommon.SimpleESDTNFTStorageHandler,
 	globalSettingsHandler vmcommon.ESDTGlobalSettingsHandler,
+	tracer process.ExecutionTracer,
 ) (process.VirtualMachinesContainerFactory, error) {
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:              accounts,
@@ -1115,7 +1165,7 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 		EnableEpochs:          pcf.epochConfig.EnableEpochs,
 	}
 
-	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(argsHook)
+	blockChainHookImpl, err := createBlockChainHook(argsHook, tracer)
 	if err != nil {
 		return nil, err
 	}
@@ -1141,6 +1191,7 @@ func (pcf *processComponentsFactory) createVMFactoryMeta(
 	configSCStorage config.StorageConfig,
 	nftStorageHandler vmcommon.SimpleESDTNFTStorageHandler,
 	globalSettingsHandler vmcommon.ESDTGlobalSettingsHandler,
+	tracer process.ExecutionTracer,
 ) (process.VirtualMachinesContainerFactory, error) {
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:              accounts,
@@ -1162,7 +1213,7 @@ func (pcf *processComponentsFactory) createVMFactoryMeta(
 		EnableEpochs:          pcf.epochConfig.EnableEpochs,
 	}
 
-	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(argsHook)
+	blockChainHookImpl, err := createBlockChainHook(argsHook, tracer)
 	if err != nil {
 		return nil, err
 	}
@@ -1186,6 +1237,20 @@ func (pcf *processComponentsFactory) createVMFactoryMeta(
 	return metachain.NewVMContainerFactory(argsNewVMContainer)
 }
 
+// createBlockChainHook creates the blockchain hook used by the virtual machines. If a tracer is provided, the hook
+// reports to it the storage reads and the built-in function calls
+func createBlockChainHook(argsHook hooks.ArgBlockChainHook, tracer process.ExecutionTracer) (process.BlockChainHookHandler, error) {
+	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(argsHook)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(tracer) {
+		return blockChainHookImpl, nil
+	}
+
+	return hooks.NewTracingBlockChainHook(blockChainHookImpl, tracer)
+}
+
 func (pcf *processComponentsFactory) createBuiltInFunctionContainer(
 	accounts state.AccountsAdapter,
 	mapDNSAddresses map[string]struct{},
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
@@ -60,6 +61,7 @@ type consensusComponents struct {
 	bootstrapper       process.Bootstrapper
 	broadcastMessenger consensus.BroadcastMessenger
 	worker             ConsensusWorker
+	timelineRecorder   consensus.ConsensusTimelineRecorder
 	consensusTopic     string
 	consensusGroupSize int
 }
@@ -175,6 +177,27 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		marshalizer = marshal.NewSizeCheckUnmarshalizer(marshalizer, sizeCheckDelta)
 	}
 
+	equivocationDetector, err := spos.NewEquivocationDetector(spos.ArgsEquivocationDetector{
+		ConsensusService: consensusService,
+		Hasher:           ccf.coreComponents.Hasher(),
+		Storer:           ccf.dataComponents.StorageService().GetStorer(dataRetriever.EquivocationEvidenceUnit),
+		OutportHandler:   ccf.statusComponents.OutportHandler(),
+		ShardID:          ccf.processComponents.ShardCoordinator().SelfId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cc.timelineRecorder, err = spos.NewConsensusTimelineRecorder(spos.ArgsConsensusTimelineRecorder{
+		ConsensusService: consensusService,
+		SyncTimer:        ccf.coreComponents.SyncTimer(),
+		RoundHandler:     ccf.processComponents.RoundHandler(),
+		NumRounds:        ccf.config.Consensus.NumRoundsInTimeline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	workerArgs := &spos.WorkerArgs{
 		ConsensusService:         consensusService,
 		BlockChain:               ccf.dataComponents.Blockchain(),
@@ -200,6 +223,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.coreComponents.StatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		EquivocationDetector:     equivocationDetector,
+		TimelineRecorder:         cc.timelineRecorder,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -242,6 +267,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
 		ScheduledProcessor:            ccf.scheduledProcessor,
+		ConsensusTimelineRecorder:     cc.timelineRecorder,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -100,6 +100,18 @@ func (mcc *managedConsensusComponents) BroadcastMessenger() consensus.BroadcastM
 	return mcc.consensusComponents.broadcastMessenger
 }
 
+// ConsensusTimelineRecorder returns the recorder of the consensus rounds timeline
+func (mcc *managedConsensusComponents) ConsensusTimelineRecorder() consensus.ConsensusTimelineRecorder {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.timelineRecorder
+}
+
 // ConsensusGroupSize returns the consensus group size
 func (mcc *managedConsensusComponents) ConsensusGroupSize() (int, error) {
 	mcc.mutConsensusComponents.RLock()
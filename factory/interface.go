@@ -27,6 +27,7 @@ import (
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
@@ -82,6 +83,19 @@ type PreferredPeersHolderHandler interface {
 	IsInterfaceNil() bool
 }
 
+// P2PFaultsRulesHandler defines the behavior of a component holding the network faults injection rules
+type P2PFaultsRulesHandler interface {
+	SetRules(rules []common.P2PFaultRule) error
+	GetRules() []common.P2PFaultRuleStatus
+	IsInterfaceNil() bool
+}
+
+// P2PTrafficCounter defines the behavior of a component accounting the p2p traffic per topic and per peer
+type P2PTrafficCounter interface {
+	GetTrafficReport() *common.P2PTrafficReport
+	IsInterfaceNil() bool
+}
+
 // Closer defines the Close behavior
 type Closer interface {
 	Close() error
@@ -219,6 +233,8 @@ type NetworkComponentsHolder interface {
 	PeerHonestyHandler() PeerHonestyHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	PeersRatingHandler() p2p.PeersRatingHandler
+	P2PFaultsRulesHandler() P2PFaultsRulesHandler
+	P2PTrafficCounter() P2PTrafficCounter
 	IsInterfaceNil() bool
 }
 
@@ -231,6 +247,7 @@ type NetworkComponentsHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxs(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -301,6 +318,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	SubscriptionsHandler() subscriptions.SubscriptionsHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	IsInterfaceNil() bool
 }
@@ -418,6 +436,7 @@ type ConsensusComponentsHolder interface {
 	Chronology() consensus.ChronologyHandler
 	ConsensusWorker() ConsensusWorker
 	BroadcastMessenger() consensus.BroadcastMessenger
+	ConsensusTimelineRecorder() consensus.ConsensusTimelineRecorder
 	ConsensusGroupSize() (int, error)
 	Bootstrapper() process.Bootstrapper
 	IsInterfaceNil() bool
@@ -505,3 +524,9 @@ type ReceiptsRepository interface {
 	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
 	IsInterfaceNil() bool
 }
+
+// SCQueryServiceWithHistory defines a smart contract query service able to run queries and gas computations against past blocks
+type SCQueryServiceWithHistory interface {
+	process.SCQueryService
+	ComputeScCallGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (uint64, error)
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug/antiflood"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/faults"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
 	peersHolder "github.com/ElrondNetwork/elrond-go/p2p/peersHolder"
 	"github.com/ElrondNetwork/elrond-go/p2p/rating"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -65,6 +68,8 @@ type networkComponents struct {
 	peerHonestyHandler     consensus.PeerHonestyHandler
 	peersHolder            PreferredPeersHolderHandler
 	peersRatingHandler     p2p.PeersRatingHandler
+	faultsRulesHandler     faults.RulesHandler
+	trafficCounter         *metrics.TrafficCounter
 	closeFunc              context.CancelFunc
 }
 
@@ -121,6 +126,20 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		return nil, err
 	}
 
+	faultsRulesHandler, err := ncf.createFaultsRulesHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	argsTrafficCounter := metrics.ArgsTrafficCounter{
+		WindowDuration: time.Duration(ncf.p2pConfig.TrafficAccounting.WindowInSeconds) * time.Second,
+		NumTopTalkers:  int(ncf.p2pConfig.TrafficAccounting.NumTopTalkers),
+	}
+	trafficCounter, err := metrics.NewTrafficCounter(argsTrafficCounter)
+	if err != nil {
+		return nil, err
+	}
+
 	arg := libp2p.ArgsNetworkMessenger{
 		Marshalizer:           ncf.marshalizer,
 		ListenAddress:         ncf.listenAddress,
@@ -130,8 +149,10 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		NodeOperationMode:     ncf.nodeOperationMode,
 		PeersRatingHandler:    peersRatingHandler,
 		ConnectionWatcherType: ncf.connectionWatcherType,
+		FaultsRulesHandler:    faultsRulesHandler,
+		TrafficCounter:        trafficCounter,
 	}
-	netMessenger, err := libp2p.NewNetworkMessenger(arg)
+	netMessenger, err := ncf.createNetworkMessenger(arg)
 	if err != nil {
 		return nil, err
 	}
@@ -210,10 +231,47 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerHonestyHandler:     peerHonestyHandler,
 		peersHolder:            ph,
 		peersRatingHandler:     peersRatingHandler,
+		faultsRulesHandler:     faultsRulesHandler,
+		trafficCounter:         trafficCounter,
 		closeFunc:              cancelFunc,
 	}, nil
 }
 
+func (ncf *networkComponentsFactory) createFaultsRulesHandler() (faults.RulesHandler, error) {
+	if !ncf.p2pConfig.FaultInjection.Enabled {
+		return nil, nil
+	}
+
+	rules, err := common.LoadP2PFaultRules(ncf.p2pConfig.FaultInjection.RulesFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the p2p fault rules from %s", err, ncf.p2pConfig.FaultInjection.RulesFile)
+	}
+
+	return faults.NewRulesHandler(rules)
+}
+
+func (ncf *networkComponentsFactory) createNetworkMessenger(arg libp2p.ArgsNetworkMessenger) (p2p.Messenger, error) {
+	netMessenger, err := libp2p.NewNetworkMessenger(arg)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.FaultsRulesHandler) {
+		return netMessenger, nil
+	}
+
+	argsFaultyMessenger := faults.ArgsFaultyMessenger{
+		Messenger:    netMessenger,
+		RulesHandler: arg.FaultsRulesHandler,
+	}
+	faultyMessenger, err := faults.NewFaultyMessenger(argsFaultyMessenger)
+	if err != nil {
+		log.LogIfError(netMessenger.Close())
+		return nil, err
+	}
+
+	return faultyMessenger, nil
+}
+
 func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
@@ -88,6 +88,9 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.trafficCounter) {
+		return errors.ErrNilP2PTrafficCounter
+	}
 
 	return nil
 }
@@ -188,6 +191,34 @@ func (mnc *managedNetworkComponents) PeersRatingHandler() p2p.PeersRatingHandler
 	return mnc.networkComponents.peersRatingHandler
 }
 
+// P2PFaultsRulesHandler returns the network faults injection rules handler. It is nil if the fault injection is not
+// enabled
+func (mnc *managedNetworkComponents) P2PFaultsRulesHandler() P2PFaultsRulesHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+	if check.IfNil(mnc.networkComponents.faultsRulesHandler) {
+		return nil
+	}
+
+	return mnc.networkComponents.faultsRulesHandler
+}
+
+// P2PTrafficCounter returns the component accounting the p2p traffic per topic and per peer
+func (mnc *managedNetworkComponents) P2PTrafficCounter() P2PTrafficCounter {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.trafficCounter
+}
+
 // IsInterfaceNil returns true if the value under the interface is nil
 func (mnc *managedNetworkComponents) IsInterfaceNil() bool {
 	return mnc == nil
@@ -43,6 +43,8 @@ func TestManagedNetworkComponents_CreateShouldWork(t *testing.T) {
 	require.Nil(t, managedNetworkComponents.PubKeyCacher())
 	require.Nil(t, managedNetworkComponents.PreferredPeersHolderHandler())
 	require.Nil(t, managedNetworkComponents.PeerHonestyHandler())
+	require.Nil(t, managedNetworkComponents.P2PFaultsRulesHandler())
+	require.Nil(t, managedNetworkComponents.P2PTrafficCounter())
 
 	err = managedNetworkComponents.Create()
 	require.NoError(t, err)
@@ -53,6 +55,8 @@ func TestManagedNetworkComponents_CreateShouldWork(t *testing.T) {
 	require.NotNil(t, managedNetworkComponents.PubKeyCacher())
 	require.NotNil(t, managedNetworkComponents.PreferredPeersHolderHandler())
 	require.NotNil(t, managedNetworkComponents.PeerHonestyHandler())
+	require.Nil(t, managedNetworkComponents.P2PFaultsRulesHandler())
+	require.NotNil(t, managedNetworkComponents.P2PTrafficCounter())
 }
 
 func TestManagedNetworkComponents_CheckSubcomponents(t *testing.T) {
@@ -2,6 +2,9 @@ package factory_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -84,6 +87,63 @@ func TestNetworkComponentsFactory_CreateShouldWork(t *testing.T) {
 	require.NotNil(t, nc)
 }
 
+func TestNetworkComponentsFactory_CreateWithFaultInjection(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	t.Run("missing rules file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getNetworkArgs()
+		args.P2pConfig.FaultInjection = config.FaultInjectionConfig{
+			Enabled:   true,
+			RulesFile: filepath.Join(t.TempDir(), "missing.toml"),
+		}
+		ncf, _ := factory.NewNetworkComponentsFactory(args)
+		ncf.SetListenAddress(libp2p.ListenLocalhostAddrWithIp4AndTcp)
+
+		nc, err := ncf.Create()
+		require.Error(t, err)
+		require.Nil(t, nc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rulesFile := filepath.Join(t.TempDir(), "p2pFaults.toml")
+		rules := `
+[[Rules]]
+    Name = "drop transactions"
+    Topic = "transactions*"
+    Fault = "drop"
+    Probability = 0.5
+`
+		err := ioutil.WriteFile(rulesFile, []byte(rules), os.ModePerm)
+		require.NoError(t, err)
+
+		args := getNetworkArgs()
+		args.P2pConfig.FaultInjection = config.FaultInjectionConfig{
+			Enabled:   true,
+			RulesFile: rulesFile,
+		}
+		ncf, _ := factory.NewNetworkComponentsFactory(args)
+		ncf.SetListenAddress(libp2p.ListenLocalhostAddrWithIp4AndTcp)
+		managedNetworkComponents, _ := factory.NewManagedNetworkComponents(ncf)
+
+		err = managedNetworkComponents.Create()
+		require.NoError(t, err)
+		defer func() {
+			_ = managedNetworkComponents.Close()
+		}()
+
+		rulesHandler := managedNetworkComponents.P2PFaultsRulesHandler()
+		require.NotNil(t, rulesHandler)
+		require.Equal(t, 1, len(rulesHandler.GetRules()))
+		require.Equal(t, "drop transactions", rulesHandler.GetRules()[0].Rule.Name)
+	})
+}
+
 // ------------ Test NetworkComponents --------------------
 func TestNetworkComponents_CloseShouldWork(t *testing.T) {
 	t.Parallel()
@@ -127,6 +187,10 @@ func getNetworkArgs() factory.NetworkComponentsFactoryArgs {
 				MaxFullHistoryObservers: 10,
 			},
 		},
+		TrafficAccounting: config.TrafficAccountingConfig{
+			WindowInSeconds: 60,
+			NumTopTalkers:   10,
+		},
 	}
 
 	mainConfig := config.Config{
@@ -513,6 +513,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		VMOutputCacher:         vmOutputCacher,
 		Hasher:                 pcf.coreData.Hasher(),
 		Marshalizer:            pcf.coreData.InternalMarshalizer(),
+		FeeHandler:             pcf.coreData.EconomicsData(),
 	}
 
 	scheduledTxsExecutionHandler, err := preprocess.NewScheduledTxsExecution(
@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
@@ -17,22 +18,27 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/disabled"
 	outportDriverFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
 // TODO: move app status handler initialization here
 
 type statusComponents struct {
-	nodesCoordinator nodesCoordinator.NodesCoordinator
-	statusHandler    core.AppStatusHandler
-	outportHandler   outport.OutportHandler
-	softwareVersion  statistics.SoftwareVersionChecker
-	resourceMonitor  statistics.ResourceMonitorHandler
-	cancelFunc       func()
+	nodesCoordinator     nodesCoordinator.NodesCoordinator
+	statusHandler        core.AppStatusHandler
+	outportHandler       outport.OutportHandler
+	subscriptionsHandler subscriptions.SubscriptionsHandler
+	softwareVersion      statistics.SoftwareVersionChecker
+	resourceMonitor      statistics.ResourceMonitorHandler
+	cancelFunc           func()
 }
 
 // StatusComponentsFactoryArgs redefines the arguments structure needed for the status components factory
@@ -143,7 +149,12 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, errors.ErrInvalidRoundDuration
 	}
 
-	outportHandler, err := scf.createOutportDriver()
+	subscriptionsHandler, subscriptionsDriver, err := scf.createSubscriptionsHub()
+	if err != nil {
+		return nil, err
+	}
+
+	outportHandler, err := scf.createOutportDriver(subscriptionsDriver)
 	if err != nil {
 		return nil, err
 	}
@@ -151,12 +162,13 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 	_, cancelFunc := context.WithCancel(context.Background())
 
 	statusComponentsInstance := &statusComponents{
-		nodesCoordinator: scf.nodesCoordinator,
-		softwareVersion:  softwareVersionChecker,
-		outportHandler:   outportHandler,
-		statusHandler:    scf.coreComponents.StatusHandler(),
-		resourceMonitor:  resMon,
-		cancelFunc:       cancelFunc,
+		nodesCoordinator:     scf.nodesCoordinator,
+		softwareVersion:      softwareVersionChecker,
+		outportHandler:       outportHandler,
+		subscriptionsHandler: subscriptionsHandler,
+		statusHandler:        scf.coreComponents.StatusHandler(),
+		resourceMonitor:      resMon,
+		cancelFunc:           cancelFunc,
 	}
 
 	if scf.shardCoordinator.SelfId() == core.MetachainShardId {
@@ -200,23 +212,56 @@ func (pc *statusComponents) Close() error {
 		log.LogIfError(pc.resourceMonitor.Close())
 	}
 
+	if !check.IfNil(pc.outportHandler) {
+		log.LogIfError(pc.outportHandler.Close())
+	}
+
 	return nil
 }
 
 // createOutportDriver creates a new outport.OutportHandler which is used to register outport drivers
 // once a driver is subscribed it will receive data through the implemented outport.Driver methods
-func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandler, error) {
+func (scf *statusComponentsFactory) createOutportDriver(subscriptionsDriver outport.Driver) (outport.OutportHandler, error) {
+
+	eventNotifierArgs, err := scf.makeEventNotifierArgs()
+	if err != nil {
+		return nil, err
+	}
 
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		RetrialInterval:            common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs:  scf.makeElasticIndexerArgs(),
-		EventNotifierFactoryArgs:   scf.makeEventNotifierArgs(),
+		EventNotifierFactoryArgs:   eventNotifierArgs,
 		CovalentIndexerFactoryArgs: scf.makeCovalentIndexerArgs(),
+		FileDriverFactoryArgs:      scf.makeFileDriverArgs(),
+		SubscriptionsHub:           subscriptionsDriver,
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createSubscriptionsHub creates the hub used by the websocket subscribers. The returned driver is nil
+// if the subscriptions are disabled, so it will not be registered on the outport handler
+func (scf *statusComponentsFactory) createSubscriptionsHub() (subscriptions.SubscriptionsHandler, outport.Driver, error) {
+	subscriptionsConfig := scf.externalConfig.SubscriptionsConnector
+	if !subscriptionsConfig.Enabled {
+		return disabled.NewDisabledSubscriptionsHandler(), nil, nil
+	}
+
+	hub, err := outportDriverFactory.CreateSubscriptionsHub(&outportDriverFactory.SubscriptionsHubFactoryArgs{
+		SubscriberBufferSize: subscriptionsConfig.SubscriberBufferSize,
+		MaxSubscribers:       subscriptionsConfig.MaxSubscribers,
+		Marshaller:           scf.coreComponents.InternalMarshalizer(),
+		Hasher:               scf.coreComponents.Hasher(),
+		PubKeyConverter:      scf.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hub, hub, nil
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return &indexerFactory.ArgsIndexerFactory{
@@ -240,9 +285,9 @@ func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.Arg
 	}
 }
 
-func (scf *statusComponentsFactory) makeEventNotifierArgs() *outportDriverFactory.EventNotifierFactoryArgs {
+func (scf *statusComponentsFactory) makeEventNotifierArgs() (*outportDriverFactory.EventNotifierFactoryArgs, error) {
 	eventNotifierConfig := scf.externalConfig.EventNotifierConnector
-	return &outportDriverFactory.EventNotifierFactoryArgs{
+	args := &outportDriverFactory.EventNotifierFactoryArgs{
 		Enabled:          eventNotifierConfig.Enabled,
 		UseAuthorization: eventNotifierConfig.UseAuthorization,
 		ProxyUrl:         eventNotifierConfig.ProxyUrl,
@@ -252,6 +297,47 @@ func (scf *statusComponentsFactory) makeEventNotifierArgs() *outportDriverFactor
 		Hasher:           scf.coreComponents.Hasher(),
 		PubKeyConverter:  scf.coreComponents.AddressPubKeyConverter(),
 	}
+
+	queueConfig := eventNotifierConfig.PersistentQueue
+	if !eventNotifierConfig.Enabled || !queueConfig.Enabled {
+		return args, nil
+	}
+
+	queueStorer, err := scf.createEventNotifierQueueStorer(queueConfig.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	args.PersistentQueue = &outportDriverFactory.PersistentQueueFactoryArgs{
+		Storer:               queueStorer,
+		StatusHandler:        scf.coreComponents.StatusHandler(),
+		InitialRetryInterval: time.Duration(queueConfig.InitialRetryIntervalInMilliseconds) * time.Millisecond,
+		MaxRetryInterval:     time.Duration(queueConfig.MaxRetryIntervalInSeconds) * time.Second,
+	}
+
+	return args, nil
+}
+
+func (scf *statusComponentsFactory) createEventNotifierQueueStorer(storageConfig config.StorageConfig) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	shardID := core.GetShardIDString(scf.shardCoordinator.SelfId())
+	dbConfig.FilePath = scf.coreComponents.PathHandler().PathForStatic(shardID, storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(storageFactory.GetCacherFromConfig(storageConfig.Cache), dbConfig)
+}
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() *outportDriverFactory.FileDriverFactoryArgs {
+	fileDriverConfig := scf.externalConfig.FileDriverConnector
+	return &outportDriverFactory.FileDriverFactoryArgs{
+		Enabled:                 fileDriverConfig.Enabled,
+		Path:                    fileDriverConfig.Path,
+		UseNamedPipe:            fileDriverConfig.UseNamedPipe,
+		Format:                  fileDriverConfig.Format,
+		MaxFileSizeInMB:         fileDriverConfig.MaxFileSizeInMB,
+		RemoveAcknowledgedFiles: fileDriverConfig.RemoveAcknowledgedFiles,
+		Marshaller:              scf.coreComponents.InternalMarshalizer(),
+		Hasher:                  scf.coreComponents.Hasher(),
+	}
 }
 
 func (scf *statusComponentsFactory) makeCovalentIndexerArgs() *covalentFactory.ArgsCovalentIndexerFactory {
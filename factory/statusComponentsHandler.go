@@ -15,16 +15,21 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/statistics"
 	"github.com/ElrondNetwork/elrond-go/common/statistics/machine"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/debug/goroutine"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 )
 
+const storageStatisticsPollingInterval = time.Minute
+
 var _ ComponentHandler = (*managedStatusComponents)(nil)
 var _ StatusComponentsHolder = (*managedStatusComponents)(nil)
 var _ StatusComponentsHandler = (*managedStatusComponents)(nil)
@@ -147,6 +152,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// SubscriptionsHandler returns the handler used to register subscribers for the node events
+func (msc *managedStatusComponents) SubscriptionsHandler() subscriptions.SubscriptionsHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.subscriptionsHandler
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
@@ -190,6 +207,16 @@ func (msc *managedStatusComponents) startStatusPolling(ctx context.Context) erro
 		return err
 	}
 
+	err = registerPollStorageStatistics(appStatusPollingHandler, msc.statusComponentsFactory.dataComponents)
+	if err != nil {
+		return err
+	}
+
+	err = registerPollTrieSnapshotProgress(appStatusPollingHandler, msc.statusComponentsFactory.stateComponents)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll(ctx)
 
 	return nil
@@ -327,6 +354,74 @@ func registerPollProbableHighestNonce(
 	return nil
 }
 
+func registerPollStorageStatistics(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	dataComponents DataComponentsHolder,
+) error {
+
+	// computing the storage statistics walks all the databases directories, so it is not done on each polling
+	lastComputeTime := time.Time{}
+	storageStatisticsHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		if check.IfNil(dataComponents) || time.Since(lastComputeTime) < storageStatisticsPollingInterval {
+			return
+		}
+		lastComputeTime = time.Now()
+
+		storageStats := dataRetriever.GetStorageStatistics(dataComponents.StorageService(), false)
+		appStatusHandler.SetUInt64Value(common.MetricStorageSizeInBytes, storageStats.SizeInBytes)
+		appStatusHandler.SetUInt64Value(common.MetricStorageNumCacheHits, storageStats.NumCacheHits)
+		appStatusHandler.SetUInt64Value(common.MetricStorageNumCacheMisses, storageStats.NumCacheMisses)
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(storageStatisticsHandlerFunc)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for storage statistics", err)
+	}
+
+	return nil
+}
+
+func registerPollTrieSnapshotProgress(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	stateComponents StateComponentsHolder,
+) error {
+
+	trieSnapshotProgressHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		if check.IfNil(stateComponents) {
+			return
+		}
+		userTrieStorageManager, ok := stateComponents.TrieStorageManagers()[trieFactory.UserAccountTrie]
+		if !ok || check.IfNil(userTrieStorageManager) {
+			return
+		}
+		progressHandler, ok := userTrieStorageManager.GetBaseTrieStorageManager().(common.SnapshotProgressHandler)
+		if !ok {
+			return
+		}
+
+		progress := progressHandler.GetSnapshotProgress()
+		appStatusHandler.SetUInt64Value(common.MetricTrieSnapshotInProgress, boolToUint64(progress.InProgress))
+		appStatusHandler.SetUInt64Value(common.MetricTrieSnapshotResumed, boolToUint64(progress.Resumed))
+		appStatusHandler.SetUInt64Value(common.MetricTrieSnapshotProgressPercent, progress.Percent)
+		appStatusHandler.SetUInt64Value(common.MetricTrieSnapshotNumNodesCopied, progress.NumNodesCopied)
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(trieSnapshotProgressHandlerFunc)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for trie snapshot progress", err)
+	}
+
+	return nil
+}
+
+func boolToUint64(value bool) uint64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
 func (msc *managedStatusComponents) startMachineStatisticsPolling(ctx context.Context) error {
 	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(msc.statusComponentsFactory.coreComponents.StatusHandler(), time.Second, log)
 	if err != nil {
@@ -0,0 +1,114 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+)
+
+// ArgsTxsPoolReloader holds the arguments needed to reload the transactions pool saved upon the last clean shutdown
+type ArgsTxsPoolReloader struct {
+	CoreComponents            CoreComponentsHolder
+	CryptoComponents          CryptoComponentsHolder
+	DataComponents            DataComponentsHolder
+	StateComponents           StateComponentsHolder
+	ProcessComponents         ProcessComponentsHolder
+	NetworkComponents         NetworkComponentsHolder
+	EnableSignTxWithHashEpoch uint32
+}
+
+// ReloadPersistedTxsPool revalidates the transactions saved by the pool upon the last clean shutdown and adds the valid ones back.
+// It should be called once the node has loaded its state from storage, so that the nonce & balance checks are meaningful.
+func ReloadPersistedTxsPool(args ArgsTxsPoolReloader) error {
+	err := checkArgsTxsPoolReloader(args)
+	if err != nil {
+		return err
+	}
+
+	txsPool, ok := args.DataComponents.Datapool().Transactions().(process.PersistedTxsPoolHandler)
+	if !ok {
+		log.Debug("ReloadPersistedTxsPool: the transactions pool does not support persistence")
+		return nil
+	}
+
+	shardCoordinator := args.ProcessComponents.ShardCoordinator()
+	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
+		CoreComponents:            args.CoreComponents,
+		CryptoComponents:          args.CryptoComponents,
+		ShardCoordinator:          shardCoordinator,
+		FeeHandler:                args.CoreComponents.EconomicsData(),
+		WhiteListerVerifiedTxs:    args.ProcessComponents.WhiteListerVerifiedTxs(),
+		EpochStartTrigger:         args.ProcessComponents.EpochStartTrigger(),
+		ArgsParser:                smartContract.NewArgumentParser(),
+		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+	}
+	txFactory, err := interceptorFactory.NewInterceptedTxDataFactory(argInterceptorFactory)
+	if err != nil {
+		return err
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		args.StateComponents.AccountsAdapter(),
+		shardCoordinator,
+		args.ProcessComponents.WhiteListHandler(),
+		args.CoreComponents.AddressPubKeyConverter(),
+		common.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return err
+	}
+
+	txProcessor, err := processor.NewTxInterceptorProcessor(&processor.ArgTxInterceptorProcessor{
+		ShardedDataCache: args.DataComponents.Datapool().Transactions(),
+		TxValidator:      txValidator,
+	})
+	if err != nil {
+		return err
+	}
+
+	reloader, err := interceptors.NewPersistedTxsReloader(interceptors.ArgPersistedTxsReloader{
+		TxsPool:       txsPool,
+		DataFactory:   txFactory,
+		Processor:     txProcessor,
+		Topic:         processFactory.TransactionTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId()),
+		CurrentPeerId: args.NetworkComponents.NetworkMessenger().ID(),
+	})
+	if err != nil {
+		return err
+	}
+
+	numReloaded, numRejected := reloader.Reload()
+	log.Info("reloaded the transactions pool saved upon the last shutdown", "numReloaded", numReloaded, "numRejected", numRejected)
+
+	return nil
+}
+
+func checkArgsTxsPoolReloader(args ArgsTxsPoolReloader) error {
+	if check.IfNil(args.CoreComponents) {
+		return errors.ErrNilCoreComponentsHolder
+	}
+	if check.IfNil(args.CryptoComponents) {
+		return errors.ErrNilCryptoComponentsHolder
+	}
+	if check.IfNil(args.DataComponents) {
+		return errors.ErrNilDataComponentsHolder
+	}
+	if check.IfNil(args.StateComponents) {
+		return errors.ErrNilStateComponentsHolder
+	}
+	if check.IfNil(args.ProcessComponents) {
+		return errors.ErrNilProcessComponentsHolder
+	}
+	if check.IfNil(args.NetworkComponents) {
+		return errors.ErrNilNetworkComponentsHolder
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -10,9 +11,19 @@ import (
 type QueryServiceStub struct {
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTraceCalled func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	CloseCalled                 func() error
 }
 
+// ExecuteQueryWithTrace -
+func (qss *QueryServiceStub) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if qss.ExecuteQueryWithTraceCalled != nil {
+		return qss.ExecuteQueryWithTraceCalled(query)
+	}
+
+	return &vmcommon.VMOutput{}, &tracing.ExecutionTrace{}, nil
+}
+
 // ComputeScCallGasLimit -
 func (qss *QueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if qss.ComputeScCallGasLimitCalled != nil {
@@ -0,0 +1,22 @@
+package simulator
+
+import "errors"
+
+// ErrInvalidNumNodes signals that an invalid number of nodes has been provided
+var ErrInvalidNumNodes = errors.New("invalid number of nodes")
+
+// ErrInvalidConsensusSize signals that an invalid consensus size has been provided
+var ErrInvalidConsensusSize = errors.New("invalid consensus size")
+
+// ErrInvalidRoundDuration signals that an invalid round duration has been provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrInvalidNodeIndex signals that an invalid node index has been provided
+var ErrInvalidNodeIndex = errors.New("invalid node index")
+
+// ErrReorderWithoutDelay signals that a reorder fault was requested without a delay window in which the messages
+// could be reordered
+var ErrReorderWithoutDelay = errors.New("reorder fault requires a non zero delay")
+
+// ErrSimulatorAlreadyStarted signals that the simulator has already been started
+var ErrSimulatorAlreadyStarted = errors.New("simulator already started")
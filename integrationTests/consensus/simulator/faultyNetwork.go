@@ -0,0 +1,361 @@
+package simulator
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+// LinkFault describes the faults injected on the messages sent from one node to another
+type LinkFault struct {
+	// MessageTypes restricts the fault to the consensus messages of the provided types. All the messages sent on the
+	// link are affected if no message type is provided
+	MessageTypes []consensus.MessageType
+	// Drop discards the affected messages
+	Drop bool
+	// Delay postpones the delivery of the affected messages with the provided virtual duration
+	Delay time.Duration
+	// Reorder delivers the affected messages sent during the same Delay window in the reverse order
+	Reorder bool
+}
+
+type link struct {
+	from int
+	to   int
+}
+
+type delivery struct {
+	messenger *memp2p.Messenger
+	topic     string
+	buff      []byte
+	to        *memp2p.Messenger
+}
+
+// pendingDeliveries holds the messages which will be delivered, in the reverse order if required, when the virtual
+// clock reaches the due time
+type pendingDeliveries struct {
+	dueTime    time.Time
+	sequence   uint64
+	deliveries []*delivery
+	isReversed bool
+}
+
+// faultyNetwork sits on top of a memp2p network and applies the configured link faults on every message sent from
+// one node to another. The delayed messages are kept until the simulator advances the virtual clock past their due
+// time. The network also counts the consensus messages not yet processed by the receivers, so the simulator knows
+// when the nodes finished reacting to a step
+type faultyNetwork struct {
+	network        *memp2p.Network
+	messengers     []*memp2p.Messenger
+	clock          *VirtualClock
+	marshalizer    marshal.Marshalizer
+	consensusTopic string
+
+	mutFaults      sync.Mutex
+	faults         map[link]LinkFault
+	offlineNodes   map[int]struct{}
+	reorderBatches map[link]*pendingDeliveries
+	pending        []*pendingDeliveries
+	numPending     uint64
+
+	numInFlight int64
+	activity    uint64
+}
+
+func newFaultyNetwork(numNodes int, clock *VirtualClock, marshalizer marshal.Marshalizer, consensusTopic string) (*faultyNetwork, error) {
+	network := memp2p.NewNetwork()
+	messengers := make([]*memp2p.Messenger, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		messenger, err := memp2p.NewMessenger(network)
+		if err != nil {
+			return nil, err
+		}
+
+		messengers = append(messengers, messenger)
+	}
+
+	return &faultyNetwork{
+		network:        network,
+		messengers:     messengers,
+		clock:          clock,
+		marshalizer:    marshalizer,
+		consensusTopic: consensusTopic,
+		faults:         make(map[link]LinkFault),
+		offlineNodes:   make(map[int]struct{}),
+		reorderBatches: make(map[link]*pendingDeliveries),
+		pending:        make([]*pendingDeliveries, 0),
+	}, nil
+}
+
+func (fn *faultyNetwork) setLinkFault(from int, to int, fault LinkFault) {
+	fn.mutFaults.Lock()
+	fn.faults[link{from: from, to: to}] = fault
+	fn.mutFaults.Unlock()
+}
+
+func (fn *faultyNetwork) setNodeOffline(index int, isOffline bool) {
+	fn.mutFaults.Lock()
+	defer fn.mutFaults.Unlock()
+
+	if isOffline {
+		fn.offlineNodes[index] = struct{}{}
+		return
+	}
+
+	delete(fn.offlineNodes, index)
+}
+
+func (fn *faultyNetwork) clearFaults() {
+	fn.mutFaults.Lock()
+	fn.faults = make(map[link]LinkFault)
+	fn.offlineNodes = make(map[int]struct{})
+	fn.mutFaults.Unlock()
+}
+
+// broadcast sends the message from the provided node to all the nodes, in the nodes order, so that the delivery on
+// the links without faults is deterministic
+func (fn *faultyNetwork) broadcast(from int, topic string, buff []byte) {
+	atomic.AddUint64(&fn.activity, 1)
+
+	for to := range fn.messengers {
+		fn.send(from, to, topic, buff)
+	}
+}
+
+func (fn *faultyNetwork) send(from int, to int, topic string, buff []byte) {
+	dlv := &delivery{
+		messenger: fn.messengers[from],
+		topic:     topic,
+		buff:      buff,
+		to:        fn.messengers[to],
+	}
+	if from == to {
+		fn.deliver(dlv)
+		return
+	}
+
+	fn.mutFaults.Lock()
+	_, isSenderOffline := fn.offlineNodes[from]
+	_, isReceiverOffline := fn.offlineNodes[to]
+	if isSenderOffline || isReceiverOffline {
+		fn.mutFaults.Unlock()
+		log.Trace("faultyNetwork: message dropped, node offline", "from", from, "to", to, "topic", topic)
+		return
+	}
+
+	lnk := link{from: from, to: to}
+	fault, found := fn.faults[lnk]
+	if !found || !fn.isAffected(fault, topic, buff) {
+		fn.mutFaults.Unlock()
+		fn.deliver(dlv)
+		return
+	}
+
+	if fault.Drop {
+		fn.mutFaults.Unlock()
+		log.Trace("faultyNetwork: message dropped", "from", from, "to", to, "topic", topic)
+		return
+	}
+
+	if fault.Reorder {
+		batch, isBatchOpen := fn.reorderBatches[lnk]
+		if !isBatchOpen {
+			batch = fn.addPendingDeliveries(fault.Delay, true)
+			fn.reorderBatches[lnk] = batch
+		}
+		batch.deliveries = append(batch.deliveries, dlv)
+		fn.mutFaults.Unlock()
+		return
+	}
+	if fault.Delay <= 0 {
+		fn.mutFaults.Unlock()
+		fn.deliver(dlv)
+		return
+	}
+
+	batch := fn.addPendingDeliveries(fault.Delay, false)
+	batch.deliveries = append(batch.deliveries, dlv)
+	fn.mutFaults.Unlock()
+
+	log.Trace("faultyNetwork: message delayed", "from", from, "to", to, "topic", topic, "delay", fault.Delay)
+}
+
+// addPendingDeliveries must be called under the faults mutex
+func (fn *faultyNetwork) addPendingDeliveries(delay time.Duration, isReversed bool) *pendingDeliveries {
+	batch := &pendingDeliveries{
+		dueTime:    fn.clock.CurrentTime().Add(delay),
+		sequence:   fn.numPending,
+		deliveries: make([]*delivery, 0, 1),
+		isReversed: isReversed,
+	}
+	fn.numPending++
+	fn.pending = append(fn.pending, batch)
+
+	return batch
+}
+
+// nextDueTime returns the earliest due time of the delayed messages, if any
+func (fn *faultyNetwork) nextDueTime() (time.Time, bool) {
+	fn.mutFaults.Lock()
+	defer fn.mutFaults.Unlock()
+
+	if len(fn.pending) == 0 {
+		return time.Time{}, false
+	}
+
+	nextTime := fn.pending[0].dueTime
+	for _, batch := range fn.pending[1:] {
+		if batch.dueTime.Before(nextTime) {
+			nextTime = batch.dueTime
+		}
+	}
+
+	return nextTime, true
+}
+
+// deliverDue delivers the delayed messages which are due at the provided virtual time, in the order of their due
+// times. Returns the number of delivered messages
+func (fn *faultyNetwork) deliverDue(currentTime time.Time) int {
+	fn.mutFaults.Lock()
+	dueBatches := make([]*pendingDeliveries, 0)
+	remaining := make([]*pendingDeliveries, 0, len(fn.pending))
+	for _, batch := range fn.pending {
+		if batch.dueTime.After(currentTime) {
+			remaining = append(remaining, batch)
+			continue
+		}
+
+		dueBatches = append(dueBatches, batch)
+	}
+	fn.pending = remaining
+	for lnk, batch := range fn.reorderBatches {
+		if !batch.dueTime.After(currentTime) {
+			delete(fn.reorderBatches, lnk)
+		}
+	}
+	fn.mutFaults.Unlock()
+
+	sort.Slice(dueBatches, func(i, j int) bool {
+		if dueBatches[i].dueTime.Equal(dueBatches[j].dueTime) {
+			return dueBatches[i].sequence < dueBatches[j].sequence
+		}
+
+		return dueBatches[i].dueTime.Before(dueBatches[j].dueTime)
+	})
+
+	numDelivered := 0
+	for _, batch := range dueBatches {
+		if batch.isReversed {
+			log.Trace("faultyNetwork: delivering messages in reverse order", "num messages", len(batch.deliveries))
+		}
+
+		for i := range batch.deliveries {
+			index := i
+			if batch.isReversed {
+				index = len(batch.deliveries) - 1 - i
+			}
+
+			fn.deliver(batch.deliveries[index])
+			numDelivered++
+		}
+	}
+
+	return numDelivered
+}
+
+func (fn *faultyNetwork) isAffected(fault LinkFault, topic string, buff []byte) bool {
+	if len(fault.MessageTypes) == 0 {
+		return true
+	}
+	if topic != fn.consensusTopic {
+		return false
+	}
+
+	cnsMsg := &consensus.Message{}
+	err := fn.marshalizer.Unmarshal(cnsMsg, buff)
+	if err != nil {
+		return false
+	}
+
+	for _, msgType := range fault.MessageTypes {
+		if consensus.MessageType(cnsMsg.MsgType) == msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (fn *faultyNetwork) deliver(dlv *delivery) {
+	isTracked := dlv.topic == fn.consensusTopic
+	if isTracked {
+		atomic.AddInt64(&fn.numInFlight, 1)
+	}
+
+	err := dlv.messenger.SendToConnectedPeer(dlv.topic, dlv.buff, dlv.to.ID())
+	if err != nil {
+		log.Trace("faultyNetwork: message not delivered", "topic", dlv.topic, "error", err)
+		if isTracked {
+			fn.messageProcessed()
+		}
+	}
+}
+
+func (fn *faultyNetwork) messageProcessed() {
+	atomic.AddInt64(&fn.numInFlight, -1)
+	atomic.AddUint64(&fn.activity, 1)
+}
+
+// activityStatus returns the number of consensus messages not yet processed by the receivers and a counter which
+// changes each time a message is sent or processed
+func (fn *faultyNetwork) activityStatus() (int64, uint64) {
+	return atomic.LoadInt64(&fn.numInFlight), atomic.LoadUint64(&fn.activity)
+}
+
+func (fn *faultyNetwork) close() {
+	for _, messenger := range fn.messengers {
+		_ = messenger.Close()
+	}
+}
+
+// trackedMessageProcessor is registered on the consensus topic instead of the node worker, so that the network knows
+// when a consensus message was processed
+type trackedMessageProcessor struct {
+	processor p2p.MessageProcessor
+	network   *faultyNetwork
+}
+
+// ProcessReceivedMessage passes the message to the wrapped processor and marks it as processed
+func (tmp *trackedMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	defer tmp.network.messageProcessed()
+
+	return tmp.processor.ProcessReceivedMessage(message, fromConnectedPeer)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tmp *trackedMessageProcessor) IsInterfaceNil() bool {
+	return tmp == nil
+}
+
+// nodeMessenger is the consensus.P2PMessenger used by a simulated node, broadcasting through the faulty network
+type nodeMessenger struct {
+	index   int
+	network *faultyNetwork
+}
+
+// Broadcast sends the message to all the nodes, applying the configured link faults
+func (nm *nodeMessenger) Broadcast(topic string, buff []byte) {
+	nm.network.broadcast(nm.index, topic, buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nm *nodeMessenger) IsInterfaceNil() bool {
+	return nm == nil
+}
@@ -0,0 +1,534 @@
+package simulator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/watchdog"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	mclsinglesig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	consensusMock "github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/disabled"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	syncFork "github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/nodeTypeProviderMock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+)
+
+const blsConsensusType = "bls"
+const signatureSize = 48
+const publicKeySize = 96
+const numRoundsInTimeline = 10
+
+// subroundRealWait is the real timeout used by the subrounds waiting for their virtual deadline. The simulator wakes
+// them up each time it advances the virtual clock, so this timeout is only a safety net
+const subroundRealWait = time.Minute
+
+// componentsRealWait is the real duration the consensus components wait, before checking again, for a virtual deadline
+// which was not reached yet. It must be shorter than the settle duration of the simulator
+const componentsRealWait = time.Millisecond * 5
+
+// FinalizedHeader holds the data of a header committed by a simulated node
+type FinalizedHeader struct {
+	Round    uint64
+	Nonce    uint64
+	Hash     []byte
+	PrevHash []byte
+}
+
+type argsSimulatedNode struct {
+	index          int
+	consensusSize  int
+	roundDuration  time.Duration
+	keys           []*keyPair
+	metaKey        *keyPair
+	keyGen         crypto.KeyGenerator
+	clock          *VirtualClock
+	network        *faultyNetwork
+	hasher         hashing.Hasher
+	marshalizer    marshal.Marshalizer
+	onBlockCreated func(nodeIndex int, header data.HeaderHandler)
+}
+
+type simulatedNode struct {
+	index      int
+	messenger  *memp2p.Messenger
+	worker     *spos.Worker
+	chronology *subroundsChronology
+
+	mutFinalized sync.RWMutex
+	finalized    []FinalizedHeader
+}
+
+func newSimulatedNode(args argsSimulatedNode) (*simulatedNode, error) {
+	node := &simulatedNode{
+		index:     args.index,
+		messenger: args.network.messengers[args.index],
+		finalized: make([]FinalizedHeader, 0),
+	}
+
+	selfKey := args.keys[args.index]
+	pubKeys := make([]string, 0, len(args.keys))
+	for _, kp := range args.keys {
+		pubKeys = append(pubKeys, string(kp.pkBytes))
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	blockChain, err := createGenesisBlockChain(args.hasher, args.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	blockProcessor := node.createBlockProcessor(blockChain, args)
+
+	baseRoundHandler, err := round.NewRound(args.clock.GenesisTime(), args.clock.CurrentTime(), args.roundDuration, args.clock, 0)
+	if err != nil {
+		return nil, err
+	}
+	roundHandler := &virtualRoundHandler{
+		RoundHandler: baseRoundHandler,
+		realWait:     componentsRealWait,
+	}
+	subroundsRoundHandler := &virtualRoundHandler{
+		RoundHandler: baseRoundHandler,
+		realWait:     subroundRealWait,
+	}
+
+	forkDetector, err := syncFork.NewShardForkDetector(roundHandler, timecache.NewTimeCache(time.Second), &mock.BlockTrackerStub{}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	nodesCoord, err := createNodesCoordinator(args, selfKey, epochStartNotifier)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusState, err := createConsensusState(nodesCoord, selfKey, args.consensusSize)
+	if err != nil {
+		return nil, err
+	}
+
+	singleBlsSigner := &mclsinglesig.BlsSingleSigner{}
+	peerSigCache, err := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 1000})
+	if err != nil {
+		return nil, err
+	}
+	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(peerSigCache, singleBlsSigner, args.keyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	multiSigner := cryptoMocks.NewMultiSigner(uint32(args.consensusSize))
+	err = multiSigner.Reset(pubKeys, uint16(args.index))
+	if err != nil {
+		return nil, err
+	}
+
+	dataPool := dataRetrieverMock.CreatePoolsHolder(1, 0)
+	broadcastMessenger, err := sposFactory.GetBroadcastMessenger(
+		args.marshalizer,
+		args.hasher,
+		&nodeMessenger{index: args.index, network: args.network},
+		shardCoordinator,
+		selfKey.sk,
+		peerSigHandler,
+		dataPool.Headers(),
+		&testscommon.InterceptorsContainerStub{},
+		&testscommon.AlarmSchedulerStub{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusService, err := sposFactory.GetConsensusCoreFactory(blsConsensusType)
+	if err != nil {
+		return nil, err
+	}
+
+	outportHandler := disabled.NewDisabledOutport()
+	equivocationDetector, err := spos.NewEquivocationDetector(spos.ArgsEquivocationDetector{
+		ConsensusService: consensusService,
+		Hasher:           args.hasher,
+		Storer:           integrationTests.CreateMemUnit(),
+		OutportHandler:   outportHandler,
+		ShardID:          shardCoordinator.SelfId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timelineRecorder, err := spos.NewConsensusTimelineRecorder(spos.ArgsConsensusTimelineRecorder{
+		ConsensusService: consensusService,
+		SyncTimer:        args.clock,
+		RoundHandler:     roundHandler,
+		NumRounds:        numRoundsInTimeline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appStatusHandler := &statusHandlerMock.AppStatusHandlerStub{}
+	bootstrapper := &consensusMock.BootstrapperStub{}
+	scheduledProcessor := &consensusMocks.ScheduledProcessorStub{}
+	antifloodHandler := &mock.NilAntifloodHandler{}
+	headerSigVerifier := &mock.HeaderSigVerifierStub{}
+	nodeRedundancyHandler := &mock.RedundancyHandlerStub{}
+
+	node.worker, err = spos.NewWorker(&spos.WorkerArgs{
+		ConsensusService:         consensusService,
+		BlockChain:               blockChain,
+		BlockProcessor:           blockProcessor,
+		ScheduledProcessor:       scheduledProcessor,
+		Bootstrapper:             bootstrapper,
+		BroadcastMessenger:       broadcastMessenger,
+		ConsensusState:           consensusState,
+		ForkDetector:             forkDetector,
+		Marshalizer:              args.marshalizer,
+		Hasher:                   args.hasher,
+		RoundHandler:             roundHandler,
+		ShardCoordinator:         shardCoordinator,
+		PeerSignatureHandler:     peerSigHandler,
+		SyncTimer:                args.clock,
+		HeaderSigVerifier:        headerSigVerifier,
+		HeaderIntegrityVerifier:  &mock.HeaderIntegrityVerifierStub{},
+		ChainID:                  integrationTests.ChainID,
+		NetworkShardingCollector: mock.NewNetworkShardingCollectorMock(),
+		AntifloodHandler:         antifloodHandler,
+		PoolAdder:                dataPool.MiniBlocks(),
+		SignatureSize:            signatureSize,
+		PublicKeySize:            publicKeySize,
+		AppStatusHandler:         appStatusHandler,
+		NodeRedundancyHandler:    nodeRedundancyHandler,
+		EquivocationDetector:     equivocationDetector,
+		TimelineRecorder:         timelineRecorder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chronologyHandler, err := chronology.NewChronology(chronology.ArgChronology{
+		GenesisTime:      args.clock.GenesisTime(),
+		RoundHandler:     subroundsRoundHandler,
+		SyncTimer:        args.clock,
+		Watchdog:         &watchdog.DisabledWatchdog{},
+		AppStatusHandler: appStatusHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	node.chronology = &subroundsChronology{
+		ChronologyHandler: chronologyHandler,
+		subrounds:         make([]consensus.SubroundHandler, 0),
+	}
+
+	consensusCore, err := spos.NewConsensusCore(&spos.ConsensusCoreArgs{
+		BlockChain:                    blockChain,
+		BlockProcessor:                blockProcessor,
+		Bootstrapper:                  bootstrapper,
+		BroadcastMessenger:            broadcastMessenger,
+		ChronologyHandler:             node.chronology,
+		Hasher:                        args.hasher,
+		Marshalizer:                   args.marshalizer,
+		BlsPrivateKey:                 selfKey.sk,
+		BlsSingleSigner:               singleBlsSigner,
+		MultiSigner:                   multiSigner,
+		RoundHandler:                  roundHandler,
+		ShardCoordinator:              shardCoordinator,
+		NodesCoordinator:              nodesCoord,
+		SyncTimer:                     args.clock,
+		EpochStartRegistrationHandler: epochStartNotifier,
+		AntifloodHandler:              antifloodHandler,
+		PeerHonestyHandler:            &mock.PeerHonestyHandlerStub{},
+		HeaderSigVerifier:             headerSigVerifier,
+		FallbackHeaderValidator:       &testscommon.FallBackHeaderValidatorStub{},
+		NodeRedundancyHandler:         nodeRedundancyHandler,
+		ScheduledProcessor:            scheduledProcessor,
+		ConsensusTimelineRecorder:     timelineRecorder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subroundsFactory, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		consensusState,
+		node.worker,
+		blsConsensusType,
+		appStatusHandler,
+		outportHandler,
+		integrationTests.ChainID,
+		node.messenger.ID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subroundsFactory.GenerateSubrounds()
+	if err != nil {
+		return nil, err
+	}
+
+	consensusTopic := spos.GetConsensusTopicID(shardCoordinator)
+	err = node.messenger.CreateTopic(consensusTopic, true)
+	if err != nil {
+		return nil, err
+	}
+	messageProcessor := &trackedMessageProcessor{
+		processor: node.worker,
+		network:   args.network,
+	}
+	err = node.messenger.RegisterMessageProcessor(consensusTopic, common.DefaultInterceptorsIdentifier, messageProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func createGenesisBlockChain(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (data.ChainHandler, error) {
+	blockChain, err := blockchain.NewBlockChain(&statusHandlerMock.AppStatusHandlerStub{})
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := []byte("roothash")
+	genesisHeader := &dataBlock.Header{
+		Nonce:         0,
+		ShardID:       0,
+		BlockBodyType: dataBlock.StateBlock,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		PrevRandSeed:  rootHash,
+		RandSeed:      rootHash,
+	}
+	err = blockChain.SetGenesisHeader(genesisHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisHeaderBytes, err := marshalizer.Marshal(genesisHeader)
+	if err != nil {
+		return nil, err
+	}
+	blockChain.SetGenesisHeaderHash(hasher.Compute(string(genesisHeaderBytes)))
+
+	return blockChain, nil
+}
+
+func (node *simulatedNode) createBlockProcessor(blockChain data.ChainHandler, args argsSimulatedNode) *mock.BlockProcessorMock {
+	blockProcessor := &mock.BlockProcessorMock{
+		Marshalizer: args.marshalizer,
+		ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+			return nil
+		},
+		RevertCurrentBlockCalled: func() {
+		},
+		CreateBlockCalled: func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+			args.onBlockCreated(args.index, header)
+			return header, &dataBlock.Body{}, nil
+		},
+		MarshalizedDataToBroadcastCalled: func(header data.HeaderHandler, body data.BodyHandler) (map[uint32][]byte, map[string][][]byte, error) {
+			return make(map[uint32][]byte), make(map[string][][]byte), nil
+		},
+		CreateNewHeaderCalled: func(round uint64, nonce uint64) (data.HeaderHandler, error) {
+			return &dataBlock.Header{
+				Round:           round,
+				Nonce:           nonce,
+				SoftwareVersion: []byte("version"),
+			}, nil
+		},
+	}
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		headerBytes, err := args.marshalizer.Marshal(header)
+		if err != nil {
+			return err
+		}
+		headerHash := args.hasher.Compute(string(headerBytes))
+
+		err = blockChain.SetCurrentBlockHeaderAndRootHash(header, header.GetRootHash())
+		if err != nil {
+			return err
+		}
+		blockChain.SetCurrentBlockHeaderHash(headerHash)
+
+		node.mutFinalized.Lock()
+		node.finalized = append(node.finalized, FinalizedHeader{
+			Round:    header.GetRound(),
+			Nonce:    header.GetNonce(),
+			Hash:     headerHash,
+			PrevHash: header.GetPrevHash(),
+		})
+		node.mutFinalized.Unlock()
+
+		return nil
+	}
+
+	return blockProcessor
+}
+
+func createNodesCoordinator(
+	args argsSimulatedNode,
+	selfKey *keyPair,
+	epochStartNotifier nodesCoordinator.EpochStartEventNotifier,
+) (nodesCoordinator.NodesCoordinator, error) {
+	eligibleNodes := make(map[uint32][]nodesCoordinator.Validator)
+	for i, kp := range args.keys {
+		validator, err := nodesCoordinator.NewValidator(kp.pkBytes, 1, uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		eligibleNodes[0] = append(eligibleNodes[0], validator)
+	}
+
+	metaValidator, err := nodesCoordinator.NewValidator(args.metaKey.pkBytes, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	eligibleNodes[core.MetachainShardId] = []nodesCoordinator.Validator{metaValidator}
+
+	consensusGroupCache, err := lrucache.NewCache(10000)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesCoordinator.NewIndexHashedNodesCoordinator(nodesCoordinator.ArgNodesCoordinator{
+		ShardConsensusGroupSize:    args.consensusSize,
+		MetaConsensusGroupSize:     1,
+		Marshalizer:                args.marshalizer,
+		Hasher:                     args.hasher,
+		Shuffler:                   &shardingMocks.NodeShufflerMock{},
+		EpochStartNotifier:         epochStartNotifier,
+		BootStorer:                 integrationTests.CreateMemUnit(),
+		NbShards:                   1,
+		EligibleNodes:              eligibleNodes,
+		WaitingNodes:               make(map[uint32][]nodesCoordinator.Validator),
+		SelfPublicKey:              selfKey.pkBytes,
+		ConsensusGroupCache:        consensusGroupCache,
+		ShuffledOutHandler:         &mock.ShuffledOutHandlerStub{},
+		WaitingListFixEnabledEpoch: 0,
+		ChanStopNode:               endProcess.GetDummyEndProcessChannel(),
+		NodeTypeProvider:           &nodeTypeProviderMock.NodeTypeProviderStub{},
+		IsFullArchive:              false,
+	})
+}
+
+func createConsensusState(nodesCoord nodesCoordinator.NodesCoordinator, selfKey *keyPair, consensusSize int) (*spos.ConsensusState, error) {
+	eligibleNodesPubKeys, err := nodesCoord.GetConsensusWhitelistedNodes(0)
+	if err != nil {
+		return nil, err
+	}
+
+	roundConsensus := spos.NewRoundConsensus(eligibleNodesPubKeys, consensusSize, string(selfKey.pkBytes))
+	roundConsensus.ResetRoundState()
+
+	roundStatus := spos.NewRoundStatus()
+	roundStatus.ResetRoundStatus()
+
+	return spos.NewConsensusState(roundConsensus, spos.NewRoundThreshold(), roundStatus), nil
+}
+
+func (node *simulatedNode) start() {
+	node.worker.StartWorking()
+	node.chronology.StartRounds()
+}
+
+// wakeUp makes the subround in progress check again its virtual deadline
+func (node *simulatedNode) wakeUp() {
+	select {
+	case node.worker.GetConsensusStateChangedChannel() <- true:
+	default:
+	}
+}
+
+// subroundsTimeFrames returns the moments, relative to the round start, when the subrounds start and end
+func (node *simulatedNode) subroundsTimeFrames() []time.Duration {
+	return node.chronology.timeFrames()
+}
+
+func (node *simulatedNode) close() {
+	_ = node.chronology.Close()
+	_ = node.worker.Close()
+}
+
+func (node *simulatedNode) finalizedHeaders() []FinalizedHeader {
+	node.mutFinalized.RLock()
+	defer node.mutFinalized.RUnlock()
+
+	return append(make([]FinalizedHeader, 0, len(node.finalized)), node.finalized...)
+}
+
+// subroundsChronology passes the subrounds to the chronology and keeps them, so that the simulator can step the
+// virtual clock through their time frames
+type subroundsChronology struct {
+	consensus.ChronologyHandler
+
+	mutSubrounds sync.RWMutex
+	subrounds    []consensus.SubroundHandler
+}
+
+// AddSubround adds the subround to the chronology
+func (sc *subroundsChronology) AddSubround(subroundHandler consensus.SubroundHandler) {
+	sc.mutSubrounds.Lock()
+	sc.subrounds = append(sc.subrounds, subroundHandler)
+	sc.mutSubrounds.Unlock()
+
+	sc.ChronologyHandler.AddSubround(subroundHandler)
+}
+
+// RemoveAllSubrounds removes all the subrounds from the chronology
+func (sc *subroundsChronology) RemoveAllSubrounds() {
+	sc.mutSubrounds.Lock()
+	sc.subrounds = make([]consensus.SubroundHandler, 0)
+	sc.mutSubrounds.Unlock()
+
+	sc.ChronologyHandler.RemoveAllSubrounds()
+}
+
+func (sc *subroundsChronology) timeFrames() []time.Duration {
+	sc.mutSubrounds.RLock()
+	defer sc.mutSubrounds.RUnlock()
+
+	timeFrames := make([]time.Duration, 0, 2*len(sc.subrounds))
+	for _, subround := range sc.subrounds {
+		timeFrames = append(timeFrames, time.Duration(subround.StartTime()), time.Duration(subround.EndTime()))
+	}
+
+	return timeFrames
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *subroundsChronology) IsInterfaceNil() bool {
+	return sc == nil
+}
@@ -0,0 +1,459 @@
+package simulator
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/require"
+)
+
+var log = logger.GetOrCreate("integrationtests/consensus/simulator")
+
+// genesisTime is the virtual time at which all the simulations start
+var genesisTime = time.Unix(1600000000, 0)
+
+// keysSeed offsets the scalars used to derive the validators keys, so that they are not trivial values
+const keysSeed = 1000
+
+// settleDuration is the real time without any network activity after which the nodes are considered to have finished
+// reacting to a step of the virtual clock
+const settleDuration = time.Millisecond * 20
+
+const settlePollInterval = time.Millisecond
+
+// ArgsSimulator holds the arguments needed to create a consensus simulator
+type ArgsSimulator struct {
+	NumNodes      int
+	ConsensusSize int
+	// RoundDuration is the virtual duration of a round
+	RoundDuration time.Duration
+}
+
+type keyPair struct {
+	sk      crypto.PrivateKey
+	pkBytes []byte
+}
+
+// Simulator drives a set of consensus nodes of a shard (the spos workers, the bls subrounds and the chronologies)
+// connected through an in-memory network and running on a shared virtual clock. The nodes keys are derived
+// deterministically, so the leaders schedule is the same from one run to another. The virtual clock is advanced in
+// steps: the start and the end of each subround, the subrounds deadline and the due times of the delayed messages.
+// After each step, the simulator waits for the nodes to finish reacting before moving to the next one. Faults can be
+// injected on each link between two nodes and the headers finalized by each node can be checked against the expected
+// outcome
+type Simulator struct {
+	clock         *VirtualClock
+	network       *faultyNetwork
+	nodes         []*simulatedNode
+	roundDuration time.Duration
+	timeFrames    []time.Duration
+
+	mutSteps sync.Mutex
+
+	mutProposers sync.RWMutex
+	proposers    map[uint64][]int
+
+	mutStarted sync.Mutex
+	isStarted  bool
+}
+
+// NewSimulator creates a new consensus simulator. The nodes are created but they will only start working after the
+// Start method is called
+func NewSimulator(args ArgsSimulator) (*Simulator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clock := NewVirtualClock(genesisTime)
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher, err := blake2b.NewBlake2bWithSize(32)
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := newFaultyNetwork(args.NumNodes, clock, marshalizer, spos.GetConsensusTopicID(shardCoordinator))
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	keys, err := createKeys(keyGen, args.NumNodes+1)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &Simulator{
+		clock:         clock,
+		network:       network,
+		nodes:         make([]*simulatedNode, 0, args.NumNodes),
+		roundDuration: args.RoundDuration,
+		proposers:     make(map[uint64][]int),
+	}
+
+	for i := 0; i < args.NumNodes; i++ {
+		node, errCreate := newSimulatedNode(argsSimulatedNode{
+			index:          i,
+			consensusSize:  args.ConsensusSize,
+			roundDuration:  args.RoundDuration,
+			keys:           keys[:args.NumNodes],
+			metaKey:        keys[args.NumNodes],
+			keyGen:         keyGen,
+			clock:          clock,
+			network:        network,
+			hasher:         hasher,
+			marshalizer:    marshalizer,
+			onBlockCreated: sim.blockCreated,
+		})
+		if errCreate != nil {
+			sim.Close()
+			return nil, fmt.Errorf("%w while creating node %d", errCreate, i)
+		}
+
+		sim.nodes = append(sim.nodes, node)
+	}
+	sim.timeFrames = computeTimeFrames(sim.nodes[0].subroundsTimeFrames(), args.RoundDuration)
+
+	return sim, nil
+}
+
+// computeTimeFrames returns the sorted and distinct moments, relative to the round start, at which the virtual clock
+// stops in each round: the round start, the subrounds time frames and the deadline of the subrounds
+func computeTimeFrames(subroundsTimeFrames []time.Duration, roundDuration time.Duration) []time.Duration {
+	timeFrames := append([]time.Duration{0, roundDuration * spos.MaxThresholdPercent / 100}, subroundsTimeFrames...)
+	sort.Slice(timeFrames, func(i, j int) bool {
+		return timeFrames[i] < timeFrames[j]
+	})
+
+	distinctTimeFrames := make([]time.Duration, 0, len(timeFrames))
+	for _, timeFrame := range timeFrames {
+		if timeFrame < 0 || timeFrame >= roundDuration {
+			continue
+		}
+		if len(distinctTimeFrames) > 0 && distinctTimeFrames[len(distinctTimeFrames)-1] == timeFrame {
+			continue
+		}
+
+		distinctTimeFrames = append(distinctTimeFrames, timeFrame)
+	}
+
+	return distinctTimeFrames
+}
+
+func checkArgs(args ArgsSimulator) error {
+	if args.NumNodes < 1 {
+		return ErrInvalidNumNodes
+	}
+	if args.ConsensusSize < 1 || args.ConsensusSize > args.NumNodes {
+		return ErrInvalidConsensusSize
+	}
+	if args.RoundDuration <= 0 {
+		return ErrInvalidRoundDuration
+	}
+
+	return nil
+}
+
+// createKeys derives the BLS keys from small scalars, which is insecure but makes the simulations reproducible
+func createKeys(keyGen crypto.KeyGenerator, numKeys int) ([]*keyPair, error) {
+	keys := make([]*keyPair, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		scalar := keyGen.Suite().CreateScalar()
+		scalar.SetInt64(int64(keysSeed + i))
+		scalarBytes, err := scalar.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		sk, err := keyGen.PrivateKeyFromByteArray(scalarBytes)
+		if err != nil {
+			return nil, err
+		}
+		pkBytes, err := sk.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, &keyPair{
+			sk:      sk,
+			pkBytes: pkBytes,
+		})
+	}
+
+	return keys, nil
+}
+
+func (sim *Simulator) blockCreated(nodeIndex int, header data.HeaderHandler) {
+	sim.mutProposers.Lock()
+	sim.proposers[header.GetRound()] = append(sim.proposers[header.GetRound()], nodeIndex)
+	sim.mutProposers.Unlock()
+}
+
+// Start makes all the nodes start working
+func (sim *Simulator) Start() error {
+	sim.mutStarted.Lock()
+	defer sim.mutStarted.Unlock()
+
+	if sim.isStarted {
+		return ErrSimulatorAlreadyStarted
+	}
+	sim.isStarted = true
+
+	for _, node := range sim.nodes {
+		node.start()
+	}
+	sim.waitUntilSettled()
+
+	return nil
+}
+
+// Close stops all the nodes and disconnects them from the network
+func (sim *Simulator) Close() {
+	for _, node := range sim.nodes {
+		node.close()
+	}
+	sim.network.close()
+}
+
+// NumNodes returns the number of simulated nodes
+func (sim *Simulator) NumNodes() int {
+	return len(sim.nodes)
+}
+
+// CurrentRound returns the round in progress, according to the virtual clock
+func (sim *Simulator) CurrentRound() uint64 {
+	elapsed := sim.clock.CurrentTime().Sub(sim.clock.GenesisTime())
+
+	return uint64(elapsed / sim.roundDuration)
+}
+
+// WaitForRound advances the virtual clock, step by step, until the provided round starts and the nodes finished
+// reacting to its start
+func (sim *Simulator) WaitForRound(round uint64) {
+	sim.mutSteps.Lock()
+	defer sim.mutSteps.Unlock()
+
+	roundStartTime := sim.roundStartTime(round)
+	for sim.clock.CurrentTime().Before(roundStartTime) {
+		sim.step(sim.nextStepTime())
+	}
+}
+
+func (sim *Simulator) roundStartTime(round uint64) time.Time {
+	return sim.clock.GenesisTime().Add(time.Duration(round) * sim.roundDuration)
+}
+
+// nextStepTime returns the earliest moment, after the current virtual time, at which a time frame of a round starts or
+// a delayed message is due
+func (sim *Simulator) nextStepTime() time.Time {
+	currentTime := sim.clock.CurrentTime()
+	currentRound := sim.CurrentRound()
+
+	nextTime := sim.roundStartTime(currentRound + 1)
+	for _, timeFrame := range sim.timeFrames {
+		timeFrameStart := sim.roundStartTime(currentRound).Add(timeFrame)
+		if timeFrameStart.After(currentTime) {
+			nextTime = timeFrameStart
+			break
+		}
+	}
+
+	dueTime, hasPendingMessages := sim.network.nextDueTime()
+	if hasPendingMessages && dueTime.After(currentTime) && dueTime.Before(nextTime) {
+		nextTime = dueTime
+	}
+
+	return nextTime
+}
+
+// step advances the virtual clock to the provided time and waits for the nodes to react to it. The delayed messages
+// are delivered after the nodes moved to the new time frame, so a message delayed until the next round start is seen
+// as a message from the past round
+func (sim *Simulator) step(stepTime time.Time) {
+	sim.clock.advanceTo(stepTime)
+	for _, node := range sim.nodes {
+		node.wakeUp()
+	}
+	sim.waitUntilSettled()
+
+	numDelivered := sim.network.deliverDue(stepTime)
+	if numDelivered > 0 {
+		sim.waitUntilSettled()
+	}
+}
+
+// waitUntilSettled blocks until all the consensus messages were processed and no message was sent or processed for
+// the settle duration
+func (sim *Simulator) waitUntilSettled() {
+	_, lastActivity := sim.network.activityStatus()
+	quietSince := time.Now()
+	for {
+		time.Sleep(settlePollInterval)
+
+		numInFlight, activity := sim.network.activityStatus()
+		if numInFlight > 0 || activity != lastActivity {
+			lastActivity = activity
+			quietSince = time.Now()
+			continue
+		}
+		if time.Since(quietSince) >= settleDuration {
+			return
+		}
+	}
+}
+
+// SetLinkFault sets the faults injected on the messages sent by the node with the index from to the node with the
+// index to, replacing the previous faults set on that link
+func (sim *Simulator) SetLinkFault(from int, to int, fault LinkFault) error {
+	if !sim.isValidIndex(from) || !sim.isValidIndex(to) {
+		return ErrInvalidNodeIndex
+	}
+	if fault.Reorder && fault.Delay <= 0 {
+		return ErrReorderWithoutDelay
+	}
+
+	sim.network.setLinkFault(from, to, fault)
+
+	return nil
+}
+
+// SetNodeOffline disconnects or reconnects the provided node. An offline node keeps running its consensus but it
+// neither sends nor receives messages
+func (sim *Simulator) SetNodeOffline(index int, isOffline bool) error {
+	if !sim.isValidIndex(index) {
+		return ErrInvalidNodeIndex
+	}
+
+	sim.network.setNodeOffline(index, isOffline)
+
+	return nil
+}
+
+// Partition drops all the messages sent between nodes belonging to different groups. The nodes not found in any
+// group keep communicating with everyone
+func (sim *Simulator) Partition(groups ...[]int) error {
+	groupOfNode := make(map[int]int)
+	for groupIndex, group := range groups {
+		for _, nodeIndex := range group {
+			if !sim.isValidIndex(nodeIndex) {
+				return ErrInvalidNodeIndex
+			}
+
+			groupOfNode[nodeIndex] = groupIndex
+		}
+	}
+
+	for from, fromGroup := range groupOfNode {
+		for to, toGroup := range groupOfNode {
+			if fromGroup == toGroup {
+				continue
+			}
+
+			sim.network.setLinkFault(from, to, LinkFault{Drop: true})
+		}
+	}
+
+	return nil
+}
+
+// ClearFaults removes all the link faults, partitions and offline nodes. Messages already delayed are still delivered
+func (sim *Simulator) ClearFaults() {
+	sim.network.clearFaults()
+}
+
+func (sim *Simulator) isValidIndex(index int) bool {
+	return index >= 0 && index < len(sim.nodes)
+}
+
+// FinalizedHeaders returns the headers committed so far by the provided node, in the commit order
+func (sim *Simulator) FinalizedHeaders(index int) []FinalizedHeader {
+	if !sim.isValidIndex(index) {
+		return nil
+	}
+
+	return sim.nodes[index].finalizedHeaders()
+}
+
+// ProposersOfRound returns the indexes of the nodes which created a block in the provided round. There can be more
+// than one proposer in a round if some nodes got isolated and their view of the chain diverged
+func (sim *Simulator) ProposersOfRound(round uint64) []int {
+	sim.mutProposers.RLock()
+	defer sim.mutProposers.RUnlock()
+
+	return append(make([]int, 0, len(sim.proposers[round])), sim.proposers[round]...)
+}
+
+// RequireConsistentFinalizedHeaders checks that each node finalized a proper chain (consecutive nonces, each header
+// linked to the previous one) and that no two nodes finalized different headers with the same nonce
+func (sim *Simulator) RequireConsistentFinalizedHeaders(t testing.TB) {
+	hashesByNonce := make(map[uint64][]byte)
+	for nodeIndex, node := range sim.nodes {
+		headers := node.finalizedHeaders()
+		for i, header := range headers {
+			if i > 0 {
+				require.Equal(t, headers[i-1].Nonce+1, header.Nonce, "node %d finalized non consecutive nonces", nodeIndex)
+				require.Equal(t, headers[i-1].Hash, header.PrevHash, "node %d finalized a header not linked to the previous one", nodeIndex)
+			}
+
+			hash, found := hashesByNonce[header.Nonce]
+			if !found {
+				hashesByNonce[header.Nonce] = header.Hash
+				continue
+			}
+
+			require.True(t, bytes.Equal(hash, header.Hash), "node %d finalized a different header for nonce %d", nodeIndex, header.Nonce)
+		}
+	}
+}
+
+// RequireFinalizedHeadersInRounds checks that each of the provided nodes finalized at least minNumHeaders headers
+// proposed in the rounds between fromRound and toRound, inclusive
+func (sim *Simulator) RequireFinalizedHeadersInRounds(t testing.TB, fromRound uint64, toRound uint64, minNumHeaders int, nodeIndexes ...int) {
+	for _, nodeIndex := range nodeIndexes {
+		require.True(t, sim.isValidIndex(nodeIndex), "invalid node index %d", nodeIndex)
+
+		numHeaders := sim.numFinalizedHeadersInRounds(nodeIndex, fromRound, toRound)
+		require.GreaterOrEqual(t, numHeaders, minNumHeaders,
+			"node %d finalized %d headers in rounds %d-%d", nodeIndex, numHeaders, fromRound, toRound)
+	}
+}
+
+// RequireNoFinalizedHeadersInRounds checks that none of the provided nodes finalized headers proposed in the rounds
+// between fromRound and toRound, inclusive
+func (sim *Simulator) RequireNoFinalizedHeadersInRounds(t testing.TB, fromRound uint64, toRound uint64, nodeIndexes ...int) {
+	for _, nodeIndex := range nodeIndexes {
+		require.True(t, sim.isValidIndex(nodeIndex), "invalid node index %d", nodeIndex)
+
+		numHeaders := sim.numFinalizedHeadersInRounds(nodeIndex, fromRound, toRound)
+		require.Zero(t, numHeaders, "node %d finalized %d headers in rounds %d-%d", nodeIndex, numHeaders, fromRound, toRound)
+	}
+}
+
+func (sim *Simulator) numFinalizedHeadersInRounds(nodeIndex int, fromRound uint64, toRound uint64) int {
+	numHeaders := 0
+	for _, header := range sim.nodes[nodeIndex].finalizedHeaders() {
+		if header.Round >= fromRound && header.Round <= toRound {
+			numHeaders++
+		}
+	}
+
+	return numHeaders
+}
@@ -0,0 +1,107 @@
+package simulator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+var _ ntp.SyncTimer = (*VirtualClock)(nil)
+var _ consensus.RoundHandler = (*virtualRoundHandler)(nil)
+
+// VirtualClock is a ntp.SyncTimer implementation that does not depend on the wall clock or on the NTP servers. The
+// virtual time starts at the provided genesis time and it only moves forward when the simulator advances it, step by
+// step, through the rounds and the subrounds. All the nodes of a simulation share the same virtual clock, so they are
+// perfectly synchronized and they see the same sequence of times on every run
+type VirtualClock struct {
+	genesisTime time.Time
+
+	mutTime     sync.RWMutex
+	currentTime time.Time
+}
+
+// NewVirtualClock creates a new virtual clock, stopped at the provided genesis time
+func NewVirtualClock(genesisTime time.Time) *VirtualClock {
+	return &VirtualClock{
+		genesisTime: genesisTime,
+		currentTime: genesisTime,
+	}
+}
+
+// GenesisTime returns the virtual time at which the clock started
+func (vc *VirtualClock) GenesisTime() time.Time {
+	return vc.genesisTime
+}
+
+// CurrentTime returns the current virtual time
+func (vc *VirtualClock) CurrentTime() time.Time {
+	vc.mutTime.RLock()
+	defer vc.mutTime.RUnlock()
+
+	return vc.currentTime
+}
+
+// advanceTo moves the virtual time forward to the provided time. The virtual time never goes back
+func (vc *VirtualClock) advanceTo(newTime time.Time) {
+	vc.mutTime.Lock()
+	defer vc.mutTime.Unlock()
+
+	if newTime.After(vc.currentTime) {
+		vc.currentTime = newTime
+	}
+}
+
+// FormattedCurrentTime returns the current virtual time as a formatted string
+func (vc *VirtualClock) FormattedCurrentTime() string {
+	currentTime := vc.CurrentTime()
+
+	return fmt.Sprintf("%.4d-%.2d-%.2d %.2d:%.2d:%.2d.%.9d ",
+		currentTime.Year(), currentTime.Month(), currentTime.Day(),
+		currentTime.Hour(), currentTime.Minute(), currentTime.Second(), currentTime.Nanosecond())
+}
+
+// ClockOffset returns 0 as the virtual clock is always synchronized
+func (vc *VirtualClock) ClockOffset() time.Duration {
+	return 0
+}
+
+// StartSyncingTime does nothing as the virtual clock is always synchronized
+func (vc *VirtualClock) StartSyncingTime() {
+}
+
+// Close does nothing and returns nil
+func (vc *VirtualClock) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vc *VirtualClock) IsInterfaceNil() bool {
+	return vc == nil
+}
+
+// virtualRoundHandler is a consensus.RoundHandler working on the virtual time. The components which wait for a virtual
+// deadline use the remaining time as a real timeout, but the virtual time does not elapse while they wait. So, as long
+// as the deadline is not reached, the real duration to wait before checking again is returned instead
+type virtualRoundHandler struct {
+	consensus.RoundHandler
+	realWait time.Duration
+}
+
+// RemainingTime returns the virtual time remaining until the provided deadline, if it was reached, or the real
+// duration to wait before checking again otherwise
+func (vrh *virtualRoundHandler) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
+	remainingTime := vrh.RoundHandler.RemainingTime(startTime, maxTime)
+	if remainingTime <= 0 {
+		return remainingTime
+	}
+
+	return vrh.realWait
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vrh *virtualRoundHandler) IsInterfaceNil() bool {
+	return vrh == nil
+}
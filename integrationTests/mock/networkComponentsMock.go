@@ -8,13 +8,15 @@ import (
 
 // NetworkComponentsStub -
 type NetworkComponentsStub struct {
-	Messenger               p2p.Messenger
-	InputAntiFlood          factory.P2PAntifloodHandler
-	OutputAntiFlood         factory.P2PAntifloodHandler
-	PeerBlackList           process.PeerBlackListCacher
-	PeerHonesty             factory.PeerHonestyHandler
-	PreferredPeersHolder    factory.PreferredPeersHolderHandler
-	PeersRatingHandlerField p2p.PeersRatingHandler
+	Messenger                  p2p.Messenger
+	InputAntiFlood             factory.P2PAntifloodHandler
+	OutputAntiFlood            factory.P2PAntifloodHandler
+	PeerBlackList              process.PeerBlackListCacher
+	PeerHonesty                factory.PeerHonestyHandler
+	PreferredPeersHolder       factory.PreferredPeersHolderHandler
+	PeersRatingHandlerField    p2p.PeersRatingHandler
+	P2PFaultsRulesHandlerField factory.P2PFaultsRulesHandler
+	P2PTrafficCounterField     factory.P2PTrafficCounter
 }
 
 // PubKeyCacher -
@@ -72,6 +74,16 @@ func (ncs *NetworkComponentsStub) PeersRatingHandler() p2p.PeersRatingHandler {
 	return ncs.PeersRatingHandlerField
 }
 
+// P2PFaultsRulesHandler -
+func (ncs *NetworkComponentsStub) P2PFaultsRulesHandler() factory.P2PFaultsRulesHandler {
+	return ncs.P2PFaultsRulesHandlerField
+}
+
+// P2PTrafficCounter -
+func (ncs *NetworkComponentsStub) P2PTrafficCounter() factory.P2PTrafficCounter {
+	return ncs.P2PTrafficCounterField
+}
+
 // String -
 func (ncs *NetworkComponentsStub) String() string {
 	return "NetworkComponentsStub"
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
@@ -37,6 +38,10 @@ func (n *nilOutport) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRating
 func (n *nilOutport) SaveAccounts(_ uint64, _ []data.UserAccountHandler) {
 }
 
+// SaveEquivocationEvidence -
+func (n *nilOutport) SaveEquivocationEvidence(_ *common.EquivocationEvidence) {
+}
+
 // FinalizedBlock -
 func (n *nilOutport) FinalizedBlock(_ []byte) {
 }
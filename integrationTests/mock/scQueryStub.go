@@ -3,13 +3,16 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ScQueryStub -
 type ScQueryStub struct {
-	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
+	ExecuteQueryCalled                 func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTraceCalled        func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
+	ComputeScCallGasLimitCalled        func(tx *transaction.Transaction) (uint64, error)
+	ComputeScCallGasLimitInBlockCalled func(tx *transaction.Transaction, coordinates process.BlockCoordinates) (uint64, error)
 }
 
 // ExecuteQuery -
@@ -20,6 +23,14 @@ func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput,
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueryWithTrace -
+func (s *ScQueryStub) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if s.ExecuteQueryWithTraceCalled != nil {
+		return s.ExecuteQueryWithTraceCalled(query)
+	}
+	return &vmcommon.VMOutput{}, &tracing.ExecutionTrace{}, nil
+}
+
 // ComputeScCallGasLimit --
 func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if s.ComputeScCallGasLimitCalled != nil {
@@ -28,6 +39,14 @@ func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64
 	return 100, nil
 }
 
+// ComputeScCallGasLimitInBlock -
+func (s *ScQueryStub) ComputeScCallGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (uint64, error) {
+	if s.ComputeScCallGasLimitInBlockCalled != nil {
+		return s.ComputeScCallGasLimitInBlockCalled(tx, coordinates)
+	}
+	return 100, nil
+}
+
 // IsInterfaceNil -
 func (s *ScQueryStub) IsInterfaceNil() bool {
 	return s == nil
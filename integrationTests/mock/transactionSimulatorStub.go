@@ -7,7 +7,8 @@ import (
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled  func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxsCalled func(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +20,15 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tx
 	return nil, nil
 }
 
+// ProcessTxs -
+func (tss *TransactionSimulatorStub) ProcessTxs(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error) {
+	if tss.ProcessTxsCalled != nil {
+		return tss.ProcessTxsCalled(txs, stopOnFailure)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
@@ -8,8 +8,10 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExecTransaction_SelfTransactionShouldWork(t *testing.T) {
@@ -203,3 +205,108 @@ func TestExecTransaction_MoreTransactionsMoreIterationsWithRevertShouldWork(t *t
 		testExecTransactionsMoreTxWithRevert(t, accnts, sender, receiver, initialHash, nonce, initialBalance)
 	}
 }
+
+func TestExecTransaction_ChainedTransactionsInSimulationSessionShouldNotAlterState(t *testing.T) {
+	t.Parallel()
+
+	trieStorage, _ := integrationTests.CreateTrieStorageManager(integrationTests.CreateMemUnit())
+	accnts, _ := integrationTests.CreateAccountsDB(0, trieStorage)
+	scratchAccnts, _ := integrationTests.CreateAccountsDB(0, trieStorage)
+	simulationAccnts, err := txsimulator.NewReadOnlyAccountsDBWithSessions(accnts, scratchAccnts)
+	require.Nil(t, err)
+	txProcessor := integrationTests.CreateSimpleTxProcessor(simulationAccnts)
+
+	nonce := uint64(6)
+	balance := big.NewInt(10000)
+	sender := integrationTests.CreateAccount(accnts, nonce, balance)
+	receiver := integrationTests.CreateRandomBytes(32)
+	rootHash, _ := accnts.Commit()
+
+	err = simulationAccnts.StartSession()
+	require.Nil(t, err)
+
+	for i := uint64(0); i < 2; i++ {
+		tx := &transaction.Transaction{
+			Nonce:    nonce + i,
+			Value:    big.NewInt(100),
+			GasLimit: 2,
+			GasPrice: 1,
+			SndAddr:  sender,
+			RcvAddr:  receiver,
+		}
+		_, err = txProcessor.ProcessTransaction(tx)
+		require.Nil(t, err)
+	}
+
+	receiverAccount, _ := simulationAccnts.GetExistingAccount(receiver)
+	require.Equal(t, big.NewInt(200), receiverAccount.(state.UserAccountHandler).GetBalance())
+
+	simulationAccnts.EndSession()
+
+	_, err = simulationAccnts.GetExistingAccount(receiver)
+	require.NotNil(t, err)
+	senderAccount, _ := accnts.LoadAccount(sender)
+	require.Equal(t, nonce, senderAccount.(state.UserAccountHandler).GetNonce())
+	require.Equal(t, balance, senderAccount.(state.UserAccountHandler).GetBalance())
+	currentRootHash, _ := accnts.RootHash()
+	require.Equal(t, rootHash, currentRootHash)
+}
+
+func TestExecTransaction_RecordStateChangesInSimulationSession(t *testing.T) {
+	t.Parallel()
+
+	trieStorage, _ := integrationTests.CreateTrieStorageManager(integrationTests.CreateMemUnit())
+	accnts, _ := integrationTests.CreateAccountsDB(0, trieStorage)
+	scratchAccnts, _ := integrationTests.CreateAccountsDB(0, trieStorage)
+	simulationAccnts, _ := txsimulator.NewReadOnlyAccountsDBWithSessions(accnts, scratchAccnts)
+	txProcessor := integrationTests.CreateSimpleTxProcessor(simulationAccnts)
+
+	nonce := uint64(6)
+	balance := big.NewInt(10000)
+	sender := integrationTests.CreateAccount(accnts, nonce, balance)
+	receiver := integrationTests.CreateRandomBytes(32)
+	_, _ = accnts.Commit()
+
+	err := simulationAccnts.StartSession()
+	require.Nil(t, err)
+	defer simulationAccnts.EndSession()
+
+	simulationAccnts.StartRecordingStateChanges()
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(100),
+		GasLimit: 2,
+		GasPrice: 1,
+		SndAddr:  sender,
+		RcvAddr:  receiver,
+	}
+	_, err = txProcessor.ProcessTransaction(tx)
+	require.Nil(t, err)
+
+	storageKey := []byte("storage key")
+	account, _ := simulationAccnts.LoadAccount(receiver)
+	_ = account.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue(storageKey, []byte("value"))
+	err = simulationAccnts.SaveAccount(account)
+	require.Nil(t, err)
+
+	changes, err := simulationAccnts.StopRecordingStateChanges()
+	require.Nil(t, err)
+	require.Len(t, changes, 2)
+
+	senderChanges := changes[0]
+	require.Equal(t, sender, senderChanges.Address)
+	require.Equal(t, balance, senderChanges.BalanceBefore)
+	require.Equal(t, big.NewInt(10000-100-2), senderChanges.BalanceAfter)
+	require.Equal(t, nonce, senderChanges.NonceBefore)
+	require.Equal(t, nonce+1, senderChanges.NonceAfter)
+	require.Empty(t, senderChanges.DataChanges)
+
+	receiverChanges := changes[1]
+	require.Equal(t, receiver, receiverChanges.Address)
+	require.Equal(t, big.NewInt(0), receiverChanges.BalanceBefore)
+	require.Equal(t, big.NewInt(100), receiverChanges.BalanceAfter)
+	require.Len(t, receiverChanges.DataChanges, 1)
+	require.Equal(t, storageKey, receiverChanges.DataChanges[0].Key)
+	require.Empty(t, receiverChanges.DataChanges[0].Before)
+	require.Equal(t, []byte("value"), receiverChanges.DataChanges[0].After)
+}
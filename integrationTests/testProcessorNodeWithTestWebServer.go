@@ -90,11 +90,12 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 			TrieOperationsDeadlineMilliseconds: 1,
 			EndpointsThrottlers:                []config.EndpointsThrottlersConfig{},
 		},
-		FacadeConfig:    config.FacadeConfig{},
-		ApiRoutesConfig: createTestApiConfig(),
-		AccountsState:   tpn.AccntState,
-		PeerState:       tpn.PeerState,
-		Blockchain:      tpn.BlockChain,
+		FacadeConfig:         config.FacadeConfig{},
+		ApiRoutesConfig:      createTestApiConfig(),
+		AccountsState:        tpn.AccntState,
+		PeerState:            tpn.PeerState,
+		Blockchain:           tpn.BlockChain,
+		SubscriptionsHandler: &testscommon.SubscriptionsHandlerStub{},
 	}
 }
 
@@ -107,7 +108,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool"},
+		"transaction": {"/send", "/simulate", "/simulate-multiple", "/send-multiple", "/cost", "/:txhash", "/pool"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
 
@@ -171,6 +172,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		tpn.ShardCoordinator,
 		tpn.EpochNotifier,
 		0,
+		&mock.ScQueryStub{},
 	)
 	log.LogIfError(err)
 
@@ -270,6 +272,9 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		SessionHandler:            &testscommon.SimulationSessionHandlerStub{},
+		FeeHandler:                tpn.EconomicsData,
+		ExecutionTracer:           &testscommon.ExecutionTracerStub{},
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
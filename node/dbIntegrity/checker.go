@@ -0,0 +1,556 @@
+package dbIntegrity
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+var log = logger.GetOrCreate("node/dbIntegrity")
+
+const (
+	maxNumMissingKeys   = 10000
+	progressLogInterval = 1000
+)
+
+// MissingKey holds a key that should have been found in a storage unit
+type MissingKey struct {
+	Unit        dataRetriever.UnitType
+	Key         []byte
+	Epoch       uint32
+	HeaderNonce uint64
+	// RequestShardID is the shard that is paired with the own shard on the topic used to request the missing data
+	RequestShardID uint32
+	Refetched      bool
+}
+
+// ArgsDbIntegrityChecker holds the arguments needed to create a database integrity checker
+type ArgsDbIntegrityChecker struct {
+	StorageService           dataRetriever.StorageService
+	UserAccountsTrieStorage  common.DBWriteCacher
+	PeerAccountsTrieStorage  common.DBWriteCacher
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	Refetcher                MissingDataRefetcher
+	ShardID                  uint32
+}
+
+// ArgsCheck holds the options of a database integrity check
+type ArgsCheck struct {
+	// StartHeaderHash is the hash of the newest header to be checked, the check walking backwards from it
+	StartHeaderHash []byte
+	// NumEpochs is the number of epochs to be checked, including the epoch of the start header
+	NumEpochs uint32
+	// CheckAllRootHashes should be set only when the tries are not pruned. Otherwise, only the root hashes of the
+	// start header and of the start of epoch headers are checked, since these are the only states kept by a node
+	CheckAllRootHashes bool
+	// Refetch enables requesting the missing data from peers, once the walk is done
+	Refetch bool
+}
+
+type dbIntegrityChecker struct {
+	store                    dataRetriever.StorageService
+	userAccountsTrieStorage  common.DBWriteCacher
+	peerAccountsTrieStorage  common.DBWriteCacher
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	refetcher                MissingDataRefetcher
+	shardID                  uint32
+	headerUnit               dataRetriever.UnitType
+	nonceHashUnit            dataRetriever.UnitType
+	emptyReceiptsHash        []byte
+}
+
+// NewDbIntegrityChecker creates a component able to find the data missing from the storage of a node. It walks the
+// headers of the last epochs and checks that their miniblocks, transactions, receipts and state tries are stored
+func NewDbIntegrityChecker(args ArgsDbIntegrityChecker) (*dbIntegrityChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	emptyReceiptsHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, &batch.Batch{Data: make([][]byte, 0)})
+	if err != nil {
+		return nil, err
+	}
+
+	dic := &dbIntegrityChecker{
+		store:                    args.StorageService,
+		userAccountsTrieStorage:  args.UserAccountsTrieStorage,
+		peerAccountsTrieStorage:  args.PeerAccountsTrieStorage,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		refetcher:                args.Refetcher,
+		shardID:                  args.ShardID,
+		headerUnit:               dataRetriever.BlockHeaderUnit,
+		nonceHashUnit:            dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(args.ShardID),
+		emptyReceiptsHash:        emptyReceiptsHash,
+	}
+	if args.ShardID == core.MetachainShardId {
+		dic.headerUnit = dataRetriever.MetaBlockUnit
+		dic.nonceHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	requiredUnits := []dataRetriever.UnitType{
+		dic.headerUnit,
+		dic.nonceHashUnit,
+		dataRetriever.MiniBlockUnit,
+		dataRetriever.TransactionUnit,
+		dataRetriever.UnsignedTransactionUnit,
+		dataRetriever.RewardTransactionUnit,
+		dataRetriever.ReceiptsUnit,
+	}
+	for _, unit := range requiredUnits {
+		if check.IfNil(args.StorageService.GetStorer(unit)) {
+			return nil, fmt.Errorf("%w for unit %s", ErrMissingStorer, unit.String())
+		}
+	}
+
+	return dic, nil
+}
+
+func checkArgs(args ArgsDbIntegrityChecker) error {
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.UserAccountsTrieStorage) {
+		return fmt.Errorf("%w for the user accounts", ErrNilTrieStorage)
+	}
+	if check.IfNil(args.PeerAccountsTrieStorage) {
+		return fmt.Errorf("%w for the peer accounts", ErrNilTrieStorage)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(args.Refetcher) {
+		return ErrNilMissingDataRefetcher
+	}
+
+	return nil
+}
+
+// Check walks the headers, starting with the provided one, until all the requested epochs were checked, and returns
+// the keys missing from the storage. A missing trie node hides its whole subtree, so the check should be run again
+// after the missing trie nodes were refetched
+func (dic *dbIntegrityChecker) Check(ctx context.Context, args ArgsCheck) (*common.DbIntegrityReport, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if len(args.StartHeaderHash) == 0 {
+		return nil, ErrEmptyStartHeaderHash
+	}
+	if args.NumEpochs == 0 {
+		return nil, ErrInvalidNumEpochs
+	}
+
+	run, err := dic.newCheckRun(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = run.walkHeaders(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Refetch && len(run.missingKeys) > 0 {
+		numRefetched := dic.refetcher.Refetch(ctx, run.missingKeys)
+		run.report.NumRefetched = uint64(numRefetched)
+	}
+
+	run.report.MissingKeys = make([]common.DbIntegrityMissingKey, 0, len(run.missingKeys))
+	for _, missingKey := range run.missingKeys {
+		run.report.MissingKeys = append(run.report.MissingKeys, common.DbIntegrityMissingKey{
+			Unit:        missingKey.Unit.String(),
+			Key:         hex.EncodeToString(missingKey.Key),
+			Epoch:       missingKey.Epoch,
+			HeaderNonce: missingKey.HeaderNonce,
+			Refetched:   missingKey.Refetched,
+		})
+	}
+
+	log.Info("database integrity check finished",
+		"first epoch", run.report.FirstEpoch,
+		"last epoch", run.report.LastEpoch,
+		"num headers", run.report.NumHeaders,
+		"num trie nodes", run.report.NumTrieNodes,
+		"num missing keys", len(run.missingKeys),
+		"num refetched", run.report.NumRefetched,
+	)
+
+	return run.report, nil
+}
+
+// checkRun holds the state of a single check, so that the checker can be used for several checks
+type checkRun struct {
+	*dbIntegrityChecker
+	ctx                 context.Context
+	report              *common.DbIntegrityReport
+	missingKeys         []*MissingKey
+	userAccountsChecker TrieIntegrityChecker
+	peerAccountsChecker TrieIntegrityChecker
+	currentNonce        uint64
+	currentEpoch        uint32
+}
+
+func (dic *dbIntegrityChecker) newCheckRun(ctx context.Context) (*checkRun, error) {
+	userAccountsChecker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		DB:          dic.userAccountsTrieStorage,
+		Marshalizer: dic.marshalizer,
+		Hasher:      dic.hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+	peerAccountsChecker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		DB:          dic.peerAccountsTrieStorage,
+		Marshalizer: dic.marshalizer,
+		Hasher:      dic.hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkRun{
+		dbIntegrityChecker:  dic,
+		ctx:                 ctx,
+		report:              &common.DbIntegrityReport{},
+		missingKeys:         make([]*MissingKey, 0),
+		userAccountsChecker: userAccountsChecker,
+		peerAccountsChecker: peerAccountsChecker,
+	}, nil
+}
+
+func (run *checkRun) walkHeaders(args ArgsCheck) error {
+	header, err := run.getStartHeader(args.StartHeaderHash)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrStartHeaderNotFound, err)
+	}
+
+	firstEpoch := uint32(0)
+	if header.GetEpoch()+1 > args.NumEpochs {
+		firstEpoch = header.GetEpoch() + 1 - args.NumEpochs
+	}
+	run.report.LastEpoch = header.GetEpoch()
+	run.report.LastNonce = header.GetNonce()
+
+	log.Info("starting database integrity check", "shard", run.shardID, "first epoch", firstEpoch,
+		"last epoch", header.GetEpoch(), "start nonce", header.GetNonce())
+
+	shouldCheckRootHashes := true
+	for {
+		if run.isContextDone() {
+			return fmt.Errorf("%w at nonce %d", ErrCheckInterrupted, header.GetNonce())
+		}
+
+		err = run.checkHeader(header, shouldCheckRootHashes || header.IsStartOfEpochBlock())
+		if err != nil {
+			return fmt.Errorf("%w while checking the header with nonce %d", err, header.GetNonce())
+		}
+		shouldCheckRootHashes = args.CheckAllRootHashes
+
+		if run.report.NumHeaders%progressLogInterval == 0 {
+			log.Info("database integrity check in progress", "nonce", header.GetNonce(), "epoch", header.GetEpoch(),
+				"num missing keys", len(run.missingKeys))
+		}
+
+		// the genesis block is not checked, as it is not the result of processing
+		if header.GetNonce() <= 1 {
+			return nil
+		}
+
+		header, err = run.getPreviousHeader(header)
+		if err != nil {
+			log.Warn("database integrity check stopped before reaching the first epoch", "error", err.Error())
+			run.report.Error = err.Error()
+			return nil
+		}
+		if header.GetEpoch() < firstEpoch {
+			return nil
+		}
+	}
+}
+
+func (run *checkRun) checkHeader(header data.HeaderHandler, shouldCheckRootHashes bool) error {
+	run.currentNonce = header.GetNonce()
+	run.currentEpoch = header.GetEpoch()
+	run.report.NumHeaders++
+	run.report.FirstEpoch = header.GetEpoch()
+	run.report.FirstNonce = header.GetNonce()
+
+	nonceBytes := run.uint64ByteSliceConverter.ToByteSlice(header.GetNonce())
+	_, err := run.store.GetStorer(run.nonceHashUnit).Get(nonceBytes)
+	if err != nil {
+		run.addMissingKey(run.nonceHashUnit, nonceBytes, run.shardID)
+	}
+
+	run.checkReceipts(header)
+
+	err = run.checkMiniBlocks(header)
+	if err != nil {
+		return err
+	}
+
+	if !shouldCheckRootHashes {
+		return nil
+	}
+
+	err = run.checkTrie(run.userAccountsChecker, dataRetriever.UserAccountsUnit, header.GetRootHash(), run.checkDataTrie)
+	if err != nil {
+		return err
+	}
+
+	metaHeader, isMetaHeader := header.(data.MetaHeaderHandler)
+	if !isMetaHeader {
+		return nil
+	}
+
+	return run.checkTrie(run.peerAccountsChecker, dataRetriever.PeerAccountsUnit, metaHeader.GetValidatorStatsRootHash(), noOpLeafHandler)
+}
+
+// checkReceipts checks the receipts of the header, which are saved under the receipts hash. When the receipts hash is
+// the one of the empty receipts, they are saved under the header hash only if the block has intra shard miniblocks,
+// so these can not be checked
+func (run *checkRun) checkReceipts(header data.HeaderHandler) {
+	receiptsHash := header.GetReceiptsHash()
+	if len(receiptsHash) == 0 || bytes.Equal(receiptsHash, run.emptyReceiptsHash) {
+		return
+	}
+
+	_, err := run.getFromStorage(dataRetriever.ReceiptsUnit, receiptsHash, header.GetEpoch())
+	if err != nil {
+		run.addMissingKey(dataRetriever.ReceiptsUnit, receiptsHash, run.shardID)
+	}
+}
+
+func (run *checkRun) checkMiniBlocks(header data.HeaderHandler) error {
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		requestShardID := run.getRequestShardID(miniBlockHeader.GetSenderShardID(), miniBlockHeader.GetReceiverShardID())
+		miniBlockHash := miniBlockHeader.GetHash()
+
+		buff, err := run.getFromStorage(dataRetriever.MiniBlockUnit, miniBlockHash, header.GetEpoch())
+		if err != nil {
+			run.addMissingKey(dataRetriever.MiniBlockUnit, miniBlockHash, requestShardID)
+			continue
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = run.marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return fmt.Errorf("%w while unmarshalling miniblock %s", err, hex.EncodeToString(miniBlockHash))
+		}
+		run.report.NumMiniBlocks++
+
+		txUnit, hasTransactions := getTransactionsUnit(miniBlock.Type)
+		if !hasTransactions {
+			continue
+		}
+
+		run.checkTransactions(txUnit, miniBlock.TxHashes, header.GetEpoch(), requestShardID)
+	}
+
+	return nil
+}
+
+func (run *checkRun) checkTransactions(unit dataRetriever.UnitType, txHashes [][]byte, epoch uint32, requestShardID uint32) {
+	run.report.NumTransactions += uint64(len(txHashes))
+
+	found := make(map[string]struct{}, len(txHashes))
+	pairs, err := run.store.GetStorer(unit).GetBulkFromEpoch(txHashes, epoch)
+	if err == nil {
+		for _, pair := range pairs {
+			found[string(pair.Key)] = struct{}{}
+		}
+	}
+
+	for _, txHash := range txHashes {
+		_, isFound := found[string(txHash)]
+		if isFound {
+			continue
+		}
+
+		_, err = run.getFromStorage(unit, txHash, epoch)
+		if err != nil {
+			run.addMissingKey(unit, txHash, requestShardID)
+		}
+	}
+}
+
+func (run *checkRun) checkTrie(
+	checker TrieIntegrityChecker,
+	unit dataRetriever.UnitType,
+	rootHash []byte,
+	leafHandler func(value []byte) error,
+) error {
+	run.report.NumRootHashes++
+	numChecked, err := checker.Check(run.ctx, rootHash, leafHandler, func(hash []byte) error {
+		run.addMissingKey(unit, hash, run.shardID)
+		return nil
+	})
+	run.report.NumTrieNodes += numChecked
+
+	return err
+}
+
+// checkDataTrie checks the data trie of the account found in a leaf of the user accounts trie
+func (run *checkRun) checkDataTrie(accountBytes []byte) error {
+	account := &state.UserAccountData{}
+	err := run.marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return err
+	}
+	if len(account.RootHash) == 0 {
+		return nil
+	}
+
+	numChecked, err := run.userAccountsChecker.Check(run.ctx, account.RootHash, noOpLeafHandler, func(hash []byte) error {
+		run.addMissingKey(dataRetriever.UserAccountsUnit, hash, run.shardID)
+		return nil
+	})
+	run.report.NumTrieNodes += numChecked
+
+	return err
+}
+
+func (run *checkRun) getPreviousHeader(header data.HeaderHandler) (data.HeaderHandler, error) {
+	prevNonce := header.GetNonce() - 1
+	prevHash := header.GetPrevHash()
+	prevHeader, err := run.getHeader(prevHash, header.GetEpoch())
+	if err == nil {
+		return prevHeader, nil
+	}
+
+	run.currentNonce = prevNonce
+	run.addMissingKey(run.headerUnit, prevHash, run.shardID)
+
+	// the header might still be found under the hash saved in the nonce-hash mapping, which allows the walk to continue
+	hash, errMapping := run.store.GetStorer(run.nonceHashUnit).Get(run.uint64ByteSliceConverter.ToByteSlice(prevNonce))
+	if errMapping != nil || bytes.Equal(hash, prevHash) {
+		return nil, fmt.Errorf("%w: missing header with nonce %d", ErrCheckInterrupted, prevNonce)
+	}
+
+	prevHeader, err = run.getHeader(hash, header.GetEpoch())
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing header with nonce %d", ErrCheckInterrupted, prevNonce)
+	}
+
+	return prevHeader, nil
+}
+
+func (run *checkRun) getStartHeader(headerHash []byte) (data.HeaderHandler, error) {
+	buff, err := run.store.GetStorer(run.headerUnit).SearchFirst(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(run.shardID, run.marshalizer, buff)
+}
+
+// getHeader searches the header in the epoch of the next header and in the one before it, as the previous header of
+// a start of epoch block belongs to the previous epoch
+func (run *checkRun) getHeader(headerHash []byte, nextHeaderEpoch uint32) (data.HeaderHandler, error) {
+	buff, err := run.getFromStorage(run.headerUnit, headerHash, nextHeaderEpoch)
+	if err != nil && nextHeaderEpoch > 0 {
+		buff, err = run.getFromStorage(run.headerUnit, headerHash, nextHeaderEpoch-1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(run.shardID, run.marshalizer, buff)
+}
+
+// getFromStorage searches the key in the persister of the provided epoch first and then in all the active persisters
+func (run *checkRun) getFromStorage(unit dataRetriever.UnitType, key []byte, epoch uint32) ([]byte, error) {
+	storer := run.store.GetStorer(unit)
+	buff, err := storer.GetFromEpoch(key, epoch)
+	if err == nil {
+		return buff, nil
+	}
+
+	return storer.SearchFirst(key)
+}
+
+func (run *checkRun) addMissingKey(unit dataRetriever.UnitType, key []byte, requestShardID uint32) {
+	if len(run.missingKeys) >= maxNumMissingKeys {
+		run.report.Truncated = true
+		return
+	}
+
+	log.Debug("database integrity check: missing key", "unit", unit.String(), "key", key, "nonce", run.currentNonce)
+	run.missingKeys = append(run.missingKeys, &MissingKey{
+		Unit:           unit,
+		Key:            key,
+		Epoch:          run.currentEpoch,
+		HeaderNonce:    run.currentNonce,
+		RequestShardID: requestShardID,
+	})
+}
+
+// getRequestShardID returns the shard that is paired with the own shard on the topic of a miniblock
+func (run *checkRun) getRequestShardID(senderShardID uint32, receiverShardID uint32) uint32 {
+	if senderShardID != run.shardID {
+		return senderShardID
+	}
+	if receiverShardID == core.AllShardId {
+		return run.shardID
+	}
+
+	return receiverShardID
+}
+
+func (run *checkRun) isContextDone() bool {
+	select {
+	case <-run.ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func getTransactionsUnit(miniBlockType block.Type) (dataRetriever.UnitType, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+func noOpLeafHandler(_ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dic *dbIntegrityChecker) IsInterfaceNil() bool {
+	return dic == nil
+}
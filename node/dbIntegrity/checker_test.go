@@ -0,0 +1,394 @@
+package dbIntegrity_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity/disabled"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/portableSnapshot"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshalizer = &marshal.GogoProtoMarshalizer{}
+	testHasher      = sha256.NewSha256()
+)
+
+type testChain struct {
+	store        *genericMocks.ChainStorerMock
+	trieStorage  *memorydb.DB
+	headerHashes [][]byte
+	headers      []*block.Header
+	rootHash     []byte
+	dataRootHash []byte
+}
+
+type refetcherStub struct {
+	refetchCalled func(ctx context.Context, missingKeys []*dbIntegrity.MissingKey) int
+}
+
+func (rs *refetcherStub) Refetch(ctx context.Context, missingKeys []*dbIntegrity.MissingKey) int {
+	if rs.refetchCalled != nil {
+		return rs.refetchCalled(ctx, missingKeys)
+	}
+
+	return 0
+}
+
+func (rs *refetcherStub) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+func createAccountsState(t *testing.T, trieStorage *memorydb.DB) ([]byte, []byte) {
+	accounts, err := portableSnapshot.CreateAccountsAdapter(portableSnapshot.ArgsAccountsAdapter{
+		MainStorer:        trieStorage,
+		CheckpointsStorer: memorydb.New(),
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+	})
+	require.Nil(t, err)
+
+	for i := 0; i < 10; i++ {
+		account, errLoad := accounts.LoadAccount(testHasher.Compute(fmt.Sprintf("address%d", i)))
+		require.Nil(t, errLoad)
+
+		userAccount := account.(state.UserAccountHandler)
+		userAccount.IncreaseNonce(uint64(i))
+		if i == 0 {
+			require.Nil(t, userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value")))
+		}
+		require.Nil(t, accounts.SaveAccount(userAccount))
+	}
+
+	rootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	account, err := accounts.GetExistingAccount(testHasher.Compute("address0"))
+	require.Nil(t, err)
+
+	return rootHash, account.(state.UserAccountHandler).GetRootHash()
+}
+
+// createTestChain saves numHeaders shard headers, one per epoch, each with a miniblock holding two transactions
+func createTestChain(t *testing.T, numHeaders int) *testChain {
+	chain := &testChain{
+		store:        genericMocks.NewChainStorerMock(uint32(numHeaders - 1)),
+		trieStorage:  memorydb.New(),
+		headerHashes: make([][]byte, 0, numHeaders),
+		headers:      make([]*block.Header, 0, numHeaders),
+	}
+	chain.rootHash, chain.dataRootHash = createAccountsState(t, chain.trieStorage)
+
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	prevHash := []byte("genesis hash")
+	for i := 1; i <= numHeaders; i++ {
+		epoch := uint32(i - 1)
+		txHashes := [][]byte{[]byte(fmt.Sprintf("tx%d-0", i)), []byte(fmt.Sprintf("tx%d-1", i))}
+		for _, txHash := range txHashes {
+			_ = chain.store.Transactions.PutInEpoch(txHash, []byte("tx"), epoch)
+		}
+		miniBlock := &block.MiniBlock{TxHashes: txHashes, Type: block.TxBlock}
+		miniBlockBytes, _ := testMarshalizer.Marshal(miniBlock)
+		miniBlockHash := testHasher.Compute(string(miniBlockBytes))
+		_ = chain.store.Miniblocks.PutInEpoch(miniBlockHash, miniBlockBytes, epoch)
+
+		receiptsHash := []byte(fmt.Sprintf("receipts%d", i))
+		_ = chain.store.Receipts.PutInEpoch(receiptsHash, []byte("receipts"), epoch)
+
+		header := &block.Header{
+			Nonce:        uint64(i),
+			Epoch:        epoch,
+			PrevHash:     prevHash,
+			RootHash:     chain.rootHash,
+			ReceiptsHash: receiptsHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: miniBlockHash, TxCount: 2},
+			},
+		}
+		headerBytes, _ := testMarshalizer.Marshal(header)
+		headerHash := testHasher.Compute(string(headerBytes))
+		_ = chain.store.BlockHeaders.PutInEpoch(headerHash, headerBytes, epoch)
+		_ = chain.store.ShardHdrNonce.Put(converter.ToByteSlice(uint64(i)), headerHash)
+
+		chain.headers = append(chain.headers, header)
+		chain.headerHashes = append(chain.headerHashes, headerHash)
+		prevHash = headerHash
+	}
+
+	return chain
+}
+
+func createCheckerArgs(chain *testChain) dbIntegrity.ArgsDbIntegrityChecker {
+	return dbIntegrity.ArgsDbIntegrityChecker{
+		StorageService:           chain.store,
+		UserAccountsTrieStorage:  chain.trieStorage,
+		PeerAccountsTrieStorage:  memorydb.New(),
+		Marshalizer:              testMarshalizer,
+		Hasher:                   testHasher,
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		Refetcher:                disabled.NewDisabledMissingDataRefetcher(),
+		ShardID:                  0,
+	}
+}
+
+func getMissingKeysByUnit(report *common.DbIntegrityReport) map[string]int {
+	missingKeysByUnit := make(map[string]int)
+	for _, missingKey := range report.MissingKeys {
+		missingKeysByUnit[missingKey.Unit]++
+	}
+
+	return missingKeysByUnit
+}
+
+func TestNewDbIntegrityChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.StorageService = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, dbIntegrity.ErrNilStorageService, err)
+	})
+	t.Run("nil user accounts trie storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.UserAccountsTrieStorage = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.True(t, errors.Is(err, dbIntegrity.ErrNilTrieStorage))
+	})
+	t.Run("nil peer accounts trie storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.PeerAccountsTrieStorage = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.True(t, errors.Is(err, dbIntegrity.ErrNilTrieStorage))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.Marshalizer = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.Hasher = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.Uint64ByteSliceConverter = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, dbIntegrity.ErrNilUint64ByteSliceConverter, err)
+	})
+	t.Run("nil refetcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.Refetcher = nil
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, dbIntegrity.ErrNilMissingDataRefetcher, err)
+	})
+	t.Run("missing storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckerArgs(createTestChain(t, 1))
+		args.StorageService = &storageStubs.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return nil
+			},
+		}
+		checker, err := dbIntegrity.NewDbIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.True(t, errors.Is(err, dbIntegrity.ErrMissingStorer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(createTestChain(t, 1)))
+		assert.Nil(t, err)
+		assert.False(t, checker.IsInterfaceNil())
+	})
+}
+
+func TestDbIntegrityChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 1)
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(chain))
+
+		_, err := checker.Check(nil, dbIntegrity.ArgsCheck{StartHeaderHash: chain.headerHashes[0], NumEpochs: 1}) //nolint
+		assert.Equal(t, dbIntegrity.ErrNilContext, err)
+
+		_, err = checker.Check(context.Background(), dbIntegrity.ArgsCheck{NumEpochs: 1})
+		assert.Equal(t, dbIntegrity.ErrEmptyStartHeaderHash, err)
+
+		_, err = checker.Check(context.Background(), dbIntegrity.ArgsCheck{StartHeaderHash: chain.headerHashes[0]})
+		assert.Equal(t, dbIntegrity.ErrInvalidNumEpochs, err)
+	})
+	t.Run("missing start header should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(createTestChain(t, 1)))
+		_, err := checker.Check(context.Background(), dbIntegrity.ArgsCheck{StartHeaderHash: []byte("missing"), NumEpochs: 1})
+		assert.True(t, errors.Is(err, dbIntegrity.ErrStartHeaderNotFound))
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(chain))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := checker.Check(ctx, dbIntegrity.ArgsCheck{StartHeaderHash: chain.headerHashes[2], NumEpochs: 3})
+		assert.True(t, errors.Is(err, dbIntegrity.ErrCheckInterrupted))
+	})
+	t.Run("complete storage should not report missing keys", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(chain))
+
+		report, err := checker.Check(context.Background(), dbIntegrity.ArgsCheck{
+			StartHeaderHash:    chain.headerHashes[2],
+			NumEpochs:          10,
+			CheckAllRootHashes: true,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, report.MissingKeys)
+		assert.Empty(t, report.Error)
+		assert.False(t, report.Truncated)
+		assert.Equal(t, uint64(3), report.NumHeaders)
+		assert.Equal(t, uint64(3), report.NumMiniBlocks)
+		assert.Equal(t, uint64(6), report.NumTransactions)
+		assert.Equal(t, uint64(3), report.NumRootHashes)
+		assert.True(t, report.NumTrieNodes > 0)
+		assert.Equal(t, uint32(0), report.FirstEpoch)
+		assert.Equal(t, uint32(2), report.LastEpoch)
+		assert.Equal(t, uint64(1), report.FirstNonce)
+		assert.Equal(t, uint64(3), report.LastNonce)
+	})
+	t.Run("should check only the requested epochs", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 5)
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(chain))
+
+		report, err := checker.Check(context.Background(), dbIntegrity.ArgsCheck{StartHeaderHash: chain.headerHashes[4], NumEpochs: 2})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), report.NumHeaders)
+		assert.Equal(t, uint32(3), report.FirstEpoch)
+		assert.Equal(t, uint64(1), report.NumRootHashes, "only the start header root hash should have been checked")
+	})
+	t.Run("should report the missing keys", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		converter := uint64ByteSlice.NewBigEndianConverter()
+		chain.store.Transactions.GetEpochData(1).Remove("tx2-1")
+		chain.store.Miniblocks.GetEpochData(0).Remove(string(chain.headers[0].MiniBlockHeaders[0].Hash))
+		chain.store.Receipts.GetEpochData(2).Remove("receipts3")
+		chain.store.ShardHdrNonce.GetCurrentEpochData().Remove(string(converter.ToByteSlice(2)))
+		_ = chain.trieStorage.Remove(chain.dataRootHash)
+
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(chain))
+		report, err := checker.Check(context.Background(), dbIntegrity.ArgsCheck{StartHeaderHash: chain.headerHashes[2], NumEpochs: 10})
+		require.Nil(t, err)
+
+		expectedMissingKeysByUnit := map[string]int{
+			dataRetriever.TransactionUnit.String():           1,
+			dataRetriever.MiniBlockUnit.String():             1,
+			dataRetriever.ReceiptsUnit.String():              1,
+			dataRetriever.ShardHdrNonceHashDataUnit.String(): 1,
+			dataRetriever.UserAccountsUnit.String():          1,
+		}
+		assert.Equal(t, expectedMissingKeysByUnit, getMissingKeysByUnit(report))
+		for _, missingKey := range report.MissingKeys {
+			if missingKey.Unit == dataRetriever.TransactionUnit.String() {
+				assert.Equal(t, common.DbIntegrityMissingKey{
+					Unit:        dataRetriever.TransactionUnit.String(),
+					Key:         fmt.Sprintf("%x", "tx2-1"),
+					Epoch:       1,
+					HeaderNonce: 2,
+				}, missingKey)
+			}
+		}
+	})
+	t.Run("missing header should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		chain.store.BlockHeaders.GetEpochData(1).Remove(string(chain.headerHashes[1]))
+
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(createCheckerArgs(chain))
+		report, err := checker.Check(context.Background(), dbIntegrity.ArgsCheck{StartHeaderHash: chain.headerHashes[2], NumEpochs: 10})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumHeaders)
+		assert.NotEmpty(t, report.Error)
+		require.Equal(t, 1, len(report.MissingKeys))
+		assert.Equal(t, dataRetriever.BlockHeaderUnit.String(), report.MissingKeys[0].Unit)
+		assert.Equal(t, uint64(2), report.MissingKeys[0].HeaderNonce)
+	})
+	t.Run("refetch should call the refetcher", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 2)
+		chain.store.Transactions.GetEpochData(1).Remove("tx2-1")
+
+		args := createCheckerArgs(chain)
+		args.Refetcher = &refetcherStub{
+			refetchCalled: func(_ context.Context, missingKeys []*dbIntegrity.MissingKey) int {
+				require.Equal(t, 1, len(missingKeys))
+				missingKeys[0].Refetched = true
+				return 1
+			},
+		}
+		checker, _ := dbIntegrity.NewDbIntegrityChecker(args)
+		report, err := checker.Check(context.Background(), dbIntegrity.ArgsCheck{
+			StartHeaderHash: chain.headerHashes[1],
+			NumEpochs:       10,
+			Refetch:         true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumRefetched)
+		require.Equal(t, 1, len(report.MissingKeys))
+		assert.True(t, report.MissingKeys[0].Refetched)
+	})
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity"
+)
+
+type disabledMissingDataRefetcher struct{}
+
+// NewDisabledMissingDataRefetcher returns a new instance of disabledMissingDataRefetcher, to be used when the missing
+// data can not be requested from the network
+func NewDisabledMissingDataRefetcher() *disabledMissingDataRefetcher {
+	return &disabledMissingDataRefetcher{}
+}
+
+// Refetch returns 0 as this is a disabled component
+func (d *disabledMissingDataRefetcher) Refetch(_ context.Context, _ []*dbIntegrity.MissingKey) int {
+	return 0
+}
+
+// IsInterfaceNil returns true if the value under interface is nil
+func (d *disabledMissingDataRefetcher) IsInterfaceNil() bool {
+	return d == nil
+}
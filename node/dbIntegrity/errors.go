@@ -0,0 +1,42 @@
+package dbIntegrity
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilTrieStorage signals that a nil trie storage has been provided
+var ErrNilTrieStorage = errors.New("nil trie storage")
+
+// ErrMissingStorer signals that the storage service does not hold one of the needed storers
+var ErrMissingStorer = errors.New("missing storer")
+
+// ErrNilContext signals that a nil context has been provided
+var ErrNilContext = errors.New("nil context")
+
+// ErrNilUint64ByteSliceConverter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64ByteSliceConverter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilMissingDataRefetcher signals that a nil missing data refetcher has been provided
+var ErrNilMissingDataRefetcher = errors.New("nil missing data refetcher")
+
+// ErrNilRequestHandler signals that a nil request handler has been provided
+var ErrNilRequestHandler = errors.New("nil request handler")
+
+// ErrNilDataPool signals that a nil data pool has been provided
+var ErrNilDataPool = errors.New("nil data pool")
+
+// ErrInvalidNumEpochs signals that an invalid number of epochs has been provided
+var ErrInvalidNumEpochs = errors.New("invalid number of epochs")
+
+// ErrInvalidRefetchTimeout signals that an invalid refetch timeout has been provided
+var ErrInvalidRefetchTimeout = errors.New("invalid refetch timeout")
+
+// ErrEmptyStartHeaderHash signals that an empty start header hash has been provided
+var ErrEmptyStartHeaderHash = errors.New("empty start header hash")
+
+// ErrStartHeaderNotFound signals that the header from which the check should start could not be found
+var ErrStartHeaderNotFound = errors.New("start header not found")
+
+// ErrCheckInterrupted signals that the check was interrupted before walking all the headers
+var ErrCheckInterrupted = errors.New("database integrity check interrupted")
@@ -0,0 +1,16 @@
+package dbIntegrity
+
+import "context"
+
+// MissingDataRefetcher defines the component able to request the missing data from peers and to save it back in the
+// storage
+type MissingDataRefetcher interface {
+	Refetch(ctx context.Context, missingKeys []*MissingKey) int
+	IsInterfaceNil() bool
+}
+
+// TrieIntegrityChecker defines the component able to find the trie nodes missing from the storage
+type TrieIntegrityChecker interface {
+	Check(ctx context.Context, rootHash []byte, leafHandler func(value []byte) error, missingHandler func(hash []byte) error) (uint64, error)
+	IsInterfaceNil() bool
+}
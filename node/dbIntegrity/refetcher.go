@@ -0,0 +1,257 @@
+package dbIntegrity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+)
+
+const (
+	maxNumKeysPerRequest = 100
+	checkPoolInterval    = 100 * time.Millisecond
+)
+
+// ArgsMissingDataRefetcher holds the arguments needed to create a missing data refetcher
+type ArgsMissingDataRefetcher struct {
+	RequestHandler          process.RequestHandler
+	DataPool                dataRetriever.PoolsHolder
+	StorageService          dataRetriever.StorageService
+	UserAccountsTrieStorage common.DBWriteCacher
+	PeerAccountsTrieStorage common.DBWriteCacher
+	Marshalizer             marshal.Marshalizer
+	ShardID                 uint32
+	Timeout                 time.Duration
+}
+
+type missingDataRefetcher struct {
+	requestHandler          process.RequestHandler
+	dataPool                dataRetriever.PoolsHolder
+	store                   dataRetriever.StorageService
+	userAccountsTrieStorage common.DBWriteCacher
+	peerAccountsTrieStorage common.DBWriteCacher
+	marshalizer             marshal.Marshalizer
+	shardID                 uint32
+	timeout                 time.Duration
+}
+
+type requestKey struct {
+	unit           dataRetriever.UnitType
+	requestShardID uint32
+}
+
+// NewMissingDataRefetcher creates a component able to request the missing data through the resolvers. The received
+// data is taken from the data pools, where the interceptors put it, and saved in the storage units
+func NewMissingDataRefetcher(args ArgsMissingDataRefetcher) (*missingDataRefetcher, error) {
+	if check.IfNil(args.RequestHandler) {
+		return nil, ErrNilRequestHandler
+	}
+	if check.IfNil(args.DataPool) {
+		return nil, ErrNilDataPool
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.UserAccountsTrieStorage) {
+		return nil, fmt.Errorf("%w for the user accounts", ErrNilTrieStorage)
+	}
+	if check.IfNil(args.PeerAccountsTrieStorage) {
+		return nil, fmt.Errorf("%w for the peer accounts", ErrNilTrieStorage)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if args.Timeout <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRefetchTimeout, args.Timeout)
+	}
+
+	return &missingDataRefetcher{
+		requestHandler:          args.RequestHandler,
+		dataPool:                args.DataPool,
+		store:                   args.StorageService,
+		userAccountsTrieStorage: args.UserAccountsTrieStorage,
+		peerAccountsTrieStorage: args.PeerAccountsTrieStorage,
+		marshalizer:             args.Marshalizer,
+		shardID:                 args.ShardID,
+		timeout:                 args.Timeout,
+	}, nil
+}
+
+// Refetch requests the missing keys from peers and waits, until the timeout expires, for them to be received. Each
+// received key is saved in its storage unit and marked as refetched. The keys that can not be requested, such as the
+// receipts or the nonce-hash mappings, are skipped. It returns the number of refetched keys
+func (mdr *missingDataRefetcher) Refetch(ctx context.Context, missingKeys []*MissingKey) int {
+	pending := make([]*MissingKey, 0, len(missingKeys))
+	keysToRequest := make(map[requestKey][][]byte)
+	for _, missingKey := range missingKeys {
+		if !mdr.canRequest(missingKey.Unit) {
+			continue
+		}
+
+		pending = append(pending, missingKey)
+		key := requestKey{unit: missingKey.Unit, requestShardID: missingKey.RequestShardID}
+		keysToRequest[key] = append(keysToRequest[key], missingKey.Key)
+	}
+	if len(pending) == 0 {
+		return 0
+	}
+
+	for key, hashes := range keysToRequest {
+		for start := 0; start < len(hashes); start += maxNumKeysPerRequest {
+			end := core.MinInt(start+maxNumKeysPerRequest, len(hashes))
+			mdr.request(key, hashes[start:end])
+		}
+	}
+
+	log.Info("requested the missing keys", "num keys", len(pending), "timeout", mdr.timeout)
+
+	numRefetched := 0
+	timeout := time.After(mdr.timeout)
+	for {
+		pending, numRefetched = mdr.saveReceived(pending, numRefetched)
+		if len(pending) == 0 {
+			return numRefetched
+		}
+
+		select {
+		case <-time.After(checkPoolInterval):
+		case <-timeout:
+			log.Warn("not all the missing keys were received", "num refetched", numRefetched, "num missing", len(pending))
+			return numRefetched
+		case <-ctx.Done():
+			return numRefetched
+		}
+	}
+}
+
+func (mdr *missingDataRefetcher) canRequest(unit dataRetriever.UnitType) bool {
+	switch unit {
+	case dataRetriever.MiniBlockUnit, dataRetriever.TransactionUnit, dataRetriever.UnsignedTransactionUnit,
+		dataRetriever.RewardTransactionUnit, dataRetriever.BlockHeaderUnit, dataRetriever.MetaBlockUnit,
+		dataRetriever.UserAccountsUnit:
+		return true
+	case dataRetriever.PeerAccountsUnit:
+		return mdr.shardID == core.MetachainShardId
+	default:
+		return false
+	}
+}
+
+func (mdr *missingDataRefetcher) request(key requestKey, hashes [][]byte) {
+	switch key.unit {
+	case dataRetriever.MiniBlockUnit:
+		mdr.requestHandler.RequestMiniBlocks(key.requestShardID, hashes)
+	case dataRetriever.TransactionUnit:
+		mdr.requestHandler.RequestTransaction(key.requestShardID, hashes)
+	case dataRetriever.UnsignedTransactionUnit:
+		mdr.requestHandler.RequestUnsignedTransactions(key.requestShardID, hashes)
+	case dataRetriever.RewardTransactionUnit:
+		mdr.requestHandler.RequestRewardTransactions(key.requestShardID, hashes)
+	case dataRetriever.BlockHeaderUnit:
+		for _, hash := range hashes {
+			mdr.requestHandler.RequestShardHeader(key.requestShardID, hash)
+		}
+	case dataRetriever.MetaBlockUnit:
+		for _, hash := range hashes {
+			mdr.requestHandler.RequestMetaHeader(hash)
+		}
+	case dataRetriever.UserAccountsUnit:
+		mdr.requestHandler.RequestTrieNodes(key.requestShardID, hashes, factory.AccountTrieNodesTopic)
+	case dataRetriever.PeerAccountsUnit:
+		mdr.requestHandler.RequestTrieNodes(core.MetachainShardId, hashes, factory.ValidatorTrieNodesTopic)
+	}
+}
+
+// saveReceived saves the keys found in the data pools and returns the ones that are still pending
+func (mdr *missingDataRefetcher) saveReceived(pending []*MissingKey, numRefetched int) ([]*MissingKey, int) {
+	stillPending := make([]*MissingKey, 0, len(pending))
+	for _, missingKey := range pending {
+		buff, found := mdr.getFromPool(missingKey)
+		if !found {
+			stillPending = append(stillPending, missingKey)
+			continue
+		}
+
+		err := mdr.save(missingKey, buff)
+		if err != nil {
+			log.Warn("could not save refetched key", "unit", missingKey.Unit.String(), "key", missingKey.Key,
+				"error", err.Error())
+			continue
+		}
+
+		missingKey.Refetched = true
+		numRefetched++
+	}
+
+	return stillPending, numRefetched
+}
+
+func (mdr *missingDataRefetcher) getFromPool(missingKey *MissingKey) ([]byte, bool) {
+	var value interface{}
+	found := false
+
+	switch missingKey.Unit {
+	case dataRetriever.MiniBlockUnit:
+		value, found = mdr.dataPool.MiniBlocks().Peek(missingKey.Key)
+	case dataRetriever.TransactionUnit:
+		value, found = mdr.dataPool.Transactions().SearchFirstData(missingKey.Key)
+	case dataRetriever.UnsignedTransactionUnit:
+		value, found = mdr.dataPool.UnsignedTransactions().SearchFirstData(missingKey.Key)
+	case dataRetriever.RewardTransactionUnit:
+		value, found = mdr.dataPool.RewardTransactions().SearchFirstData(missingKey.Key)
+	case dataRetriever.BlockHeaderUnit, dataRetriever.MetaBlockUnit:
+		header, err := mdr.dataPool.Headers().GetHeaderByHash(missingKey.Key)
+		value, found = header, err == nil
+	case dataRetriever.UserAccountsUnit, dataRetriever.PeerAccountsUnit:
+		return mdr.getTrieNodeFromPool(missingKey.Key)
+	}
+	if !found {
+		return nil, false
+	}
+
+	buff, err := mdr.marshalizer.Marshal(value)
+	if err != nil {
+		log.Warn("could not marshal refetched key", "unit", missingKey.Unit.String(), "key", missingKey.Key,
+			"error", err.Error())
+		return nil, false
+	}
+
+	return buff, true
+}
+
+func (mdr *missingDataRefetcher) getTrieNodeFromPool(hash []byte) ([]byte, bool) {
+	value, found := mdr.dataPool.TrieNodes().Peek(hash)
+	if !found {
+		return nil, false
+	}
+
+	trieNode, ok := value.(interface{ GetSerialized() []byte })
+	if !ok {
+		return nil, false
+	}
+
+	return trieNode.GetSerialized(), true
+}
+
+func (mdr *missingDataRefetcher) save(missingKey *MissingKey, buff []byte) error {
+	switch missingKey.Unit {
+	case dataRetriever.UserAccountsUnit:
+		return mdr.userAccountsTrieStorage.Put(missingKey.Key, buff)
+	case dataRetriever.PeerAccountsUnit:
+		return mdr.peerAccountsTrieStorage.Put(missingKey.Key, buff)
+	default:
+		return mdr.store.GetStorer(missingKey.Unit).PutInEpoch(missingKey.Key, buff, missingKey.Epoch)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mdr *missingDataRefetcher) IsInterfaceNil() bool {
+	return mdr == nil
+}
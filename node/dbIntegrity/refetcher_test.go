@@ -0,0 +1,212 @@
+package dbIntegrity_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trieNodeStub struct {
+	serialized []byte
+}
+
+func (tns *trieNodeStub) GetSerialized() []byte {
+	return tns.serialized
+}
+
+func createRefetcherArgs() dbIntegrity.ArgsMissingDataRefetcher {
+	return dbIntegrity.ArgsMissingDataRefetcher{
+		RequestHandler:          &testscommon.RequestHandlerStub{},
+		DataPool:                dataRetrieverMock.NewPoolsHolderMock(),
+		StorageService:          genericMocks.NewChainStorerMock(0),
+		UserAccountsTrieStorage: memorydb.New(),
+		PeerAccountsTrieStorage: memorydb.New(),
+		Marshalizer:             testMarshalizer,
+		ShardID:                 0,
+		Timeout:                 time.Second,
+	}
+}
+
+func TestNewMissingDataRefetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.RequestHandler = nil
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(args)
+		assert.Nil(t, refetcher)
+		assert.Equal(t, dbIntegrity.ErrNilRequestHandler, err)
+	})
+	t.Run("nil data pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.DataPool = nil
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(args)
+		assert.Nil(t, refetcher)
+		assert.Equal(t, dbIntegrity.ErrNilDataPool, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.StorageService = nil
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(args)
+		assert.Nil(t, refetcher)
+		assert.Equal(t, dbIntegrity.ErrNilStorageService, err)
+	})
+	t.Run("nil trie storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.PeerAccountsTrieStorage = nil
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(args)
+		assert.Nil(t, refetcher)
+		assert.True(t, errors.Is(err, dbIntegrity.ErrNilTrieStorage))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.Marshalizer = nil
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(args)
+		assert.Nil(t, refetcher)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.Timeout = 0
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(args)
+		assert.Nil(t, refetcher)
+		assert.True(t, errors.Is(err, dbIntegrity.ErrInvalidRefetchTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		refetcher, err := dbIntegrity.NewMissingDataRefetcher(createRefetcherArgs())
+		assert.Nil(t, err)
+		assert.False(t, refetcher.IsInterfaceNil())
+	})
+}
+
+func TestMissingDataRefetcher_Refetch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keys that can not be requested should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRefetcherArgs()
+		args.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestTrieNodesCalled: func(_ uint32, _ [][]byte, _ string) {
+				assert.Fail(t, "should have not requested the peer accounts trie nodes on a shard")
+			},
+		}
+		refetcher, _ := dbIntegrity.NewMissingDataRefetcher(args)
+
+		missingKeys := []*dbIntegrity.MissingKey{
+			{Unit: dataRetriever.ReceiptsUnit, Key: []byte("receipts")},
+			{Unit: dataRetriever.ShardHdrNonceHashDataUnit, Key: []byte("nonce")},
+			{Unit: dataRetriever.PeerAccountsUnit, Key: []byte("peer node")},
+		}
+		assert.Equal(t, 0, refetcher.Refetch(context.Background(), missingKeys))
+	})
+	t.Run("not received keys should time out", func(t *testing.T) {
+		t.Parallel()
+
+		numRequested := 0
+		args := createRefetcherArgs()
+		args.Timeout = 200 * time.Millisecond
+		args.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestMiniBlocksHandlerCalled: func(_ uint32, hashes [][]byte) {
+				numRequested += len(hashes)
+			},
+		}
+		refetcher, _ := dbIntegrity.NewMissingDataRefetcher(args)
+
+		missingKey := &dbIntegrity.MissingKey{Unit: dataRetriever.MiniBlockUnit, Key: []byte("miniblock")}
+		numRefetched := refetcher.Refetch(context.Background(), []*dbIntegrity.MissingKey{missingKey})
+		assert.Equal(t, 0, numRefetched)
+		assert.Equal(t, 1, numRequested)
+		assert.False(t, missingKey.Refetched)
+	})
+	t.Run("received miniblock should be saved in its epoch", func(t *testing.T) {
+		t.Parallel()
+
+		miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}, SenderShardID: 1}
+		miniBlockHash := []byte("miniblock")
+		store := genericMocks.NewChainStorerMock(3)
+		pools := dataRetrieverMock.NewPoolsHolderMock()
+
+		args := createRefetcherArgs()
+		args.StorageService = store
+		args.DataPool = pools
+		args.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestMiniBlocksHandlerCalled: func(destShardID uint32, hashes [][]byte) {
+				assert.Equal(t, uint32(1), destShardID)
+				assert.Equal(t, [][]byte{miniBlockHash}, hashes)
+				pools.MiniBlocks().Put(miniBlockHash, miniBlock, 0)
+			},
+		}
+		refetcher, _ := dbIntegrity.NewMissingDataRefetcher(args)
+
+		missingKey := &dbIntegrity.MissingKey{
+			Unit:           dataRetriever.MiniBlockUnit,
+			Key:            miniBlockHash,
+			Epoch:          2,
+			RequestShardID: 1,
+		}
+		numRefetched := refetcher.Refetch(context.Background(), []*dbIntegrity.MissingKey{missingKey})
+		assert.Equal(t, 1, numRefetched)
+		assert.True(t, missingKey.Refetched)
+
+		buff, err := store.Miniblocks.GetFromEpoch(miniBlockHash, 2)
+		require.Nil(t, err)
+		expectedBuff, _ := testMarshalizer.Marshal(miniBlock)
+		assert.Equal(t, expectedBuff, buff)
+	})
+	t.Run("received trie node should be saved in the trie storage", func(t *testing.T) {
+		t.Parallel()
+
+		nodeHash := []byte("node")
+		encodedNode := []byte("encoded node")
+		trieStorage := memorydb.New()
+		pools := dataRetrieverMock.NewPoolsHolderMock()
+
+		args := createRefetcherArgs()
+		args.UserAccountsTrieStorage = trieStorage
+		args.DataPool = pools
+		args.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestTrieNodesCalled: func(_ uint32, hashes [][]byte, topic string) {
+				assert.Equal(t, factory.AccountTrieNodesTopic, topic)
+				assert.Equal(t, [][]byte{nodeHash}, hashes)
+				pools.TrieNodes().Put(nodeHash, &trieNodeStub{serialized: encodedNode}, 0)
+			},
+		}
+		refetcher, _ := dbIntegrity.NewMissingDataRefetcher(args)
+
+		missingKey := &dbIntegrity.MissingKey{Unit: dataRetriever.UserAccountsUnit, Key: nodeHash}
+		numRefetched := refetcher.Refetch(context.Background(), []*dbIntegrity.MissingKey{missingKey})
+		assert.Equal(t, 1, numRefetched)
+
+		buff, err := trieStorage.Get(nodeHash)
+		require.Nil(t, err)
+		assert.Equal(t, encodedNode, buff)
+	})
+}
@@ -132,3 +132,36 @@ var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
 
 // ErrNilStorer signals the using of a nil storer
 var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyDataTrieRootHash signals that the account has an empty data trie root hash
+var ErrEmptyDataTrieRootHash = errors.New("empty data trie root hash")
+
+// ErrInvalidNumberOfProofKeys signals that an invalid number of keys was provided for a multi key proof
+var ErrInvalidNumberOfProofKeys = errors.New("invalid number of proof keys")
+
+// ErrDbIntegrityCheckInProgress signals that a database integrity check was requested while another one is running
+var ErrDbIntegrityCheckInProgress = errors.New("a database integrity check is already in progress")
+
+// ErrDbIntegrityReportNotAvailable signals that no database integrity check was started
+var ErrDbIntegrityReportNotAvailable = errors.New("no database integrity check was started")
+
+// ErrNilCurrentHeaderHash signals that the current block header hash is not set
+var ErrNilCurrentHeaderHash = errors.New("nil current header hash")
+
+// ErrDataTriesAnalysisInProgress signals that a data tries analysis was requested while another one is running
+var ErrDataTriesAnalysisInProgress = errors.New("a data tries analysis is already in progress")
+
+// ErrDataTriesReportNotAvailable signals that no data tries analysis was started
+var ErrDataTriesReportNotAvailable = errors.New("no data tries analysis was started")
+
+// ErrNilCurrentRootHash signals that the current block root hash is not set
+var ErrNilCurrentRootHash = errors.New("nil current root hash")
+
+// ErrConsensusTimelineNotAvailable signals that the consensus components, holding the consensus timeline, are not created
+var ErrConsensusTimelineNotAvailable = errors.New("consensus timeline is not available")
+
+// ErrP2PFaultInjectionDisabled signals that the network faults injection is not enabled
+var ErrP2PFaultInjectionDisabled = errors.New("p2p fault injection is not enabled")
+
+// ErrP2PTrafficNotAvailable signals that the p2p traffic accounting is not available
+var ErrP2PTrafficNotAvailable = errors.New("p2p traffic is not available")
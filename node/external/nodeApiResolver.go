@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -116,6 +117,11 @@ func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueryWithTrace retrieves data stored in a SC account through a VM, recording the trace of the execution
+func (nar *nodeApiResolver) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	return nar.scQueryService.ExecuteQueryWithTrace(query)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -126,6 +132,11 @@ func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// ComputeTransactionGasLimitInBlock will calculate how many gas a transaction would have consumed at the block selected by the coordinates
+func (nar *nodeApiResolver) ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	return nar.txCostHandler.ComputeTransactionGasLimitInBlock(tx, coordinates)
+}
+
 // Close closes all underlying components
 func (nar *nodeApiResolver) Close() error {
 	return nar.scQueryService.Close()
@@ -171,6 +182,11 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string)
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// GetTransactionsByAddress will return a page of (historical) transactions of the provided address, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsByAddress(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsByAddress(address, offset, maxTransactions)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -206,6 +206,42 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolForSender(sender, fields
 	return transactions, nil
 }
 
+// GetTransactionsByAddress will return at most maxTransactions (historical) transactions of the provided address,
+// starting from the given offset, ordered from the newest to the oldest one
+func (atp *apiTransactionProcessor) GetTransactionsByAddress(address string, offset uint64, maxTransactions uint64) (*common.TransactionsByAddressApiResponse, error) {
+	decodedAddress, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	if !atp.historyRepository.IsEnabled() {
+		return nil, ErrDbLookupExtensionsNotEnabled
+	}
+
+	page, err := atp.historyRepository.GetTransactionsByAddress(decodedAddress, offset, maxTransactions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCannotRetrieveTransactions.Error(), err)
+	}
+
+	transactions := make([]*transaction.ApiTransactionResult, 0, len(page.Records))
+	for _, record := range page.Records {
+		tx, errLookup := atp.lookupHistoricalTransaction(record.TxHash, false)
+		if errLookup != nil {
+			log.Warn("GetTransactionsByAddress(): cannot lookup transaction", "hash", record.TxHash, "error", errLookup)
+			continue
+		}
+
+		tx.Hash = hex.EncodeToString(record.TxHash)
+		atp.PopulateComputedFields(tx)
+		transactions = append(transactions, tx)
+	}
+
+	return &common.TransactionsByAddressApiResponse{
+		Transactions:    transactions,
+		NumTransactions: page.NumRecords,
+	}, nil
+}
+
 // GetLastPoolNonceForSender will return the last nonce from pool for sender that is to be returned on API calls
 func (atp *apiTransactionProcessor) GetLastPoolNonceForSender(sender string) (uint64, error) {
 	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/txsByAddress"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	processMocks "github.com/ElrondNetwork/elrond-go/process/mock"
@@ -954,6 +956,69 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		res, err := atp.GetTransactionsByAddress("not hex", 0, 10)
+		require.Nil(t, res)
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("db lookup extensions not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, false)
+		res, err := atp.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 0, 10)
+		require.Nil(t, res)
+		require.Equal(t, ErrDbLookupExtensionsNotEnabled, err)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		atp, _, _, historyRepo := createAPITransactionProc(t, 42, true)
+		historyRepo.GetTransactionsByAddressCalled = func(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error) {
+			return nil, expectedErr
+		}
+
+		res, err := atp.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 0, 10)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 42, true)
+		txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("alice")}
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, atp.marshalizer)
+		setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 42, nil, 0)
+
+		historyRepo.GetTransactionsByAddressCalled = func(address []byte, offset uint64, maxRecords uint64) (*txsByAddress.TransactionsPage, error) {
+			require.Equal(t, []byte("alice"), address)
+			require.Equal(t, uint64(1), offset)
+			require.Equal(t, uint64(10), maxRecords)
+
+			return &txsByAddress.TransactionsPage{
+				Records: []*txsByAddress.AddressTransaction{
+					{TxHash: []byte("a")},
+					{TxHash: []byte("missing")},
+				},
+				NumRecords: 5,
+			}, nil
+		}
+
+		res, err := atp.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 1, 10)
+		require.Nil(t, err)
+		require.Equal(t, uint64(5), res.NumTransactions)
+		require.Len(t, res.Transactions, 1)
+		require.Equal(t, hex.EncodeToString([]byte("a")), res.Transactions[0].Hash)
+		require.Equal(t, txA.Nonce, res.Transactions[0].Nonce)
+	})
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -34,3 +34,6 @@ var ErrInvalidAddress = errors.New("invalid address")
 
 // ErrCannotRetrieveNonce signals that nonce cannot be retrieved
 var ErrCannotRetrieveNonce = errors.New("nonce cannot be retrieved")
+
+// ErrDbLookupExtensionsNotEnabled signals that the db lookup extensions are not enabled
+var ErrDbLookupExtensionsNotEnabled = errors.New("db lookup extensions are not enabled")
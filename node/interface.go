@@ -1,11 +1,14 @@
 package node
 
 import (
+	"context"
 	"io"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
@@ -82,3 +85,8 @@ type HealthService interface {
 	io.Closer
 	RegisterComponent(component interface{})
 }
+
+type dbIntegrityCheckHandler interface {
+	Check(ctx context.Context, args dbIntegrity.ArgsCheck) (*common.DbIntegrityReport, error)
+	IsInterfaceNil() bool
+}
@@ -8,12 +8,14 @@ import (
 
 // NetworkComponentsMock -
 type NetworkComponentsMock struct {
-	Messenger               p2p.Messenger
-	InputAntiFlood          factory.P2PAntifloodHandler
-	OutputAntiFlood         factory.P2PAntifloodHandler
-	PeerBlackList           process.PeerBlackListCacher
-	PreferredPeersHolder    factory.PreferredPeersHolderHandler
-	PeersRatingHandlerField p2p.PeersRatingHandler
+	Messenger                  p2p.Messenger
+	InputAntiFlood             factory.P2PAntifloodHandler
+	OutputAntiFlood            factory.P2PAntifloodHandler
+	PeerBlackList              process.PeerBlackListCacher
+	PreferredPeersHolder       factory.PreferredPeersHolderHandler
+	PeersRatingHandlerField    p2p.PeersRatingHandler
+	P2PFaultsRulesHandlerField factory.P2PFaultsRulesHandler
+	P2PTrafficCounterField     factory.P2PTrafficCounter
 }
 
 // PubKeyCacher -
@@ -71,6 +73,16 @@ func (ncm *NetworkComponentsMock) PeersRatingHandler() p2p.PeersRatingHandler {
 	return ncm.PeersRatingHandlerField
 }
 
+// P2PFaultsRulesHandler -
+func (ncm *NetworkComponentsMock) P2PFaultsRulesHandler() factory.P2PFaultsRulesHandler {
+	return ncm.P2PFaultsRulesHandlerField
+}
+
+// P2PTrafficCounter -
+func (ncm *NetworkComponentsMock) P2PTrafficCounter() factory.P2PTrafficCounter {
+	return ncm.P2PTrafficCounterField
+}
+
 // String -
 func (ncm *NetworkComponentsMock) String() string {
 	return "NetworkComponentsMock"
@@ -1,10 +1,14 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go-core/data/transaction"
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
 
 // TransactionCostEstimatorMock  --
 type TransactionCostEstimatorMock struct {
-	ComputeTransactionGasLimitCalled func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitCalled        func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitInBlockCalled func(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error)
 }
 
 // ComputeTransactionGasLimit --
@@ -15,6 +19,14 @@ func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transac
 	return &transaction.CostResponse{}, nil
 }
 
+// ComputeTransactionGasLimitInBlock --
+func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	if tcem.ComputeTransactionGasLimitInBlockCalled != nil {
+		return tcem.ComputeTransactionGasLimitInBlockCalled(tx, coordinates)
+	}
+	return &transaction.CostResponse{}, nil
+}
+
 // IsInterfaceNil --
 func (tcem *TransactionCostEstimatorMock) IsInterfaceNil() bool {
 	return tcem == nil
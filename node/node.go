@@ -23,6 +23,7 @@ import (
 	disabledSig "github.com/ElrondNetwork/elrond-go-crypto/signing/disabled/singlesig"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade"
@@ -95,6 +96,16 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+
+	mutDbIntegrity         syncGo.Mutex
+	dbIntegrityInProgress  bool
+	dbIntegrityReport      *common.DbIntegrityReport
+	cancelDbIntegrityCheck func()
+
+	mutDataTriesAnalysis    syncGo.Mutex
+	dataTriesInProgress     bool
+	dataTriesReport         *common.DataTriesReport
+	cancelDataTriesAnalysis func()
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -326,22 +337,31 @@ func (n *Node) GetESDTData(address, tokenID string, nonce uint64, options api.Ac
 		return nil, api.BlockInfo{}, err
 	}
 
+	esdtToken, err := n.getESDTDataFromUserAccount(userAccount, tokenID, nonce)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	return esdtToken, blockInfo, nil
+}
+
+func (n *Node) getESDTDataFromUserAccount(userAccount state.UserAccountHandler, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
 	userAccountVmCommon, ok := userAccount.(vmcommon.UserAccountHandler)
 	if !ok {
-		return nil, api.BlockInfo{}, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
+		return nil, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
 	}
 
 	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenID)
 	esdtToken, _, err := n.esdtStorageHandler.GetESDTNFTTokenOnDestination(userAccountVmCommon, esdtTokenKey, nonce)
 	if err != nil {
-		return nil, api.BlockInfo{}, err
+		return nil, err
 	}
 
 	if esdtToken.TokenMetaData != nil {
 		esdtToken.TokenMetaData.Creator = []byte(n.coreComponents.AddressPubKeyConverter().Encode(esdtToken.TokenMetaData.Creator))
 	}
 
-	return esdtToken, blockInfo, nil
+	return esdtToken, nil
 }
 
 func (n *Node) getTokensIDsWithFilter(
@@ -800,6 +820,10 @@ func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (api.
 		return api.AccountResponse{}, api.BlockInfo{}, err
 	}
 
+	return n.createAccountResponse(address, account), blockInfo, nil
+}
+
+func (n *Node) createAccountResponse(address string, account state.UserAccountHandler) api.AccountResponse {
 	ownerAddress := ""
 	if len(account.GetOwnerAddress()) > 0 {
 		addressPubkeyConverter := n.coreComponents.AddressPubKeyConverter()
@@ -816,7 +840,7 @@ func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (api.
 		CodeMetadata:    account.GetCodeMetadata(),
 		DeveloperReward: account.GetDeveloperReward().String(),
 		OwnerAddress:    ownerAddress,
-	}, blockInfo, nil
+	}
 }
 
 // GetCode returns the code for the given code hash
@@ -1113,6 +1137,9 @@ func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
 
 // Close closes all underlying components
 func (n *Node) Close() error {
+	n.stopDbIntegrityCheck()
+	n.stopDataTriesAnalysis()
+
 	for _, qh := range n.queryHandlers {
 		log.LogIfError(qh.Close())
 	}
@@ -1287,6 +1314,79 @@ func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	return hex.DecodeString(key)
 }
 
+// GetStorageStatistics returns the usage statistics of the storage units
+func (n *Node) GetStorageStatistics(withNumKeys bool) (*common.StorageStatsApiResponse, error) {
+	return dataRetriever.GetStorageStatistics(n.dataComponents.StorageService(), withNumKeys), nil
+}
+
+// GetEquivocationEvidences returns the evidences of the validators caught signing conflicting consensus messages
+func (n *Node) GetEquivocationEvidences() ([]*common.EquivocationEvidence, error) {
+	storer := n.dataComponents.StorageService().GetStorer(dataRetriever.EquivocationEvidenceUnit)
+
+	return spos.LoadEquivocationEvidences(storer)
+}
+
+// GetConsensusRounds returns the timeline of the last consensus rounds
+func (n *Node) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrConsensusTimelineNotAvailable
+	}
+
+	timelineRecorder := n.consensusComponents.ConsensusTimelineRecorder()
+	if check.IfNil(timelineRecorder) {
+		return nil, ErrConsensusTimelineNotAvailable
+	}
+
+	return timelineRecorder.GetRounds(), nil
+}
+
+// GetP2PFaultRules returns the network faults injection rules together with the number of times each was applied
+func (n *Node) GetP2PFaultRules() ([]common.P2PFaultRuleStatus, error) {
+	rulesHandler, err := n.getP2PFaultsRulesHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	return rulesHandler.GetRules(), nil
+}
+
+// SetP2PFaultRules replaces the network faults injection rules
+func (n *Node) SetP2PFaultRules(rules []common.P2PFaultRule) error {
+	rulesHandler, err := n.getP2PFaultsRulesHandler()
+	if err != nil {
+		return err
+	}
+
+	return rulesHandler.SetRules(rules)
+}
+
+// GetP2PTraffic returns the p2p traffic accounted per topic and per peer
+func (n *Node) GetP2PTraffic() (*common.P2PTrafficReport, error) {
+	if check.IfNil(n.networkComponents) {
+		return nil, ErrP2PTrafficNotAvailable
+	}
+
+	trafficCounter := n.networkComponents.P2PTrafficCounter()
+	if check.IfNil(trafficCounter) {
+		return nil, ErrP2PTrafficNotAvailable
+	}
+
+	return trafficCounter.GetTrafficReport(), nil
+}
+
+func (n *Node) getP2PFaultsRulesHandler() (mainFactory.P2PFaultsRulesHandler, error) {
+	if check.IfNil(n.networkComponents) {
+		return nil, ErrP2PFaultInjectionDisabled
+	}
+
+	rulesHandler := n.networkComponents.P2PFaultsRulesHandler()
+	if check.IfNil(rulesHandler) {
+		return nil, ErrP2PFaultInjectionDisabled
+	}
+
+	return rulesHandler, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	return n == nil
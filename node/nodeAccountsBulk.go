@@ -0,0 +1,145 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// GetAccountsBulk returns the accounts of the provided addresses, along with the requested ESDT tokens and storage values.
+// All the accounts are loaded from the same state: the one of the block selected by the options or, if no block is selected,
+// the one the first account was loaded from. An error that concerns a single address is reported in its own result.
+func (n *Node) GetAccountsBulk(query common.AccountsBulkQuery, options api.AccountQueryOptions) ([]*common.AccountBulkResult, api.BlockInfo, error) {
+	keys, err := decodeStorageKeys(query.Keys)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	options, err = n.addBlockCoordinatesToAccountQueryOptions(options)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	var resolvedBlockInfo common.BlockInfo
+	results := make([]*common.AccountBulkResult, 0, len(query.Addresses))
+	for _, address := range query.Addresses {
+		result, blockInfo := n.getAccountBulkResult(address, query.TokenIdentifiers, keys, options)
+		results = append(results, result)
+
+		if !check.IfNil(resolvedBlockInfo) || check.IfNil(blockInfo) || len(blockInfo.GetRootHash()) == 0 {
+			continue
+		}
+
+		// the remaining accounts will be loaded from the same root hash, even if new blocks get committed meanwhile
+		resolvedBlockInfo = blockInfo
+		options = api.AccountQueryOptions{
+			BlockRootHash: blockInfo.GetRootHash(),
+			HintEpoch:     options.HintEpoch,
+		}
+	}
+
+	return results, accountBlockInfoToApiResource(resolvedBlockInfo), nil
+}
+
+func (n *Node) getAccountBulkResult(
+	address string,
+	tokenIDs []string,
+	keys map[string][]byte,
+	options api.AccountQueryOptions,
+) (*common.AccountBulkResult, common.BlockInfo) {
+	result := &common.AccountBulkResult{
+		Address: address,
+		ESDTs:   make(map[string]*esdt.ESDigitalToken, len(tokenIDs)),
+		Values:  make(map[string]string, len(keys)),
+	}
+
+	pubKey, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	userAccount, blockInfo, err := n.loadUserAccountHandlerWithBlockCoordinates(pubKey, options)
+	if err != nil {
+		notFoundBlockInfo, ok := extractBlockInfoIfErrAccountNotFoundAtBlock(err)
+		if !ok {
+			result.Error = err.Error()
+			return result, nil
+		}
+
+		fillAccountBulkResultForMissingAccount(result, tokenIDs, keys)
+		return result, notFoundBlockInfo
+	}
+
+	accountResponse := n.createAccountResponse(address, userAccount)
+	result.Account = &accountResponse
+
+	err = n.fillAccountBulkResultFromUserAccount(result, userAccount, tokenIDs, keys)
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result, blockInfo
+}
+
+func (n *Node) fillAccountBulkResultFromUserAccount(
+	result *common.AccountBulkResult,
+	userAccount state.UserAccountHandler,
+	tokenIDs []string,
+	keys map[string][]byte,
+) error {
+	for _, tokenID := range tokenIDs {
+		esdtToken, err := n.getESDTDataFromUserAccount(userAccount, tokenID, 0)
+		if err != nil {
+			return fmt.Errorf("%w for token %s", err, tokenID)
+		}
+
+		result.ESDTs[tokenID] = esdtToken
+	}
+
+	for key, keyBytes := range keys {
+		valueBytes, err := userAccount.DataTrieTracker().RetrieveValue(keyBytes)
+		if err != nil {
+			return fmt.Errorf("fetching value error: %w", err)
+		}
+
+		result.Values[key] = hex.EncodeToString(valueBytes)
+	}
+
+	return nil
+}
+
+func fillAccountBulkResultForMissingAccount(result *common.AccountBulkResult, tokenIDs []string, keys map[string][]byte) {
+	result.Account = &api.AccountResponse{
+		Address:         result.Address,
+		Balance:         "0",
+		DeveloperReward: "0",
+	}
+
+	for _, tokenID := range tokenIDs {
+		result.ESDTs[tokenID] = &esdt.ESDigitalToken{Value: big.NewInt(0)}
+	}
+	for key := range keys {
+		result.Values[key] = ""
+	}
+}
+
+func decodeStorageKeys(keys []string) (map[string][]byte, error) {
+	decodedKeys := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		keyBytes, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key: %w", err)
+		}
+
+		decodedKeys[key] = keyBytes
+	}
+
+	return decodedKeys, nil
+}
@@ -0,0 +1,113 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/holders"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	mockState "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetAccountsBulkInvalidKeyShouldError(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithStateComponents(getDefaultStateComponents()),
+	)
+
+	query := common.AccountsBulkQuery{
+		Addresses: []string{testscommon.TestAddressAlice},
+		Keys:      []string{"not hex"},
+	}
+	results, _, err := n.GetAccountsBulk(query, api.AccountQueryOptions{})
+	require.Nil(t, results)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid key")
+}
+
+func TestNode_GetAccountsBulkShouldLoadAllAccountsFromTheSameRootHash(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("key")
+	value := []byte("value")
+	rootHash := []byte{0xbb}
+
+	alice, _ := state.NewUserAccount(testscommon.TestPubKeyAlice)
+	alice.Balance = big.NewInt(100)
+	_ = alice.DataTrieTracker().SaveKeyValue(key, value)
+
+	numCalls := 0
+	accountsRepository := &mockState.AccountsRepositoryStub{}
+	accountsRepository.GetAccountWithBlockInfoCalled = func(pubkey []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+		numCalls++
+		if numCalls == 1 {
+			require.True(t, options.OnFinalBlock)
+			require.Empty(t, options.BlockRootHash)
+
+			return alice, holders.NewBlockInfo([]byte{0xaa}, 1, rootHash), nil
+		}
+
+		require.Equal(t, rootHash, options.BlockRootHash)
+		if bytes.Equal(pubkey, testscommon.TestPubKeyBob) {
+			return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, rootHash))
+		}
+
+		return alice, holders.NewBlockInfo(nil, 0, rootHash), nil
+	}
+
+	esdtStorageStub := &mock.EsdtStorageHandlerStub{
+		GetESDTNFTTokenOnDestinationCalled: func(acnt vmcommon.UserAccountHandler, esdtTokenKey []byte, nonce uint64) (*esdt.ESDigitalToken, bool, error) {
+			return &esdt.ESDigitalToken{Value: big.NewInt(37)}, false, nil
+		},
+	}
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsRepo = accountsRepository
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithStateComponents(stateComponents),
+		node.WithESDTNFTStorageHandler(esdtStorageStub),
+	)
+
+	query := common.AccountsBulkQuery{
+		Addresses:        []string{testscommon.TestAddressAlice, "invalid address", testscommon.TestAddressBob, testscommon.TestAddressAlice},
+		TokenIdentifiers: []string{"TKN-abcdef"},
+		Keys:             []string{hex.EncodeToString(key)},
+	}
+	results, blockInfo, err := n.GetAccountsBulk(query, api.AccountQueryOptions{OnFinalBlock: true})
+	require.Nil(t, err)
+	require.Equal(t, 3, numCalls)
+	require.Equal(t, api.BlockInfo{Nonce: 1, Hash: "aa", RootHash: "bb"}, blockInfo)
+	require.Len(t, results, 4)
+
+	aliceResult := results[0]
+	require.Empty(t, aliceResult.Error)
+	require.Equal(t, testscommon.TestAddressAlice, aliceResult.Account.Address)
+	require.Equal(t, "100", aliceResult.Account.Balance)
+	require.Equal(t, "37", aliceResult.ESDTs["TKN-abcdef"].Value.String())
+	require.Equal(t, hex.EncodeToString(value), aliceResult.Values[hex.EncodeToString(key)])
+	require.Equal(t, aliceResult, results[3])
+
+	invalidResult := results[1]
+	require.Nil(t, invalidResult.Account)
+	require.Contains(t, invalidResult.Error, "invalid address")
+
+	bobResult := results[2]
+	require.Empty(t, bobResult.Error)
+	require.Equal(t, "0", bobResult.Account.Balance)
+	require.Equal(t, "0", bobResult.ESDTs["TKN-abcdef"].Value.String())
+	require.Equal(t, "", bobResult.Values[hex.EncodeToString(key)])
+}
@@ -0,0 +1,124 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// StartDataTriesAnalysis starts, in background, the computation of the statistics of all the data tries found in the
+// state identified by the root hash, or in the current state if the root hash is empty. The largest numLargest data
+// tries, ranked by the sortBy criterion, are available through GetDataTriesReport
+func (n *Node) StartDataTriesAnalysis(rootHash string, numLargest uint32, sortBy string) error {
+	rootHashBytes, err := n.getDataTriesAnalysisRootHash(rootHash)
+	if err != nil {
+		return err
+	}
+
+	n.mutDataTriesAnalysis.Lock()
+	defer n.mutDataTriesAnalysis.Unlock()
+
+	if n.dataTriesInProgress {
+		return ErrDataTriesAnalysisInProgress
+	}
+
+	args := state.ArgsDataTriesAnalysis{
+		Accounts:    n.stateComponents.AccountsAdapterAPI(),
+		Marshalizer: n.coreComponents.InternalMarshalizer(),
+		RootHash:    rootHashBytes,
+		NumLargest:  int(numLargest),
+		SortBy:      state.DataTriesSortCriterion(sortBy),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n.dataTriesInProgress = true
+	n.dataTriesReport = &common.DataTriesReport{
+		InProgress: true,
+		RootHash:   hex.EncodeToString(rootHashBytes),
+		SortBy:     sortBy,
+	}
+	n.cancelDataTriesAnalysis = cancel
+
+	go n.runDataTriesAnalysis(ctx, args)
+
+	return nil
+}
+
+func (n *Node) getDataTriesAnalysisRootHash(rootHash string) ([]byte, error) {
+	if len(rootHash) > 0 {
+		return hex.DecodeString(rootHash)
+	}
+
+	currentRootHash := n.dataComponents.Blockchain().GetCurrentBlockRootHash()
+	if len(currentRootHash) == 0 {
+		return nil, ErrNilCurrentRootHash
+	}
+
+	return currentRootHash, nil
+}
+
+func (n *Node) runDataTriesAnalysis(ctx context.Context, args state.ArgsDataTriesAnalysis) {
+	report := &common.DataTriesReport{
+		RootHash: hex.EncodeToString(args.RootHash),
+		SortBy:   string(args.SortBy),
+		Largest:  make([]common.DataTrieStatisticsApiResponse, 0),
+	}
+
+	analysis, err := state.AnalyzeDataTries(ctx, args)
+	if err != nil {
+		log.Warn("data tries analysis failed", "error", err.Error())
+		report.Error = err.Error()
+	} else {
+		n.fillDataTriesReport(report, analysis)
+	}
+
+	n.mutDataTriesAnalysis.Lock()
+	n.dataTriesInProgress = false
+	n.dataTriesReport = report
+	n.cancelDataTriesAnalysis = nil
+	n.mutDataTriesAnalysis.Unlock()
+}
+
+func (n *Node) fillDataTriesReport(report *common.DataTriesReport, analysis *state.DataTriesAnalysis) {
+	report.NumAccounts = analysis.NumAccounts
+	report.NumDataTries = analysis.NumDataTries
+	report.TotalNumNodes = analysis.TotalNumNodes
+	report.TotalSizeInBytes = analysis.TotalSizeInBytes
+
+	for i := range analysis.Largest {
+		stats := &analysis.Largest[i]
+		report.Largest = append(report.Largest, common.DataTrieStatisticsApiResponse{
+			Address:       n.coreComponents.AddressPubKeyConverter().Encode(stats.Address),
+			RootHash:      hex.EncodeToString(stats.RootHash),
+			NumNodes:      stats.NumNodes(),
+			NumLeaves:     stats.Leaves,
+			NumExtensions: stats.Extensions,
+			NumBranches:   stats.Branches,
+			Depth:         stats.MaxLevel,
+			SizeInBytes:   stats.SizeInBytes,
+		})
+	}
+}
+
+// GetDataTriesReport returns the report of the last data tries analysis
+func (n *Node) GetDataTriesReport() (*common.DataTriesReport, error) {
+	n.mutDataTriesAnalysis.Lock()
+	defer n.mutDataTriesAnalysis.Unlock()
+
+	if n.dataTriesReport == nil {
+		return nil, ErrDataTriesReportNotAvailable
+	}
+
+	return n.dataTriesReport, nil
+}
+
+func (n *Node) stopDataTriesAnalysis() {
+	n.mutDataTriesAnalysis.Lock()
+	defer n.mutDataTriesAnalysis.Unlock()
+
+	if n.cancelDataTriesAnalysis != nil {
+		n.cancelDataTriesAnalysis()
+	}
+}
@@ -0,0 +1,178 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForDataTries(t *testing.T, mainTrie common.Trie) *node.Node {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return mainTrie, nil
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithDataComponents(getDefaultDataComponents()),
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func waitDataTriesReport(t *testing.T, n *node.Node) *common.DataTriesReport {
+	var report *common.DataTriesReport
+	var err error
+	for i := 0; i < 100; i++ {
+		report, err = n.GetDataTriesReport()
+		require.Nil(t, err)
+		if !report.InProgress {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return report
+}
+
+func TestNode_GetDataTriesReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no analysis started should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		report, err := n.GetDataTriesReport()
+		assert.Nil(t, report)
+		assert.Equal(t, node.ErrDataTriesReportNotAvailable, err)
+	})
+	t.Run("failed analysis should set the error in the report", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeForDataTries(t, &trieMock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(_ chan core.KeyValueHolder, _ context.Context, _ []byte) error {
+				return expectedErr
+			},
+		})
+
+		err := n.StartDataTriesAnalysis("", 10, string(state.SortByNumNodes))
+		require.Nil(t, err)
+
+		report := waitDataTriesReport(t, n)
+		assert.False(t, report.InProgress)
+		assert.Equal(t, hex.EncodeToString([]byte("root hash")), report.RootHash)
+		assert.Contains(t, report.Error, expectedErr.Error())
+	})
+	t.Run("should convert the largest data tries", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		accountBytes, _ := coreComponents.InternalMarshalizer().Marshal(&state.UserAccountData{RootHash: []byte("data trie root hash")})
+		address := make([]byte, 32)
+		n := createNodeForDataTries(t, &trieMock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(leavesChannel chan core.KeyValueHolder, _ context.Context, rootHash []byte) error {
+				assert.Equal(t, []byte{0xaa}, rootHash)
+				leavesChannel <- keyValStorage.NewKeyValStorage(address, accountBytes)
+				close(leavesChannel)
+				return nil
+			},
+			GetTrieStatisticsCalled: func(_ context.Context, rootHash []byte) (common.TrieStatisticsDTO, error) {
+				assert.Equal(t, []byte("data trie root hash"), rootHash)
+				return common.TrieStatisticsDTO{
+					NumNodesDTO: common.NumNodesDTO{Leaves: 3, Extensions: 1, Branches: 2, MaxLevel: 4},
+					SizeInBytes: 300,
+				}, nil
+			},
+		})
+
+		err := n.StartDataTriesAnalysis("aa", 10, string(state.SortBySizeInBytes))
+		require.Nil(t, err)
+
+		report := waitDataTriesReport(t, n)
+		assert.False(t, report.InProgress)
+		assert.Empty(t, report.Error)
+		assert.Equal(t, string(state.SortBySizeInBytes), report.SortBy)
+		assert.Equal(t, uint64(1), report.NumAccounts)
+		assert.Equal(t, uint64(1), report.NumDataTries)
+		assert.Equal(t, uint64(6), report.TotalNumNodes)
+		assert.Equal(t, uint64(300), report.TotalSizeInBytes)
+		expectedStats := common.DataTrieStatisticsApiResponse{
+			Address:       coreComponents.AddressPubKeyConverter().Encode(address),
+			RootHash:      hex.EncodeToString([]byte("data trie root hash")),
+			NumNodes:      6,
+			NumLeaves:     3,
+			NumExtensions: 1,
+			NumBranches:   2,
+			Depth:         4,
+			SizeInBytes:   300,
+		}
+		assert.Equal(t, []common.DataTrieStatisticsApiResponse{expectedStats}, report.Largest)
+	})
+}
+
+func TestNode_StartDataTriesAnalysis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForDataTries(t, &trieMock.TrieStub{})
+		err := n.StartDataTriesAnalysis("invalid root hash", 10, string(state.SortByNumNodes))
+		assert.NotNil(t, err)
+
+		_, err = n.GetDataTriesReport()
+		assert.Equal(t, node.ErrDataTriesReportNotAvailable, err)
+	})
+	t.Run("missing current root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForDataTries(t, &trieMock.TrieStub{})
+		dataComponents := getDefaultDataComponents()
+		dataComponents.BlockChain = &testscommon.ChainHandlerStub{}
+		_ = n.ApplyOptions(node.WithDataComponents(dataComponents))
+
+		err := n.StartDataTriesAnalysis("", 10, string(state.SortByNumNodes))
+		assert.Equal(t, node.ErrNilCurrentRootHash, err)
+	})
+	t.Run("analysis in progress should error", func(t *testing.T) {
+		t.Parallel()
+
+		chanDone := make(chan struct{})
+		n := createNodeForDataTries(t, &trieMock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(leavesChannel chan core.KeyValueHolder, _ context.Context, _ []byte) error {
+				<-chanDone
+				close(leavesChannel)
+				return nil
+			},
+		})
+		defer close(chanDone)
+
+		err := n.StartDataTriesAnalysis("", 10, string(state.SortByNumNodes))
+		require.Nil(t, err)
+
+		err = n.StartDataTriesAnalysis("", 10, string(state.SortByNumNodes))
+		assert.Equal(t, node.ErrDataTriesAnalysisInProgress, err)
+
+		report, err := n.GetDataTriesReport()
+		require.Nil(t, err)
+		assert.True(t, report.InProgress)
+	})
+}
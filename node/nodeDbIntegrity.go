@@ -0,0 +1,122 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/dbIntegrity"
+	dbIntegrityDisabled "github.com/ElrondNetwork/elrond-go/node/dbIntegrity/disabled"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+)
+
+const dbIntegrityRefetchTimeout = time.Minute
+
+// StartDbIntegrityCheck starts, in background, a check of the data stored for the last numEpochs epochs. The missing
+// keys can be optionally requested from peers. The result is available through GetDbIntegrityReport
+func (n *Node) StartDbIntegrityCheck(numEpochs uint32, refetch bool) error {
+	n.mutDbIntegrity.Lock()
+	defer n.mutDbIntegrity.Unlock()
+
+	if n.dbIntegrityInProgress {
+		return ErrDbIntegrityCheckInProgress
+	}
+
+	startHeaderHash := n.dataComponents.Blockchain().GetCurrentBlockHeaderHash()
+	if len(startHeaderHash) == 0 {
+		return ErrNilCurrentHeaderHash
+	}
+
+	checker, err := n.createDbIntegrityChecker(refetch)
+	if err != nil {
+		return err
+	}
+	userTrieStorage := n.stateComponents.TrieStorageManagers()[trieFactory.UserAccountTrie]
+
+	args := dbIntegrity.ArgsCheck{
+		StartHeaderHash:    startHeaderHash,
+		NumEpochs:          numEpochs,
+		CheckAllRootHashes: !userTrieStorage.IsPruningEnabled(),
+		Refetch:            refetch,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n.dbIntegrityInProgress = true
+	n.dbIntegrityReport = &common.DbIntegrityReport{InProgress: true}
+	n.cancelDbIntegrityCheck = cancel
+
+	go n.runDbIntegrityCheck(ctx, checker, args)
+
+	return nil
+}
+
+func (n *Node) createDbIntegrityChecker(refetch bool) (dbIntegrityCheckHandler, error) {
+	trieStorageManagers := n.stateComponents.TrieStorageManagers()
+	userTrieStorage := trieStorageManagers[trieFactory.UserAccountTrie]
+	peerTrieStorage := trieStorageManagers[trieFactory.PeerAccountTrie]
+	shardID := n.processComponents.ShardCoordinator().SelfId()
+
+	var refetcher dbIntegrity.MissingDataRefetcher = dbIntegrityDisabled.NewDisabledMissingDataRefetcher()
+	if refetch {
+		var err error
+		refetcher, err = dbIntegrity.NewMissingDataRefetcher(dbIntegrity.ArgsMissingDataRefetcher{
+			RequestHandler:          n.processComponents.RequestHandler(),
+			DataPool:                n.dataComponents.Datapool(),
+			StorageService:          n.dataComponents.StorageService(),
+			UserAccountsTrieStorage: userTrieStorage,
+			PeerAccountsTrieStorage: peerTrieStorage,
+			Marshalizer:             n.coreComponents.InternalMarshalizer(),
+			ShardID:                 shardID,
+			Timeout:                 dbIntegrityRefetchTimeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dbIntegrity.NewDbIntegrityChecker(dbIntegrity.ArgsDbIntegrityChecker{
+		StorageService:           n.dataComponents.StorageService(),
+		UserAccountsTrieStorage:  userTrieStorage,
+		PeerAccountsTrieStorage:  peerTrieStorage,
+		Marshalizer:              n.coreComponents.InternalMarshalizer(),
+		Hasher:                   n.coreComponents.Hasher(),
+		Uint64ByteSliceConverter: n.coreComponents.Uint64ByteSliceConverter(),
+		Refetcher:                refetcher,
+		ShardID:                  shardID,
+	})
+}
+
+func (n *Node) runDbIntegrityCheck(ctx context.Context, checker dbIntegrityCheckHandler, args dbIntegrity.ArgsCheck) {
+	report, err := checker.Check(ctx, args)
+	if err != nil {
+		log.Warn("database integrity check failed", "error", err.Error())
+		report = &common.DbIntegrityReport{Error: err.Error()}
+	}
+
+	n.mutDbIntegrity.Lock()
+	n.dbIntegrityInProgress = false
+	n.dbIntegrityReport = report
+	n.cancelDbIntegrityCheck = nil
+	n.mutDbIntegrity.Unlock()
+}
+
+// GetDbIntegrityReport returns the report of the last database integrity check
+func (n *Node) GetDbIntegrityReport() (*common.DbIntegrityReport, error) {
+	n.mutDbIntegrity.Lock()
+	defer n.mutDbIntegrity.Unlock()
+
+	if n.dbIntegrityReport == nil {
+		return nil, ErrDbIntegrityReportNotAvailable
+	}
+
+	return n.dbIntegrityReport, nil
+}
+
+func (n *Node) stopDbIntegrityCheck() {
+	n.mutDbIntegrity.Lock()
+	defer n.mutDbIntegrity.Unlock()
+
+	if n.cancelDbIntegrityCheck != nil {
+		n.cancelDbIntegrityCheck()
+	}
+}
@@ -0,0 +1,118 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForDbIntegrity(t *testing.T, searchFirstHeader func(key []byte) ([]byte, error)) *node.Node {
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &storageStubs.StorerStub{
+				SearchFirstCalled: searchFirstHeader,
+			}
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.StorageManagers = map[string]common.StorageManager{
+		trieFactory.UserAccountTrie: &testscommon.StorageManagerStub{},
+		trieFactory.PeerAccountTrie: &testscommon.StorageManagerStub{},
+	}
+
+	n, err := node.NewNode(
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(getDefaultProcessComponents()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetDbIntegrityReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no check started should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		report, err := n.GetDbIntegrityReport()
+		assert.Nil(t, report)
+		assert.Equal(t, node.ErrDbIntegrityReportNotAvailable, err)
+	})
+	t.Run("failed check should set the error in the report", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeForDbIntegrity(t, func(_ []byte) ([]byte, error) {
+			return nil, expectedErr
+		})
+
+		err := n.StartDbIntegrityCheck(1, false)
+		require.Nil(t, err)
+
+		var report *common.DbIntegrityReport
+		for i := 0; i < 100; i++ {
+			report, err = n.GetDbIntegrityReport()
+			require.Nil(t, err)
+			if !report.InProgress {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.False(t, report.InProgress)
+		assert.Contains(t, report.Error, expectedErr.Error())
+	})
+}
+
+func TestNode_StartDbIntegrityCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("check in progress should error", func(t *testing.T) {
+		t.Parallel()
+
+		chanDone := make(chan struct{})
+		n := createNodeForDbIntegrity(t, func(_ []byte) ([]byte, error) {
+			<-chanDone
+			return nil, errors.New("not found")
+		})
+		defer close(chanDone)
+
+		err := n.StartDbIntegrityCheck(1, false)
+		require.Nil(t, err)
+
+		err = n.StartDbIntegrityCheck(1, true)
+		assert.Equal(t, node.ErrDbIntegrityCheckInProgress, err)
+
+		report, err := n.GetDbIntegrityReport()
+		require.Nil(t, err)
+		assert.True(t, report.InProgress)
+	})
+	t.Run("missing trie storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForDbIntegrity(t, nil)
+		stateComponents := getDefaultStateComponents()
+		_ = n.ApplyOptions(node.WithStateComponents(stateComponents))
+
+		err := n.StartDbIntegrityCheck(1, false)
+		assert.NotNil(t, err)
+
+		_, err = n.GetDbIntegrityReport()
+		assert.Equal(t, node.ErrDbIntegrityReportNotAvailable, err)
+	})
+}
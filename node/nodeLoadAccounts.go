@@ -27,6 +27,16 @@ func (n *Node) loadUserAccountHandlerByPubKey(pubKey []byte, options api.Account
 		return nil, api.BlockInfo{}, err
 	}
 
+	userAccount, blockInfo, err := n.loadUserAccountHandlerWithBlockCoordinates(pubKey, options)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	return userAccount, accountBlockInfoToApiResource(blockInfo), nil
+}
+
+// loadUserAccountHandlerWithBlockCoordinates expects the options to be already completed with the block coordinates
+func (n *Node) loadUserAccountHandlerWithBlockCoordinates(pubKey []byte, options api.AccountQueryOptions) (state.UserAccountHandler, common.BlockInfo, error) {
 	repository := n.stateComponents.AccountsRepository()
 
 	account, blockInfo, err := repository.GetAccountWithBlockInfo(pubKey, options)
@@ -35,19 +45,19 @@ func (n *Node) loadUserAccountHandlerByPubKey(pubKey []byte, options api.Account
 		if ok {
 			blockInfo = mergeAccountQueryOptionsIntoBlockInfo(options, blockInfo)
 			// Return the same error (now with additional block info)
-			return nil, api.BlockInfo{}, state.NewErrAccountNotFoundAtBlock(blockInfo)
+			return nil, nil, state.NewErrAccountNotFoundAtBlock(blockInfo)
 		}
 
-		return nil, api.BlockInfo{}, err
+		return nil, nil, err
 	}
 
 	userAccount, err := n.castAccountToUserAccount(account)
 	if err != nil {
-		return nil, api.BlockInfo{}, err
+		return nil, nil, err
 	}
 
 	blockInfo = mergeAccountQueryOptionsIntoBlockInfo(options, blockInfo)
-	return userAccount, accountBlockInfoToApiResource(blockInfo), nil
+	return userAccount, blockInfo, nil
 }
 
 func (n *Node) loadAccountCode(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo) {
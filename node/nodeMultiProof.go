@@ -0,0 +1,282 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+const (
+	maxKeysInMultiProof   = 1000
+	maxLeavesInRangeProof = 1000
+)
+
+// GetMultiProof returns a single Merkle proof for the given addresses, proving which of them exist in the main trie
+func (n *Node) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := n.getProofKeysBytes(addresses, n.getKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getMultiProof(rootHashBytes, keys, nil)
+}
+
+// GetMultiProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for the given keys
+// of its data trie, proving which of them exist
+func (n *Node) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	keysBytes, err := n.getProofKeysBytes(keys, hex.DecodeString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mainProofResponse, addressBytes, dataTrieRootHash, err := n.getAccountProofAndDataTrieRootHash(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieProofResponse, err := n.getMultiProof(dataTrieRootHash, keysBytes, addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// GetRangeProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for all the keys of
+// its data trie found between the start key, included, and the end key, excluded, in key bytes order. All the keys
+// starting with a prefix, such as the ESDT keys, are requested with the prefix as start key and the prefix incremented
+// by one as end key. If the range holds more than maxLeaves keys, the returned end key is the first key that was left
+// out, which is also the start key of the next range
+func (n *Node) GetRangeProofDataTrie(
+	rootHash string,
+	address string,
+	startKey string,
+	endKey string,
+	maxLeaves int,
+) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	startKeyBytes, err := hex.DecodeString(startKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	endKeyBytes, err := hex.DecodeString(endKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if maxLeaves <= 0 || maxLeaves > maxLeavesInRangeProof {
+		maxLeaves = maxLeavesInRangeProof
+	}
+
+	mainProofResponse, addressBytes, dataTrieRootHash, err := n.getAccountProofAndDataTrieRootHash(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(dataTrieRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proof, leaves, err := tr.GetRangeProof(startKeyBytes, endKeyBytes, maxLeaves+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(leaves) > maxLeaves {
+		endKeyBytes = leaves[maxLeaves].Key()
+		leaves = leaves[:maxLeaves]
+	}
+
+	dataTrieProofResponse := &common.GetMultiProofResponse{
+		Proof:    proof,
+		Leaves:   make([]common.TrieLeafData, 0, len(leaves)),
+		EndKey:   endKeyBytes,
+		RootHash: hex.EncodeToString(dataTrieRootHash),
+	}
+	for _, leaf := range leaves {
+		dataTrieProofResponse.Leaves = append(dataTrieProofResponse.Leaves, common.TrieLeafData{
+			Key:   leaf.Key(),
+			Value: trimDataTrieValue(leaf.Value(), leaf.Key(), addressBytes),
+		})
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// VerifyMultiProof verifies the given multi key Merkle proof, returning the keys proven to exist, with their values, and
+// the keys proven to be missing
+func (n *Node) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keysBytes, err := n.getProofKeysBytes(keys, n.getKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := mpv.VerifyMultiProof(rootHashBytes, keysBytes, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.VerifyMultiProofResponse{
+		Leaves:      make([]common.TrieLeafData, 0, len(values)),
+		MissingKeys: make([][]byte, 0),
+	}
+	for _, key := range keysBytes {
+		value, found := values[string(key)]
+		if !found {
+			response.MissingKeys = append(response.MissingKeys, key)
+			continue
+		}
+
+		response.Leaves = append(response.Leaves, common.TrieLeafData{Key: key, Value: value})
+	}
+
+	return response, nil
+}
+
+// VerifyRangeProof verifies the given range Merkle proof, returning all the leaves proven to be between the start key,
+// included, and the end key, excluded
+func (n *Node) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	startKeyBytes, err := hex.DecodeString(startKey)
+	if err != nil {
+		return nil, err
+	}
+	endKeyBytes, err := hex.DecodeString(endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := mpv.VerifyRangeProof(rootHashBytes, startKeyBytes, endKeyBytes, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.VerifyMultiProofResponse{
+		Leaves:      make([]common.TrieLeafData, 0, len(leaves)),
+		MissingKeys: make([][]byte, 0),
+	}
+	for _, leaf := range leaves {
+		response.Leaves = append(response.Leaves, common.TrieLeafData{Key: leaf.Key(), Value: leaf.Value()})
+	}
+
+	return response, nil
+}
+
+func (n *Node) getMultiProof(rootHash []byte, keys [][]byte, dataTrieIdentifier []byte) (*common.GetMultiProofResponse, error) {
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, values, err := tr.GetMultiProof(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.GetMultiProofResponse{
+		Proof:       proof,
+		Leaves:      make([]common.TrieLeafData, 0, len(values)),
+		MissingKeys: make([][]byte, 0),
+		RootHash:    hex.EncodeToString(rootHash),
+	}
+	for _, key := range keys {
+		value, found := values[string(key)]
+		if !found {
+			response.MissingKeys = append(response.MissingKeys, key)
+			continue
+		}
+
+		response.Leaves = append(response.Leaves, common.TrieLeafData{
+			Key:   key,
+			Value: trimDataTrieValue(value, key, dataTrieIdentifier),
+		})
+	}
+
+	return response, nil
+}
+
+func (n *Node) getAccountProofAndDataTrieRootHash(rootHash string, address string) (*common.GetProofResponse, []byte, []byte, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, nil, nil, ErrCannotCastAccountHandlerToUserAccountHandler
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return nil, nil, nil, ErrEmptyDataTrieRootHash
+	}
+
+	return mainProofResponse, addressBytes, dataTrieRootHash, nil
+}
+
+func (n *Node) getProofKeysBytes(keys []string, decodeKey func(string) ([]byte, error)) ([][]byte, error) {
+	if len(keys) == 0 || len(keys) > maxKeysInMultiProof {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", ErrInvalidNumberOfProofKeys, len(keys), maxKeysInMultiProof)
+	}
+
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := decodeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %s", err, key)
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
+}
+
+// trimDataTrieValue removes the key and the account address appended to the values saved in a data trie
+func trimDataTrieValue(value []byte, key []byte, dataTrieIdentifier []byte) []byte {
+	if len(dataTrieIdentifier) == 0 {
+		return value
+	}
+
+	suffix := append(append(make([]byte, 0, len(key)+len(dataTrieIdentifier)), key...), dataTrieIdentifier...)
+	if !bytes.HasSuffix(value, suffix) {
+		return value
+	}
+
+	return value[:len(value)-len(suffix)]
+}
@@ -0,0 +1,151 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", nil)
+		assert.Nil(t, response)
+		assert.True(t, errors.Is(err, node.ErrInvalidNumberOfProofKeys))
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123", "key"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proof := [][]byte{[]byte("valid"), []byte("proof")}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetMultiProofCalled: func(keys [][]byte) ([][]byte, map[string][]byte, error) {
+						require.Equal(t, 2, len(keys))
+						return proof, map[string][]byte{string(keys[0]): []byte("value")}, nil
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		rootHash := "deadbeef"
+		response, err := n.GetMultiProof(rootHash, []string{"0123", "4567"})
+		require.Nil(t, err)
+		assert.Equal(t, proof, response.Proof)
+		assert.Equal(t, []common.TrieLeafData{{Key: []byte{0x01, 0x23}, Value: []byte("value")}}, response.Leaves)
+		assert.Equal(t, [][]byte{{0x45, 0x67}}, response.MissingKeys)
+		assert.Equal(t, rootHash, response.RootHash)
+	})
+}
+
+func TestNode_GetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	address := []byte{0x01, 0x23}
+	dataTrieRootHash := []byte("dataTrieRoot")
+	createStateComponents := func(getRangeProof func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error)) *testscommon.StateComponentsMock {
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+						return [][]byte{[]byte("main")}, []byte("account"), nil
+					},
+					GetRangeProofCalled: getRangeProof,
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+				acc := &mock.AccountWrapMock{}
+				acc.SetRootHash(dataTrieRootHash)
+				return acc, nil
+			},
+		}
+
+		return stateComponents
+	}
+
+	t.Run("should trim the data trie values", func(t *testing.T) {
+		t.Parallel()
+
+		key := []byte("key")
+		storedValue := append(append([]byte("value"), key...), address...)
+		stateComponents := createStateComponents(func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error) {
+			assert.Equal(t, []byte{0xaa}, startKey)
+			assert.Equal(t, []byte{0xbb}, endKey)
+			assert.Equal(t, 11, maxLeaves)
+			return [][]byte{[]byte("data")}, []core.KeyValueHolder{keyValStorage.NewKeyValStorage(key, storedValue)}, nil
+		})
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		mainProof, dataTrieProof, err := n.GetRangeProofDataTrie("deadbeef", hex.EncodeToString(address), "aa", "bb", 10)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("main")}, mainProof.Proof)
+		assert.Equal(t, [][]byte{[]byte("data")}, dataTrieProof.Proof)
+		assert.Equal(t, []common.TrieLeafData{{Key: key, Value: []byte("value")}}, dataTrieProof.Leaves)
+		assert.Equal(t, []byte{0xbb}, dataTrieProof.EndKey)
+		assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieProof.RootHash)
+	})
+	t.Run("truncated range should end with the first key left out", func(t *testing.T) {
+		t.Parallel()
+
+		stateComponents := createStateComponents(func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error) {
+			leaves := []core.KeyValueHolder{
+				keyValStorage.NewKeyValStorage([]byte("k1"), []byte("v1")),
+				keyValStorage.NewKeyValStorage([]byte("k2"), []byte("v2")),
+				keyValStorage.NewKeyValStorage([]byte("k3"), []byte("v3")),
+				keyValStorage.NewKeyValStorage([]byte("k4"), []byte("v4")),
+			}
+			return [][]byte{[]byte("data")}, leaves[:maxLeaves], nil
+		})
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		_, dataTrieProof, err := n.GetRangeProofDataTrie("deadbeef", hex.EncodeToString(address), "", "", 2)
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(dataTrieProof.Leaves))
+		assert.Equal(t, []byte("k2"), dataTrieProof.Leaves[1].Key)
+		assert.Equal(t, []byte("k3"), dataTrieProof.EndKey)
+	})
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -38,9 +39,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/replay"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/receipts"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -360,6 +363,30 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.OutportReplayConfig.IsOutportReplayMode {
+		err = nr.replayOutportBlocks(
+			managedCoreComponents,
+			managedBootstrapComponents,
+			managedDataComponents,
+			managedStatusComponents,
+			nodesCoord,
+		)
+
+		closeComponentsAfterOutportReplay(
+			healthService,
+			webServerHandler,
+			managedStatusComponents,
+			managedStateComponents,
+			managedDataComponents,
+			managedBootstrapComponents,
+			managedNetworkComponents,
+			managedCryptoComponents,
+			managedCoreComponents,
+		)
+
+		return true, err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig: configs.EpochConfig.GasSchedule,
 		ConfigDir:         configurationPaths.GasScheduleDirectoryName,
@@ -422,6 +449,22 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.GeneralConfig.TxPoolPersistence.Enabled {
+		log.Debug("reloading the persisted transactions pool")
+		err = mainFactory.ReloadPersistedTxsPool(mainFactory.ArgsTxsPoolReloader{
+			CoreComponents:            managedCoreComponents,
+			CryptoComponents:          managedCryptoComponents,
+			DataComponents:            managedDataComponents,
+			StateComponents:           managedStateComponents,
+			ProcessComponents:         managedProcessComponents,
+			NetworkComponents:         managedNetworkComponents,
+			EnableSignTxWithHashEpoch: configs.EpochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
+		})
+		if err != nil {
+			return true, err
+		}
+	}
+
 	managedHeartbeatComponents, err := nr.CreateManagedHeartbeatComponents(
 		managedCoreComponents,
 		managedNetworkComponents,
@@ -558,10 +601,11 @@ func (nr *nodeRunner) createApiFacade(
 			RestApiInterface: flagsConfig.RestApiInterface,
 			PprofEnabled:     flagsConfig.EnablePprof,
 		},
-		ApiRoutesConfig: *configs.ApiRoutesConfig,
-		AccountsState:   currentNode.stateComponents.AccountsAdapter(),
-		PeerState:       currentNode.stateComponents.PeerAccounts(),
-		Blockchain:      currentNode.dataComponents.Blockchain(),
+		ApiRoutesConfig:      *configs.ApiRoutesConfig,
+		AccountsState:        currentNode.stateComponents.AccountsAdapter(),
+		PeerState:            currentNode.stateComponents.PeerAccounts(),
+		Blockchain:           currentNode.dataComponents.Blockchain(),
+		SubscriptionsHandler: currentNode.statusComponents.SubscriptionsHandler(),
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -935,6 +979,76 @@ func (nr *nodeRunner) CreateManagedStatusComponents(
 	return managedStatusComponents, nil
 }
 
+// replayOutportBlocks re-emits the blocks found in the node's storage to the subscribed outport drivers. The node
+// does not take part in consensus while replaying and stops once the whole nonce range was sent
+func (nr *nodeRunner) replayOutportBlocks(
+	managedCoreComponents mainFactory.CoreComponentsHolder,
+	managedBootstrapComponents mainFactory.BootstrapComponentsHolder,
+	managedDataComponents mainFactory.DataComponentsHolder,
+	managedStatusComponents mainFactory.StatusComponentsHolder,
+	nodesCoord nodesCoordinator.NodesCoordinator,
+) error {
+	replayConfig := nr.configs.OutportReplayConfig
+
+	receiptsRepository, err := receipts.NewReceiptsRepository(receipts.ArgsNewReceiptsRepository{
+		Marshaller: managedCoreComponents.InternalMarshalizer(),
+		Hasher:     managedCoreComponents.Hasher(),
+		Store:      managedDataComponents.StorageService(),
+	})
+	if err != nil {
+		return err
+	}
+
+	blocksReplayer, err := replay.NewBlocksReplayer(replay.ArgsBlocksReplayer{
+		StorageService:           managedDataComponents.StorageService(),
+		Marshalizer:              managedCoreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: managedCoreComponents.Uint64ByteSliceConverter(),
+		OutportHandler:           managedStatusComponents.OutportHandler(),
+		ReceiptsRepository:       receiptsRepository,
+		NodesCoordinator:         nodesCoord,
+		ShardID:                  managedBootstrapComponents.ShardCoordinator().SelfId(),
+		LastEpoch:                managedBootstrapComponents.EpochBootstrapParams().Epoch(),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	go func() {
+		select {
+		case sig := <-sigs:
+			log.Info("terminating outport replay at user's signal...", "signal", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return blocksReplayer.Replay(ctx, replayConfig.StartNonce, replayConfig.EndNonce)
+}
+
+func closeComponentsAfterOutportReplay(
+	healthService io.Closer,
+	httpServer shared.UpgradeableHttpServerHandler,
+	managedComponents ...mainFactory.Closer,
+) {
+	log.Debug("closing health service...")
+	log.LogIfError(healthService.Close())
+
+	log.Debug("closing http server")
+	log.LogIfError(httpServer.Close())
+
+	for _, managedComponent := range managedComponents {
+		log.Debug("closing", "managedComponent", fmt.Sprintf("%T", managedComponent))
+		log.LogIfError(managedComponent.Close())
+	}
+}
+
 func (nr *nodeRunner) logSessionInformation(
 	workingDir string,
 	sessionInfoFileOutput string,
@@ -1021,6 +1135,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 
 	historyRepoFactoryArgs := &dbLookupFactory.ArgsHistoryRepositoryFactory{
 		SelfShardID:              bootstrapComponents.ShardCoordinator().SelfId(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
 		Config:                   configs.GeneralConfig.DbLookupExtensions,
 		Hasher:                   coreComponents.Hasher(),
 		Marshalizer:              coreComponents.InternalMarshalizer(),
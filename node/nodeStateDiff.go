@@ -0,0 +1,77 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const maxEntriesInStateDiff = 10000
+
+var errStateDiffLimitReached = errors.New("state diff limit reached")
+
+// GetStateDiff returns the accounts and the accounts storage keys that were added, removed or modified between the
+// two root hashes. At most maxEntriesInStateDiff keys are returned, the response being marked as truncated otherwise.
+// The trie walk stops when the limit is reached or when the provided context is done
+func (n *Node) GetStateDiff(fromRootHash string, toRootHash string, ctx context.Context) (*common.StateDiffApiResponse, error) {
+	fromRootHashBytes, err := hex.DecodeString(fromRootHash)
+	if err != nil {
+		return nil, err
+	}
+	toRootHashBytes, err := hex.DecodeString(toRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.StateDiffApiResponse{
+		Added:    make([]common.StateDiffKeyApiResponse, 0),
+		Removed:  make([]common.StateDiffKeyApiResponse, 0),
+		Modified: make([]common.StateDiffKeyApiResponse, 0),
+	}
+	numEntries := 0
+	handler := func(entry common.StateDiffEntry) error {
+		if numEntries == maxEntriesInStateDiff {
+			response.Truncated = true
+			return errStateDiffLimitReached
+		}
+		numEntries++
+
+		n.addStateDiffEntry(response, entry)
+		return nil
+	}
+
+	err = state.ComputeStateDiff(
+		ctx,
+		n.stateComponents.AccountsAdapterAPI(),
+		n.coreComponents.InternalMarshalizer(),
+		fromRootHashBytes,
+		toRootHashBytes,
+		handler,
+	)
+	if err != nil && !errors.Is(err, errStateDiffLimitReached) {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (n *Node) addStateDiffEntry(response *common.StateDiffApiResponse, entry common.StateDiffEntry) {
+	keyResponse := common.StateDiffKeyApiResponse{
+		Address:  n.coreComponents.AddressPubKeyConverter().Encode(entry.Address),
+		Key:      hex.EncodeToString(entry.Key),
+		OldValue: hex.EncodeToString(entry.OldValue),
+		NewValue: hex.EncodeToString(entry.NewValue),
+	}
+
+	switch {
+	case len(entry.OldValue) == 0:
+		response.Added = append(response.Added, keyResponse)
+	case len(entry.NewValue) == 0:
+		response.Removed = append(response.Removed, keyResponse)
+	default:
+		response.Modified = append(response.Modified, keyResponse)
+	}
+}
@@ -0,0 +1,87 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetStateDiff("invalidRootHash", "aaaa", context.Background())
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("should split the entries and truncate the response", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		accountBytes, _ := coreComponents.InternalMarshalizer().Marshal(&state.UserAccountData{Nonce: 1})
+		address := make([]byte, 32)
+		numAddedAccounts := 20000
+		numHandlerCalls := 0
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetLeavesDiffCalled: func(walkCtx context.Context, fromRootHash []byte, toRootHash []byte, handler common.TrieLeavesDiffHandler) error {
+						assert.Equal(t, ctx, walkCtx)
+						assert.Equal(t, []byte{0xaa}, fromRootHash)
+						assert.Equal(t, []byte{0xbb}, toRootHash)
+
+						err := handler(address, accountBytes, accountBytes)
+						if err != nil {
+							return err
+						}
+						err = handler(address, accountBytes, nil)
+						if err != nil {
+							return err
+						}
+						for i := 0; i < numAddedAccounts; i++ {
+							numHandlerCalls++
+							err = handler(address, nil, accountBytes)
+							if err != nil {
+								return err
+							}
+						}
+
+						return nil
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(coreComponents),
+		)
+
+		response, err := n.GetStateDiff("aa", "bb", ctx)
+		require.Nil(t, err)
+		assert.True(t, response.Truncated)
+		assert.Equal(t, 10000-1, numHandlerCalls)
+		assert.Equal(t, 1, len(response.Modified))
+		assert.Equal(t, 1, len(response.Removed))
+		assert.Equal(t, 10000-2, len(response.Added))
+		assert.Equal(t, testscommon.RealWorldBech32PubkeyConverter.Encode(address), response.Added[0].Address)
+		assert.Empty(t, response.Added[0].OldValue)
+	})
+}
@@ -4081,3 +4081,106 @@ func getDefaultBootstrapComponents() *mainFactoryMocks.BootstrapComponentsStub {
 		HdrIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 	}
 }
+
+func TestNode_GetP2PFaultRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fault injection not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithNetworkComponents(getDefaultNetworkComponents()))
+		rules, err := n.GetP2PFaultRules()
+		assert.Nil(t, rules)
+		assert.Equal(t, node.ErrP2PFaultInjectionDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRules := []common.P2PFaultRuleStatus{
+			{
+				Rule:       common.P2PFaultRule{Name: "rule", Fault: "drop", Probability: 1},
+				NumApplied: 3,
+			},
+		}
+		networkComponents := getDefaultNetworkComponents()
+		networkComponents.P2PFaultsRulesHandlerField = &p2pmocks.FaultsRulesHandlerStub{
+			GetRulesCalled: func() []common.P2PFaultRuleStatus {
+				return expectedRules
+			},
+		}
+		n, _ := node.NewNode(node.WithNetworkComponents(networkComponents))
+
+		rules, err := n.GetP2PFaultRules()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedRules, rules)
+	})
+}
+
+func TestNode_GetP2PTraffic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		traffic, err := n.GetP2PTraffic()
+		assert.Nil(t, traffic)
+		assert.Equal(t, node.ErrP2PTrafficNotAvailable, err)
+	})
+	t.Run("nil traffic counter should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithNetworkComponents(getDefaultNetworkComponents()))
+		traffic, err := n.GetP2PTraffic()
+		assert.Nil(t, traffic)
+		assert.Equal(t, node.ErrP2PTrafficNotAvailable, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTraffic := &common.P2PTrafficReport{
+			WindowInSeconds: 60,
+			TopTalkers:      []string{"pid"},
+		}
+		networkComponents := getDefaultNetworkComponents()
+		networkComponents.P2PTrafficCounterField = &p2pmocks.TrafficCounterStub{
+			GetTrafficReportCalled: func() *common.P2PTrafficReport {
+				return expectedTraffic
+			},
+		}
+		n, _ := node.NewNode(node.WithNetworkComponents(networkComponents))
+
+		traffic, err := n.GetP2PTraffic()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTraffic, traffic)
+	})
+}
+
+func TestNode_SetP2PFaultRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fault injection not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		err := n.SetP2PFaultRules(nil)
+		assert.Equal(t, node.ErrP2PFaultInjectionDisabled, err)
+	})
+	t.Run("should forward the rules", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		providedRules := []common.P2PFaultRule{{Name: "rule", Fault: "drop", Probability: 1}}
+		networkComponents := getDefaultNetworkComponents()
+		networkComponents.P2PFaultsRulesHandlerField = &p2pmocks.FaultsRulesHandlerStub{
+			SetRulesCalled: func(rules []common.P2PFaultRule) error {
+				assert.Equal(t, providedRules, rules)
+				return expectedErr
+			},
+		}
+		n, _ := node.NewNode(node.WithNetworkComponents(networkComponents))
+
+		err := n.SetP2PFaultRules(providedRules)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+)
+
+type disabledSubscriptionsHandler struct{}
+
+// NewDisabledSubscriptionsHandler will create a new instance of disabledSubscriptionsHandler
+func NewDisabledSubscriptionsHandler() *disabledSubscriptionsHandler {
+	return new(disabledSubscriptionsHandler)
+}
+
+// Subscribe returns ErrSubscriptionsDisabled
+func (dsh *disabledSubscriptionsHandler) Subscribe(_ subscriptions.Filter) (subscriptions.Subscription, error) {
+	return nil, subscriptions.ErrSubscriptionsDisabled
+}
+
+// Unsubscribe does nothing
+func (dsh *disabledSubscriptionsHandler) Unsubscribe(_ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsh *disabledSubscriptionsHandler) IsInterfaceNil() bool {
+	return dsh == nil
+}
@@ -0,0 +1,35 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/filedriver"
+)
+
+const megabyte = 1024 * 1024
+
+// FileDriverFactoryArgs defines the args needed for the file driver creation
+type FileDriverFactoryArgs struct {
+	Enabled                 bool
+	Path                    string
+	UseNamedPipe            bool
+	Format                  string
+	MaxFileSizeInMB         uint64
+	RemoveAcknowledgedFiles bool
+	Marshaller              marshal.Marshalizer
+	Hasher                  hashing.Hasher
+}
+
+// CreateFileDriver will create a new driver that writes the records to local files or to a named pipe
+func CreateFileDriver(args *FileDriverFactoryArgs) (outport.Driver, error) {
+	return filedriver.NewFileDriver(filedriver.ArgsFileDriver{
+		Path:                    args.Path,
+		UseNamedPipe:            args.UseNamedPipe,
+		Format:                  args.Format,
+		MaxFileSize:             args.MaxFileSizeInMB * megabyte,
+		RemoveAcknowledgedFiles: args.RemoveAcknowledgedFiles,
+		Marshalizer:             args.Marshaller,
+		Hasher:                  args.Hasher,
+	})
+}
@@ -0,0 +1,46 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockFileDriverFactoryArgs(t *testing.T) *factory.FileDriverFactoryArgs {
+	return &factory.FileDriverFactoryArgs{
+		Enabled:         true,
+		Path:            t.TempDir(),
+		Format:          "protobuf",
+		MaxFileSizeInMB: 1,
+		Marshaller:      &testscommon.MarshalizerMock{},
+		Hasher:          &hashingMocks.HasherMock{},
+	}
+}
+
+func TestCreateFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFileDriverFactoryArgs(t)
+		args.Marshaller = nil
+
+		fd, err := factory.CreateFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fd, err := factory.CreateFileDriver(createMockFileDriverFactoryArgs(t))
+		require.Nil(t, err)
+		require.NotNil(t, fd)
+		require.Nil(t, fd.Close())
+	})
+}
@@ -1,12 +1,15 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/notifier"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // EventNotifierFactoryArgs defines the args needed for event notifier creation
@@ -19,6 +22,16 @@ type EventNotifierFactoryArgs struct {
 	Marshaller       marshal.Marshalizer
 	Hasher           hashing.Hasher
 	PubKeyConverter  core.PubkeyConverter
+	PersistentQueue  *PersistentQueueFactoryArgs
+}
+
+// PersistentQueueFactoryArgs defines the args needed for the creation of the disk-backed queue used by the
+// event notifier. The queue is not used if the args are nil
+type PersistentQueueFactoryArgs struct {
+	Storer               storage.Storer
+	StatusHandler        core.AppStatusHandler
+	InitialRetryInterval time.Duration
+	MaxRetryInterval     time.Duration
 }
 
 // CreateEventNotifier will create a new event notifier client instance
@@ -41,6 +54,21 @@ func CreateEventNotifier(args *EventNotifierFactoryArgs) (outport.Driver, error)
 		PubKeyConverter: args.PubKeyConverter,
 	}
 
+	if args.PersistentQueue != nil {
+		persistentQueue, err := notifier.NewPersistentQueue(notifier.ArgsPersistentQueue{
+			HttpClient:           httpClient,
+			Storer:               args.PersistentQueue.Storer,
+			StatusHandler:        args.PersistentQueue.StatusHandler,
+			InitialRetryInterval: args.PersistentQueue.InitialRetryInterval,
+			MaxRetryInterval:     args.PersistentQueue.MaxRetryInterval,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		notifierArgs.HttpClient = persistentQueue
+	}
+
 	return notifier.NewEventNotifier(notifierArgs)
 }
 
@@ -2,12 +2,15 @@ package factory_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/notifier"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,6 +63,21 @@ func TestCreateEventNotifier(t *testing.T) {
 		require.Equal(t, outport.ErrNilPubKeyConverter, err)
 	})
 
+	t.Run("invalid persistent queue args", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.PersistentQueue = &factory.PersistentQueueFactoryArgs{
+			StatusHandler:        &statusHandler.AppStatusHandlerStub{},
+			InitialRetryInterval: time.Millisecond,
+			MaxRetryInterval:     time.Second,
+		}
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, notifier.ErrNilStorer, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -67,4 +85,21 @@ func TestCreateEventNotifier(t *testing.T) {
 		require.Nil(t, err)
 		require.NotNil(t, en)
 	})
+
+	t.Run("with persistent queue should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.PersistentQueue = &factory.PersistentQueueFactoryArgs{
+			Storer:               testscommon.CreateMemUnit(),
+			StatusHandler:        &statusHandler.AppStatusHandlerStub{},
+			InitialRetryInterval: time.Millisecond,
+			MaxRetryInterval:     time.Second,
+		}
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, err)
+		require.NotNil(t, en)
+		require.Nil(t, en.Close())
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
@@ -14,6 +15,8 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs  *indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs   *EventNotifierFactoryArgs
 	CovalentIndexerFactoryArgs *covalentFactory.ArgsCovalentIndexerFactory
+	FileDriverFactoryArgs      *FileDriverFactoryArgs
+	SubscriptionsHub           outport.Driver
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -52,7 +55,23 @@ func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFact
 		return err
 	}
 
-	return nil
+	err = createAndSubscribeFileDriverIfNeeded(outport, args.FileDriverFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	return subscribeSubscriptionsHubIfNeeded(outport, args.SubscriptionsHub)
+}
+
+func subscribeSubscriptionsHubIfNeeded(
+	outport outport.OutportHandler,
+	subscriptionsHub outport.Driver,
+) error {
+	if check.IfNil(subscriptionsHub) {
+		return nil
+	}
+
+	return outport.SubscribeDriver(subscriptionsHub)
 }
 
 func createAndSubscribeCovalentDriverIfNeeded(
@@ -103,6 +122,22 @@ func createAndSubscribeEventNotifierIfNeeded(
 	return outport.SubscribeDriver(eventNotifier)
 }
 
+func createAndSubscribeFileDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *FileDriverFactoryArgs,
+) error {
+	if args == nil || !args.Enabled {
+		return nil
+	}
+
+	fileDriver, err := CreateFileDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return outport.SubscribeDriver(fileDriver)
+}
+
 func checkArguments(args *OutportFactoryArgs) error {
 	if args == nil {
 		return outport.ErrNilArgsOutportFactory
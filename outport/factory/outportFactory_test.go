@@ -31,6 +31,7 @@ func createMockArgsOutportHandler(indexerEnabled, notifierEnabled, covalentEnabl
 		ElasticIndexerFactoryArgs:  mockElasticArgs,
 		EventNotifierFactoryArgs:   mockNotifierArgs,
 		CovalentIndexerFactoryArgs: mockCovalentArgs,
+		FileDriverFactoryArgs:      &factory.FileDriverFactoryArgs{},
 	}
 }
 
@@ -136,3 +137,47 @@ func TestCreateOutport_SubscribeNotifierDriver(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 	require.Nil(t, err)
 }
+
+func TestCreateOutport_SubscribeSubscriptionsHub(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+
+	hub, err := factory.CreateSubscriptionsHub(&factory.SubscriptionsHubFactoryArgs{
+		SubscriberBufferSize: 10,
+		MaxSubscribers:       10,
+		Marshaller:           &mock.MarshalizerMock{},
+		Hasher:               &hashingMocks.HasherMock{},
+		PubKeyConverter:      &mock.PubkeyConverterMock{},
+	})
+	require.Nil(t, err)
+
+	args.SubscriptionsHub = hub
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeFileDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+
+	args.FileDriverFactoryArgs = &factory.FileDriverFactoryArgs{
+		Enabled:         true,
+		Path:            t.TempDir(),
+		Format:          "json",
+		MaxFileSizeInMB: 1,
+		Marshaller:      &mock.MarshalizerMock{},
+		Hasher:          &hashingMocks.HasherMock{},
+	}
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+}
@@ -0,0 +1,37 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+)
+
+// SubscriptionsHubFactoryArgs defines the args needed for the subscriptions hub creation
+type SubscriptionsHubFactoryArgs struct {
+	SubscriberBufferSize int
+	MaxSubscribers       int
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	PubKeyConverter      core.PubkeyConverter
+}
+
+// SubscriptionsHub defines an outport driver that is also able to register subscribers
+type SubscriptionsHub interface {
+	outport.Driver
+	subscriptions.SubscriptionsHandler
+}
+
+// CreateSubscriptionsHub will create a new subscriptions hub instance
+func CreateSubscriptionsHub(args *SubscriptionsHubFactoryArgs) (SubscriptionsHub, error) {
+	hubArgs := subscriptions.ArgsSubscriptionsHub{
+		Marshalizer:          args.Marshaller,
+		Hasher:               args.Hasher,
+		PubKeyConverter:      args.PubKeyConverter,
+		SubscriberBufferSize: args.SubscriberBufferSize,
+		MaxSubscribers:       args.MaxSubscribers,
+	}
+
+	return subscriptions.NewSubscriptionsHub(hubArgs)
+}
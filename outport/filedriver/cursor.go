@@ -0,0 +1,94 @@
+package filedriver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// CursorFileName is the name of the file, placed next to the records, that holds the position of the last
+	// record written by the driver
+	CursorFileName = "cursor.json"
+	// AckFileName is the name of the file, placed next to the records, that holds the sequence of the last record
+	// processed by the downstream consumer
+	AckFileName = "ack.json"
+
+	temporaryFileSuffix = ".tmp"
+)
+
+// Cursor holds the position of the last record written by the driver. It is updated after each written record,
+// so the driver is able to continue the sequence, and to drop a partially written record, after a restart
+type Cursor struct {
+	Sequence uint64 `json:"sequence"`
+	FileName string `json:"fileName,omitempty"`
+	Offset   int64  `json:"offset,omitempty"`
+}
+
+// Ack holds the sequence of the last record processed by the downstream consumer. The consumer writes it
+// so it can resume from the next record after a restart, while the driver reads it in order to remove the
+// files that only hold acknowledged records
+type Ack struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+// LoadCursor reads the cursor from the provided file. An empty cursor is returned if the file does not exist
+func LoadCursor(path string) (*Cursor, error) {
+	cursor := &Cursor{}
+	err := loadJSONFile(path, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
+
+// LoadAck reads the acknowledged sequence from the provided file. An empty ack is returned if the file does not exist
+func LoadAck(path string) (*Ack, error) {
+	ack := &Ack{}
+	err := loadJSONFile(path, ack)
+	if err != nil {
+		return nil, err
+	}
+
+	return ack, nil
+}
+
+// SaveAck atomically writes the acknowledged sequence in the provided file
+func SaveAck(path string, sequence uint64) error {
+	return saveJSONFile(path, &Ack{Sequence: sequence})
+}
+
+func saveCursor(path string, cursor *Cursor) error {
+	return saveJSONFile(path, cursor)
+}
+
+func loadJSONFile(path string, value interface{}) error {
+	buff, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, value)
+}
+
+// saveJSONFile writes the value in a temporary file which then replaces the destination file, so a reader
+// never sees a partially written file
+func saveJSONFile(path string, value interface{}) error {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	temporaryPath := filepath.Clean(path) + temporaryFileSuffix
+	err = ioutil.WriteFile(temporaryPath, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(temporaryPath, path)
+}
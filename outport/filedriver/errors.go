@@ -0,0 +1,27 @@
+package filedriver
+
+import "errors"
+
+// ErrEmptyPath signals that an empty path was provided
+var ErrEmptyPath = errors.New("empty path")
+
+// ErrInvalidFormat signals that an invalid records format was provided
+var ErrInvalidFormat = errors.New("invalid records format")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size was provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrNotANamedPipe signals that the provided path does not point to a named pipe
+var ErrNotANamedPipe = errors.New("the provided path is not a named pipe")
+
+// ErrNilSaveBlockData signals that nil save block data was provided
+var ErrNilSaveBlockData = errors.New("nil save block data")
+
+// ErrNilHeader signals that a nil header was provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilTransactionsPool signals that a nil transactions pool was provided
+var ErrNilTransactionsPool = errors.New("nil transactions pool")
+
+// ErrRecordTooLarge signals that an encoded record does not fit in the length prefix
+var ErrRecordTooLarge = errors.New("record too large")
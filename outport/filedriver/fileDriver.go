@@ -0,0 +1,385 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. record.proto
+
+package filedriver
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("outport/filedriver")
+
+const (
+	// RecordTypeSaveBlock is the type of the records written on SaveBlock calls
+	RecordTypeSaveBlock = "saveBlock"
+	// RecordTypeRevertIndexedBlock is the type of the records written on RevertIndexedBlock calls
+	RecordTypeRevertIndexedBlock = "revertIndexedBlock"
+	// RecordTypeFinalizedBlock is the type of the records written on FinalizedBlock calls
+	RecordTypeFinalizedBlock = "finalizedBlock"
+	// RecordTypeSaveRoundsInfo is the type of the records written on SaveRoundsInfo calls
+	RecordTypeSaveRoundsInfo = "saveRoundsInfo"
+	// RecordTypeSaveValidatorsRating is the type of the records written on SaveValidatorsRating calls
+	RecordTypeSaveValidatorsRating = "saveValidatorsRating"
+
+	poolEntryTransaction = "transaction"
+	poolEntryScr         = "scr"
+	poolEntryReward      = "reward"
+	poolEntryInvalid     = "invalid"
+	poolEntryReceipt     = "receipt"
+	poolEntryLog         = "log"
+)
+
+// ArgsFileDriver defines the arguments needed for the file driver creation
+type ArgsFileDriver struct {
+	Path                    string
+	UseNamedPipe            bool
+	Format                  string
+	MaxFileSize             uint64
+	RemoveAcknowledgedFiles bool
+	Marshalizer             marshal.Marshalizer
+	Hasher                  hashing.Hasher
+}
+
+type fileDriver struct {
+	mutWrite     sync.Mutex
+	encoder      recordEncoder
+	writer       recordWriter
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	lastSequence uint64
+}
+
+// NewFileDriver creates an outport driver that writes a record for each saved, reverted or finalized block and for
+// each rounds info or validators rating update. The records are appended either to a set of rotating files placed
+// in the directory pointed by the path, or to the named pipe found at the path. Each record carries a sequence
+// number which continues after a restart of the node
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, err := newRecordEncoder(args.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := createRecordWriter(args, encoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileDriver{
+		encoder:      encoder,
+		writer:       writer,
+		marshalizer:  args.Marshalizer,
+		hasher:       args.Hasher,
+		lastSequence: writer.LastSequence(),
+	}, nil
+}
+
+func checkArgs(args ArgsFileDriver) error {
+	if len(args.Path) == 0 {
+		return ErrEmptyPath
+	}
+	if !args.UseNamedPipe && args.MaxFileSize == 0 {
+		return ErrInvalidMaxFileSize
+	}
+	if check.IfNil(args.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+
+	return nil
+}
+
+func createRecordWriter(args ArgsFileDriver, encoder recordEncoder) (recordWriter, error) {
+	if args.UseNamedPipe {
+		return newNamedPipeWriter(args.Path)
+	}
+
+	return newRotatingFileWriter(argsRotatingFileWriter{
+		directory:               args.Path,
+		fileExtension:           encoder.FileExtension(),
+		maxFileSize:             args.MaxFileSize,
+		removeAcknowledgedFiles: args.RemoveAcknowledgedFiles,
+	})
+}
+
+// SaveBlock writes a record holding the block, together with its transactions and logs
+func (fd *fileDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return ErrNilSaveBlockData
+	}
+	if check.IfNil(args.Header) {
+		return ErrNilHeader
+	}
+	if args.TransactionsPool == nil {
+		return ErrNilTransactionsPool
+	}
+
+	savedBlock, err := fd.createSavedBlock(args)
+	if err != nil {
+		return fmt.Errorf("%w in fileDriver.SaveBlock while encoding block data", err)
+	}
+
+	return fd.writeRecord(&Record{
+		Type:       RecordTypeSaveBlock,
+		SavedBlock: savedBlock,
+	})
+}
+
+func (fd *fileDriver) createSavedBlock(args *indexer.ArgsSaveBlockData) (*SavedBlock, error) {
+	header, err := fd.marshalizer.Marshal(args.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if !check.IfNil(args.Body) {
+		body, err = fd.marshalizer.Marshal(args.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transactions, err := fd.createTransactionsEntries(args.TransactionsPool)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := fd.createLogsEntries(args.TransactionsPool.Logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SavedBlock{
+		HeaderHash:             args.HeaderHash,
+		HeaderType:             getHeaderType(args.Header),
+		ShardID:                args.Header.GetShardID(),
+		Nonce:                  args.Header.GetNonce(),
+		Round:                  args.Header.GetRound(),
+		Epoch:                  args.Header.GetEpoch(),
+		Header:                 header,
+		Body:                   body,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		Transactions:           transactions,
+		Logs:                   logs,
+	}, nil
+}
+
+func (fd *fileDriver) createTransactionsEntries(pool *indexer.Pool) ([]*PoolEntry, error) {
+	entries := make([]*PoolEntry, 0)
+	poolsByKind := []struct {
+		kind string
+		txs  map[string]data.TransactionHandler
+	}{
+		{kind: poolEntryTransaction, txs: pool.Txs},
+		{kind: poolEntryScr, txs: pool.Scrs},
+		{kind: poolEntryReward, txs: pool.Rewards},
+		{kind: poolEntryInvalid, txs: pool.Invalid},
+		{kind: poolEntryReceipt, txs: pool.Receipts},
+	}
+
+	for _, poolOfKind := range poolsByKind {
+		hashes := make([]string, 0, len(poolOfKind.txs))
+		for hash := range poolOfKind.txs {
+			hashes = append(hashes, hash)
+		}
+		sort.Strings(hashes)
+
+		for _, hash := range hashes {
+			tx := poolOfKind.txs[hash]
+			if check.IfNil(tx) {
+				continue
+			}
+
+			txBytes, err := fd.marshalizer.Marshal(tx)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, &PoolEntry{
+				Kind: poolOfKind.kind,
+				Hash: []byte(hash),
+				Data: txBytes,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+func (fd *fileDriver) createLogsEntries(logs []*data.LogData) ([]*PoolEntry, error) {
+	entries := make([]*PoolEntry, 0, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		logBytes, err := fd.marshalizer.Marshal(logData.LogHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &PoolEntry{
+			Kind: poolEntryLog,
+			Hash: []byte(logData.TxHash),
+			Data: logBytes,
+		})
+	}
+
+	return entries, nil
+}
+
+// RevertIndexedBlock writes a record holding the identification data of the reverted block
+func (fd *fileDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	headerHash, err := core.CalculateHash(fd.marshalizer, fd.hasher, header)
+	if err != nil {
+		return fmt.Errorf("%w in fileDriver.RevertIndexedBlock while computing the block hash", err)
+	}
+
+	return fd.writeRecord(&Record{
+		Type: RecordTypeRevertIndexedBlock,
+		RevertedBlock: &RevertedBlock{
+			HeaderHash: headerHash,
+			ShardID:    header.GetShardID(),
+			Nonce:      header.GetNonce(),
+			Round:      header.GetRound(),
+			Epoch:      header.GetEpoch(),
+		},
+	})
+}
+
+// FinalizedBlock writes a record holding the hash of the finalized block
+func (fd *fileDriver) FinalizedBlock(headerHash []byte) error {
+	return fd.writeRecord(&Record{
+		Type:           RecordTypeFinalizedBlock,
+		FinalizedBlock: &FinalizedBlock{HeaderHash: headerHash},
+	})
+}
+
+// SaveRoundsInfo writes a record holding the provided rounds information
+func (fd *fileDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	rounds := make([]*RoundInfo, 0, len(roundsInfos))
+	for _, roundInfo := range roundsInfos {
+		if roundInfo == nil {
+			continue
+		}
+
+		rounds = append(rounds, &RoundInfo{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			ShardID:          roundInfo.ShardId,
+			Epoch:            roundInfo.Epoch,
+			Timestamp:        uint64(roundInfo.Timestamp),
+		})
+	}
+
+	return fd.writeRecord(&Record{
+		Type:       RecordTypeSaveRoundsInfo,
+		RoundsInfo: &RoundsInfo{Rounds: rounds},
+	})
+}
+
+// SaveValidatorsRating writes a record holding the provided validators ratings
+func (fd *fileDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	ratings := make([]*ValidatorRating, 0, len(infoRating))
+	for _, ratingInfo := range infoRating {
+		if ratingInfo == nil {
+			continue
+		}
+
+		ratings = append(ratings, &ValidatorRating{
+			PublicKey: ratingInfo.PublicKey,
+			Rating:    ratingInfo.Rating,
+		})
+	}
+
+	return fd.writeRecord(&Record{
+		Type: RecordTypeSaveValidatorsRating,
+		ValidatorsRating: &ValidatorsRating{
+			IndexID: indexID,
+			Ratings: ratings,
+		},
+	})
+}
+
+// SaveValidatorsPubKeys returns nil
+func (fd *fileDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (fd *fileDriver) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// writeRecord assigns the next sequence to the record and writes it. The sequence is consumed only if the write
+// succeeds, so a retried call writes the record under the same sequence
+func (fd *fileDriver) writeRecord(record *Record) error {
+	fd.mutWrite.Lock()
+	defer fd.mutWrite.Unlock()
+
+	record.Sequence = fd.lastSequence + 1
+	record.Timestamp = time.Now().Unix()
+
+	encodedRecord, err := fd.encoder.Encode(record)
+	if err != nil {
+		return fmt.Errorf("%w in fileDriver while encoding the %s record", err, record.Type)
+	}
+
+	err = fd.writer.Write(record.Sequence, encodedRecord)
+	if err != nil {
+		return fmt.Errorf("%w in fileDriver while writing the %s record", err, record.Type)
+	}
+
+	fd.lastSequence = record.Sequence
+
+	return nil
+}
+
+func getHeaderType(header data.HeaderHandler) string {
+	switch header.(type) {
+	case *block.MetaBlock:
+		return "MetaBlock"
+	case *block.HeaderV2:
+		return "HeaderV2"
+	case *block.Header:
+		return "Header"
+	default:
+		return fmt.Sprintf("%T", header)
+	}
+}
+
+// Close closes the underlying file or named pipe
+func (fd *fileDriver) Close() error {
+	fd.mutWrite.Lock()
+	defer fd.mutWrite.Unlock()
+
+	return fd.writer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
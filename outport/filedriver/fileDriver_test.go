@@ -0,0 +1,290 @@
+package filedriver_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/outport/filedriver"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFileDriver(t *testing.T) filedriver.ArgsFileDriver {
+	return filedriver.ArgsFileDriver{
+		Path:        t.TempDir(),
+		Format:      filedriver.FormatJSON,
+		MaxFileSize: 1024 * 1024,
+		Marshalizer: &testscommon.MarshalizerMock{},
+		Hasher:      &hashingMocks.HasherMock{},
+	}
+}
+
+func createSaveBlockData() *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash:     []byte("header hash"),
+		Header:         &block.Header{Nonce: 7, Round: 8, Epoch: 2, ShardID: 1},
+		Body:           &block.Body{},
+		SignersIndexes: []uint64{0, 1},
+		TransactionsPool: &indexer.Pool{
+			Txs:  map[string]data.TransactionHandler{"tx hash": &transaction.Transaction{Nonce: 3}},
+			Scrs: map[string]data.TransactionHandler{},
+			Logs: []*data.LogData{{LogHandler: &transaction.Log{Address: []byte("address")}, TxHash: "tx hash"}},
+		},
+	}
+}
+
+func readJSONRecords(t *testing.T, path string) []*filedriver.Record {
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]*filedriver.Record, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := &filedriver.Record{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func recordsFilePath(directory string, firstSequence string, extension string) string {
+	return filepath.Join(directory, "records-"+firstSequence+extension)
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.Path = ""
+
+		fd, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(fd))
+		assert.Equal(t, filedriver.ErrEmptyPath, err)
+	})
+	t.Run("zero max file size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.MaxFileSize = 0
+
+		fd, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(fd))
+		assert.Equal(t, filedriver.ErrInvalidMaxFileSize, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.Marshalizer = nil
+
+		fd, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(fd))
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.Hasher = nil
+
+		fd, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(fd))
+		assert.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.Format = "xml"
+
+		fd, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(fd))
+		assert.True(t, errors.Is(err, filedriver.ErrInvalidFormat))
+	})
+	t.Run("path is not a named pipe should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.UseNamedPipe = true
+		args.Path = filepath.Join(args.Path, "regular file")
+		require.Nil(t, ioutil.WriteFile(args.Path, []byte("data"), 0644))
+
+		fd, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(fd))
+		assert.True(t, errors.Is(err, filedriver.ErrNotANamedPipe))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fd, err := filedriver.NewFileDriver(createMockArgsFileDriver(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(fd))
+		assert.Nil(t, fd.Close())
+	})
+}
+
+func TestFileDriver_SaveBlockInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fd, _ := filedriver.NewFileDriver(createMockArgsFileDriver(t))
+	defer func() {
+		_ = fd.Close()
+	}()
+
+	assert.Equal(t, filedriver.ErrNilSaveBlockData, fd.SaveBlock(nil))
+
+	args := createSaveBlockData()
+	args.Header = nil
+	assert.Equal(t, filedriver.ErrNilHeader, fd.SaveBlock(args))
+
+	args = createSaveBlockData()
+	args.TransactionsPool = nil
+	assert.Equal(t, filedriver.ErrNilTransactionsPool, fd.SaveBlock(args))
+
+	assert.Equal(t, filedriver.ErrNilHeader, fd.RevertIndexedBlock(nil, nil))
+}
+
+func TestFileDriver_ShouldWriteJSONLines(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFileDriver(t)
+	fd, _ := filedriver.NewFileDriver(args)
+
+	require.Nil(t, fd.SaveBlock(createSaveBlockData()))
+	require.Nil(t, fd.RevertIndexedBlock(&block.Header{Nonce: 7}, &block.Body{}))
+	require.Nil(t, fd.FinalizedBlock([]byte("finalized hash")))
+	require.Nil(t, fd.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 5, BlockWasProposed: true}, nil}))
+	require.Nil(t, fd.SaveValidatorsRating("0_1", []*indexer.ValidatorRatingInfo{{PublicKey: "key", Rating: 50}}))
+	require.Nil(t, fd.SaveValidatorsPubKeys(nil, 0))
+	require.Nil(t, fd.SaveAccounts(0, nil))
+	require.Nil(t, fd.Close())
+
+	records := readJSONRecords(t, recordsFilePath(args.Path, "00000000000000000001", ".jsonl"))
+	require.Equal(t, 5, len(records))
+	for i, record := range records {
+		assert.Equal(t, uint64(i+1), record.Sequence)
+		assert.NotZero(t, record.Timestamp)
+	}
+
+	assert.Equal(t, filedriver.RecordTypeSaveBlock, records[0].Type)
+	savedBlock := records[0].SavedBlock
+	require.NotNil(t, savedBlock)
+	assert.Equal(t, []byte("header hash"), savedBlock.HeaderHash)
+	assert.Equal(t, "Header", savedBlock.HeaderType)
+	assert.Equal(t, uint32(1), savedBlock.ShardID)
+	assert.Equal(t, uint64(7), savedBlock.Nonce)
+	assert.Equal(t, uint64(8), savedBlock.Round)
+	assert.Equal(t, uint32(2), savedBlock.Epoch)
+	assert.Equal(t, []uint64{0, 1}, savedBlock.SignersIndexes)
+	require.Equal(t, 1, len(savedBlock.Transactions))
+	assert.Equal(t, "transaction", savedBlock.Transactions[0].Kind)
+	assert.Equal(t, []byte("tx hash"), savedBlock.Transactions[0].Hash)
+	require.Equal(t, 1, len(savedBlock.Logs))
+	assert.Equal(t, "log", savedBlock.Logs[0].Kind)
+
+	header := &block.Header{}
+	require.Nil(t, json.Unmarshal(savedBlock.Header, header))
+	assert.Equal(t, uint64(7), header.Nonce)
+
+	assert.Equal(t, filedriver.RecordTypeRevertIndexedBlock, records[1].Type)
+	require.NotNil(t, records[1].RevertedBlock)
+	assert.Equal(t, uint64(7), records[1].RevertedBlock.Nonce)
+	assert.NotEmpty(t, records[1].RevertedBlock.HeaderHash)
+
+	assert.Equal(t, filedriver.RecordTypeFinalizedBlock, records[2].Type)
+	assert.Equal(t, []byte("finalized hash"), records[2].FinalizedBlock.HeaderHash)
+
+	assert.Equal(t, filedriver.RecordTypeSaveRoundsInfo, records[3].Type)
+	require.Equal(t, 1, len(records[3].RoundsInfo.Rounds))
+	assert.Equal(t, uint64(5), records[3].RoundsInfo.Rounds[0].Index)
+	assert.True(t, records[3].RoundsInfo.Rounds[0].BlockWasProposed)
+
+	assert.Equal(t, filedriver.RecordTypeSaveValidatorsRating, records[4].Type)
+	assert.Equal(t, "0_1", records[4].ValidatorsRating.IndexID)
+	assert.Equal(t, float32(50), records[4].ValidatorsRating.Ratings[0].Rating)
+
+	cursor, err := filedriver.LoadCursor(filepath.Join(args.Path, filedriver.CursorFileName))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), cursor.Sequence)
+	assert.Equal(t, "records-00000000000000000001.jsonl", cursor.FileName)
+}
+
+func TestFileDriver_ShouldWriteLengthPrefixedProtobuf(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFileDriver(t)
+	args.Format = filedriver.FormatProtobuf
+	fd, _ := filedriver.NewFileDriver(args)
+
+	require.Nil(t, fd.FinalizedBlock([]byte("hash 1")))
+	require.Nil(t, fd.FinalizedBlock([]byte("hash 2")))
+	require.Nil(t, fd.Close())
+
+	buff, err := ioutil.ReadFile(recordsFilePath(args.Path, "00000000000000000001", ".pb"))
+	require.Nil(t, err)
+
+	for i := 1; i <= 2; i++ {
+		require.True(t, len(buff) >= 4)
+		size := binary.BigEndian.Uint32(buff)
+		buff = buff[4:]
+
+		record := &filedriver.Record{}
+		require.Nil(t, record.Unmarshal(buff[:size]))
+		buff = buff[size:]
+
+		assert.Equal(t, uint64(i), record.Sequence)
+		assert.Equal(t, filedriver.RecordTypeFinalizedBlock, record.Type)
+		assert.Equal(t, []byte(fmt.Sprintf("hash %d", i)), record.FinalizedBlock.HeaderHash)
+	}
+	assert.Empty(t, buff)
+}
+
+func TestFileDriver_ShouldContinueAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFileDriver(t)
+	fd, _ := filedriver.NewFileDriver(args)
+	require.Nil(t, fd.FinalizedBlock([]byte("hash 1")))
+	require.Nil(t, fd.FinalizedBlock([]byte("hash 2")))
+	require.Nil(t, fd.Close())
+
+	// simulate a record that was partially written before the node stopped
+	path := recordsFilePath(args.Path, "00000000000000000001", ".jsonl")
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.Nil(t, err)
+	_, err = file.Write([]byte(`{"Sequence":3,"Type":"fina`))
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	fd, err = filedriver.NewFileDriver(args)
+	require.Nil(t, err)
+	require.Nil(t, fd.FinalizedBlock([]byte("hash 3")))
+	require.Nil(t, fd.Close())
+
+	records := readJSONRecords(t, path)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, uint64(3), records[2].Sequence)
+	assert.Equal(t, []byte("hash 3"), records[2].FinalizedBlock.HeaderHash)
+}
@@ -0,0 +1,12 @@
+package filedriver
+
+type recordEncoder interface {
+	Encode(record *Record) ([]byte, error)
+	FileExtension() string
+}
+
+type recordWriter interface {
+	Write(sequence uint64, encodedRecord []byte) error
+	LastSequence() uint64
+	Close() error
+}
@@ -0,0 +1,88 @@
+package filedriver
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+const (
+	cursorFileSuffix = "." + CursorFileName
+)
+
+// namedPipeWriter writes the records to a named pipe created by the consumer. The pipe is opened on the first write
+// and reopened after the consumer disconnects, the writes failing while no consumer is connected
+type namedPipeWriter struct {
+	path       string
+	cursorPath string
+	cursor     *Cursor
+	pipe       *os.File
+}
+
+func newNamedPipeWriter(path string) (*namedPipeWriter, error) {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.Mode()&os.ModeNamedPipe == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotANamedPipe, path)
+	}
+
+	writer := &namedPipeWriter{
+		path:       path,
+		cursorPath: path + cursorFileSuffix,
+	}
+
+	writer.cursor, err = LoadCursor(writer.cursorPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write writes the encoded record to the named pipe and then updates the cursor
+func (npw *namedPipeWriter) Write(sequence uint64, encodedRecord []byte) error {
+	if npw.pipe == nil {
+		// opening in non-blocking mode fails instead of waiting for a consumer to connect
+		pipe, err := os.OpenFile(npw.path, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+		if err != nil {
+			return fmt.Errorf("%w while opening the named pipe, is the consumer connected?", err)
+		}
+
+		npw.pipe = pipe
+	}
+
+	_, err := npw.pipe.Write(encodedRecord)
+	if err != nil {
+		log.LogIfError(npw.Close())
+		return err
+	}
+
+	newCursor := &Cursor{Sequence: sequence}
+	err = saveCursor(npw.cursorPath, newCursor)
+	if err != nil {
+		return err
+	}
+
+	npw.cursor = newCursor
+
+	return nil
+}
+
+// LastSequence returns the sequence of the last written record
+func (npw *namedPipeWriter) LastSequence() uint64 {
+	return npw.cursor.Sequence
+}
+
+// Close closes the named pipe
+func (npw *namedPipeWriter) Close() error {
+	if npw.pipe == nil {
+		return nil
+	}
+
+	err := npw.pipe.Close()
+	npw.pipe = nil
+
+	return err
+}
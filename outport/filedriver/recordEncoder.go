@@ -0,0 +1,70 @@
+package filedriver
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+const (
+	// FormatJSON is the format that writes each record as a JSON document on its own line
+	FormatJSON = "json"
+	// FormatProtobuf is the format that writes each record as a protobuf message prefixed by its length,
+	// encoded as a big endian uint32
+	FormatProtobuf = "protobuf"
+
+	lengthPrefixSize = 4
+)
+
+type jsonLinesEncoder struct{}
+
+// Encode returns the JSON encoding of the record, followed by a new line
+func (jle *jsonLinesEncoder) Encode(record *Record) ([]byte, error) {
+	buff, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buff, '\n'), nil
+}
+
+// FileExtension returns the extension of the files holding JSON lines
+func (jle *jsonLinesEncoder) FileExtension() string {
+	return ".jsonl"
+}
+
+type lengthPrefixedEncoder struct{}
+
+// Encode returns the protobuf encoding of the record, prefixed by its length
+func (lpe *lengthPrefixedEncoder) Encode(record *Record) ([]byte, error) {
+	size := record.Size()
+	if uint64(size) > math.MaxUint32 {
+		return nil, fmt.Errorf("%w, size: %d", ErrRecordTooLarge, size)
+	}
+
+	buff := make([]byte, lengthPrefixSize+size)
+	binary.BigEndian.PutUint32(buff, uint32(size))
+	_, err := record.MarshalToSizedBuffer(buff[lengthPrefixSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// FileExtension returns the extension of the files holding length prefixed protobuf records
+func (lpe *lengthPrefixedEncoder) FileExtension() string {
+	return ".pb"
+}
+
+func newRecordEncoder(format string) (recordEncoder, error) {
+	switch format {
+	case FormatJSON:
+		return &jsonLinesEncoder{}, nil
+	case FormatProtobuf:
+		return &lengthPrefixedEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFormat, format)
+	}
+}
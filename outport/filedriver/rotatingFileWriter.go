@@ -0,0 +1,240 @@
+package filedriver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const recordsFilePrefix = "records-"
+
+type argsRotatingFileWriter struct {
+	directory               string
+	fileExtension           string
+	maxFileSize             uint64
+	removeAcknowledgedFiles bool
+}
+
+// rotatingFileWriter appends the records to a set of files placed in the same directory. A new file is started
+// when the current one would exceed the maximum size, each file being named after the sequence of its first record
+type rotatingFileWriter struct {
+	directory               string
+	fileExtension           string
+	maxFileSize             uint64
+	removeAcknowledgedFiles bool
+	cursorPath              string
+	ackPath                 string
+	cursor                  *Cursor
+	file                    *os.File
+}
+
+func newRotatingFileWriter(args argsRotatingFileWriter) (*rotatingFileWriter, error) {
+	err := os.MkdirAll(args.directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &rotatingFileWriter{
+		directory:               args.directory,
+		fileExtension:           args.fileExtension,
+		maxFileSize:             args.maxFileSize,
+		removeAcknowledgedFiles: args.removeAcknowledgedFiles,
+		cursorPath:              filepath.Join(args.directory, CursorFileName),
+		ackPath:                 filepath.Join(args.directory, AckFileName),
+	}
+
+	writer.cursor, err = LoadCursor(writer.cursorPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.reopenCurrentFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// reopenCurrentFile opens the file pointed by the cursor, dropping anything written after the last record
+// that was recorded in the cursor
+func (rfw *rotatingFileWriter) reopenCurrentFile() error {
+	if len(rfw.cursor.FileName) == 0 {
+		return nil
+	}
+
+	file, err := os.OpenFile(filepath.Join(rfw.directory, rfw.cursor.FileName), os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	err = file.Truncate(rfw.cursor.Offset)
+	if err == nil {
+		_, err = file.Seek(rfw.cursor.Offset, 0)
+	}
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	rfw.file = file
+
+	return nil
+}
+
+// Write appends the encoded record to the current file, starting a new file if needed, and then updates the cursor
+func (rfw *rotatingFileWriter) Write(sequence uint64, encodedRecord []byte) error {
+	if rfw.shouldRotate(len(encodedRecord)) {
+		err := rfw.rotate(sequence)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := rfw.file.Write(encodedRecord)
+	if err != nil {
+		return rfw.revertPartialWrite(err)
+	}
+
+	newCursor := &Cursor{
+		Sequence: sequence,
+		FileName: rfw.cursor.FileName,
+		Offset:   rfw.cursor.Offset + int64(len(encodedRecord)),
+	}
+	err = saveCursor(rfw.cursorPath, newCursor)
+	if err != nil {
+		return rfw.revertPartialWrite(err)
+	}
+
+	rfw.cursor = newCursor
+
+	return nil
+}
+
+func (rfw *rotatingFileWriter) shouldRotate(recordSize int) bool {
+	if rfw.file == nil {
+		return true
+	}
+
+	return rfw.cursor.Offset > 0 && uint64(rfw.cursor.Offset)+uint64(recordSize) > rfw.maxFileSize
+}
+
+func (rfw *rotatingFileWriter) rotate(firstSequence uint64) error {
+	if rfw.file != nil {
+		err := rfw.file.Close()
+		if err != nil {
+			return err
+		}
+		rfw.file = nil
+	}
+
+	fileName := fmt.Sprintf("%s%020d%s", recordsFilePrefix, firstSequence, rfw.fileExtension)
+	file, err := os.OpenFile(filepath.Join(rfw.directory, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	rfw.file = file
+	rfw.cursor = &Cursor{
+		Sequence: rfw.cursor.Sequence,
+		FileName: fileName,
+	}
+
+	if rfw.removeAcknowledgedFiles {
+		rfw.removeFilesWithAcknowledgedRecords()
+	}
+
+	return nil
+}
+
+// revertPartialWrite truncates the current file to the last record recorded in the cursor, so the record can be
+// written again when the call is retried
+func (rfw *rotatingFileWriter) revertPartialWrite(writeErr error) error {
+	err := rfw.file.Truncate(rfw.cursor.Offset)
+	if err == nil {
+		_, err = rfw.file.Seek(rfw.cursor.Offset, 0)
+	}
+	if err != nil {
+		log.Warn("rotatingFileWriter: could not revert partially written record", "file", rfw.cursor.FileName, "error", err)
+	}
+
+	return writeErr
+}
+
+// removeFilesWithAcknowledgedRecords removes the files, other than the current one, whose records were all
+// processed by the consumer. The last sequence of a file is the one preceding the first sequence of the next file
+func (rfw *rotatingFileWriter) removeFilesWithAcknowledgedRecords() {
+	ack, err := LoadAck(rfw.ackPath)
+	if err != nil {
+		log.Warn("rotatingFileWriter: could not load the acknowledged sequence", "error", err)
+		return
+	}
+
+	files, sequences := rfw.listRecordsFiles()
+	for i := 0; i < len(files)-1; i++ {
+		if files[i] == rfw.cursor.FileName {
+			return
+		}
+
+		lastSequence := sequences[i+1] - 1
+		if lastSequence > ack.Sequence {
+			return
+		}
+
+		err = os.Remove(filepath.Join(rfw.directory, files[i]))
+		if err != nil {
+			log.Warn("rotatingFileWriter: could not remove acknowledged records file", "file", files[i], "error", err)
+			return
+		}
+
+		log.Debug("rotatingFileWriter: removed acknowledged records file", "file", files[i])
+	}
+}
+
+func (rfw *rotatingFileWriter) listRecordsFiles() ([]string, []uint64) {
+	matches, err := filepath.Glob(filepath.Join(rfw.directory, recordsFilePrefix+"*"+rfw.fileExtension))
+	if err != nil {
+		return nil, nil
+	}
+
+	files := make([]string, 0, len(matches))
+	for _, match := range matches {
+		files = append(files, filepath.Base(match))
+	}
+	sort.Strings(files)
+
+	validFiles := make([]string, 0, len(files))
+	sequences := make([]uint64, 0, len(files))
+	for _, file := range files {
+		sequenceString := strings.TrimSuffix(strings.TrimPrefix(file, recordsFilePrefix), rfw.fileExtension)
+		sequence, errParse := strconv.ParseUint(sequenceString, 10, 64)
+		if errParse != nil || sequence == 0 {
+			continue
+		}
+
+		validFiles = append(validFiles, file)
+		sequences = append(sequences, sequence)
+	}
+
+	return validFiles, sequences
+}
+
+// LastSequence returns the sequence of the last written record
+func (rfw *rotatingFileWriter) LastSequence() uint64 {
+	return rfw.cursor.Sequence
+}
+
+// Close closes the current file
+func (rfw *rotatingFileWriter) Close() error {
+	if rfw.file == nil {
+		return nil
+	}
+
+	err := rfw.file.Close()
+	rfw.file = nil
+
+	return err
+}
@@ -0,0 +1,91 @@
+package filedriver
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listFiles(t *testing.T, directory string) []string {
+	fileInfos, err := ioutil.ReadDir(directory)
+	require.Nil(t, err)
+
+	files := make([]string, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		files = append(files, fileInfo.Name())
+	}
+
+	return files
+}
+
+func TestRotatingFileWriter_ShouldRotateWhenMaxSizeIsExceeded(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	writer, err := newRotatingFileWriter(argsRotatingFileWriter{
+		directory:     directory,
+		fileExtension: ".jsonl",
+		maxFileSize:   10,
+	})
+	require.Nil(t, err)
+
+	require.Nil(t, writer.Write(1, []byte("record 1\n")))
+	require.Nil(t, writer.Write(2, []byte("record 2\n")))
+	require.Nil(t, writer.Write(3, []byte("a record larger than the maximum size\n")))
+	require.Nil(t, writer.Write(4, []byte("record 4\n")))
+	require.Nil(t, writer.Close())
+
+	expectedFiles := []string{
+		CursorFileName,
+		"records-00000000000000000001.jsonl",
+		"records-00000000000000000002.jsonl",
+		"records-00000000000000000003.jsonl",
+		"records-00000000000000000004.jsonl",
+	}
+	assert.Equal(t, expectedFiles, listFiles(t, directory))
+
+	buff, err := ioutil.ReadFile(filepath.Join(directory, "records-00000000000000000003.jsonl"))
+	require.Nil(t, err)
+	assert.Equal(t, "a record larger than the maximum size\n", string(buff))
+	assert.Equal(t, uint64(4), writer.LastSequence())
+}
+
+func TestRotatingFileWriter_ShouldRemoveAcknowledgedFiles(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	args := argsRotatingFileWriter{
+		directory:               directory,
+		fileExtension:           ".jsonl",
+		maxFileSize:             20,
+		removeAcknowledgedFiles: true,
+	}
+	writer, err := newRotatingFileWriter(args)
+	require.Nil(t, err)
+
+	for sequence := uint64(1); sequence <= 6; sequence++ {
+		require.Nil(t, writer.Write(sequence, []byte("record\n")))
+	}
+	require.Nil(t, writer.Close())
+
+	// records 1-2 in the first file, 3-4 in the second one and 5-6 in the third one
+	require.Nil(t, SaveAck(filepath.Join(directory, AckFileName), 3))
+
+	writer, err = newRotatingFileWriter(args)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(6), writer.LastSequence())
+	require.Nil(t, writer.Write(7, []byte("record\n")))
+	require.Nil(t, writer.Close())
+
+	expectedFiles := []string{
+		AckFileName,
+		CursorFileName,
+		"records-00000000000000000003.jsonl",
+		"records-00000000000000000005.jsonl",
+		"records-00000000000000000007.jsonl",
+	}
+	assert.Equal(t, expectedFiles, listFiles(t, directory))
+}
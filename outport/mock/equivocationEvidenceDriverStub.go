@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// EquivocationEvidenceDriverStub -
+type EquivocationEvidenceDriverStub struct {
+	DriverStub
+	SaveEquivocationEvidenceCalled func(evidence *common.EquivocationEvidence) error
+}
+
+// SaveEquivocationEvidence -
+func (d *EquivocationEvidenceDriverStub) SaveEquivocationEvidence(evidence *common.EquivocationEvidence) error {
+	if d.SaveEquivocationEvidenceCalled != nil {
+		return d.SaveEquivocationEvidenceCalled(evidence)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (d *EquivocationEvidenceDriverStub) IsInterfaceNil() bool {
+	return d == nil
+}
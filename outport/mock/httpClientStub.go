@@ -2,7 +2,8 @@ package mock
 
 // HTTPClientStub -
 type HTTPClientStub struct {
-	PostCalled func(route string, payload interface{}, response interface{}) error
+	PostCalled  func(route string, payload interface{}, response interface{}) error
+	CloseCalled func() error
 }
 
 // Post -
@@ -14,6 +15,15 @@ func (stub *HTTPClientStub) Post(route string, payload interface{}, response int
 	return nil
 }
 
+// Close -
+func (stub *HTTPClientStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (stub *HTTPClientStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -6,3 +6,15 @@ import (
 
 // ErrNilTransactionsPool signals that a nil transactions pool was provided
 var ErrNilTransactionsPool = errors.New("nil transactions pool")
+
+// ErrNilHttpClient signals that a nil http client was provided
+var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilAppStatusHandler signals that a nil app status handler was provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidRetryInterval signals that an invalid retry interval was provided
+var ErrInvalidRetryInterval = errors.New("invalid retry interval")
@@ -11,14 +11,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
 var log = logger.GetOrCreate("outport/eventNotifier")
 
+var _ outport.EquivocationEvidenceDriver = (*eventNotifier)(nil)
+
 const (
 	pushEventEndpoint       = "/events/push"
 	revertEventsEndpoint    = "/events/revert"
 	finalizedEventsEndpoint = "/events/finalized"
+	equivocationEndpoint    = "/events/equivocation"
 )
 
 // SaveBlockData holds the data that will be sent to notifier instance
@@ -197,6 +202,16 @@ func (en *eventNotifier) FinalizedBlock(headerHash []byte) error {
 	return nil
 }
 
+// SaveEquivocationEvidence pushes the evidence of a validator that signed two conflicting consensus messages
+func (en *eventNotifier) SaveEquivocationEvidence(evidence *common.EquivocationEvidence) error {
+	err := en.httpClient.Post(equivocationEndpoint, evidence, nil)
+	if err != nil {
+		return fmt.Errorf("%w in eventNotifier.SaveEquivocationEvidence while posting event data", err)
+	}
+
+	return nil
+}
+
 // SaveRoundsInfo returns nil
 func (en *eventNotifier) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
 	return nil
@@ -222,7 +237,7 @@ func (en *eventNotifier) IsInterfaceNil() bool {
 	return en == nil
 }
 
-// Close returns nil
+// Close closes the http client
 func (en *eventNotifier) Close() error {
-	return nil
+	return en.httpClient.Close()
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/ElrondNetwork/elrond-go/outport/notifier"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -116,6 +117,33 @@ func TestFinalizedBlock(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestSaveEquivocationEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventNotifierArgs()
+
+	evidence := &common.EquivocationEvidence{
+		PublicKey: []byte("pk"),
+		Round:     37,
+	}
+	var postedRoute string
+	var postedPayload interface{}
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload, response interface{}) error {
+			postedRoute = route
+			postedPayload = payload
+			return nil
+		},
+	}
+
+	en, _ := notifier.NewEventNotifier(args)
+
+	err := en.SaveEquivocationEvidence(evidence)
+	require.Nil(t, err)
+	require.Equal(t, "/events/equivocation", postedRoute)
+	require.Equal(t, evidence, postedPayload)
+}
+
 func TestGetLogEventsFromTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -15,6 +15,7 @@ const (
 
 type httpClientHandler interface {
 	Post(route string, payload interface{}, response interface{}) error
+	Close() error
 }
 
 type httpClient struct {
@@ -91,3 +92,8 @@ func (h *httpClient) Post(
 
 	return json.Unmarshal(resBody, &response)
 }
+
+// Close returns nil
+func (h *httpClient) Close() error {
+	return nil
+}
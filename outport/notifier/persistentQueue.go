@@ -0,0 +1,287 @@
+package notifier
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// queuedRequest is the persisted form of a request waiting to be delivered
+type queuedRequest struct {
+	Route     string          `json:"route"`
+	Payload   json.RawMessage `json:"payload"`
+	Timestamp int64           `json:"timestamp"`
+}
+
+// ArgsPersistentQueue defines the arguments needed for the persistent queue creation
+type ArgsPersistentQueue struct {
+	HttpClient           httpClientHandler
+	Storer               storage.Storer
+	StatusHandler        core.AppStatusHandler
+	InitialRetryInterval time.Duration
+	MaxRetryInterval     time.Duration
+}
+
+// persistentQueue is an http client that saves each request in a storer and delivers the saved requests, in
+// the order they were made, through the wrapped http client. A failed delivery is retried with an exponential
+// backoff, without delivering the next requests, so the events of a block are never pushed before the events of
+// a previous block. The requests that were not delivered before the node stopped are delivered after a restart
+type persistentQueue struct {
+	httpClient           httpClientHandler
+	storer               storage.Storer
+	statusHandler        core.AppStatusHandler
+	initialRetryInterval time.Duration
+	maxRetryInterval     time.Duration
+
+	mutQueue    sync.RWMutex
+	firstIndex  uint64
+	nextIndex   uint64
+	chanNewItem chan struct{}
+	cancelFunc  func()
+	wgDelivery  sync.WaitGroup
+}
+
+// NewPersistentQueue creates a persistent queue and starts delivering the requests left in the storer
+func NewPersistentQueue(args ArgsPersistentQueue) (*persistentQueue, error) {
+	err := checkPersistentQueueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pq := &persistentQueue{
+		httpClient:           args.HttpClient,
+		storer:               args.Storer,
+		statusHandler:        args.StatusHandler,
+		initialRetryInterval: args.InitialRetryInterval,
+		maxRetryInterval:     args.MaxRetryInterval,
+		chanNewItem:          make(chan struct{}, 1),
+	}
+	pq.loadIndexes()
+	pq.updateMetrics()
+
+	var ctx context.Context
+	ctx, pq.cancelFunc = context.WithCancel(context.Background())
+	pq.wgDelivery.Add(1)
+	go pq.deliverRequests(ctx)
+
+	return pq, nil
+}
+
+func checkPersistentQueueArgs(args ArgsPersistentQueue) error {
+	if args.HttpClient == nil {
+		return ErrNilHttpClient
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if args.InitialRetryInterval <= 0 || args.MaxRetryInterval < args.InitialRetryInterval {
+		return ErrInvalidRetryInterval
+	}
+
+	return nil
+}
+
+// loadIndexes finds the range of the requests left in the storer by a previous run
+func (pq *persistentQueue) loadIndexes() {
+	isEmpty := true
+	pq.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		if len(key) != 8 {
+			return true
+		}
+
+		index := binary.BigEndian.Uint64(key)
+		if isEmpty || index < pq.firstIndex {
+			pq.firstIndex = index
+		}
+		if isEmpty || index >= pq.nextIndex {
+			pq.nextIndex = index + 1
+		}
+		isEmpty = false
+
+		return true
+	})
+
+	if !isEmpty {
+		log.Info("persistentQueue: found undelivered events", "num events", pq.nextIndex-pq.firstIndex)
+	}
+}
+
+// Post saves the request in the queue. The response is not available since the request is delivered later
+func (pq *persistentQueue) Post(route string, payload interface{}, _ interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request := &queuedRequest{
+		Route:     route,
+		Payload:   payloadBytes,
+		Timestamp: time.Now().Unix(),
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	pq.mutQueue.Lock()
+	err = pq.storer.Put(indexToKey(pq.nextIndex), requestBytes)
+	if err == nil {
+		pq.nextIndex++
+	}
+	pq.mutQueue.Unlock()
+	if err != nil {
+		return err
+	}
+
+	pq.updateMetrics()
+
+	select {
+	case pq.chanNewItem <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (pq *persistentQueue) deliverRequests(ctx context.Context) {
+	defer pq.wgDelivery.Done()
+
+	retryInterval := pq.initialRetryInterval
+	for {
+		index, request, ok := pq.peek()
+		if !ok {
+			select {
+			case <-pq.chanNewItem:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if request != nil {
+			err := pq.httpClient.Post(request.Route, request.Payload, nil)
+			if err != nil {
+				log.Debug("persistentQueue: could not deliver event, will retry",
+					"route", request.Route,
+					"retrial in", retryInterval,
+					"error", err)
+				pq.updateMetrics()
+
+				select {
+				case <-time.After(retryInterval):
+				case <-ctx.Done():
+					return
+				}
+
+				retryInterval = computeNextRetryInterval(retryInterval, pq.maxRetryInterval)
+				continue
+			}
+		}
+
+		retryInterval = pq.initialRetryInterval
+		pq.remove(index)
+		pq.updateMetrics()
+	}
+}
+
+// peek returns the oldest request in the queue. A request that can not be read is returned as nil, so it
+// will be dropped instead of blocking the queue
+func (pq *persistentQueue) peek() (uint64, *queuedRequest, bool) {
+	pq.mutQueue.RLock()
+	defer pq.mutQueue.RUnlock()
+
+	if pq.firstIndex == pq.nextIndex {
+		return 0, nil, false
+	}
+
+	request, err := pq.getRequest(pq.firstIndex)
+	if err != nil {
+		log.Warn("persistentQueue: dropping unreadable event", "index", pq.firstIndex, "error", err)
+		return pq.firstIndex, nil, true
+	}
+
+	return pq.firstIndex, request, true
+}
+
+func (pq *persistentQueue) getRequest(index uint64) (*queuedRequest, error) {
+	requestBytes, err := pq.storer.Get(indexToKey(index))
+	if err != nil {
+		return nil, err
+	}
+
+	request := &queuedRequest{}
+	err = json.Unmarshal(requestBytes, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (pq *persistentQueue) remove(index uint64) {
+	pq.mutQueue.Lock()
+	defer pq.mutQueue.Unlock()
+
+	err := pq.storer.Remove(indexToKey(index))
+	if err != nil {
+		log.Warn("persistentQueue: could not remove delivered event", "index", index, "error", err)
+	}
+
+	pq.firstIndex = index + 1
+}
+
+func (pq *persistentQueue) updateMetrics() {
+	pq.mutQueue.RLock()
+	defer pq.mutQueue.RUnlock()
+
+	depth := pq.nextIndex - pq.firstIndex
+	lag := uint64(0)
+	if depth > 0 {
+		oldestRequest, err := pq.getRequest(pq.firstIndex)
+		if err == nil {
+			lag = uint64(core.MaxInt64(time.Now().Unix()-oldestRequest.Timestamp, 0))
+		}
+	}
+
+	pq.statusHandler.SetUInt64Value(common.MetricEventNotifierQueueDepth, depth)
+	pq.statusHandler.SetUInt64Value(common.MetricEventNotifierQueueLag, lag)
+}
+
+// Close stops the delivery and closes the storer. The undelivered requests will be delivered after a restart
+func (pq *persistentQueue) Close() error {
+	pq.cancelFunc()
+	pq.wgDelivery.Wait()
+
+	return pq.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pq *persistentQueue) IsInterfaceNil() bool {
+	return pq == nil
+}
+
+func computeNextRetryInterval(retryInterval time.Duration, maxRetryInterval time.Duration) time.Duration {
+	nextRetryInterval := retryInterval * 2
+	if nextRetryInterval > maxRetryInterval {
+		return maxRetryInterval
+	}
+
+	return nextRetryInterval
+}
+
+func indexToKey(index uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, index)
+
+	return key
+}
@@ -0,0 +1,223 @@
+package notifier_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/notifier"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMemoryStorer creates a storer that keeps its data after being closed, as a database would
+func createMemoryStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 10, Shards: 1})
+	storer, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return storer
+}
+
+func createMockPersistentQueueArgs() notifier.ArgsPersistentQueue {
+	return notifier.ArgsPersistentQueue{
+		HttpClient:           &mock.HTTPClientStub{},
+		Storer:               createMemoryStorer(),
+		StatusHandler:        &statusHandler.AppStatusHandlerStub{},
+		InitialRetryInterval: time.Millisecond,
+		MaxRetryInterval:     time.Millisecond * 4,
+	}
+}
+
+type deliveredRequests struct {
+	mut      sync.Mutex
+	payloads []string
+}
+
+func (dr *deliveredRequests) add(route string, payload interface{}) {
+	dr.mut.Lock()
+	defer dr.mut.Unlock()
+
+	payloadBytes, _ := json.Marshal(payload)
+	dr.payloads = append(dr.payloads, route+":"+string(payloadBytes))
+}
+
+func (dr *deliveredRequests) get() []string {
+	dr.mut.Lock()
+	defer dr.mut.Unlock()
+
+	return append([]string(nil), dr.payloads...)
+}
+
+func TestNewPersistentQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil http client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPersistentQueueArgs()
+		args.HttpClient = nil
+
+		pq, err := notifier.NewPersistentQueue(args)
+		assert.True(t, check.IfNil(pq))
+		assert.Equal(t, notifier.ErrNilHttpClient, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPersistentQueueArgs()
+		args.Storer = nil
+
+		pq, err := notifier.NewPersistentQueue(args)
+		assert.True(t, check.IfNil(pq))
+		assert.Equal(t, notifier.ErrNilStorer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPersistentQueueArgs()
+		args.StatusHandler = nil
+
+		pq, err := notifier.NewPersistentQueue(args)
+		assert.True(t, check.IfNil(pq))
+		assert.Equal(t, notifier.ErrNilAppStatusHandler, err)
+	})
+	t.Run("invalid retry intervals should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPersistentQueueArgs()
+		args.InitialRetryInterval = 0
+
+		pq, err := notifier.NewPersistentQueue(args)
+		assert.True(t, check.IfNil(pq))
+		assert.Equal(t, notifier.ErrInvalidRetryInterval, err)
+
+		args = createMockPersistentQueueArgs()
+		args.MaxRetryInterval = args.InitialRetryInterval - 1
+
+		pq, err = notifier.NewPersistentQueue(args)
+		assert.True(t, check.IfNil(pq))
+		assert.Equal(t, notifier.ErrInvalidRetryInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pq, err := notifier.NewPersistentQueue(createMockPersistentQueueArgs())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pq))
+		assert.Nil(t, pq.Close())
+	})
+}
+
+func TestPersistentQueue_ShouldDeliverInOrderRetryingFailedRequests(t *testing.T) {
+	t.Parallel()
+
+	delivered := &deliveredRequests{}
+	numFailures := 0
+	args := createMockPersistentQueueArgs()
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload interface{}, response interface{}) error {
+			if route == "/second" && numFailures < 3 {
+				numFailures++
+				return errors.New("consumer is down")
+			}
+
+			delivered.add(route, payload)
+			return nil
+		},
+	}
+	pq, _ := notifier.NewPersistentQueue(args)
+
+	require.Nil(t, pq.Post("/first", map[string]int{"nonce": 1}, nil))
+	require.Nil(t, pq.Post("/second", map[string]int{"nonce": 2}, nil))
+	require.Nil(t, pq.Post("/third", map[string]int{"nonce": 3}, nil))
+
+	expectedPayloads := []string{
+		`/first:{"nonce":1}`,
+		`/second:{"nonce":2}`,
+		`/third:{"nonce":3}`,
+	}
+	require.Eventually(t, func() bool {
+		return len(delivered.get()) == 3
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, expectedPayloads, delivered.get())
+	assert.Nil(t, pq.Close())
+}
+
+func TestPersistentQueue_ShouldDeliverAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPersistentQueueArgs()
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload interface{}, response interface{}) error {
+			return errors.New("consumer is down")
+		},
+	}
+	pq, _ := notifier.NewPersistentQueue(args)
+	require.Nil(t, pq.Post("/first", 1, nil))
+	require.Nil(t, pq.Post("/second", 2, nil))
+	require.Nil(t, pq.Close())
+
+	delivered := &deliveredRequests{}
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload interface{}, response interface{}) error {
+			delivered.add(route, payload)
+			return nil
+		},
+	}
+	pq, _ = notifier.NewPersistentQueue(args)
+	require.Nil(t, pq.Post("/third", 3, nil))
+
+	require.Eventually(t, func() bool {
+		return len(delivered.get()) == 3
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"/first:1", "/second:2", "/third:3"}, delivered.get())
+	assert.Nil(t, pq.Close())
+}
+
+func TestPersistentQueue_ShouldUpdateMetrics(t *testing.T) {
+	t.Parallel()
+
+	mutMetrics := sync.Mutex{}
+	metrics := make(map[string]uint64)
+	args := createMockPersistentQueueArgs()
+	args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mutMetrics.Lock()
+			metrics[key] = value
+			mutMetrics.Unlock()
+		},
+	}
+	chanDeliver := make(chan struct{})
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload interface{}, response interface{}) error {
+			<-chanDeliver
+			return nil
+		},
+	}
+	pq, _ := notifier.NewPersistentQueue(args)
+
+	require.Nil(t, pq.Post("/first", 1, nil))
+	require.Nil(t, pq.Post("/second", 2, nil))
+	mutMetrics.Lock()
+	assert.Equal(t, uint64(2), metrics[common.MetricEventNotifierQueueDepth])
+	assert.Equal(t, uint64(0), metrics[common.MetricEventNotifierQueueLag])
+	mutMetrics.Unlock()
+
+	close(chanDeliver)
+	require.Eventually(t, func() bool {
+		mutMetrics.Lock()
+		defer mutMetrics.Unlock()
+
+		return metrics[common.MetricEventNotifierQueueDepth] == 0
+	}, time.Second, time.Millisecond)
+	assert.Nil(t, pq.Close())
+}
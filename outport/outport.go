@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 var log = logger.GetOrCreate("outport")
@@ -241,6 +242,39 @@ func (o *outport) finalizedBlockBlocking(headerHash []byte, driver Driver) {
 	}
 }
 
+// SaveEquivocationEvidence will save the equivocation evidence for every driver able to handle it
+func (o *outport) SaveEquivocationEvidence(evidence *common.EquivocationEvidence) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.drivers {
+		evidenceDriver, ok := driver.(EquivocationEvidenceDriver)
+		if !ok {
+			continue
+		}
+
+		o.saveEquivocationEvidenceBlocking(evidence, evidenceDriver, driver)
+	}
+}
+
+func (o *outport) saveEquivocationEvidenceBlocking(evidence *common.EquivocationEvidence, evidenceDriver EquivocationEvidenceDriver, driver Driver) {
+	for {
+		err := evidenceDriver.SaveEquivocationEvidence(evidence)
+		if err == nil {
+			return
+		}
+
+		log.Error("error calling SaveEquivocationEvidence, will retry",
+			"driver", driverString(driver),
+			"retrial in", o.retrialInterval,
+			"error", err)
+
+		if o.shouldTerminate() {
+			return
+		}
+	}
+}
+
 // Close will close all the drivers that are in outport
 func (o *outport) Close() error {
 	close(o.chanClose)
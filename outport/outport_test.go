@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,6 +256,41 @@ func TestOutport_FinalizedBlock(t *testing.T) {
 	assert.Equal(t, 1, numCalled2)
 }
 
+func TestOutport_SaveEquivocationEvidence(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	evidence := &common.EquivocationEvidence{Round: 37}
+	numCalled1 := 0
+	numCalled2 := 0
+	driver1 := &mock.EquivocationEvidenceDriverStub{
+		SaveEquivocationEvidenceCalled: func(evidenceToSave *common.EquivocationEvidence) error {
+			assert.Equal(t, evidence, evidenceToSave)
+			numCalled1++
+			if numCalled1 < 10 {
+				return expectedError
+			}
+
+			return nil
+		},
+	}
+	driver2 := &mock.EquivocationEvidenceDriverStub{
+		SaveEquivocationEvidenceCalled: func(_ *common.EquivocationEvidence) error {
+			numCalled2++
+			return nil
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+	outportHandler.SaveEquivocationEvidence(evidence)
+	_ = outportHandler.SubscribeDriver(driver1)
+	_ = outportHandler.SubscribeDriver(&mock.DriverStub{})
+	_ = outportHandler.SubscribeDriver(driver2)
+
+	outportHandler.SaveEquivocationEvidence(evidence)
+	assert.Equal(t, 10, numCalled1)
+	assert.Equal(t, 1, numCalled2)
+}
+
 func TestOutport_SubscribeDriver(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,460 @@
+package replay
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+const progressLogInterval = 1000
+
+// ArgsBlocksReplayer holds the arguments needed to create a blocks replayer
+type ArgsBlocksReplayer struct {
+	StorageService           dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	OutportHandler           outport.OutportHandler
+	ReceiptsRepository       ReceiptsRepository
+	NodesCoordinator         NodesCoordinator
+	ShardID                  uint32
+	LastEpoch                uint32
+}
+
+type blocksReplayer struct {
+	store                    dataRetriever.StorageService
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	outportHandler           outport.OutportHandler
+	receiptsRepository       ReceiptsRepository
+	nodesCoordinator         NodesCoordinator
+	shardID                  uint32
+	lastEpoch                uint32
+	epochHint                uint32
+}
+
+// NewBlocksReplayer creates a component able to re-emit the blocks found in the node's storage to the outport drivers
+func NewBlocksReplayer(args ArgsBlocksReplayer) (*blocksReplayer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blocksReplayer{
+		store:                    args.StorageService,
+		marshalizer:              args.Marshalizer,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		outportHandler:           args.OutportHandler,
+		receiptsRepository:       args.ReceiptsRepository,
+		nodesCoordinator:         args.NodesCoordinator,
+		shardID:                  args.ShardID,
+		lastEpoch:                args.LastEpoch,
+	}, nil
+}
+
+func checkArgs(args ArgsBlocksReplayer) error {
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if check.IfNil(args.ReceiptsRepository) {
+		return ErrNilReceiptsRepository
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+
+	return nil
+}
+
+// Replay reads the blocks in the [startNonce, endNonce] interval from the storage and sends them, in order, to the
+// subscribed outport drivers. It stops at the first block that can not be rebuilt or when the context is done
+func (br *blocksReplayer) Replay(ctx context.Context, startNonce uint64, endNonce uint64) error {
+	if startNonce > endNonce {
+		return fmt.Errorf("%w: start nonce %d is greater than end nonce %d", ErrInvalidNonceRange, startNonce, endNonce)
+	}
+	if !br.outportHandler.HasDrivers() {
+		return ErrNoDriversSubscribed
+	}
+
+	log.Info("starting outport replay", "shard", br.shardID, "start nonce", startNonce, "end nonce", endNonce)
+
+	for nonce := startNonce; ; nonce++ {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w at nonce %d", ErrReplayInterrupted, nonce)
+		default:
+		}
+
+		err := br.replayBlock(nonce)
+		if err != nil {
+			return fmt.Errorf("%w while replaying block with nonce %d", err, nonce)
+		}
+
+		if (nonce-startNonce+1)%progressLogInterval == 0 {
+			log.Info("outport replay in progress", "nonce", nonce, "end nonce", endNonce)
+		}
+
+		if nonce == endNonce {
+			break
+		}
+	}
+
+	log.Info("outport replay finished", "shard", br.shardID, "start nonce", startNonce, "end nonce", endNonce)
+
+	return nil
+}
+
+func (br *blocksReplayer) replayBlock(nonce uint64) error {
+	headerHash, header, err := br.getHeaderByNonce(nonce)
+	if err != nil {
+		return err
+	}
+
+	br.epochHint = header.GetEpoch()
+
+	body, err := br.getBody(header)
+	if err != nil {
+		return err
+	}
+
+	pool, err := br.createPool(header, headerHash, body)
+	if err != nil {
+		return err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             headerHash,
+		Body:                   body,
+		Header:                 header,
+		SignersIndexes:         br.getSignersIndexes(header, headerHash),
+		NotarizedHeadersHashes: getNotarizedHeadersHashes(header),
+		TransactionsPool:       pool,
+	}
+
+	br.outportHandler.SaveBlock(args)
+	br.outportHandler.FinalizedBlock(headerHash)
+
+	log.Debug("replayed block", "nonce", nonce, "hash", headerHash, "epoch", header.GetEpoch())
+
+	return nil
+}
+
+func (br *blocksReplayer) getHeaderByNonce(nonce uint64) ([]byte, data.HeaderHandler, error) {
+	nonceHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(br.shardID)
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if br.shardID == core.MetachainShardId {
+		nonceHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	nonceBytes := br.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := br.getFromAnyEpoch(nonceHashUnit, nonceBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: nonce-hash mapping is missing", ErrBlockNotFound)
+	}
+
+	headerBytes, err := br.getFromAnyEpoch(headerUnit, headerHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: hash %s", ErrBlockNotFound, hex.EncodeToString(headerHash))
+	}
+
+	header, err := process.UnmarshalHeader(br.shardID, br.marshalizer, headerBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerHash, header, nil
+}
+
+// getFromAnyEpoch searches the key in the active persisters first and then in the persisters of each epoch, starting
+// with the epoch of the last replayed block since consecutive blocks are most likely stored in the same epoch
+func (br *blocksReplayer) getFromAnyEpoch(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
+	storer := br.store.GetStorer(unit)
+	if check.IfNil(storer) {
+		return nil, fmt.Errorf("missing storer for unit %s", unit.String())
+	}
+
+	buff, err := storer.Get(key)
+	if err == nil {
+		return buff, nil
+	}
+
+	for epoch := br.epochHint; epoch <= br.lastEpoch; epoch++ {
+		buff, err = storer.GetFromEpoch(key, epoch)
+		if err == nil {
+			return buff, nil
+		}
+	}
+	for epoch := br.epochHint; epoch > 0; epoch-- {
+		buff, err = storer.GetFromEpoch(key, epoch-1)
+		if err == nil {
+			return buff, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (br *blocksReplayer) getBody(header data.HeaderHandler) (*block.Body, error) {
+	storer := br.store.GetStorer(dataRetriever.MiniBlockUnit)
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(header.GetMiniBlockHeaderHandlers())),
+	}
+
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		miniBlockHash := miniBlockHeader.GetHash()
+		buff, err := storer.GetFromEpoch(miniBlockHash, header.GetEpoch())
+		if err != nil {
+			return nil, fmt.Errorf("%w: missing miniblock %s", err, hex.EncodeToString(miniBlockHash))
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = br.marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (br *blocksReplayer) createPool(header data.HeaderHandler, headerHash []byte, body *block.Body) (*indexer.Pool, error) {
+	pool := &indexer.Pool{
+		Txs:      make(map[string]data.TransactionHandler),
+		Scrs:     make(map[string]data.TransactionHandler),
+		Rewards:  make(map[string]data.TransactionHandler),
+		Invalid:  make(map[string]data.TransactionHandler),
+		Receipts: make(map[string]data.TransactionHandler),
+		Logs:     make([]*data.LogData, 0),
+	}
+
+	epoch := header.GetEpoch()
+	miniBlockHeaders := header.GetMiniBlockHeaderHandlers()
+	for index, miniBlock := range body.MiniBlocks {
+		executedTxHashes := extractExecutedTxHashes(
+			miniBlock.TxHashes,
+			miniBlockHeaders[index].GetIndexOfFirstTxProcessed(),
+			miniBlockHeaders[index].GetIndexOfLastTxProcessed(),
+		)
+		err := br.addTransactions(pool, miniBlock.Type, executedTxHashes, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	receiptsHolder, err := br.receiptsRepository.LoadReceipts(header, headerHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, miniBlock := range receiptsHolder.GetMiniblocks() {
+		err = br.addTransactions(pool, miniBlock.Type, miniBlock.TxHashes, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pool.Logs, err = br.getLogs(pool, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+func (br *blocksReplayer) addTransactions(pool *indexer.Pool, miniBlockType block.Type, hashes [][]byte, epoch uint32) error {
+	switch miniBlockType {
+	case block.TxBlock:
+		return br.loadTransactions(pool.Txs, hashes, epoch, dataRetriever.TransactionUnit, newTransaction)
+	case block.InvalidBlock:
+		return br.loadTransactions(pool.Invalid, hashes, epoch, dataRetriever.TransactionUnit, newTransaction)
+	case block.SmartContractResultBlock:
+		return br.loadTransactions(pool.Scrs, hashes, epoch, dataRetriever.UnsignedTransactionUnit, newSmartContractResult)
+	case block.RewardsBlock:
+		return br.loadTransactions(pool.Rewards, hashes, epoch, dataRetriever.RewardTransactionUnit, newRewardTx)
+	case block.ReceiptBlock:
+		return br.loadTransactions(pool.Receipts, hashes, epoch, dataRetriever.UnsignedTransactionUnit, newReceipt)
+	default:
+		return nil
+	}
+}
+
+func (br *blocksReplayer) loadTransactions(
+	destination map[string]data.TransactionHandler,
+	hashes [][]byte,
+	epoch uint32,
+	unit dataRetriever.UnitType,
+	newTxHandler func() data.TransactionHandler,
+) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	storer := br.store.GetStorer(unit)
+	pairs, err := storer.GetBulkFromEpoch(hashes, epoch)
+	if err != nil {
+		return err
+	}
+
+	if len(pairs) != len(hashes) {
+		log.Warn("some transactions were not found in storage",
+			"unit", unit.String(),
+			"epoch", epoch,
+			"num requested", len(hashes),
+			"num found", len(pairs))
+	}
+
+	for _, pair := range pairs {
+		tx := newTxHandler()
+		err = br.marshalizer.Unmarshal(tx, pair.Value)
+		if err != nil {
+			return fmt.Errorf("%w: transaction %s", err, hex.EncodeToString(pair.Key))
+		}
+
+		destination[string(pair.Key)] = tx
+	}
+
+	return nil
+}
+
+func (br *blocksReplayer) getLogs(pool *indexer.Pool, epoch uint32) ([]*data.LogData, error) {
+	logs := make([]*data.LogData, 0)
+	storer := br.store.GetStorer(dataRetriever.TxLogsUnit)
+	if check.IfNil(storer) {
+		return logs, nil
+	}
+
+	hashes := make([]string, 0, len(pool.Txs)+len(pool.Scrs)+len(pool.Invalid))
+	for _, txs := range []map[string]data.TransactionHandler{pool.Txs, pool.Scrs, pool.Invalid} {
+		for hash := range txs {
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Strings(hashes)
+
+	for _, hash := range hashes {
+		buff, err := storer.GetFromEpoch([]byte(hash), epoch)
+		if err != nil {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err = br.marshalizer.Unmarshal(txLog, buff)
+		if err != nil {
+			return nil, fmt.Errorf("%w: log of transaction %s", err, hex.EncodeToString([]byte(hash)))
+		}
+
+		logs = append(logs, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     hash,
+		})
+	}
+
+	return logs, nil
+}
+
+// getSignersIndexes computes the signers indexes in the same way the block processors do when indexing a block. Since
+// the nodes coordinator only keeps the configuration of the last epochs, the indexes of older blocks are left empty
+func (br *blocksReplayer) getSignersIndexes(header data.HeaderHandler, headerHash []byte) []uint64 {
+	epoch := header.GetEpoch()
+	if br.shardID != core.MetachainShardId && header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	publicKeys, err := br.nodesCoordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		br.shardID,
+		epoch,
+	)
+	if err != nil {
+		log.Debug("blocksReplayer.getSignersIndexes: GetConsensusValidatorsPublicKeys",
+			"hash", headerHash,
+			"epoch", epoch,
+			"error", err.Error())
+		return nil
+	}
+
+	signersIndexes, err := br.nodesCoordinator.GetValidatorsIndexes(publicKeys, epoch)
+	if err != nil {
+		log.Debug("blocksReplayer.getSignersIndexes: GetValidatorsIndexes",
+			"hash", headerHash,
+			"epoch", epoch,
+			"error", err.Error())
+		return nil
+	}
+
+	return signersIndexes
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+func extractExecutedTxHashes(txHashes [][]byte, firstProcessed int32, lastProcessed int32) [][]byte {
+	invalidIndexes := firstProcessed < 0 || lastProcessed >= int32(len(txHashes)) || firstProcessed > lastProcessed
+	if invalidIndexes {
+		return txHashes
+	}
+
+	return txHashes[firstProcessed : lastProcessed+1]
+}
+
+func newTransaction() data.TransactionHandler {
+	return &transaction.Transaction{}
+}
+
+func newSmartContractResult() data.TransactionHandler {
+	return &smartContractResult.SmartContractResult{}
+}
+
+func newRewardTx() data.TransactionHandler {
+	return &rewardTx.RewardTx{}
+}
+
+func newReceipt() data.TransactionHandler {
+	return &receipt.Receipt{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *blocksReplayer) IsInterfaceNil() bool {
+	return br == nil
+}
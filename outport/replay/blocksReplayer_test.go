@@ -0,0 +1,299 @@
+package replay_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/holders"
+	"github.com/ElrondNetwork/elrond-go/outport/replay"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	marshalizer = &marshal.GogoProtoMarshalizer{}
+	converter   = uint64ByteSlice.NewBigEndianConverter()
+)
+
+func createMockArgs(store *genericMocks.ChainStorerMock, outportHandler *testscommon.OutportStub) replay.ArgsBlocksReplayer {
+	return replay.ArgsBlocksReplayer{
+		StorageService:           store,
+		Marshalizer:              marshalizer,
+		Uint64ByteSliceConverter: converter,
+		OutportHandler:           outportHandler,
+		ReceiptsRepository:       &testscommon.ReceiptsRepositoryStub{},
+		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		ShardID:                  0,
+		LastEpoch:                1,
+	}
+}
+
+func createOutportStub(savedBlocks *[]*indexer.ArgsSaveBlockData, finalizedHashes *[][]byte) *testscommon.OutportStub {
+	return &testscommon.OutportStub{
+		HasDriversCalled: func() bool {
+			return true
+		},
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) {
+			*savedBlocks = append(*savedBlocks, args)
+		},
+		FinalizedBlockCalled: func(headerHash []byte) {
+			*finalizedHashes = append(*finalizedHashes, headerHash)
+		},
+	}
+}
+
+func putInEpoch(t *testing.T, storer *genericMocks.StorerMock, key []byte, value interface{}, epoch uint32) {
+	buff, err := marshalizer.Marshal(value)
+	require.Nil(t, err)
+	require.Nil(t, storer.PutInEpoch(key, buff, epoch))
+}
+
+func putShardBlock(t *testing.T, store *genericMocks.ChainStorerMock, nonce uint64, epoch uint32, miniBlocks map[string]*block.MiniBlock) []byte {
+	headerHash := []byte("header" + string(rune('0'+nonce)))
+	header := &block.HeaderV2{
+		Header: &block.Header{
+			Nonce: nonce,
+			Epoch: epoch,
+			Round: nonce + 10,
+		},
+	}
+	for hash, miniBlock := range miniBlocks {
+		header.Header.MiniBlockHeaders = append(header.Header.MiniBlockHeaders, block.MiniBlockHeader{
+			Hash:    []byte(hash),
+			TxCount: uint32(len(miniBlock.TxHashes)),
+			Type:    miniBlock.Type,
+		})
+		putInEpoch(t, store.Miniblocks, []byte(hash), miniBlock, epoch)
+	}
+
+	putInEpoch(t, store.BlockHeaders, headerHash, header, epoch)
+	require.Nil(t, store.ShardHdrNonce.PutInEpoch(converter.ToByteSlice(nonce), headerHash, epoch))
+
+	return headerHash
+}
+
+func TestNewBlocksReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{})
+		args.StorageService = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Equal(t, replay.ErrNilStorageService, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{})
+		args.Marshalizer = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{})
+		args.Uint64ByteSliceConverter = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Equal(t, replay.ErrNilUint64ByteSliceConverter, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(genericMocks.NewChainStorerMock(0), nil)
+		args.OutportHandler = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Equal(t, replay.ErrNilOutportHandler, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil receipts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{})
+		args.ReceiptsRepository = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Equal(t, replay.ErrNilReceiptsRepository, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{})
+		args.NodesCoordinator = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Equal(t, replay.ErrNilNodesCoordinator, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := replay.NewBlocksReplayer(createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{}))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(replayer))
+	})
+}
+
+func TestBlocksReplayer_ReplayErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := replay.NewBlocksReplayer(createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{}))
+		err := replayer.Replay(context.Background(), 5, 4)
+		assert.True(t, errors.Is(err, replay.ErrInvalidNonceRange))
+	})
+	t.Run("no drivers should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := replay.NewBlocksReplayer(createMockArgs(genericMocks.NewChainStorerMock(0), &testscommon.OutportStub{}))
+		err := replayer.Replay(context.Background(), 1, 2)
+		assert.Equal(t, replay.ErrNoDriversSubscribed, err)
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+		finalizedHashes := make([][]byte, 0)
+		store := genericMocks.NewChainStorerMock(0)
+		_ = putShardBlock(t, store, 1, 0, nil)
+
+		replayer, _ := replay.NewBlocksReplayer(createMockArgs(store, createOutportStub(&savedBlocks, &finalizedHashes)))
+		err := replayer.Replay(context.Background(), 1, 2)
+		assert.True(t, errors.Is(err, replay.ErrBlockNotFound))
+		assert.Equal(t, 1, len(savedBlocks))
+	})
+	t.Run("closed context should interrupt the replay", func(t *testing.T) {
+		t.Parallel()
+
+		savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+		finalizedHashes := make([][]byte, 0)
+		store := genericMocks.NewChainStorerMock(0)
+		_ = putShardBlock(t, store, 1, 0, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		replayer, _ := replay.NewBlocksReplayer(createMockArgs(store, createOutportStub(&savedBlocks, &finalizedHashes)))
+		err := replayer.Replay(ctx, 1, 1)
+		assert.True(t, errors.Is(err, replay.ErrReplayInterrupted))
+		assert.Empty(t, savedBlocks)
+	})
+}
+
+func TestBlocksReplayer_ReplayShardBlocks(t *testing.T) {
+	t.Parallel()
+
+	store := genericMocks.NewChainStorerMock(0)
+	txHash, scrHash, receiptHash := []byte("tx"), []byte("scr"), []byte("receipt")
+	putInEpoch(t, store.Transactions, txHash, &transaction.Transaction{Nonce: 7}, 1)
+	putInEpoch(t, store.Unsigned, scrHash, &smartContractResult.SmartContractResult{Nonce: 8}, 1)
+	putInEpoch(t, store.Unsigned, receiptHash, &receipt.Receipt{Data: []byte("receipt data")}, 1)
+	putInEpoch(t, store.Logs, txHash, &transaction.Log{Address: []byte("address")}, 1)
+
+	firstHash := putShardBlock(t, store, 1, 0, nil)
+	secondHash := putShardBlock(t, store, 2, 1, map[string]*block.MiniBlock{
+		"txMb":  {Type: block.TxBlock, TxHashes: [][]byte{txHash, []byte("missing tx")}},
+		"scrMb": {Type: block.SmartContractResultBlock, TxHashes: [][]byte{scrHash}},
+	})
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	finalizedHashes := make([][]byte, 0)
+	args := createMockArgs(store, createOutportStub(&savedBlocks, &finalizedHashes))
+	args.ReceiptsRepository = &testscommon.ReceiptsRepositoryStub{
+		LoadReceiptsCalled: func(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error) {
+			if header.GetNonce() != 2 {
+				return holders.NewReceiptsHolder(nil), nil
+			}
+
+			return holders.NewReceiptsHolder([]*block.MiniBlock{
+				{Type: block.ReceiptBlock, TxHashes: [][]byte{receiptHash}},
+			}), nil
+		},
+	}
+	args.NodesCoordinator = &shardingMocks.NodesCoordinatorStub{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			return nil, errors.New("epoch config not available")
+		},
+	}
+
+	replayer, _ := replay.NewBlocksReplayer(args)
+	err := replayer.Replay(context.Background(), 1, 2)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(savedBlocks))
+	assert.Equal(t, [][]byte{firstHash, secondHash}, finalizedHashes)
+	assert.Equal(t, firstHash, savedBlocks[0].HeaderHash)
+	assert.Equal(t, uint64(1), savedBlocks[0].Header.GetNonce())
+
+	saved := savedBlocks[1]
+	assert.Equal(t, secondHash, saved.HeaderHash)
+	assert.Equal(t, uint32(1), saved.Header.GetEpoch())
+	assert.Nil(t, saved.SignersIndexes)
+	assert.Nil(t, saved.NotarizedHeadersHashes)
+	assert.Equal(t, 2, len(saved.Body.(*block.Body).MiniBlocks))
+
+	pool := saved.TransactionsPool
+	require.Equal(t, 1, len(pool.Txs))
+	assert.Equal(t, uint64(7), pool.Txs[string(txHash)].GetNonce())
+	require.Equal(t, 1, len(pool.Scrs))
+	assert.Equal(t, uint64(8), pool.Scrs[string(scrHash)].GetNonce())
+	require.Equal(t, 1, len(pool.Receipts))
+	assert.Equal(t, []byte("receipt data"), pool.Receipts[string(receiptHash)].GetData())
+	require.Equal(t, 1, len(pool.Logs))
+	assert.Equal(t, string(txHash), pool.Logs[0].TxHash)
+	assert.Equal(t, []byte("address"), pool.Logs[0].GetAddress())
+}
+
+func TestBlocksReplayer_ReplayMetaBlockShouldSetNotarizedHeadersHashes(t *testing.T) {
+	t.Parallel()
+
+	store := genericMocks.NewChainStorerMock(0)
+	headerHash := []byte("meta header")
+	metaBlock := &block.MetaBlock{
+		Nonce: 3,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte("shard 0 header")},
+			{HeaderHash: []byte("shard 1 header")},
+		},
+	}
+	putInEpoch(t, store.Metablocks, headerHash, metaBlock, 0)
+	require.Nil(t, store.MetaHdrNonce.PutInEpoch(converter.ToByteSlice(3), headerHash, 0))
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	finalizedHashes := make([][]byte, 0)
+	args := createMockArgs(store, createOutportStub(&savedBlocks, &finalizedHashes))
+	args.ShardID = core.MetachainShardId
+
+	replayer, _ := replay.NewBlocksReplayer(args)
+	err := replayer.Replay(context.Background(), 3, 3)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(savedBlocks))
+	expectedHashes := []string{
+		hex.EncodeToString([]byte("shard 0 header")),
+		hex.EncodeToString([]byte("shard 1 header")),
+	}
+	assert.Equal(t, expectedHashes, savedBlocks[0].NotarizedHeadersHashes)
+}
@@ -0,0 +1,30 @@
+package replay
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64ByteSliceConverter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64ByteSliceConverter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrNilReceiptsRepository signals that a nil receipts repository has been provided
+var ErrNilReceiptsRepository = errors.New("nil receipts repository")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNoDriversSubscribed signals that the outport handler has no subscribed drivers to replay the blocks to
+var ErrNoDriversSubscribed = errors.New("no outport drivers subscribed")
+
+// ErrInvalidNonceRange signals that an invalid nonce range has been provided
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrBlockNotFound signals that a block could not be found in the storage
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrReplayInterrupted signals that the replay was interrupted before reaching the end nonce
+var ErrReplayInterrupted = errors.New("replay interrupted")
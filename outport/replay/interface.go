@@ -0,0 +1,19 @@
+package replay
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ReceiptsRepository defines the component able to load the receipts saved for a block
+type ReceiptsRepository interface {
+	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
+	IsInterfaceNil() bool
+}
+
+// NodesCoordinator defines the nodes coordinator operations needed to compute the signers of a block
+type NodesCoordinator interface {
+	GetConsensusValidatorsPublicKeys(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
+	GetValidatorsIndexes(publicKeys []string, epoch uint32) ([]uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,27 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilTransactionsPool signals that a nil transactions pool was provided
+var ErrNilTransactionsPool = errors.New("nil transactions pool")
+
+// ErrNilHeader signals that a nil header was provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrInvalidBufferSize signals that an invalid subscriber buffer size was provided
+var ErrInvalidBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers was provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrUnknownEventType signals that an unknown event type was provided in a filter
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// ErrHubClosed signals that the subscriptions hub is closed
+var ErrHubClosed = errors.New("subscriptions hub is closed")
+
+// ErrSubscriptionsDisabled signals that the subscriptions are not enabled on this node
+var ErrSubscriptionsDisabled = errors.New("subscriptions are disabled on this node")
@@ -0,0 +1,78 @@
+package subscriptions
+
+const (
+	// EventBlock is the event type sent for every committed header
+	EventBlock = "block"
+	// EventTransaction is the event type sent for every transaction included in a committed block
+	EventTransaction = "transaction"
+	// EventLog is the event type sent for every smart contract log event included in a committed block
+	EventLog = "log"
+	// EventRevert is the event type sent when a previously committed block is reverted
+	EventRevert = "revert"
+	// EventFinalized is the event type sent when a block becomes final
+	EventFinalized = "finalized"
+)
+
+const (
+	txTypeNormal   = "normal"
+	txTypeUnsigned = "unsigned"
+	txTypeReward   = "reward"
+	txTypeInvalid  = "invalid"
+)
+
+// Message is the envelope of every event pushed to a subscriber
+type Message struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// BlockEvent holds the data pushed to subscribers for a committed header
+type BlockEvent struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	ShardID   uint32 `json:"shardID"`
+	TimeStamp uint64 `json:"timestamp"`
+	RootHash  string `json:"rootHash"`
+	NumTxs    uint32 `json:"numTxs"`
+}
+
+// TransactionEvent holds the data pushed to subscribers for a transaction included in a committed block
+type TransactionEvent struct {
+	Hash      string `json:"hash"`
+	Type      string `json:"type"`
+	Nonce     uint64 `json:"nonce"`
+	Value     string `json:"value"`
+	Sender    string `json:"sender,omitempty"`
+	Receiver  string `json:"receiver"`
+	Data      []byte `json:"data,omitempty"`
+	GasPrice  uint64 `json:"gasPrice,omitempty"`
+	GasLimit  uint64 `json:"gasLimit,omitempty"`
+	Status    string `json:"status"`
+	BlockHash string `json:"blockHash"`
+}
+
+// LogEvent holds the data pushed to subscribers for a smart contract log event included in a committed block
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+}
+
+// RevertEvent holds the data pushed to subscribers when a block is reverted
+type RevertEvent struct {
+	Hash    string `json:"hash"`
+	Nonce   uint64 `json:"nonce"`
+	Round   uint64 `json:"round"`
+	Epoch   uint32 `json:"epoch"`
+	ShardID uint32 `json:"shardID"`
+}
+
+// FinalizedEvent holds the data pushed to subscribers when a block becomes final
+type FinalizedEvent struct {
+	Hash string `json:"hash"`
+}
@@ -0,0 +1,105 @@
+package subscriptions
+
+import (
+	"fmt"
+)
+
+// Filter holds the options a subscriber provides in order to receive only the events it is interested in.
+// Empty fields mean "no restriction"
+type Filter struct {
+	Events      []string `json:"events"`
+	Addresses   []string `json:"addresses"`
+	Identifiers []string `json:"identifiers"`
+	Topics      []string `json:"topics"`
+}
+
+type compiledFilter struct {
+	events      map[string]struct{}
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+	topics      map[string]struct{}
+}
+
+func newCompiledFilter(filter Filter) (*compiledFilter, error) {
+	for _, event := range filter.Events {
+		if !isKnownEventType(event) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, event)
+		}
+	}
+
+	return &compiledFilter{
+		events:      sliceToSet(filter.Events),
+		addresses:   sliceToSet(filter.Addresses),
+		identifiers: sliceToSet(filter.Identifiers),
+		topics:      sliceToSet(filter.Topics),
+	}, nil
+}
+
+func isKnownEventType(event string) bool {
+	switch event {
+	case EventBlock, EventTransaction, EventLog, EventRevert, EventFinalized:
+		return true
+	default:
+		return false
+	}
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+func (cf *compiledFilter) wantsEvent(event string) bool {
+	return containsOrEmpty(cf.events, event)
+}
+
+func (cf *compiledFilter) matchesTransaction(tx *TransactionEvent) bool {
+	if !cf.wantsEvent(EventTransaction) {
+		return false
+	}
+	if len(cf.addresses) == 0 {
+		return true
+	}
+
+	_, senderFound := cf.addresses[tx.Sender]
+	_, receiverFound := cf.addresses[tx.Receiver]
+
+	return senderFound || receiverFound
+}
+
+func (cf *compiledFilter) matchesLog(event *LogEvent, hexTopics []string) bool {
+	if !cf.wantsEvent(EventLog) {
+		return false
+	}
+	if !containsOrEmpty(cf.addresses, event.Address) {
+		return false
+	}
+	if !containsOrEmpty(cf.identifiers, event.Identifier) {
+		return false
+	}
+	if len(cf.topics) == 0 {
+		return true
+	}
+
+	for _, topic := range hexTopics {
+		_, found := cf.topics[topic]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsOrEmpty(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[value]
+	return found
+}
@@ -0,0 +1,16 @@
+package subscriptions
+
+// Subscription defines a consumer registered on the subscriptions hub
+type Subscription interface {
+	ID() uint64
+	Messages() <-chan *Message
+	IsInterfaceNil() bool
+}
+
+// SubscriptionsHandler defines the actions needed by a component that wants to register consumers for
+// the node events
+type SubscriptionsHandler interface {
+	Subscribe(filter Filter) (Subscription, error)
+	Unsubscribe(id uint64)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,50 @@
+package subscriptions
+
+import "sync"
+
+type subscription struct {
+	id        uint64
+	filter    *compiledFilter
+	messages  chan *Message
+	closeOnce sync.Once
+}
+
+func newSubscription(id uint64, filter *compiledFilter, bufferSize int) *subscription {
+	return &subscription{
+		id:       id,
+		filter:   filter,
+		messages: make(chan *Message, bufferSize),
+	}
+}
+
+// ID returns the unique identifier of the subscription
+func (s *subscription) ID() uint64 {
+	return s.id
+}
+
+// Messages returns the channel on which the events are delivered. The channel is closed when the
+// subscription ends, either by unsubscribing, by falling behind or by closing the hub
+func (s *subscription) Messages() <-chan *Message {
+	return s.messages
+}
+
+// trySend will not block if the subscriber does not keep up, returning false instead
+func (s *subscription) trySend(message *Message) bool {
+	select {
+	case s.messages <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *subscription) close() {
+	s.closeOnce.Do(func() {
+		close(s.messages)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *subscription) IsInterfaceNil() bool {
+	return s == nil
+}
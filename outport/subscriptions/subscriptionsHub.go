@@ -0,0 +1,440 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+const signalErrorIdentifier = "signalError"
+
+// ArgsSubscriptionsHub defines the arguments needed for subscriptions hub creation
+type ArgsSubscriptionsHub struct {
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+	PubKeyConverter      core.PubkeyConverter
+	SubscriberBufferSize int
+	MaxSubscribers       int
+}
+
+type subscriptionsHub struct {
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	pubKeyConverter      core.PubkeyConverter
+	subscriberBufferSize int
+	maxSubscribers       int
+
+	mutSubscriptions sync.RWMutex
+	subscriptions    map[uint64]*subscription
+	lastID           uint64
+	closed           bool
+}
+
+// NewSubscriptionsHub creates a new instance of the subscriptions hub. The hub is an outport driver that
+// converts the committed, reverted and finalized blocks into events and pushes them to the registered subscribers
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		marshalizer:          args.Marshalizer,
+		hasher:               args.Hasher,
+		pubKeyConverter:      args.PubKeyConverter,
+		subscriberBufferSize: args.SubscriberBufferSize,
+		maxSubscribers:       args.MaxSubscribers,
+		subscriptions:        make(map[uint64]*subscription),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return outport.ErrNilPubKeyConverter
+	}
+	if args.SubscriberBufferSize < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidBufferSize, args.SubscriberBufferSize)
+	}
+	if args.MaxSubscribers < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxSubscribers, args.MaxSubscribers)
+	}
+
+	return nil
+}
+
+// Subscribe registers a new subscriber that will receive all the events matching the provided filter
+func (sh *subscriptionsHub) Subscribe(filter Filter) (Subscription, error) {
+	compiled, err := newCompiledFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sh.mutSubscriptions.Lock()
+	defer sh.mutSubscriptions.Unlock()
+
+	if sh.closed {
+		return nil, ErrHubClosed
+	}
+	if len(sh.subscriptions) >= sh.maxSubscribers {
+		return nil, fmt.Errorf("%w, maximum: %d", ErrTooManySubscribers, sh.maxSubscribers)
+	}
+
+	sh.lastID++
+	sub := newSubscription(sh.lastID, compiled, sh.subscriberBufferSize)
+	sh.subscriptions[sub.id] = sub
+
+	log.Debug("subscriptionsHub: new subscriber", "id", sub.id, "num subscribers", len(sh.subscriptions))
+
+	return sub, nil
+}
+
+// Unsubscribe removes the subscriber with the provided id and closes its messages channel
+func (sh *subscriptionsHub) Unsubscribe(id uint64) {
+	sh.mutSubscriptions.Lock()
+	defer sh.mutSubscriptions.Unlock()
+
+	sh.removeSubscriptionUnprotected(id)
+}
+
+func (sh *subscriptionsHub) removeSubscriptionUnprotected(id uint64) {
+	sub, found := sh.subscriptions[id]
+	if !found {
+		return
+	}
+
+	sub.close()
+	delete(sh.subscriptions, id)
+
+	log.Debug("subscriptionsHub: removed subscriber", "id", id, "num subscribers", len(sh.subscriptions))
+}
+
+// SaveBlock converts the committed block into block, transaction and log events and pushes them to subscribers
+func (sh *subscriptionsHub) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args.TransactionsPool == nil {
+		return ErrNilTransactionsPool
+	}
+	if check.IfNil(args.Header) {
+		return ErrNilHeader
+	}
+
+	blockHash := hex.EncodeToString(args.HeaderHash)
+	blockEvent := &BlockEvent{
+		Hash:      blockHash,
+		Nonce:     args.Header.GetNonce(),
+		Round:     args.Header.GetRound(),
+		Epoch:     args.Header.GetEpoch(),
+		ShardID:   args.Header.GetShardID(),
+		TimeStamp: args.Header.GetTimeStamp(),
+		RootHash:  hex.EncodeToString(args.Header.GetRootHash()),
+		NumTxs:    args.Header.GetTxCount(),
+	}
+
+	failedTxs := make(map[string]struct{})
+	logEvents, logTopics := sh.convertLogs(args.TransactionsPool.Logs, blockHash, failedTxs)
+	txEvents := sh.convertTransactions(args.TransactionsPool, blockHash, failedTxs)
+
+	sh.mutSubscriptions.RLock()
+	lagging := make([]uint64, 0)
+	for _, sub := range sh.subscriptions {
+		ok := sh.dispatchBlock(sub, blockEvent, txEvents, logEvents, logTopics)
+		if !ok {
+			lagging = append(lagging, sub.id)
+		}
+	}
+	sh.mutSubscriptions.RUnlock()
+
+	sh.removeLaggingSubscriptions(lagging)
+
+	return nil
+}
+
+func (sh *subscriptionsHub) dispatchBlock(
+	sub *subscription,
+	blockEvent *BlockEvent,
+	txEvents []*TransactionEvent,
+	logEvents []*LogEvent,
+	logTopics [][]string,
+) bool {
+	if sub.filter.wantsEvent(EventBlock) {
+		if !sub.trySend(&Message{Type: EventBlock, Data: blockEvent}) {
+			return false
+		}
+	}
+
+	for _, txEvent := range txEvents {
+		if !sub.filter.matchesTransaction(txEvent) {
+			continue
+		}
+		if !sub.trySend(&Message{Type: EventTransaction, Data: txEvent}) {
+			return false
+		}
+	}
+
+	for idx, logEvent := range logEvents {
+		if !sub.filter.matchesLog(logEvent, logTopics[idx]) {
+			continue
+		}
+		if !sub.trySend(&Message{Type: EventLog, Data: logEvent}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// removeLaggingSubscriptions drops the subscribers that do not keep up with the node. Closing their channel
+// lets them know that events were lost, so they can resubscribe and catch up through the regular API routes
+func (sh *subscriptionsHub) removeLaggingSubscriptions(ids []uint64) {
+	if len(ids) == 0 {
+		return
+	}
+
+	sh.mutSubscriptions.Lock()
+	defer sh.mutSubscriptions.Unlock()
+
+	for _, id := range ids {
+		log.Warn("subscriptionsHub: subscriber is lagging behind, dropping it", "id", id)
+		sh.removeSubscriptionUnprotected(id)
+	}
+}
+
+func (sh *subscriptionsHub) convertTransactions(
+	pool *indexer.Pool,
+	blockHash string,
+	failedTxs map[string]struct{},
+) []*TransactionEvent {
+	numTxs := len(pool.Txs) + len(pool.Scrs) + len(pool.Rewards) + len(pool.Invalid)
+	events := make([]*TransactionEvent, 0, numTxs)
+
+	events = sh.appendTransactions(events, pool.Txs, txTypeNormal, blockHash, failedTxs)
+	events = sh.appendTransactions(events, pool.Scrs, txTypeUnsigned, blockHash, failedTxs)
+	events = sh.appendTransactions(events, pool.Rewards, txTypeReward, blockHash, failedTxs)
+	events = sh.appendTransactions(events, pool.Invalid, txTypeInvalid, blockHash, failedTxs)
+
+	return events
+}
+
+func (sh *subscriptionsHub) appendTransactions(
+	events []*TransactionEvent,
+	txs map[string]nodeData.TransactionHandler,
+	txType string,
+	blockHash string,
+	failedTxs map[string]struct{},
+) []*TransactionEvent {
+	hashes := make([]string, 0, len(txs))
+	for txHash := range txs {
+		hashes = append(hashes, txHash)
+	}
+	sort.Strings(hashes)
+
+	for _, txHash := range hashes {
+		tx := txs[txHash]
+		if check.IfNil(tx) {
+			continue
+		}
+
+		hexHash := hex.EncodeToString([]byte(txHash))
+		events = append(events, &TransactionEvent{
+			Hash:      hexHash,
+			Type:      txType,
+			Nonce:     tx.GetNonce(),
+			Value:     bigIntToString(tx),
+			Sender:    sh.encodeAddress(tx.GetSndAddr()),
+			Receiver:  sh.encodeAddress(tx.GetRcvAddr()),
+			Data:      tx.GetData(),
+			GasPrice:  tx.GetGasPrice(),
+			GasLimit:  tx.GetGasLimit(),
+			Status:    computeStatus(txType, hexHash, failedTxs).String(),
+			BlockHash: blockHash,
+		})
+	}
+
+	return events
+}
+
+func computeStatus(txType string, hexHash string, failedTxs map[string]struct{}) transaction.TxStatus {
+	if txType == txTypeInvalid {
+		return transaction.TxStatusInvalid
+	}
+
+	_, failed := failedTxs[hexHash]
+	if failed {
+		return transaction.TxStatusFail
+	}
+
+	return transaction.TxStatusSuccess
+}
+
+func bigIntToString(tx nodeData.TransactionHandler) string {
+	value := tx.GetValue()
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func (sh *subscriptionsHub) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return sh.pubKeyConverter.Encode(address)
+}
+
+func (sh *subscriptionsHub) convertLogs(
+	logs []*nodeData.LogData,
+	blockHash string,
+	failedTxs map[string]struct{},
+) ([]*LogEvent, [][]string) {
+	events := make([]*LogEvent, 0)
+	topics := make([][]string, 0)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		txHash := hex.EncodeToString([]byte(logData.TxHash))
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			if check.IfNil(eventHandler) {
+				continue
+			}
+
+			identifier := string(eventHandler.GetIdentifier())
+			if identifier == signalErrorIdentifier {
+				failedTxs[txHash] = struct{}{}
+			}
+
+			events = append(events, &LogEvent{
+				TxHash:     txHash,
+				Address:    sh.encodeAddress(eventHandler.GetAddress()),
+				Identifier: identifier,
+				Topics:     eventHandler.GetTopics(),
+				Data:       eventHandler.GetData(),
+				BlockHash:  blockHash,
+			})
+			topics = append(topics, hexTopics(eventHandler.GetTopics()))
+		}
+	}
+
+	return events, topics
+}
+
+func hexTopics(topics [][]byte) []string {
+	hexValues := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		hexValues = append(hexValues, hex.EncodeToString(topic))
+	}
+
+	return hexValues
+}
+
+// RevertIndexedBlock signals the subscribers that the provided block has been reverted
+func (sh *subscriptionsHub) RevertIndexedBlock(header nodeData.HeaderHandler, _ nodeData.BodyHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	blockHash, err := core.CalculateHash(sh.marshalizer, sh.hasher, header)
+	if err != nil {
+		return fmt.Errorf("%w in subscriptionsHub.RevertIndexedBlock while computing the block hash", err)
+	}
+
+	sh.broadcast(&Message{
+		Type: EventRevert,
+		Data: &RevertEvent{
+			Hash:    hex.EncodeToString(blockHash),
+			Nonce:   header.GetNonce(),
+			Round:   header.GetRound(),
+			Epoch:   header.GetEpoch(),
+			ShardID: header.GetShardID(),
+		},
+	})
+
+	return nil
+}
+
+// FinalizedBlock signals the subscribers that the provided block hash is final
+func (sh *subscriptionsHub) FinalizedBlock(headerHash []byte) error {
+	sh.broadcast(&Message{
+		Type: EventFinalized,
+		Data: &FinalizedEvent{
+			Hash: hex.EncodeToString(headerHash),
+		},
+	})
+
+	return nil
+}
+
+func (sh *subscriptionsHub) broadcast(message *Message) {
+	sh.mutSubscriptions.RLock()
+	lagging := make([]uint64, 0)
+	for _, sub := range sh.subscriptions {
+		if !sub.filter.wantsEvent(message.Type) {
+			continue
+		}
+		if !sub.trySend(message) {
+			lagging = append(lagging, sub.id)
+		}
+	}
+	sh.mutSubscriptions.RUnlock()
+
+	sh.removeLaggingSubscriptions(lagging)
+}
+
+// SaveRoundsInfo returns nil
+func (sh *subscriptionsHub) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (sh *subscriptionsHub) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (sh *subscriptionsHub) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (sh *subscriptionsHub) SaveAccounts(_ uint64, _ []nodeData.UserAccountHandler) error {
+	return nil
+}
+
+// Close removes all the subscribers and refuses any new subscription
+func (sh *subscriptionsHub) Close() error {
+	sh.mutSubscriptions.Lock()
+	defer sh.mutSubscriptions.Unlock()
+
+	sh.closed = true
+	for id := range sh.subscriptions {
+		sh.removeSubscriptionUnprotected(id)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *subscriptionsHub) IsInterfaceNil() bool {
+	return sh == nil
+}
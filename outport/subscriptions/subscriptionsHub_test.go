@@ -0,0 +1,330 @@
+package subscriptions_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSubscriptionsHub() subscriptions.ArgsSubscriptionsHub {
+	return subscriptions.ArgsSubscriptionsHub{
+		Marshalizer:          &testscommon.MarshalizerMock{},
+		Hasher:               &hashingMocks.HasherMock{},
+		PubKeyConverter:      &testscommon.PubkeyConverterMock{},
+		SubscriberBufferSize: 100,
+		MaxSubscribers:       10,
+	}
+}
+
+func createSaveBlockArgs() *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hdr"),
+		Header: &block.Header{
+			Nonce:   10,
+			Round:   11,
+			Epoch:   2,
+			TxCount: 4,
+		},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"tx1": &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Value: big.NewInt(5)},
+				"tx2": &transaction.Transaction{Nonce: 2, SndAddr: []byte("carol"), RcvAddr: []byte("sc"), Value: big.NewInt(0)},
+			},
+			Scrs: map[string]data.TransactionHandler{
+				"scr1": &smartContractResult.SmartContractResult{SndAddr: []byte("sc"), RcvAddr: []byte("carol"), Value: big.NewInt(1)},
+			},
+			Rewards: map[string]data.TransactionHandler{
+				"rwd1": &rewardTx.RewardTx{RcvAddr: []byte("bob"), Value: big.NewInt(3)},
+			},
+			Invalid: map[string]data.TransactionHandler{
+				"inv1": &transaction.Transaction{Nonce: 7, SndAddr: []byte("dave"), RcvAddr: []byte("bob")},
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: "tx2",
+					LogHandler: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: []byte("sc"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t1")}},
+							{Address: []byte("sc"), Identifier: []byte("signalError"), Topics: [][]byte{[]byte("t2")}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func drain(sub subscriptions.Subscription) []*subscriptions.Message {
+	messages := make([]*subscriptions.Message, 0)
+	for {
+		select {
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return messages
+			}
+			messages = append(messages, msg)
+		default:
+			return messages
+		}
+	}
+}
+
+func encode(address string) string {
+	return hex.EncodeToString([]byte(address))
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Marshalizer = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.Nil(t, hub)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Hasher = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.Nil(t, hub)
+		assert.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.PubKeyConverter = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.Nil(t, hub)
+		assert.Equal(t, outport.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.SubscriberBufferSize = 0
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.Nil(t, hub)
+		assert.True(t, errors.Is(err, subscriptions.ErrInvalidBufferSize))
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 0
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.Nil(t, hub)
+		assert.True(t, errors.Is(err, subscriptions.ErrInvalidMaxSubscribers))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		assert.Nil(t, err)
+		assert.False(t, hub.IsInterfaceNil())
+	})
+}
+
+func TestSubscriptionsHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown event type should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, err := hub.Subscribe(subscriptions.Filter{Events: []string{"unknown"}})
+		assert.Nil(t, sub)
+		assert.True(t, errors.Is(err, subscriptions.ErrUnknownEventType))
+	})
+	t.Run("too many subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 1
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		_, err := hub.Subscribe(subscriptions.Filter{})
+		require.Nil(t, err)
+
+		sub, err := hub.Subscribe(subscriptions.Filter{})
+		assert.Nil(t, sub)
+		assert.True(t, errors.Is(err, subscriptions.ErrTooManySubscribers))
+	})
+	t.Run("closed hub should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		_ = hub.Close()
+
+		sub, err := hub.Subscribe(subscriptions.Filter{})
+		assert.Nil(t, sub)
+		assert.Equal(t, subscriptions.ErrHubClosed, err)
+	})
+	t.Run("unsubscribe should close the channel", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(subscriptions.Filter{})
+		hub.Unsubscribe(sub.ID())
+
+		_, ok := <-sub.Messages()
+		assert.False(t, ok)
+	})
+}
+
+func TestSubscriptionsHub_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transactions pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		args := createSaveBlockArgs()
+		args.TransactionsPool = nil
+		assert.Equal(t, subscriptions.ErrNilTransactionsPool, hub.SaveBlock(args))
+	})
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		args := createSaveBlockArgs()
+		args.Header = nil
+		assert.Equal(t, subscriptions.ErrNilHeader, hub.SaveBlock(args))
+	})
+	t.Run("no filter should receive everything", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(subscriptions.Filter{})
+
+		err := hub.SaveBlock(createSaveBlockArgs())
+		require.Nil(t, err)
+
+		messages := drain(sub)
+		require.Equal(t, 1+5+2, len(messages))
+		assert.Equal(t, subscriptions.EventBlock, messages[0].Type)
+		blockEvent := messages[0].Data.(*subscriptions.BlockEvent)
+		assert.Equal(t, hex.EncodeToString([]byte("hdr")), blockEvent.Hash)
+		assert.Equal(t, uint64(10), blockEvent.Nonce)
+
+		statuses := make(map[string]string)
+		for _, msg := range messages {
+			if msg.Type == subscriptions.EventTransaction {
+				txEvent := msg.Data.(*subscriptions.TransactionEvent)
+				statuses[txEvent.Hash] = txEvent.Status
+			}
+		}
+		assert.Equal(t, transaction.TxStatusSuccess.String(), statuses[encode("tx1")])
+		assert.Equal(t, transaction.TxStatusFail.String(), statuses[encode("tx2")])
+		assert.Equal(t, transaction.TxStatusInvalid.String(), statuses[encode("inv1")])
+		assert.Equal(t, transaction.TxStatusSuccess.String(), statuses[encode("rwd1")])
+	})
+	t.Run("address filter should select transactions and logs", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(subscriptions.Filter{
+			Events:    []string{subscriptions.EventTransaction, subscriptions.EventLog},
+			Addresses: []string{encode("bob")},
+		})
+
+		_ = hub.SaveBlock(createSaveBlockArgs())
+
+		messages := drain(sub)
+		require.Equal(t, 3, len(messages))
+		for _, msg := range messages {
+			assert.Equal(t, subscriptions.EventTransaction, msg.Type)
+		}
+	})
+	t.Run("topic and identifier filter should select logs", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		sub, _ := hub.Subscribe(subscriptions.Filter{
+			Events:      []string{subscriptions.EventLog},
+			Identifiers: []string{"transfer", "signalError"},
+			Topics:      []string{encode("t2")},
+		})
+
+		_ = hub.SaveBlock(createSaveBlockArgs())
+
+		messages := drain(sub)
+		require.Equal(t, 1, len(messages))
+		logEvent := messages[0].Data.(*subscriptions.LogEvent)
+		assert.Equal(t, "signalError", logEvent.Identifier)
+		assert.Equal(t, encode("tx2"), logEvent.TxHash)
+	})
+	t.Run("lagging subscriber should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.SubscriberBufferSize = 2
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		sub, _ := hub.Subscribe(subscriptions.Filter{})
+
+		_ = hub.SaveBlock(createSaveBlockArgs())
+
+		messages := drain(sub)
+		assert.Equal(t, 2, len(messages))
+		_, ok := <-sub.Messages()
+		assert.False(t, ok)
+	})
+}
+
+func TestSubscriptionsHub_RevertAndFinalized(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	sub, _ := hub.Subscribe(subscriptions.Filter{
+		Events: []string{subscriptions.EventRevert, subscriptions.EventFinalized},
+	})
+
+	err := hub.RevertIndexedBlock(&block.Header{Nonce: 4, Round: 5}, nil)
+	require.Nil(t, err)
+	err = hub.FinalizedBlock([]byte("hash"))
+	require.Nil(t, err)
+	err = hub.SaveBlock(createSaveBlockArgs())
+	require.Nil(t, err)
+
+	messages := drain(sub)
+	require.Equal(t, 2, len(messages))
+	assert.Equal(t, subscriptions.EventRevert, messages[0].Type)
+	assert.Equal(t, uint64(4), messages[0].Data.(*subscriptions.RevertEvent).Nonce)
+	assert.Equal(t, subscriptions.EventFinalized, messages[1].Type)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), messages[1].Data.(*subscriptions.FinalizedEvent).Hash)
+}
+
+func TestSubscriptionsHub_CloseShouldCloseAllSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	sub1, _ := hub.Subscribe(subscriptions.Filter{})
+	sub2, _ := hub.Subscribe(subscriptions.Filter{})
+
+	err := hub.Close()
+	assert.Nil(t, err)
+
+	_, ok := <-sub1.Messages()
+	assert.False(t, ok)
+	_, ok = <-sub2.Messages()
+	assert.False(t, ok)
+}
@@ -161,3 +161,18 @@ var ErrNilCacher = errors.New("nil cacher")
 
 // ErrNilPeerTopicNotifier signals that a nil peer topic notifier have been provided
 var ErrNilPeerTopicNotifier = errors.New("nil peer topic notifier")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilDirectSender signals that a nil direct sender has been provided
+var ErrNilDirectSender = errors.New("nil direct sender")
+
+// ErrNilFaultsRulesHandler signals that a nil faults rules handler has been provided
+var ErrNilFaultsRulesHandler = errors.New("nil faults rules handler")
+
+// ErrInvalidFaultRule signals that an invalid fault rule has been provided
+var ErrInvalidFaultRule = errors.New("invalid fault rule")
+
+// ErrMessageDroppedByFaultInjection signals that a received message was dropped by an injected fault
+var ErrMessageDroppedByFaultInjection = errors.New("message dropped by fault injection")
@@ -0,0 +1,6 @@
+package faults
+
+// SetRandFloat -
+func (rh *rulesHandler) SetRandFloat(randFloat func() float64) {
+	rh.randFloat = randFloat
+}
@@ -0,0 +1,34 @@
+package faults
+
+import (
+	"math/rand"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+func logAppliedFault(rule common.P2PFaultRule, direction string, topic string, pid core.PeerID, size int) {
+	log.Info("p2p fault applied",
+		"rule", rule.Name,
+		"fault", rule.Fault,
+		"direction", direction,
+		"topic", topic,
+		"peer", pid.Pretty(),
+		"size", size,
+		"delay in ms", rule.DelayInMilliseconds,
+	)
+}
+
+// corruptBuffer returns a copy of the provided buffer in which a randomly chosen byte has all its bits flipped
+func corruptBuffer(buff []byte) []byte {
+	corrupted := make([]byte, len(buff))
+	copy(corrupted, buff)
+	if len(corrupted) == 0 {
+		return corrupted
+	}
+
+	position := rand.Intn(len(corrupted))
+	corrupted[position] ^= 0xFF
+
+	return corrupted
+}
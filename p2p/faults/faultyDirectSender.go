@@ -0,0 +1,103 @@
+package faults
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/data"
+)
+
+var _ p2p.DirectSender = (*faultyDirectSender)(nil)
+
+// ArgsFaultyDirectSender is the DTO used to create a new faulty direct sender
+type ArgsFaultyDirectSender struct {
+	DirectSender p2p.DirectSender
+	Marshalizer  p2p.Marshalizer
+	RulesHandler RulesHandler
+}
+
+// faultyDirectSender wraps a p2p.DirectSender and injects the faults decided by the rules handler on the direct
+// messages sent to the connected peers
+type faultyDirectSender struct {
+	p2p.DirectSender
+	marshalizer  p2p.Marshalizer
+	rulesHandler RulesHandler
+}
+
+// NewFaultyDirectSender creates a new faulty direct sender
+func NewFaultyDirectSender(args ArgsFaultyDirectSender) (*faultyDirectSender, error) {
+	if check.IfNil(args.DirectSender) {
+		return nil, p2p.ErrNilDirectSender
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, p2p.ErrNilMarshalizer
+	}
+	if check.IfNil(args.RulesHandler) {
+		return nil, p2p.ErrNilFaultsRulesHandler
+	}
+
+	return &faultyDirectSender{
+		DirectSender: args.DirectSender,
+		marshalizer:  args.Marshalizer,
+		rulesHandler: args.RulesHandler,
+	}, nil
+}
+
+// Send applies the outbound fault rules, if any, and sends the resulted messages to the provided peer
+func (fds *faultyDirectSender) Send(topic string, buff []byte, peer core.PeerID) error {
+	rule, found := fds.rulesHandler.FaultFor(topic, peer, DirectionOutbound)
+	if !found {
+		return fds.DirectSender.Send(topic, buff, peer)
+	}
+
+	logAppliedFault(rule, DirectionOutbound, topic, peer, len(buff))
+
+	switch rule.Fault {
+	case FaultDrop:
+		return nil
+	case FaultDelay:
+		time.AfterFunc(time.Duration(rule.DelayInMilliseconds)*time.Millisecond, func() {
+			err := fds.DirectSender.Send(topic, buff, peer)
+			if err != nil {
+				log.Trace("faultyDirectSender: delayed message", "topic", topic, "error", err.Error())
+			}
+		})
+		return nil
+	case FaultDuplicate:
+		err := fds.DirectSender.Send(topic, buff, peer)
+		if err != nil {
+			return err
+		}
+
+		return fds.DirectSender.Send(topic, buff, peer)
+	case FaultCorrupt:
+		return fds.DirectSender.Send(topic, fds.corruptPayload(buff), peer)
+	default:
+		return fds.DirectSender.Send(topic, buff, peer)
+	}
+}
+
+// corruptPayload alters only the payload of the topic message, so that the receiver does not reject the message as
+// malformed and does not blacklist this node. The whole buffer is altered if it is not a topic message
+func (fds *faultyDirectSender) corruptPayload(buff []byte) []byte {
+	topicMessage := &data.TopicMessage{}
+	err := fds.marshalizer.Unmarshal(topicMessage, buff)
+	if err != nil {
+		return corruptBuffer(buff)
+	}
+
+	topicMessage.Payload = corruptBuffer(topicMessage.Payload)
+	corrupted, err := fds.marshalizer.Marshal(topicMessage)
+	if err != nil {
+		return corruptBuffer(buff)
+	}
+
+	return corrupted
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fds *faultyDirectSender) IsInterfaceNil() bool {
+	return fds == nil
+}
@@ -0,0 +1,169 @@
+package faults_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/faults"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFaultyDirectSender() faults.ArgsFaultyDirectSender {
+	return faults.ArgsFaultyDirectSender{
+		DirectSender: &mock.DirectSenderStub{},
+		Marshalizer:  &marshal.GogoProtoMarshalizer{},
+		RulesHandler: &p2pmocks.FaultsRulesHandlerStub{},
+	}
+}
+
+func createFaultyDirectSender(t *testing.T, fault string) (p2p.DirectSender, *buffsRecorder) {
+	recorder := &buffsRecorder{}
+	args := createMockArgsFaultyDirectSender()
+	args.DirectSender = &mock.DirectSenderStub{
+		SendCalled: func(topic string, buff []byte, peer core.PeerID) error {
+			assert.Equal(t, "topic", topic)
+			assert.Equal(t, otherPeer, peer)
+			recorder.add(buff)
+			return nil
+		},
+	}
+	args.RulesHandler = createRulesHandlerWithFault(fault)
+	fds, err := faults.NewFaultyDirectSender(args)
+	require.Nil(t, err)
+
+	return fds, recorder
+}
+
+func TestNewFaultyDirectSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil direct sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaultyDirectSender()
+		args.DirectSender = nil
+		fds, err := faults.NewFaultyDirectSender(args)
+		assert.True(t, check.IfNil(fds))
+		assert.Equal(t, p2p.ErrNilDirectSender, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaultyDirectSender()
+		args.Marshalizer = nil
+		fds, err := faults.NewFaultyDirectSender(args)
+		assert.True(t, check.IfNil(fds))
+		assert.Equal(t, p2p.ErrNilMarshalizer, err)
+	})
+	t.Run("nil rules handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaultyDirectSender()
+		args.RulesHandler = nil
+		fds, err := faults.NewFaultyDirectSender(args)
+		assert.True(t, check.IfNil(fds))
+		assert.Equal(t, p2p.ErrNilFaultsRulesHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fds, err := faults.NewFaultyDirectSender(createMockArgsFaultyDirectSender())
+		assert.False(t, check.IfNil(fds))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFaultyDirectSender_Send(t *testing.T) {
+	t.Parallel()
+
+	buff := []byte("message")
+
+	t.Run("no fault should send the message", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &buffsRecorder{}
+		rulesHandler := &p2pmocks.FaultsRulesHandlerStub{
+			FaultForCalled: func(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+				assert.Equal(t, "topic", topic)
+				assert.Equal(t, otherPeer, pid)
+				assert.Equal(t, faults.DirectionOutbound, direction)
+				return common.P2PFaultRule{}, false
+			},
+		}
+		args := createMockArgsFaultyDirectSender()
+		args.DirectSender = &mock.DirectSenderStub{
+			SendCalled: func(topic string, buff []byte, peer core.PeerID) error {
+				recorder.add(buff)
+				return nil
+			},
+		}
+		args.RulesHandler = rulesHandler
+		fds, _ := faults.NewFaultyDirectSender(args)
+
+		err := fds.Send("topic", buff, otherPeer)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{buff}, recorder.get())
+	})
+	t.Run("drop should not send the message", func(t *testing.T) {
+		t.Parallel()
+
+		fds, recorder := createFaultyDirectSender(t, faults.FaultDrop)
+		err := fds.Send("topic", buff, otherPeer)
+		assert.Nil(t, err)
+		assert.Empty(t, recorder.get())
+	})
+	t.Run("delay should send the message later", func(t *testing.T) {
+		t.Parallel()
+
+		fds, recorder := createFaultyDirectSender(t, faults.FaultDelay)
+		err := fds.Send("topic", buff, otherPeer)
+		assert.Nil(t, err)
+		assert.Empty(t, recorder.get())
+
+		assert.Eventually(t, func() bool {
+			return len(recorder.get()) == 1
+		}, time.Second, time.Millisecond*5)
+	})
+	t.Run("duplicate should send the message twice", func(t *testing.T) {
+		t.Parallel()
+
+		fds, recorder := createFaultyDirectSender(t, faults.FaultDuplicate)
+		err := fds.Send("topic", buff, otherPeer)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{buff, buff}, recorder.get())
+	})
+	t.Run("corrupt should alter only the payload of a topic message", func(t *testing.T) {
+		t.Parallel()
+
+		marshalizer := &marshal.GogoProtoMarshalizer{}
+		topicMessage := &data.TopicMessage{
+			Version:   1,
+			Payload:   buff,
+			Timestamp: 1000,
+		}
+		topicMessageBuff, err := marshalizer.Marshal(topicMessage)
+		require.Nil(t, err)
+
+		fds, recorder := createFaultyDirectSender(t, faults.FaultCorrupt)
+		err = fds.Send("topic", topicMessageBuff, otherPeer)
+		assert.Nil(t, err)
+
+		sent := recorder.get()
+		require.Equal(t, 1, len(sent))
+		sentTopicMessage := &data.TopicMessage{}
+		err = marshalizer.Unmarshal(sentTopicMessage, sent[0])
+		require.Nil(t, err)
+		assert.Equal(t, topicMessage.Version, sentTopicMessage.Version)
+		assert.Equal(t, topicMessage.Timestamp, sentTopicMessage.Timestamp)
+		assert.Equal(t, 1, numDifferentBytes(buff, sentTopicMessage.Payload))
+	})
+}
@@ -0,0 +1,238 @@
+package faults
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/message"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+// inboundDecisionsCacheSize is the number of received messages for which the inbound fault decision is remembered. The
+// handlers registered on a topic process a message one after the other, so only the latest messages are needed
+const inboundDecisionsCacheSize = 10000
+
+var _ p2p.Messenger = (*faultyMessenger)(nil)
+
+// ArgsFaultyMessenger is the DTO used to create a new faulty messenger
+type ArgsFaultyMessenger struct {
+	Messenger    p2p.Messenger
+	RulesHandler RulesHandler
+}
+
+// faultyMessenger wraps a p2p.Messenger and injects the faults decided by the rules handler on the broadcast messages
+// and on all the received messages. The direct messages should be faulted by wrapping the direct sender used by the
+// messenger, as the peer they are sent to is only known there
+type faultyMessenger struct {
+	p2p.Messenger
+	rulesHandler  RulesHandler
+	inboundFaults *inboundFaultsDecider
+}
+
+// NewFaultyMessenger creates a new faulty messenger
+func NewFaultyMessenger(args ArgsFaultyMessenger) (*faultyMessenger, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, p2p.ErrNilMessenger
+	}
+	if check.IfNil(args.RulesHandler) {
+		return nil, p2p.ErrNilFaultsRulesHandler
+	}
+
+	decisions, err := lrucache.NewCache(inboundDecisionsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Warn("p2p fault injection is enabled. NOT to be used on production networks")
+
+	return &faultyMessenger{
+		Messenger:    args.Messenger,
+		rulesHandler: args.RulesHandler,
+		inboundFaults: &inboundFaultsDecider{
+			rulesHandler: args.RulesHandler,
+			decisions:    decisions,
+		},
+	}, nil
+}
+
+// BroadcastOnChannelBlocking applies the outbound fault rules, if any, and sends the resulted messages
+func (fm *faultyMessenger) BroadcastOnChannelBlocking(channel string, topic string, buff []byte) error {
+	rule, found := fm.rulesHandler.FaultFor(topic, "", DirectionOutbound)
+	if !found {
+		return fm.Messenger.BroadcastOnChannelBlocking(channel, topic, buff)
+	}
+
+	logAppliedFault(rule, DirectionOutbound, topic, "", len(buff))
+
+	switch rule.Fault {
+	case FaultDrop:
+		return nil
+	case FaultDelay:
+		time.Sleep(time.Duration(rule.DelayInMilliseconds) * time.Millisecond)
+		return fm.Messenger.BroadcastOnChannelBlocking(channel, topic, buff)
+	case FaultDuplicate:
+		err := fm.Messenger.BroadcastOnChannelBlocking(channel, topic, buff)
+		if err != nil {
+			return err
+		}
+
+		return fm.Messenger.BroadcastOnChannelBlocking(channel, topic, buff)
+	case FaultCorrupt:
+		return fm.Messenger.BroadcastOnChannelBlocking(channel, topic, corruptBuffer(buff))
+	default:
+		return fm.Messenger.BroadcastOnChannelBlocking(channel, topic, buff)
+	}
+}
+
+// BroadcastOnChannel applies the outbound fault rules, if any, and asynchronously sends the resulted messages
+func (fm *faultyMessenger) BroadcastOnChannel(channel string, topic string, buff []byte) {
+	go func() {
+		err := fm.BroadcastOnChannelBlocking(channel, topic, buff)
+		if err != nil {
+			log.Warn("p2p broadcast", "error", err.Error())
+		}
+	}()
+}
+
+// Broadcast applies the outbound fault rules, if any, and asynchronously sends the resulted messages using the topic
+// name as channel
+func (fm *faultyMessenger) Broadcast(topic string, buff []byte) {
+	fm.BroadcastOnChannel(topic, topic, buff)
+}
+
+// RegisterMessageProcessor registers the provided message processor, wrapped so that the inbound fault rules are
+// applied on the messages received on the topic. The fault is decided once per received message, so all the message
+// processors registered on the topic are affected by the same fault
+func (fm *faultyMessenger) RegisterMessageProcessor(topic string, identifier string, handler p2p.MessageProcessor) error {
+	if check.IfNil(handler) {
+		return fm.Messenger.RegisterMessageProcessor(topic, identifier, handler)
+	}
+
+	faultyHandler := &faultyMessageProcessor{
+		MessageProcessor: handler,
+		inboundFaults:    fm.inboundFaults,
+		selfID:           fm.Messenger.ID(),
+	}
+
+	return fm.Messenger.RegisterMessageProcessor(topic, identifier, faultyHandler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fm *faultyMessenger) IsInterfaceNil() bool {
+	return fm == nil
+}
+
+// inboundFault holds the fault decided for a received message
+type inboundFault struct {
+	rule             common.P2PFaultRule
+	found            bool
+	corruptedMessage p2p.MessageP2P
+}
+
+// inboundFaultsDecider decides the inbound fault of each received message only once, when the first message processor
+// registered on its topic processes it, and remembers the decision for the other message processors of the topic
+type inboundFaultsDecider struct {
+	mut          sync.Mutex
+	rulesHandler RulesHandler
+	decisions    storage.Cacher
+}
+
+func (ifd *inboundFaultsDecider) faultFor(msg p2p.MessageP2P, fromConnectedPeer core.PeerID) *inboundFault {
+	key := make([]byte, 0, len(msg.Topic())+len(msg.From())+len(msg.SeqNo())+len(fromConnectedPeer))
+	key = append(key, msg.Topic()...)
+	key = append(key, msg.From()...)
+	key = append(key, msg.SeqNo()...)
+	key = append(key, fromConnectedPeer...)
+
+	ifd.mut.Lock()
+	defer ifd.mut.Unlock()
+
+	cachedFault, ok := ifd.decisions.Get(key)
+	if ok {
+		fault, isFault := cachedFault.(*inboundFault)
+		if isFault {
+			return fault
+		}
+	}
+
+	fault := &inboundFault{}
+	fault.rule, fault.found = ifd.rulesHandler.FaultFor(msg.Topic(), fromConnectedPeer, DirectionInbound)
+	if fault.found {
+		logAppliedFault(fault.rule, DirectionInbound, msg.Topic(), fromConnectedPeer, len(msg.Data()))
+	}
+	if fault.found && fault.rule.Fault == FaultCorrupt {
+		fault.corruptedMessage = createCorruptedMessage(msg)
+	}
+	ifd.decisions.Put(key, fault, 0)
+
+	return fault
+}
+
+// faultyMessageProcessor applies the inbound fault rules on the messages received from other peers
+type faultyMessageProcessor struct {
+	p2p.MessageProcessor
+	inboundFaults *inboundFaultsDecider
+	selfID        core.PeerID
+}
+
+// ProcessReceivedMessage applies the inbound fault decided for the message, if any, and processes the resulted messages
+func (fmp *faultyMessageProcessor) ProcessReceivedMessage(msg p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if fromConnectedPeer == fmp.selfID {
+		return fmp.MessageProcessor.ProcessReceivedMessage(msg, fromConnectedPeer)
+	}
+
+	fault := fmp.inboundFaults.faultFor(msg, fromConnectedPeer)
+	if !fault.found {
+		return fmp.MessageProcessor.ProcessReceivedMessage(msg, fromConnectedPeer)
+	}
+
+	switch fault.rule.Fault {
+	case FaultDrop:
+		return p2p.ErrMessageDroppedByFaultInjection
+	case FaultDelay:
+		// the message is accepted right away, so its propagation is not delayed, only its processing
+		time.AfterFunc(time.Duration(fault.rule.DelayInMilliseconds)*time.Millisecond, func() {
+			err := fmp.MessageProcessor.ProcessReceivedMessage(msg, fromConnectedPeer)
+			if err != nil {
+				log.Trace("faultyMessageProcessor: delayed message", "topic", msg.Topic(), "error", err.Error())
+			}
+		})
+		return nil
+	case FaultDuplicate:
+		err := fmp.MessageProcessor.ProcessReceivedMessage(msg, fromConnectedPeer)
+		errDuplicate := fmp.MessageProcessor.ProcessReceivedMessage(msg, fromConnectedPeer)
+		if errDuplicate != nil {
+			log.Trace("faultyMessageProcessor: duplicated message", "topic", msg.Topic(), "error", errDuplicate.Error())
+		}
+
+		return err
+	case FaultCorrupt:
+		return fmp.MessageProcessor.ProcessReceivedMessage(fault.corruptedMessage, fromConnectedPeer)
+	default:
+		return fmp.MessageProcessor.ProcessReceivedMessage(msg, fromConnectedPeer)
+	}
+}
+
+func createCorruptedMessage(msg p2p.MessageP2P) p2p.MessageP2P {
+	return &message.Message{
+		FromField:      msg.From(),
+		DataField:      corruptBuffer(msg.Data()),
+		PayloadField:   msg.Payload(),
+		SeqNoField:     msg.SeqNo(),
+		TopicField:     msg.Topic(),
+		SignatureField: msg.Signature(),
+		KeyField:       msg.Key(),
+		PeerField:      msg.Peer(),
+		TimestampField: msg.Timestamp(),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fmp *faultyMessageProcessor) IsInterfaceNil() bool {
+	return fmp == nil
+}
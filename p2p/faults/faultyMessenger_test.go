@@ -0,0 +1,413 @@
+package faults_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/faults"
+	"github.com/ElrondNetwork/elrond-go/p2p/message"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	selfPeer  = core.PeerID("self")
+	otherPeer = core.PeerID("other")
+)
+
+func createRulesHandlerWithFault(fault string) *p2pmocks.FaultsRulesHandlerStub {
+	return &p2pmocks.FaultsRulesHandlerStub{
+		FaultForCalled: func(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+			return common.P2PFaultRule{
+				Name:                "rule",
+				Fault:               fault,
+				Probability:         1,
+				DelayInMilliseconds: 10,
+			}, true
+		},
+	}
+}
+
+type buffsRecorder struct {
+	mut   sync.Mutex
+	buffs [][]byte
+}
+
+func (br *buffsRecorder) add(buff []byte) {
+	br.mut.Lock()
+	br.buffs = append(br.buffs, buff)
+	br.mut.Unlock()
+}
+
+func (br *buffsRecorder) get() [][]byte {
+	br.mut.Lock()
+	defer br.mut.Unlock()
+
+	return br.buffs
+}
+
+func createFaultyMessenger(t *testing.T, fault string) (p2p.Messenger, *buffsRecorder) {
+	recorder := &buffsRecorder{}
+	args := faults.ArgsFaultyMessenger{
+		Messenger: &p2pmocks.MessengerStub{
+			BroadcastOnChannelBlockingCalled: func(channel string, topic string, buff []byte) error {
+				assert.Equal(t, "channel", channel)
+				assert.Equal(t, "topic", topic)
+				recorder.add(buff)
+				return nil
+			},
+		},
+		RulesHandler: createRulesHandlerWithFault(fault),
+	}
+	fm, err := faults.NewFaultyMessenger(args)
+	require.Nil(t, err)
+
+	return fm, recorder
+}
+
+func TestNewFaultyMessenger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil messenger should error", func(t *testing.T) {
+		t.Parallel()
+
+		fm, err := faults.NewFaultyMessenger(faults.ArgsFaultyMessenger{
+			RulesHandler: &p2pmocks.FaultsRulesHandlerStub{},
+		})
+		assert.True(t, check.IfNil(fm))
+		assert.Equal(t, p2p.ErrNilMessenger, err)
+	})
+	t.Run("nil rules handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		fm, err := faults.NewFaultyMessenger(faults.ArgsFaultyMessenger{
+			Messenger: &p2pmocks.MessengerStub{},
+		})
+		assert.True(t, check.IfNil(fm))
+		assert.Equal(t, p2p.ErrNilFaultsRulesHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fm, err := faults.NewFaultyMessenger(faults.ArgsFaultyMessenger{
+			Messenger:    &p2pmocks.MessengerStub{},
+			RulesHandler: &p2pmocks.FaultsRulesHandlerStub{},
+		})
+		assert.False(t, check.IfNil(fm))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFaultyMessenger_BroadcastOnChannelBlocking(t *testing.T) {
+	t.Parallel()
+
+	buff := []byte("message")
+
+	t.Run("no fault should send the message", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &buffsRecorder{}
+		args := faults.ArgsFaultyMessenger{
+			Messenger: &p2pmocks.MessengerStub{
+				BroadcastOnChannelBlockingCalled: func(channel string, topic string, buff []byte) error {
+					recorder.add(buff)
+					return nil
+				},
+			},
+			RulesHandler: &p2pmocks.FaultsRulesHandlerStub{},
+		}
+		fm, _ := faults.NewFaultyMessenger(args)
+
+		err := fm.BroadcastOnChannelBlocking("channel", "topic", buff)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{buff}, recorder.get())
+	})
+	t.Run("drop should not send the message", func(t *testing.T) {
+		t.Parallel()
+
+		fm, recorder := createFaultyMessenger(t, faults.FaultDrop)
+		err := fm.BroadcastOnChannelBlocking("channel", "topic", buff)
+		assert.Nil(t, err)
+		assert.Empty(t, recorder.get())
+	})
+	t.Run("delay should send the message later", func(t *testing.T) {
+		t.Parallel()
+
+		fm, recorder := createFaultyMessenger(t, faults.FaultDelay)
+		startTime := time.Now()
+		err := fm.BroadcastOnChannelBlocking("channel", "topic", buff)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, time.Since(startTime), time.Millisecond*10)
+		assert.Equal(t, [][]byte{buff}, recorder.get())
+	})
+	t.Run("duplicate should send the message twice", func(t *testing.T) {
+		t.Parallel()
+
+		fm, recorder := createFaultyMessenger(t, faults.FaultDuplicate)
+		err := fm.BroadcastOnChannelBlocking("channel", "topic", buff)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{buff, buff}, recorder.get())
+	})
+	t.Run("corrupt should send an altered copy of the message", func(t *testing.T) {
+		t.Parallel()
+
+		fm, recorder := createFaultyMessenger(t, faults.FaultCorrupt)
+		err := fm.BroadcastOnChannelBlocking("channel", "topic", buff)
+		assert.Nil(t, err)
+
+		sent := recorder.get()
+		require.Equal(t, 1, len(sent))
+		assert.Equal(t, []byte("message"), buff)
+		assert.Equal(t, 1, numDifferentBytes(buff, sent[0]))
+	})
+}
+
+func TestFaultyMessenger_BroadcastShouldApplyTheFaults(t *testing.T) {
+	t.Parallel()
+
+	recorder := &buffsRecorder{}
+	args := faults.ArgsFaultyMessenger{
+		Messenger: &p2pmocks.MessengerStub{
+			BroadcastOnChannelBlockingCalled: func(channel string, topic string, buff []byte) error {
+				assert.Equal(t, "topic", channel)
+				recorder.add(buff)
+				return nil
+			},
+		},
+		RulesHandler: createRulesHandlerWithFault(faults.FaultDuplicate),
+	}
+	fm, _ := faults.NewFaultyMessenger(args)
+
+	fm.Broadcast("topic", []byte("message"))
+	assert.Eventually(t, func() bool {
+		return len(recorder.get()) == 2
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestFaultyMessenger_RegisterMessageProcessor(t *testing.T) {
+	t.Parallel()
+
+	msg := &message.Message{
+		DataField:  []byte("message"),
+		TopicField: "topic",
+	}
+
+	registerAndGetHandler := func(t *testing.T, rulesHandler faults.RulesHandler, handler p2p.MessageProcessor) p2p.MessageProcessor {
+		var registeredHandler p2p.MessageProcessor
+		args := faults.ArgsFaultyMessenger{
+			Messenger: &p2pmocks.MessengerStub{
+				IDCalled: func() core.PeerID {
+					return selfPeer
+				},
+				RegisterMessageProcessorCalled: func(topic string, identifier string, handler p2p.MessageProcessor) error {
+					assert.Equal(t, "topic", topic)
+					assert.Equal(t, "identifier", identifier)
+					registeredHandler = handler
+					return nil
+				},
+			},
+			RulesHandler: rulesHandler,
+		}
+		fm, _ := faults.NewFaultyMessenger(args)
+
+		err := fm.RegisterMessageProcessor("topic", "identifier", handler)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(registeredHandler))
+
+		return registeredHandler
+	}
+
+	t.Run("messages from self should not be faulted", func(t *testing.T) {
+		t.Parallel()
+
+		numProcessed := 0
+		handler := &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				numProcessed++
+				return nil
+			},
+		}
+		registeredHandler := registerAndGetHandler(t, createRulesHandlerWithFault(faults.FaultDrop), handler)
+
+		err := registeredHandler.ProcessReceivedMessage(msg, selfPeer)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numProcessed)
+	})
+	t.Run("drop should reject the message", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		rulesHandler := createRulesHandlerWithFault(faults.FaultDrop)
+		rulesHandler.FaultForCalled = func(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+			assert.Equal(t, "topic", topic)
+			assert.Equal(t, otherPeer, pid)
+			assert.Equal(t, faults.DirectionInbound, direction)
+			return common.P2PFaultRule{Name: "rule", Fault: faults.FaultDrop, Probability: 1}, true
+		}
+		registeredHandler := registerAndGetHandler(t, rulesHandler, handler)
+
+		err := registeredHandler.ProcessReceivedMessage(msg, otherPeer)
+		assert.Equal(t, p2p.ErrMessageDroppedByFaultInjection, err)
+	})
+	t.Run("delay should process the message later", func(t *testing.T) {
+		t.Parallel()
+
+		chProcessed := make(chan struct{}, 1)
+		handler := &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				chProcessed <- struct{}{}
+				return nil
+			},
+		}
+		registeredHandler := registerAndGetHandler(t, createRulesHandlerWithFault(faults.FaultDelay), handler)
+
+		err := registeredHandler.ProcessReceivedMessage(msg, otherPeer)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(chProcessed))
+
+		select {
+		case <-chProcessed:
+		case <-time.After(time.Second):
+			assert.Fail(t, "the delayed message was not processed")
+		}
+	})
+	t.Run("duplicate should process the message twice", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numProcessed := 0
+		handler := &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				numProcessed++
+				if numProcessed == 1 {
+					return nil
+				}
+
+				return expectedErr
+			},
+		}
+		registeredHandler := registerAndGetHandler(t, createRulesHandlerWithFault(faults.FaultDuplicate), handler)
+
+		err := registeredHandler.ProcessReceivedMessage(msg, otherPeer)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numProcessed)
+	})
+	t.Run("corrupt should process an altered copy of the message", func(t *testing.T) {
+		t.Parallel()
+
+		var processedMessage p2p.MessageP2P
+		handler := &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				processedMessage = message
+				return nil
+			},
+		}
+		registeredHandler := registerAndGetHandler(t, createRulesHandlerWithFault(faults.FaultCorrupt), handler)
+
+		err := registeredHandler.ProcessReceivedMessage(msg, otherPeer)
+		assert.Nil(t, err)
+		require.False(t, check.IfNil(processedMessage))
+		assert.Equal(t, "topic", processedMessage.Topic())
+		assert.Equal(t, []byte("message"), msg.Data())
+		assert.Equal(t, 1, numDifferentBytes(msg.Data(), processedMessage.Data()))
+	})
+}
+
+func TestFaultyMessenger_RegisterMessageProcessorShouldDecideTheFaultOncePerMessage(t *testing.T) {
+	t.Parallel()
+
+	registeredHandlers := make([]p2p.MessageProcessor, 0)
+	numFaultForCalls := 0
+	args := faults.ArgsFaultyMessenger{
+		Messenger: &p2pmocks.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return selfPeer
+			},
+			RegisterMessageProcessorCalled: func(topic string, identifier string, handler p2p.MessageProcessor) error {
+				registeredHandlers = append(registeredHandlers, handler)
+				return nil
+			},
+		},
+		RulesHandler: &p2pmocks.FaultsRulesHandlerStub{
+			FaultForCalled: func(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+				numFaultForCalls++
+				// only every other message is faulted, as a rule with a 0.5 probability would do
+				isFaulted := numFaultForCalls%2 == 1
+
+				return common.P2PFaultRule{Name: "rule", Fault: faults.FaultCorrupt, Probability: 0.5}, isFaulted
+			},
+		},
+	}
+	fm, _ := faults.NewFaultyMessenger(args)
+
+	processedData := make([][]byte, 0)
+	handler := &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			processedData = append(processedData, message.Data())
+			return nil
+		},
+	}
+	err := fm.RegisterMessageProcessor("topic", "first", handler)
+	require.Nil(t, err)
+	err = fm.RegisterMessageProcessor("topic", "second", handler)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(registeredHandlers))
+
+	processOnAllHandlers := func(msg p2p.MessageP2P) {
+		processedData = make([][]byte, 0)
+		for _, registeredHandler := range registeredHandlers {
+			errProcess := registeredHandler.ProcessReceivedMessage(msg, otherPeer)
+			assert.Nil(t, errProcess)
+		}
+	}
+
+	firstMessage := &message.Message{
+		FromField:  []byte("from"),
+		DataField:  []byte("first message"),
+		SeqNoField: []byte("1"),
+		TopicField: "topic",
+	}
+	processOnAllHandlers(firstMessage)
+	assert.Equal(t, 1, numFaultForCalls)
+	require.Equal(t, 2, len(processedData))
+	assert.Equal(t, 1, numDifferentBytes(firstMessage.Data(), processedData[0]))
+	assert.Equal(t, processedData[0], processedData[1])
+
+	secondMessage := &message.Message{
+		FromField:  []byte("from"),
+		DataField:  []byte("second message"),
+		SeqNoField: []byte("2"),
+		TopicField: "topic",
+	}
+	processOnAllHandlers(secondMessage)
+	assert.Equal(t, 2, numFaultForCalls)
+	assert.Equal(t, [][]byte{secondMessage.Data(), secondMessage.Data()}, processedData)
+}
+
+func numDifferentBytes(first []byte, second []byte) int {
+	if len(first) != len(second) {
+		return -1
+	}
+
+	numDifferent := 0
+	for i := range first {
+		if first[i] != second[i] {
+			numDifferent++
+		}
+	}
+
+	return numDifferent
+}
@@ -0,0 +1,15 @@
+package faults
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// RulesHandler defines the behaviour of the component holding the fault rules and deciding which fault, if any, is
+// applied on a message
+type RulesHandler interface {
+	SetRules(rules []common.P2PFaultRule) error
+	GetRules() []common.P2PFaultRuleStatus
+	FaultFor(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,205 @@
+package faults
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const (
+	// DirectionInbound is the direction of the messages received from the network
+	DirectionInbound = "inbound"
+	// DirectionOutbound is the direction of the messages sent on the network
+	DirectionOutbound = "outbound"
+
+	// FaultDrop discards the message
+	FaultDrop = "drop"
+	// FaultDelay postpones the message with the delay of the rule
+	FaultDelay = "delay"
+	// FaultDuplicate sends or processes the message twice
+	FaultDuplicate = "duplicate"
+	// FaultCorrupt alters one byte of the message payload
+	FaultCorrupt = "corrupt"
+
+	topicPrefixWildcard = "*"
+)
+
+var log = logger.GetOrCreate("p2p/faults")
+
+type faultRule struct {
+	common.P2PFaultRule
+	pid        core.PeerID
+	numApplied uint64
+}
+
+type rulesHandler struct {
+	mutRules  sync.RWMutex
+	rules     []*faultRule
+	randFloat func() float64
+}
+
+// NewRulesHandler creates a new rules handler holding the provided fault rules
+func NewRulesHandler(rules []common.P2PFaultRule) (*rulesHandler, error) {
+	rh := &rulesHandler{
+		randFloat: rand.Float64,
+	}
+
+	err := rh.SetRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return rh, nil
+}
+
+// SetRules validates and replaces all the fault rules. The counters of applied faults are reset
+func (rh *rulesHandler) SetRules(rules []common.P2PFaultRule) error {
+	newRules := make([]*faultRule, 0, len(rules))
+	names := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		newRule, err := createFaultRule(rule)
+		if err != nil {
+			return err
+		}
+
+		_, found := names[rule.Name]
+		if found {
+			return fmt.Errorf("%w, duplicated rule name %s", p2p.ErrInvalidFaultRule, rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		newRules = append(newRules, newRule)
+	}
+
+	rh.mutRules.Lock()
+	rh.rules = newRules
+	rh.mutRules.Unlock()
+
+	log.Info("p2p fault rules set", "num rules", len(newRules))
+	for _, rule := range newRules {
+		log.Info("p2p fault rule",
+			"name", rule.Name,
+			"topic", rule.Topic,
+			"peer", rule.Peer,
+			"direction", rule.Direction,
+			"fault", rule.Fault,
+			"probability", rule.Probability,
+			"delay in ms", rule.DelayInMilliseconds,
+		)
+	}
+
+	return nil
+}
+
+func createFaultRule(rule common.P2PFaultRule) (*faultRule, error) {
+	if len(rule.Name) == 0 {
+		return nil, fmt.Errorf("%w, empty rule name", p2p.ErrInvalidFaultRule)
+	}
+
+	switch rule.Direction {
+	case "", DirectionInbound, DirectionOutbound:
+	default:
+		return nil, fmt.Errorf("%w, rule %s has unknown direction %s", p2p.ErrInvalidFaultRule, rule.Name, rule.Direction)
+	}
+
+	switch rule.Fault {
+	case FaultDrop, FaultDuplicate, FaultCorrupt:
+	case FaultDelay:
+		if rule.DelayInMilliseconds == 0 {
+			return nil, fmt.Errorf("%w, rule %s has no delay", p2p.ErrInvalidFaultRule, rule.Name)
+		}
+	default:
+		return nil, fmt.Errorf("%w, rule %s has unknown fault %s", p2p.ErrInvalidFaultRule, rule.Name, rule.Fault)
+	}
+
+	if rule.Probability <= 0 || rule.Probability > 1 {
+		return nil, fmt.Errorf("%w, rule %s has the probability %v outside the (0, 1] interval",
+			p2p.ErrInvalidFaultRule, rule.Name, rule.Probability)
+	}
+
+	newRule := &faultRule{
+		P2PFaultRule: rule,
+	}
+	if len(rule.Peer) > 0 {
+		pid, err := core.NewPeerID(rule.Peer)
+		if err != nil {
+			return nil, fmt.Errorf("%w, rule %s has invalid peer %s: %v", p2p.ErrInvalidFaultRule, rule.Name, rule.Peer, err)
+		}
+		newRule.pid = pid
+	}
+
+	return newRule, nil
+}
+
+// GetRules returns the fault rules together with the number of times each of them was applied
+func (rh *rulesHandler) GetRules() []common.P2PFaultRuleStatus {
+	rh.mutRules.RLock()
+	defer rh.mutRules.RUnlock()
+
+	statuses := make([]common.P2PFaultRuleStatus, 0, len(rh.rules))
+	for _, rule := range rh.rules {
+		statuses = append(statuses, common.P2PFaultRuleStatus{
+			Rule:       rule.P2PFaultRule,
+			NumApplied: atomic.LoadUint64(&rule.numApplied),
+		})
+	}
+
+	return statuses
+}
+
+// FaultFor returns the fault rule to be applied on a message with the provided topic, peer and direction, if any.
+// Only the first rule matching the message is evaluated against its probability. The outbound broadcast messages are
+// not sent to a specific peer and should be provided with an empty peer ID
+func (rh *rulesHandler) FaultFor(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+	rh.mutRules.RLock()
+	defer rh.mutRules.RUnlock()
+
+	for _, rule := range rh.rules {
+		if !rule.matches(topic, pid, direction) {
+			continue
+		}
+		if rh.randFloat() >= rule.Probability {
+			return common.P2PFaultRule{}, false
+		}
+
+		atomic.AddUint64(&rule.numApplied, 1)
+
+		return rule.P2PFaultRule, true
+	}
+
+	return common.P2PFaultRule{}, false
+}
+
+func (rule *faultRule) matches(topic string, pid core.PeerID, direction string) bool {
+	if len(rule.Direction) > 0 && rule.Direction != direction {
+		return false
+	}
+	if len(rule.pid) > 0 && rule.pid != pid {
+		return false
+	}
+
+	return matchesTopic(rule.Topic, topic)
+}
+
+func matchesTopic(ruleTopic string, topic string) bool {
+	if len(ruleTopic) == 0 || ruleTopic == topic {
+		return true
+	}
+	if !strings.HasSuffix(ruleTopic, topicPrefixWildcard) {
+		return false
+	}
+
+	return strings.HasPrefix(topic, strings.TrimSuffix(ruleTopic, topicPrefixWildcard))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *rulesHandler) IsInterfaceNil() bool {
+	return rh == nil
+}
@@ -0,0 +1,215 @@
+package faults_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/faults"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPeer = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+
+func createDropRule(name string) common.P2PFaultRule {
+	return common.P2PFaultRule{
+		Name:        name,
+		Fault:       faults.FaultDrop,
+		Probability: 1,
+	}
+}
+
+func TestNewRulesHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid rules should error", func(t *testing.T) {
+		t.Parallel()
+
+		rh, err := faults.NewRulesHandler([]common.P2PFaultRule{{Name: "rule"}})
+		assert.True(t, check.IfNil(rh))
+		assert.True(t, errors.Is(err, p2p.ErrInvalidFaultRule))
+	})
+	t.Run("no rules should work", func(t *testing.T) {
+		t.Parallel()
+
+		rh, err := faults.NewRulesHandler(nil)
+		assert.False(t, check.IfNil(rh))
+		assert.Nil(t, err)
+		assert.Empty(t, rh.GetRules())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rh, err := faults.NewRulesHandler([]common.P2PFaultRule{createDropRule("rule")})
+		assert.False(t, check.IfNil(rh))
+		assert.Nil(t, err)
+		assert.Equal(t, []common.P2PFaultRuleStatus{{Rule: createDropRule("rule")}}, rh.GetRules())
+	})
+}
+
+func TestRulesHandler_SetRules(t *testing.T) {
+	t.Parallel()
+
+	testInvalidRule := func(t *testing.T, rules ...common.P2PFaultRule) {
+		rh, _ := faults.NewRulesHandler([]common.P2PFaultRule{createDropRule("initial")})
+
+		err := rh.SetRules(rules)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidFaultRule))
+		assert.Equal(t, []common.P2PFaultRuleStatus{{Rule: createDropRule("initial")}}, rh.GetRules())
+	}
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidRule(t, createDropRule(""))
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidRule(t, createDropRule("rule"), createDropRule("rule"))
+	})
+	t.Run("unknown direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		rule := createDropRule("rule")
+		rule.Direction = "sideways"
+		testInvalidRule(t, rule)
+	})
+	t.Run("unknown fault should error", func(t *testing.T) {
+		t.Parallel()
+
+		rule := createDropRule("rule")
+		rule.Fault = "reorder"
+		testInvalidRule(t, rule)
+	})
+	t.Run("delay without duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		rule := createDropRule("rule")
+		rule.Fault = faults.FaultDelay
+		testInvalidRule(t, rule)
+	})
+	t.Run("invalid probability should error", func(t *testing.T) {
+		t.Parallel()
+
+		rule := createDropRule("rule")
+		rule.Probability = 0
+		testInvalidRule(t, rule)
+
+		rule.Probability = 1.01
+		testInvalidRule(t, rule)
+	})
+	t.Run("invalid peer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rule := createDropRule("rule")
+		rule.Peer = "not a peer id"
+		testInvalidRule(t, rule)
+	})
+	t.Run("should replace the rules and reset the counters", func(t *testing.T) {
+		t.Parallel()
+
+		rh, _ := faults.NewRulesHandler([]common.P2PFaultRule{createDropRule("initial")})
+		_, found := rh.FaultFor("topic", "", faults.DirectionOutbound)
+		require.True(t, found)
+		require.Equal(t, uint64(1), rh.GetRules()[0].NumApplied)
+
+		newRule := createDropRule("initial")
+		newRule.Fault = faults.FaultDelay
+		newRule.DelayInMilliseconds = 100
+		err := rh.SetRules([]common.P2PFaultRule{newRule})
+		assert.Nil(t, err)
+		assert.Equal(t, []common.P2PFaultRuleStatus{{Rule: newRule}}, rh.GetRules())
+	})
+}
+
+func TestRulesHandler_FaultFor(t *testing.T) {
+	t.Parallel()
+
+	pid, err := core.NewPeerID(testPeer)
+	require.Nil(t, err)
+
+	t.Run("should match the topic", func(t *testing.T) {
+		t.Parallel()
+
+		exactTopic := createDropRule("exact")
+		exactTopic.Topic = "transactions_0"
+		prefixTopic := createDropRule("prefix")
+		prefixTopic.Topic = "shardBlocks*"
+		rh, _ := faults.NewRulesHandler([]common.P2PFaultRule{exactTopic, prefixTopic})
+
+		rule, found := rh.FaultFor("transactions_0", pid, faults.DirectionInbound)
+		assert.True(t, found)
+		assert.Equal(t, exactTopic, rule)
+
+		_, found = rh.FaultFor("transactions_0_1", pid, faults.DirectionInbound)
+		assert.False(t, found)
+
+		rule, found = rh.FaultFor("shardBlocks_0_META", pid, faults.DirectionInbound)
+		assert.True(t, found)
+		assert.Equal(t, prefixTopic, rule)
+
+		_, found = rh.FaultFor("metachainBlocks", pid, faults.DirectionInbound)
+		assert.False(t, found)
+	})
+	t.Run("should match the peer", func(t *testing.T) {
+		t.Parallel()
+
+		peerRule := createDropRule("peer")
+		peerRule.Peer = testPeer
+		rh, _ := faults.NewRulesHandler([]common.P2PFaultRule{peerRule})
+
+		_, found := rh.FaultFor("topic", pid, faults.DirectionOutbound)
+		assert.True(t, found)
+
+		_, found = rh.FaultFor("topic", "other peer", faults.DirectionOutbound)
+		assert.False(t, found)
+
+		_, found = rh.FaultFor("topic", "", faults.DirectionOutbound)
+		assert.False(t, found)
+	})
+	t.Run("should match the direction", func(t *testing.T) {
+		t.Parallel()
+
+		inboundRule := createDropRule("inbound")
+		inboundRule.Direction = faults.DirectionInbound
+		rh, _ := faults.NewRulesHandler([]common.P2PFaultRule{inboundRule})
+
+		_, found := rh.FaultFor("topic", pid, faults.DirectionInbound)
+		assert.True(t, found)
+
+		_, found = rh.FaultFor("topic", pid, faults.DirectionOutbound)
+		assert.False(t, found)
+	})
+	t.Run("should evaluate only the first matching rule", func(t *testing.T) {
+		t.Parallel()
+
+		firstRule := createDropRule("first")
+		firstRule.Probability = 0.5
+		secondRule := createDropRule("second")
+		rh, _ := faults.NewRulesHandler([]common.P2PFaultRule{firstRule, secondRule})
+
+		rh.SetRandFloat(func() float64 {
+			return 0.7
+		})
+		_, found := rh.FaultFor("topic", pid, faults.DirectionInbound)
+		assert.False(t, found)
+
+		rh.SetRandFloat(func() float64 {
+			return 0.2
+		})
+		rule, found := rh.FaultFor("topic", pid, faults.DirectionInbound)
+		assert.True(t, found)
+		assert.Equal(t, firstRule, rule)
+
+		expectedStatuses := []common.P2PFaultRuleStatus{
+			{Rule: firstRule, NumApplied: 1},
+			{Rule: secondRule, NumApplied: 0},
+		}
+		assert.Equal(t, expectedStatuses, rh.GetRules())
+	})
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
+type disabledTrafficCounter struct{}
+
+// NewDisabledTrafficCounter returns a disabled TrafficCounter implementation
+func NewDisabledTrafficCounter() *disabledTrafficCounter {
+	return &disabledTrafficCounter{}
+}
+
+// AddInbound does nothing
+func (dtc *disabledTrafficCounter) AddInbound(_ string, _ core.PeerID, _ uint64) {}
+
+// AddOutbound does nothing
+func (dtc *disabledTrafficCounter) AddOutbound(_ string, _ core.PeerID, _ uint64) {}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dtc *disabledTrafficCounter) IsInterfaceNil() bool {
+	return dtc == nil
+}
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledTrafficCounter_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panic: %v", r))
+		}
+	}()
+
+	dtc := NewDisabledTrafficCounter()
+	assert.False(t, check.IfNil(dtc))
+	dtc.AddInbound("", "", 0)
+	dtc.AddOutbound("", "", 0)
+}
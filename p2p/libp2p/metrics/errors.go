@@ -3,3 +3,7 @@ package metrics
 import "errors"
 
 var errInvalidValueForTimeToLiveParam = errors.New("invalid value for the time-to-live parameter")
+
+var errInvalidTrafficWindow = errors.New("invalid traffic window duration")
+
+var errInvalidNumTopTalkers = errors.New("invalid number of top talkers")
@@ -27,3 +27,8 @@ func NewPrintConnectionsWatcherWithHandler(timeToLive time.Duration, handler fun
 
 	return pcw, nil
 }
+
+// SetTimeHandler -
+func (tc *TrafficCounter) SetTimeHandler(handler func() time.Time) {
+	tc.getTimeHandler = handler
+}
@@ -0,0 +1,248 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	// numWindowBuckets is the number of buckets the window is split into. The window slides with one bucket at a time
+	numWindowBuckets = 10
+	// minWindowDuration is the minimum window duration, so that a bucket spans at least one second
+	minWindowDuration = time.Second * numWindowBuckets
+	// numWindowsToKeepInactiveEntries defines after how many windows without traffic a topic or a peer is no longer tracked
+	numWindowsToKeepInactiveEntries = 10
+)
+
+// ArgsTrafficCounter is the DTO used to create a new TrafficCounter instance
+type ArgsTrafficCounter struct {
+	WindowDuration time.Duration
+	NumTopTalkers  int
+}
+
+type trafficBucket struct {
+	id       int64
+	bytes    uint64
+	messages uint64
+}
+
+// slidingCounter counts the bytes and the messages since its creation and in the last numWindowBuckets buckets
+type slidingCounter struct {
+	bytes    uint64
+	messages uint64
+	buckets  [numWindowBuckets]trafficBucket
+}
+
+func (sc *slidingCounter) add(bucketID int64, size uint64) {
+	sc.bytes += size
+	sc.messages++
+
+	bucket := &sc.buckets[bucketID%numWindowBuckets]
+	if bucket.id != bucketID {
+		*bucket = trafficBucket{id: bucketID}
+	}
+	bucket.bytes += size
+	bucket.messages++
+}
+
+func (sc *slidingCounter) cumulative() common.P2PTrafficCounters {
+	return common.P2PTrafficCounters{
+		Bytes:    sc.bytes,
+		Messages: sc.messages,
+	}
+}
+
+func (sc *slidingCounter) inWindow(currentBucketID int64) common.P2PTrafficCounters {
+	counters := common.P2PTrafficCounters{}
+	for _, bucket := range sc.buckets {
+		isInWindow := bucket.id <= currentBucketID && currentBucketID-bucket.id < numWindowBuckets
+		if !isInWindow {
+			continue
+		}
+
+		counters.Bytes += bucket.bytes
+		counters.Messages += bucket.messages
+	}
+
+	return counters
+}
+
+type trafficEntry struct {
+	inbound      slidingCounter
+	outbound     slidingCounter
+	lastBucketID int64
+}
+
+func (te *trafficEntry) statistics(name string, currentBucketID int64) common.P2PTrafficStatistics {
+	return common.P2PTrafficStatistics{
+		Name:             name,
+		Inbound:          te.inbound.cumulative(),
+		Outbound:         te.outbound.cumulative(),
+		InboundInWindow:  te.inbound.inWindow(currentBucketID),
+		OutboundInWindow: te.outbound.inWindow(currentBucketID),
+	}
+}
+
+// TrafficCounter counts the bytes and the messages received and sent by the host, per topic and per peer. Besides the
+// cumulative values, the counters are also kept for a sliding window split in buckets. The topics and the peers without
+// traffic for numWindowsToKeepInactiveEntries windows are no longer tracked, so the number of tracked topics and peers
+// is bounded by the topics and the peers the host recently talked on and with
+type TrafficCounter struct {
+	windowDuration    time.Duration
+	bucketDuration    time.Duration
+	numTopTalkers     int
+	getTimeHandler    func() time.Time
+	mut               sync.RWMutex
+	topics            map[string]*trafficEntry
+	peers             map[core.PeerID]*trafficEntry
+	lastPruneBucketID int64
+}
+
+// NewTrafficCounter creates a new TrafficCounter instance
+func NewTrafficCounter(args ArgsTrafficCounter) (*TrafficCounter, error) {
+	if args.WindowDuration < minWindowDuration {
+		return nil, fmt.Errorf("%w, got: %v, minimum: %v", errInvalidTrafficWindow, args.WindowDuration, minWindowDuration)
+	}
+	if args.NumTopTalkers < 1 {
+		return nil, fmt.Errorf("%w, got: %d", errInvalidNumTopTalkers, args.NumTopTalkers)
+	}
+
+	return &TrafficCounter{
+		windowDuration: args.WindowDuration,
+		bucketDuration: args.WindowDuration / numWindowBuckets,
+		numTopTalkers:  args.NumTopTalkers,
+		getTimeHandler: time.Now,
+		topics:         make(map[string]*trafficEntry),
+		peers:          make(map[core.PeerID]*trafficEntry),
+	}, nil
+}
+
+// AddInbound accounts a message of the provided size received on the topic from the provided peer
+func (tc *TrafficCounter) AddInbound(topic string, pid core.PeerID, size uint64) {
+	tc.add(topic, pid, size, func(entry *trafficEntry) *slidingCounter {
+		return &entry.inbound
+	})
+}
+
+// AddOutbound accounts a message of the provided size sent on the topic to the provided peer
+func (tc *TrafficCounter) AddOutbound(topic string, pid core.PeerID, size uint64) {
+	tc.add(topic, pid, size, func(entry *trafficEntry) *slidingCounter {
+		return &entry.outbound
+	})
+}
+
+func (tc *TrafficCounter) add(topic string, pid core.PeerID, size uint64, counterOf func(entry *trafficEntry) *slidingCounter) {
+	bucketID := tc.currentBucketID()
+
+	tc.mut.Lock()
+	defer tc.mut.Unlock()
+
+	tc.pruneInactiveEntries(bucketID)
+
+	topicEntry := tc.topics[topic]
+	if topicEntry == nil {
+		topicEntry = &trafficEntry{}
+		tc.topics[topic] = topicEntry
+	}
+	counterOf(topicEntry).add(bucketID, size)
+	topicEntry.lastBucketID = bucketID
+
+	if len(pid) == 0 {
+		return
+	}
+
+	peerEntry := tc.peers[pid]
+	if peerEntry == nil {
+		peerEntry = &trafficEntry{}
+		tc.peers[pid] = peerEntry
+	}
+	counterOf(peerEntry).add(bucketID, size)
+	peerEntry.lastBucketID = bucketID
+}
+
+// pruneInactiveEntries removes the inactive topics and peers, at most once per bucket. Should be called under mutex
+// protection
+func (tc *TrafficCounter) pruneInactiveEntries(currentBucketID int64) {
+	if tc.lastPruneBucketID == currentBucketID {
+		return
+	}
+	tc.lastPruneBucketID = currentBucketID
+
+	for topic, entry := range tc.topics {
+		if isInactive(entry, currentBucketID) {
+			delete(tc.topics, topic)
+		}
+	}
+	for pid, entry := range tc.peers {
+		if isInactive(entry, currentBucketID) {
+			delete(tc.peers, pid)
+		}
+	}
+}
+
+func isInactive(entry *trafficEntry, currentBucketID int64) bool {
+	return currentBucketID-entry.lastBucketID >= numWindowBuckets*numWindowsToKeepInactiveEntries
+}
+
+func (tc *TrafficCounter) currentBucketID() int64 {
+	return tc.getTimeHandler().UnixNano() / int64(tc.bucketDuration)
+}
+
+// GetTrafficReport returns the traffic per topic, sorted by topic, and the traffic per peer, sorted descending by the
+// traffic in the last window
+func (tc *TrafficCounter) GetTrafficReport() *common.P2PTrafficReport {
+	bucketID := tc.currentBucketID()
+
+	tc.mut.RLock()
+	topics := make([]common.P2PTrafficStatistics, 0, len(tc.topics))
+	for topic, entry := range tc.topics {
+		topics = append(topics, entry.statistics(topic, bucketID))
+	}
+	peers := make([]common.P2PTrafficStatistics, 0, len(tc.peers))
+	for pid, entry := range tc.peers {
+		peers = append(peers, entry.statistics(pid.Pretty(), bucketID))
+	}
+	tc.mut.RUnlock()
+
+	sort.Slice(topics, func(i, j int) bool {
+		return topics[i].Name < topics[j].Name
+	})
+	sort.Slice(peers, func(i, j int) bool {
+		bytesI, bytesJ := bytesInWindow(peers[i]), bytesInWindow(peers[j])
+		if bytesI != bytesJ {
+			return bytesI > bytesJ
+		}
+
+		return peers[i].Name < peers[j].Name
+	})
+
+	topTalkers := make([]string, 0, tc.numTopTalkers)
+	for _, peerStatistics := range peers {
+		if len(topTalkers) == tc.numTopTalkers || bytesInWindow(peerStatistics) == 0 {
+			break
+		}
+
+		topTalkers = append(topTalkers, peerStatistics.Name)
+	}
+
+	return &common.P2PTrafficReport{
+		WindowInSeconds: uint64(tc.windowDuration.Seconds()),
+		Topics:          topics,
+		Peers:           peers,
+		TopTalkers:      topTalkers,
+	}
+}
+
+func bytesInWindow(statistics common.P2PTrafficStatistics) uint64 {
+	return statistics.InboundInWindow.Bytes + statistics.OutboundInWindow.Bytes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tc *TrafficCounter) IsInterfaceNil() bool {
+	return tc == nil
+}
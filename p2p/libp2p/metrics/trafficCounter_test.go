@@ -0,0 +1,236 @@
+package metrics
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pid1 = core.PeerID("pid1")
+	pid2 = core.PeerID("pid2")
+	pid3 = core.PeerID("pid3")
+)
+
+func createMockArgsTrafficCounter() ArgsTrafficCounter {
+	return ArgsTrafficCounter{
+		WindowDuration: time.Minute,
+		NumTopTalkers:  2,
+	}
+}
+
+func createTrafficCounterWithClock(t *testing.T) (*TrafficCounter, *time.Time) {
+	tc, err := NewTrafficCounter(createMockArgsTrafficCounter())
+	require.Nil(t, err)
+
+	currentTime := time.Unix(1000000, 0)
+	tc.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	return tc, &currentTime
+}
+
+func counters(bytes uint64, messages uint64) common.P2PTrafficCounters {
+	return common.P2PTrafficCounters{
+		Bytes:    bytes,
+		Messages: messages,
+	}
+}
+
+func TestNewTrafficCounter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("window too small should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrafficCounter()
+		args.WindowDuration = minWindowDuration - time.Nanosecond
+		tc, err := NewTrafficCounter(args)
+		assert.True(t, check.IfNil(tc))
+		assert.True(t, errors.Is(err, errInvalidTrafficWindow))
+	})
+	t.Run("invalid number of top talkers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrafficCounter()
+		args.NumTopTalkers = 0
+		tc, err := NewTrafficCounter(args)
+		assert.True(t, check.IfNil(tc))
+		assert.True(t, errors.Is(err, errInvalidNumTopTalkers))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tc, err := NewTrafficCounter(createMockArgsTrafficCounter())
+		assert.False(t, check.IfNil(tc))
+		assert.Nil(t, err)
+
+		report := tc.GetTrafficReport()
+		assert.Equal(t, uint64(60), report.WindowInSeconds)
+		assert.Empty(t, report.Topics)
+		assert.Empty(t, report.Peers)
+		assert.Empty(t, report.TopTalkers)
+	})
+}
+
+func TestTrafficCounter_AddShouldAccountPerTopicAndPerPeer(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := createTrafficCounterWithClock(t)
+	tc.AddInbound("topic1", pid1, 10)
+	tc.AddInbound("topic1", pid2, 20)
+	tc.AddOutbound("topic1", pid1, 30)
+	tc.AddOutbound("topic2", pid1, 40)
+	tc.AddInbound("topic2", "", 50)
+
+	report := tc.GetTrafficReport()
+	expectedTopics := []common.P2PTrafficStatistics{
+		{
+			Name:             "topic1",
+			Inbound:          counters(30, 2),
+			Outbound:         counters(30, 1),
+			InboundInWindow:  counters(30, 2),
+			OutboundInWindow: counters(30, 1),
+		},
+		{
+			Name:             "topic2",
+			Inbound:          counters(50, 1),
+			Outbound:         counters(40, 1),
+			InboundInWindow:  counters(50, 1),
+			OutboundInWindow: counters(40, 1),
+		},
+	}
+	assert.Equal(t, expectedTopics, report.Topics)
+
+	expectedPeers := []common.P2PTrafficStatistics{
+		{
+			Name:             pid1.Pretty(),
+			Inbound:          counters(10, 1),
+			Outbound:         counters(70, 2),
+			InboundInWindow:  counters(10, 1),
+			OutboundInWindow: counters(70, 2),
+		},
+		{
+			Name:            pid2.Pretty(),
+			Inbound:         counters(20, 1),
+			InboundInWindow: counters(20, 1),
+		},
+	}
+	assert.Equal(t, expectedPeers, report.Peers)
+	assert.Equal(t, []string{pid1.Pretty(), pid2.Pretty()}, report.TopTalkers)
+}
+
+func TestTrafficCounter_WindowShouldSlide(t *testing.T) {
+	t.Parallel()
+
+	tc, currentTime := createTrafficCounterWithClock(t)
+	tc.AddInbound("topic", pid1, 10)
+
+	*currentTime = currentTime.Add(time.Second * 30)
+	tc.AddInbound("topic", pid1, 20)
+
+	report := tc.GetTrafficReport()
+	assert.Equal(t, counters(30, 2), report.Topics[0].Inbound)
+	assert.Equal(t, counters(30, 2), report.Topics[0].InboundInWindow)
+
+	// the first bucket left the window
+	*currentTime = currentTime.Add(time.Second * 30)
+	report = tc.GetTrafficReport()
+	assert.Equal(t, counters(30, 2), report.Topics[0].Inbound)
+	assert.Equal(t, counters(20, 1), report.Topics[0].InboundInWindow)
+
+	// a bucket reused after a full window should not keep the old values
+	tc.AddInbound("topic", pid1, 5)
+	report = tc.GetTrafficReport()
+	assert.Equal(t, counters(35, 3), report.Topics[0].Inbound)
+	assert.Equal(t, counters(25, 2), report.Topics[0].InboundInWindow)
+
+	*currentTime = currentTime.Add(time.Minute)
+	report = tc.GetTrafficReport()
+	assert.Equal(t, counters(35, 3), report.Topics[0].Inbound)
+	assert.Equal(t, counters(0, 0), report.Topics[0].InboundInWindow)
+	assert.Equal(t, counters(35, 3), report.Peers[0].Inbound)
+	assert.Empty(t, report.TopTalkers)
+}
+
+func TestTrafficCounter_TopTalkersShouldBeTheBusiestPeersInWindow(t *testing.T) {
+	t.Parallel()
+
+	tc, currentTime := createTrafficCounterWithClock(t)
+	tc.AddInbound("topic", pid1, 1000)
+
+	*currentTime = currentTime.Add(time.Minute)
+	tc.AddInbound("topic", pid2, 10)
+	tc.AddOutbound("topic", pid3, 20)
+	tc.AddInbound("topic", pid3, 1)
+
+	report := tc.GetTrafficReport()
+	assert.Equal(t, []string{pid3.Pretty(), pid2.Pretty()}, report.TopTalkers)
+	require.Equal(t, 3, len(report.Peers))
+	assert.Equal(t, pid1.Pretty(), report.Peers[2].Name)
+}
+
+func TestTrafficCounter_InactiveTopicsAndPeersShouldBePruned(t *testing.T) {
+	t.Parallel()
+
+	tc, currentTime := createTrafficCounterWithClock(t)
+	tc.AddInbound("topic", pid1, 10)
+	tc.AddInbound("inactive topic", pid1, 5)
+
+	*currentTime = currentTime.Add(time.Minute * (numWindowsToKeepInactiveEntries - 1))
+	tc.AddInbound("topic", pid2, 20)
+
+	report := tc.GetTrafficReport()
+	require.Equal(t, 2, len(report.Peers))
+	require.Equal(t, 2, len(report.Topics))
+
+	*currentTime = currentTime.Add(time.Minute)
+	tc.AddInbound("topic", pid2, 30)
+
+	report = tc.GetTrafficReport()
+	require.Equal(t, 1, len(report.Peers))
+	assert.Equal(t, pid2.Pretty(), report.Peers[0].Name)
+	require.Equal(t, 1, len(report.Topics))
+	assert.Equal(t, "topic", report.Topics[0].Name)
+	assert.Equal(t, counters(60, 3), report.Topics[0].Inbound)
+}
+
+func TestTrafficCounter_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	tc, err := NewTrafficCounter(createMockArgsTrafficCounter())
+	require.Nil(t, err)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 3 {
+			case 0:
+				tc.AddInbound("topic", pid1, 1)
+			case 1:
+				tc.AddOutbound("topic", pid2, 1)
+			case 2:
+				_ = tc.GetTrafficReport()
+			}
+
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -19,6 +19,7 @@ import (
 	p2pDebug "github.com/ElrondNetwork/elrond-go/debug/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/faults"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionMonitor"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/disabled"
 	discoveryFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery/factory"
@@ -119,6 +120,7 @@ type networkMessenger struct {
 	preferredPeersHolder    p2p.PreferredPeersHolderHandler
 	printConnectionsWatcher p2p.ConnectionsWatcher
 	peersRatingHandler      p2p.PeersRatingHandler
+	trafficCounter          p2p.TrafficCounter
 	mutPeerTopicNotifiers   sync.RWMutex
 	peerTopicNotifiers      []p2p.PeerTopicNotifier
 }
@@ -133,6 +135,10 @@ type ArgsNetworkMessenger struct {
 	NodeOperationMode     p2p.NodeOperation
 	PeersRatingHandler    p2p.PeersRatingHandler
 	ConnectionWatcherType string
+	// FaultsRulesHandler is optional. When provided, the faults decided by it are injected on the direct messages
+	FaultsRulesHandler faults.RulesHandler
+	// TrafficCounter is optional. When provided, it accounts the traffic per topic and per peer
+	TrafficCounter p2p.TrafficCounter
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
@@ -289,6 +295,10 @@ func addComponentsToNode(
 	p2pNode.preferredPeersHolder = args.PreferredPeersHolder
 	p2pNode.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pNode.p2pHost.ID()))
 	p2pNode.peersRatingHandler = args.PeersRatingHandler
+	p2pNode.trafficCounter = args.TrafficCounter
+	if check.IfNil(p2pNode.trafficCounter) {
+		p2pNode.trafficCounter = metrics.NewDisabledTrafficCounter()
+	}
 
 	err = p2pNode.createPubSub(messageSigning)
 	if err != nil {
@@ -317,6 +327,18 @@ func addComponentsToNode(
 		return err
 	}
 
+	if !check.IfNil(args.FaultsRulesHandler) {
+		argsFaultyDirectSender := faults.ArgsFaultyDirectSender{
+			DirectSender: p2pNode.ds,
+			Marshalizer:  args.Marshalizer,
+			RulesHandler: args.FaultsRulesHandler,
+		}
+		p2pNode.ds, err = faults.NewFaultyDirectSender(argsFaultyDirectSender)
+		if err != nil {
+			return err
+		}
+	}
+
 	p2pNode.goRoutinesThrottler, err = throttler.NewNumGoRoutinesThrottler(broadcastGoRoutines)
 	if err != nil {
 		return err
@@ -335,6 +357,7 @@ func (netMes *networkMessenger) createPubSub(messageSigning messageSigningConfig
 	}
 
 	optsPS = append(optsPS, pubsub.WithPeerFilter(netMes.newPeerFound))
+	optsPS = append(optsPS, pubsub.WithRawTracer(&trafficTracer{trafficCounter: netMes.trafficCounter}))
 
 	var err error
 	netMes.pb, err = pubsub.NewGossipSub(netMes.ctx, netMes.p2pHost, optsPS...)
@@ -1171,6 +1194,9 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 
 	err = netMes.ds.Send(topic, buffToSend, peerID)
 	netMes.debugger.AddOutgoingMessage(topic, uint64(len(buffToSend)), err != nil)
+	if err == nil {
+		netMes.trafficCounter.AddOutbound(topic, peerID, uint64(len(buffToSend)))
+	}
 
 	return err
 }
@@ -1191,11 +1217,6 @@ func (netMes *networkMessenger) sendDirectToSelf(topic string, buff []byte) erro
 
 func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fromConnectedPeer core.PeerID) error {
 	topic := *message.Topic
-	msg, err := netMes.transformAndCheckMessage(message, fromConnectedPeer, topic)
-	if err != nil {
-		return err
-	}
-
 	netMes.mutTopics.RLock()
 	topicProcs := netMes.processors[topic]
 	netMes.mutTopics.RUnlock()
@@ -1203,6 +1224,15 @@ func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fr
 	if topicProcs == nil {
 		return fmt.Errorf("%w on directMessageHandler for topic %s", p2p.ErrNilValidator, topic)
 	}
+	// only the traffic on the registered topics is accounted, so that the tracked topics are not chosen by the remote peers
+	if fromConnectedPeer != netMes.ID() {
+		netMes.trafficCounter.AddInbound(topic, fromConnectedPeer, uint64(len(message.Data)))
+	}
+
+	msg, err := netMes.transformAndCheckMessage(message, fromConnectedPeer, topic)
+	if err != nil {
+		return err
+	}
 	identifiers, handlers := topicProcs.getList()
 
 	go func(msg p2p.MessageP2P) {
@@ -20,7 +20,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/faults"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -1114,6 +1116,120 @@ func TestLibp2pMessenger_SendDirectWithMockNetToConnectedPeerShouldWork(t *testi
 	_ = messenger2.Close()
 }
 
+func TestLibp2pMessenger_SendDirectWithFaultsRulesHandlerShouldApplyTheFaults(t *testing.T) {
+	msg := []byte("test message")
+
+	netw := mocknet.New()
+	var messenger2 p2p.Messenger
+	args := createMockNetworkArgs()
+	args.FaultsRulesHandler = &p2pmocks.FaultsRulesHandlerStub{
+		FaultForCalled: func(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+			assert.Equal(t, "test", topic)
+			assert.Equal(t, messenger2.ID(), pid)
+			assert.Equal(t, faults.DirectionOutbound, direction)
+
+			return common.P2PFaultRule{Name: "duplicate", Fault: faults.FaultDuplicate, Probability: 1}, true
+		},
+	}
+	messenger1, _ := libp2p.NewMockMessenger(args, netw)
+	messenger2, _ = libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = messenger1.ConnectToPeer(messenger2.Addresses()[0])
+
+	numReceived := uint32(0)
+	_ = messenger2.CreateTopic("test", false)
+	_ = messenger2.RegisterMessageProcessor("test", "identifier", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+			assert.Equal(t, msg, message.Data())
+			atomic.AddUint32(&numReceived, 1)
+			return nil
+		},
+	})
+
+	err := messenger1.SendToConnectedPeer("test", msg, messenger2.ID())
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadUint32(&numReceived) == 2
+	}, timeoutWaitResponses, time.Millisecond*10)
+
+	_ = messenger1.Close()
+	_ = messenger2.Close()
+}
+
+func TestLibp2pMessenger_TrafficCounterShouldAccountTheSentAndTheReceivedMessages(t *testing.T) {
+	msg := []byte("test message")
+
+	netw := mocknet.New()
+	argsTrafficCounter := metrics.ArgsTrafficCounter{
+		WindowDuration: time.Minute,
+		NumTopTalkers:  1,
+	}
+	trafficCounter1, _ := metrics.NewTrafficCounter(argsTrafficCounter)
+	trafficCounter2, _ := metrics.NewTrafficCounter(argsTrafficCounter)
+	args1 := createMockNetworkArgs()
+	args1.TrafficCounter = trafficCounter1
+	args2 := createMockNetworkArgs()
+	args2.TrafficCounter = trafficCounter2
+	messenger1, _ := libp2p.NewMockMessenger(args1, netw)
+	messenger2, _ := libp2p.NewMockMessenger(args2, netw)
+	_ = netw.LinkAll()
+
+	_ = messenger1.ConnectToPeer(messenger2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(3)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(messenger1, msg, wg)
+	prepareMessengerForMatchDataReceive(messenger2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	messenger1.Broadcast("test", msg)
+	err := messenger1.SendToConnectedPeer("test", msg, messenger2.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	report1 := trafficCounter1.GetTrafficReport()
+	require.Equal(t, 1, len(report1.Topics))
+	assert.Equal(t, "test", report1.Topics[0].Name)
+	assert.Equal(t, uint64(2), report1.Topics[0].Outbound.Messages)
+	assert.Equal(t, uint64(2), report1.Topics[0].OutboundInWindow.Messages)
+	assert.Equal(t, uint64(0), report1.Topics[0].Inbound.Messages)
+	require.Equal(t, 1, len(report1.Peers))
+	assert.Equal(t, messenger2.ID().Pretty(), report1.Peers[0].Name)
+	assert.Equal(t, []string{messenger2.ID().Pretty()}, report1.TopTalkers)
+
+	report2 := trafficCounter2.GetTrafficReport()
+	require.Equal(t, 1, len(report2.Peers))
+	assert.Equal(t, messenger1.ID().Pretty(), report2.Peers[0].Name)
+	assert.Equal(t, uint64(2), report2.Peers[0].Inbound.Messages)
+	assert.Equal(t, report1.Peers[0].Outbound.Bytes, report2.Peers[0].Inbound.Bytes)
+	assert.Equal(t, uint64(0), report2.Peers[0].Outbound.Messages)
+
+	// the direct messages on topics not registered by the receiver are not accounted by it
+	err = messenger1.SendToConnectedPeer("unregistered topic", msg, messenger2.ID())
+	assert.Nil(t, err)
+	time.Sleep(time.Second)
+
+	report2 = trafficCounter2.GetTrafficReport()
+	require.Equal(t, 1, len(report2.Topics))
+	assert.Equal(t, "test", report2.Topics[0].Name)
+	assert.Equal(t, uint64(2), report2.Peers[0].Inbound.Messages)
+
+	_ = messenger1.Close()
+	_ = messenger2.Close()
+}
+
 func TestLibp2pMessenger_SendDirectWithRealNetToConnectedPeerShouldWork(t *testing.T) {
 	msg := []byte("test message")
 
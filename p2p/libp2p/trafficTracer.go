@@ -0,0 +1,71 @@
+package libp2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	pubsub "github.com/ElrondNetwork/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+var _ pubsub.RawTracer = (*trafficTracer)(nil)
+
+// trafficTracer is a pubsub raw tracer accounting the traffic of the gossiped messages on the wire, so the messages
+// relayed for other peers and the duplicates are also accounted. The messages dropped by pubsub before entering the
+// validation pipeline (e.g. from blacklisted peers) are not accounted
+type trafficTracer struct {
+	trafficCounter p2p.TrafficCounter
+}
+
+// ValidateMessage accounts a message received from another peer that enters the validation pipeline
+func (tt *trafficTracer) ValidateMessage(msg *pubsub.Message) {
+	tt.trafficCounter.AddInbound(msg.GetTopic(), core.PeerID(msg.ReceivedFrom), uint64(len(msg.Data)))
+}
+
+// DuplicateMessage accounts a message already seen, received again from another peer
+func (tt *trafficTracer) DuplicateMessage(msg *pubsub.Message) {
+	tt.trafficCounter.AddInbound(msg.GetTopic(), core.PeerID(msg.ReceivedFrom), uint64(len(msg.Data)))
+}
+
+// SendRPC accounts the messages published in the RPC sent to the provided peer
+func (tt *trafficTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
+	for _, msg := range rpc.GetPublish() {
+		tt.trafficCounter.AddOutbound(msg.GetTopic(), core.PeerID(p), uint64(len(msg.Data)))
+	}
+}
+
+// AddPeer does nothing
+func (tt *trafficTracer) AddPeer(_ peer.ID, _ protocol.ID) {}
+
+// RemovePeer does nothing
+func (tt *trafficTracer) RemovePeer(_ peer.ID) {}
+
+// Join does nothing
+func (tt *trafficTracer) Join(_ string) {}
+
+// Leave does nothing
+func (tt *trafficTracer) Leave(_ string) {}
+
+// Graft does nothing
+func (tt *trafficTracer) Graft(_ peer.ID, _ string) {}
+
+// Prune does nothing
+func (tt *trafficTracer) Prune(_ peer.ID, _ string) {}
+
+// DeliverMessage does nothing
+func (tt *trafficTracer) DeliverMessage(_ *pubsub.Message) {}
+
+// RejectMessage does nothing
+func (tt *trafficTracer) RejectMessage(_ *pubsub.Message, _ string) {}
+
+// ThrottlePeer does nothing
+func (tt *trafficTracer) ThrottlePeer(_ peer.ID) {}
+
+// RecvRPC does nothing as the sender of the RPC is not available
+func (tt *trafficTracer) RecvRPC(_ *pubsub.RPC) {}
+
+// DropRPC does nothing
+func (tt *trafficTracer) DropRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// UndeliverableMessage does nothing
+func (tt *trafficTracer) UndeliverableMessage(_ *pubsub.Message) {}
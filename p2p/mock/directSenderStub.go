@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
+// DirectSenderStub -
+type DirectSenderStub struct {
+	NextSeqnoCalled func() []byte
+	SendCalled      func(topic string, buff []byte, peer core.PeerID) error
+}
+
+// NextSeqno -
+func (stub *DirectSenderStub) NextSeqno() []byte {
+	if stub.NextSeqnoCalled != nil {
+		return stub.NextSeqnoCalled()
+	}
+
+	return nil
+}
+
+// Send -
+func (stub *DirectSenderStub) Send(topic string, buff []byte, peer core.PeerID) error {
+	if stub.SendCalled != nil {
+		return stub.SendCalled(topic, buff, peer)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *DirectSenderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
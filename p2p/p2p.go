@@ -345,6 +345,13 @@ type ConnectionsWatcher interface {
 	IsInterfaceNil() bool
 }
 
+// TrafficCounter represent an entity able to account the traffic of the host per topic and per peer
+type TrafficCounter interface {
+	AddInbound(topic string, pid core.PeerID, size uint64)
+	AddOutbound(topic string, pid core.PeerID, size uint64)
+	IsInterfaceNil() bool
+}
+
 // PeersRatingHandler represent an entity able to handle peers ratings
 type PeersRatingHandler interface {
 	AddPeer(pid core.PeerID)
@@ -1142,3 +1142,15 @@ var ErrNilCrawlerAllowedAddress = errors.New("nil crawler allowed address")
 
 // ErrNilPayloadValidator signals that a nil payload validator was provided
 var ErrNilPayloadValidator = errors.New("nil payload validator")
+
+// ErrInvalidBlockCoordinates signals that more than one block coordinate was provided
+var ErrInvalidBlockCoordinates = errors.New("invalid block coordinates: at most one of the block nonce, block hash and block root hash can be provided")
+
+// ErrNilHistoricalScCallGasLimitComputer signals that a nil historical smart contract call gas limit computer was provided
+var ErrNilHistoricalScCallGasLimitComputer = errors.New("nil historical smart contract call gas limit computer")
+
+// ErrPersistedTxHashMismatch signals that the hash of a reloaded transaction does not match the hash it was saved with
+var ErrPersistedTxHashMismatch = errors.New("persisted transaction hash mismatch")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgPersistedTxsReloader is the argument for the persisted transactions reloader
+type ArgPersistedTxsReloader struct {
+	TxsPool       process.PersistedTxsPoolHandler
+	DataFactory   process.InterceptedDataFactory
+	Processor     process.InterceptorProcessor
+	Topic         string
+	CurrentPeerId core.PeerID
+}
+
+// PersistedTxsReloader re-inserts in the pool the transactions saved upon the last clean shutdown.
+// Each transaction goes through the same checks as an intercepted one (integrity, signature, nonce, balance).
+type PersistedTxsReloader struct {
+	txsPool       process.PersistedTxsPoolHandler
+	dataFactory   process.InterceptedDataFactory
+	processor     process.InterceptorProcessor
+	topic         string
+	currentPeerId core.PeerID
+}
+
+// NewPersistedTxsReloader creates a new persisted transactions reloader
+func NewPersistedTxsReloader(arg ArgPersistedTxsReloader) (*PersistedTxsReloader, error) {
+	if check.IfNil(arg.TxsPool) {
+		return nil, process.ErrNilTransactionPool
+	}
+	if check.IfNil(arg.DataFactory) {
+		return nil, process.ErrNilInterceptedDataFactory
+	}
+	if check.IfNil(arg.Processor) {
+		return nil, process.ErrNilInterceptedDataProcessor
+	}
+	if len(arg.Topic) == 0 {
+		return nil, process.ErrEmptyTopic
+	}
+
+	return &PersistedTxsReloader{
+		txsPool:       arg.TxsPool,
+		dataFactory:   arg.DataFactory,
+		processor:     arg.Processor,
+		topic:         arg.Topic,
+		currentPeerId: arg.CurrentPeerId,
+	}, nil
+}
+
+// Reload revalidates the persisted transactions and adds the valid ones back in the pool
+func (reloader *PersistedTxsReloader) Reload() (numReloaded int, numRejected int) {
+	reloader.txsPool.ReloadPersistedTxs(func(txHash []byte, txBuff []byte) {
+		err := reloader.reloadTx(txHash, txBuff)
+		if err != nil {
+			log.Trace("PersistedTxsReloader: persisted transaction rejected", "hash", txHash, "error", err.Error())
+			numRejected++
+			return
+		}
+
+		numReloaded++
+	})
+
+	log.Debug("PersistedTxsReloader.Reload()", "numReloaded", numReloaded, "numRejected", numRejected)
+	return numReloaded, numRejected
+}
+
+func (reloader *PersistedTxsReloader) reloadTx(txHash []byte, txBuff []byte) error {
+	interceptedData, err := reloader.dataFactory.Create(txBuff)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(interceptedData.Hash(), txHash) {
+		return process.ErrPersistedTxHashMismatch
+	}
+
+	err = interceptedData.CheckValidity()
+	if err != nil {
+		return err
+	}
+
+	err = reloader.processor.Validate(interceptedData, reloader.currentPeerId)
+	if err != nil {
+		return err
+	}
+
+	return reloader.processor.Save(interceptedData, reloader.currentPeerId, reloader.topic)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reloader *PersistedTxsReloader) IsInterfaceNil() bool {
+	return reloader == nil
+}
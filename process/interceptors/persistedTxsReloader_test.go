@@ -0,0 +1,126 @@
+package interceptors_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgPersistedTxsReloader() interceptors.ArgPersistedTxsReloader {
+	return interceptors.ArgPersistedTxsReloader{
+		TxsPool:       &mock.PersistedTxsPoolStub{},
+		DataFactory:   &mock.InterceptedDataFactoryStub{},
+		Processor:     &mock.InterceptorProcessorStub{},
+		Topic:         "transactions_0",
+		CurrentPeerId: "pid",
+	}
+}
+
+func TestNewPersistedTxsReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil txs pool should error", func(t *testing.T) {
+		arg := createMockArgPersistedTxsReloader()
+		arg.TxsPool = nil
+		reloader, err := interceptors.NewPersistedTxsReloader(arg)
+		assert.True(t, check.IfNil(reloader))
+		assert.Equal(t, process.ErrNilTransactionPool, err)
+	})
+	t.Run("nil data factory should error", func(t *testing.T) {
+		arg := createMockArgPersistedTxsReloader()
+		arg.DataFactory = nil
+		reloader, err := interceptors.NewPersistedTxsReloader(arg)
+		assert.True(t, check.IfNil(reloader))
+		assert.Equal(t, process.ErrNilInterceptedDataFactory, err)
+	})
+	t.Run("nil processor should error", func(t *testing.T) {
+		arg := createMockArgPersistedTxsReloader()
+		arg.Processor = nil
+		reloader, err := interceptors.NewPersistedTxsReloader(arg)
+		assert.True(t, check.IfNil(reloader))
+		assert.Equal(t, process.ErrNilInterceptedDataProcessor, err)
+	})
+	t.Run("empty topic should error", func(t *testing.T) {
+		arg := createMockArgPersistedTxsReloader()
+		arg.Topic = ""
+		reloader, err := interceptors.NewPersistedTxsReloader(arg)
+		assert.True(t, check.IfNil(reloader))
+		assert.Equal(t, process.ErrEmptyTopic, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		reloader, err := interceptors.NewPersistedTxsReloader(createMockArgPersistedTxsReloader())
+		assert.False(t, check.IfNil(reloader))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPersistedTxsReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	persistedTxs := map[string]string{
+		"valid":             "valid",
+		"bad-buff":          "bad-buff",
+		"hash-mismatch":     "other-hash",
+		"invalid-signature": "invalid-signature",
+		"invalid-nonce":     "invalid-nonce",
+	}
+
+	arg := createMockArgPersistedTxsReloader()
+	arg.TxsPool = &mock.PersistedTxsPoolStub{
+		ReloadPersistedTxsCalled: func(handler func(txHash []byte, txBuff []byte)) int {
+			for txHash, txBuff := range persistedTxs {
+				handler([]byte(txHash), []byte(txBuff))
+			}
+
+			return len(persistedTxs)
+		},
+	}
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+			if string(buff) == "bad-buff" {
+				return nil, errExpected
+			}
+
+			return &testscommon.InterceptedDataStub{
+				HashCalled: func() []byte {
+					return buff
+				},
+				CheckValidityCalled: func() error {
+					if string(buff) == "invalid-signature" {
+						return errExpected
+					}
+
+					return nil
+				},
+			}, nil
+		},
+	}
+	saved := make([]string, 0)
+	arg.Processor = &mock.InterceptorProcessorStub{
+		ValidateCalled: func(data process.InterceptedData) error {
+			if string(data.Hash()) == "invalid-nonce" {
+				return errExpected
+			}
+
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			saved = append(saved, string(data.Hash()))
+			return nil
+		},
+	}
+	reloader, _ := interceptors.NewPersistedTxsReloader(arg)
+
+	numReloaded, numRejected := reloader.Reload()
+	assert.Equal(t, 1, numReloaded)
+	assert.Equal(t, 4, numRejected)
+	require.Equal(t, []string{"valid"}, saved)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -115,6 +116,12 @@ type InterceptorProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// PersistedTxsPoolHandler is able to provide the transactions saved by the pool upon the last clean shutdown
+type PersistedTxsPoolHandler interface {
+	ReloadPersistedTxs(handler func(txHash []byte, txBuff []byte)) int
+	IsInterfaceNil() bool
+}
+
 // InterceptorThrottler can monitor the number of the currently running interceptor go routines
 type InterceptorThrottler interface {
 	CanProcess() bool
@@ -730,13 +737,26 @@ type NetworkConnectionWatcher interface {
 
 // SCQuery represents a prepared query for executing a function of the smart contract
 type SCQuery struct {
-	ScAddress      []byte
-	FuncName       string
-	CallerAddr     []byte
-	CallValue      *big.Int
-	Arguments      [][]byte
-	SameScState    bool
-	ShouldBeSynced bool
+	ScAddress        []byte
+	FuncName         string
+	CallerAddr       []byte
+	CallValue        *big.Int
+	Arguments        [][]byte
+	SameScState      bool
+	ShouldBeSynced   bool
+	BlockCoordinates BlockCoordinates
+}
+
+// BlockCoordinates selects a past block by its nonce, by its hash or by its root hash. At most one of them should be set
+type BlockCoordinates struct {
+	Nonce    core.OptionalUint64
+	Hash     []byte
+	RootHash []byte
+}
+
+// IsSet returns true if any of the coordinates is set
+func (coordinates BlockCoordinates) IsSet() bool {
+	return coordinates.Nonce.HasValue || len(coordinates.Hash) > 0 || len(coordinates.RootHash) > 0
 }
 
 // GasHandler is able to perform some gas calculation
@@ -878,11 +898,33 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTrace(query *SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
 }
 
+// ExecutionTracer defines the component notified by the blockchain hook about the storage reads and the built-in
+// function calls done during a smart contract execution
+type ExecutionTracer interface {
+	TraceStorageRead(address []byte, key []byte, value []byte)
+	TraceBuiltInFunctionCall(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error)
+	IsInterfaceNil() bool
+}
+
+// ExecutionTraceRecorder defines the component able to record the execution of a smart contract call as a trace
+type ExecutionTraceRecorder interface {
+	StartRecording()
+	StopRecording(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace
+	IsInterfaceNil() bool
+}
+
+// HistoricalScCallGasLimitComputer is able to compute the gas limit of a smart contract call against the state of a past block
+type HistoricalScCallGasLimitComputer interface {
+	ComputeScCallGasLimitInBlock(tx *transaction.Transaction, coordinates BlockCoordinates) (uint64, error)
+	IsInterfaceNil() bool
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
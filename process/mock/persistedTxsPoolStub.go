@@ -0,0 +1,20 @@
+package mock
+
+// PersistedTxsPoolStub -
+type PersistedTxsPoolStub struct {
+	ReloadPersistedTxsCalled func(handler func(txHash []byte, txBuff []byte)) int
+}
+
+// ReloadPersistedTxs -
+func (stub *PersistedTxsPoolStub) ReloadPersistedTxs(handler func(txHash []byte, txBuff []byte)) int {
+	if stub.ReloadPersistedTxsCalled != nil {
+		return stub.ReloadPersistedTxsCalled(handler)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *PersistedTxsPoolStub) IsInterfaceNil() bool {
+	return stub == nil
+}
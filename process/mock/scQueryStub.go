@@ -3,14 +3,17 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ScQueryStub -
 type ScQueryStub struct {
-	ExecuteQueryCalled           func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
-	CloseCalled                  func() error
+	ExecuteQueryCalled                  func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTraceCalled         func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
+	ComputeScCallGasLimitHandler        func(tx *transaction.Transaction) (uint64, error)
+	ComputeScCallGasLimitInBlockHandler func(tx *transaction.Transaction, coordinates process.BlockCoordinates) (uint64, error)
+	CloseCalled                         func() error
 }
 
 // ExecuteQuery -
@@ -21,6 +24,14 @@ func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput,
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueryWithTrace -
+func (s *ScQueryStub) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if s.ExecuteQueryWithTraceCalled != nil {
+		return s.ExecuteQueryWithTraceCalled(query)
+	}
+	return &vmcommon.VMOutput{}, &tracing.ExecutionTrace{}, nil
+}
+
 // ComputeScCallGasLimit -
 func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if s.ComputeScCallGasLimitHandler != nil {
@@ -29,6 +40,14 @@ func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64
 	return 100, nil
 }
 
+// ComputeScCallGasLimitInBlock -
+func (s *ScQueryStub) ComputeScCallGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (uint64, error) {
+	if s.ComputeScCallGasLimitInBlockHandler != nil {
+		return s.ComputeScCallGasLimitInBlockHandler(tx, coordinates)
+	}
+	return 100, nil
+}
+
 // Close -
 func (s *ScQueryStub) Close() error {
 	if s.CloseCalled != nil {
@@ -0,0 +1,55 @@
+package hooks
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BlockChainHookHandler = (*tracingBlockChainHook)(nil)
+
+// tracingBlockChainHook decorates a blockchain hook, notifying the execution tracer about the storage reads and the
+// built-in function calls done by the virtual machines
+type tracingBlockChainHook struct {
+	process.BlockChainHookHandler
+	tracer process.ExecutionTracer
+}
+
+// NewTracingBlockChainHook creates a blockchain hook that reports the storage reads and the built-in function calls
+// of the wrapped hook to the provided execution tracer
+func NewTracingBlockChainHook(blockChainHook process.BlockChainHookHandler, tracer process.ExecutionTracer) (*tracingBlockChainHook, error) {
+	if check.IfNil(blockChainHook) {
+		return nil, process.ErrNilBlockChainHook
+	}
+	if check.IfNil(tracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
+
+	return &tracingBlockChainHook{
+		BlockChainHookHandler: blockChainHook,
+		tracer:                tracer,
+	}, nil
+}
+
+// GetStorageData returns the storage value of a variable held in account's data trie and reports the read
+func (hook *tracingBlockChainHook) GetStorageData(accountAddress []byte, index []byte) ([]byte, error) {
+	value, err := hook.BlockChainHookHandler.GetStorageData(accountAddress, index)
+	if err == nil {
+		hook.tracer.TraceStorageRead(accountAddress, index, value)
+	}
+
+	return value, err
+}
+
+// ProcessBuiltInFunction processes the built-in function call and reports it, together with its output
+func (hook *tracingBlockChainHook) ProcessBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := hook.BlockChainHookHandler.ProcessBuiltInFunction(input)
+	hook.tracer.TraceBuiltInFunctionCall(input, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hook *tracingBlockChainHook) IsInterfaceNil() bool {
+	return hook == nil
+}
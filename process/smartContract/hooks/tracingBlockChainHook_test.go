@@ -0,0 +1,106 @@
+package hooks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTracingBlockChainHook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil blockchain hook should error", func(t *testing.T) {
+		t.Parallel()
+
+		hook, err := hooks.NewTracingBlockChainHook(nil, &testscommon.ExecutionTracerStub{})
+		assert.Equal(t, process.ErrNilBlockChainHook, err)
+		assert.True(t, hook.IsInterfaceNil())
+	})
+	t.Run("nil tracer should error", func(t *testing.T) {
+		t.Parallel()
+
+		hook, err := hooks.NewTracingBlockChainHook(&testscommon.BlockChainHookStub{}, nil)
+		assert.Equal(t, process.ErrNilExecutionTracer, err)
+		assert.True(t, hook.IsInterfaceNil())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hook, err := hooks.NewTracingBlockChainHook(&testscommon.BlockChainHookStub{}, &testscommon.ExecutionTracerStub{})
+		assert.Nil(t, err)
+		assert.False(t, hook.IsInterfaceNil())
+	})
+}
+
+func TestTracingBlockChainHook_GetStorageData(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	key := []byte("key")
+	value := []byte("value")
+	expectedErr := errors.New("expected error")
+
+	var readErr error
+	numTracedReads := 0
+	hook, _ := hooks.NewTracingBlockChainHook(
+		&testscommon.BlockChainHookStub{
+			GetStorageDataCalled: func(accountsAddress []byte, index []byte) ([]byte, error) {
+				return value, readErr
+			},
+		},
+		&testscommon.ExecutionTracerStub{
+			TraceStorageReadCalled: func(tracedAddress []byte, tracedKey []byte, tracedValue []byte) {
+				numTracedReads++
+				assert.Equal(t, address, tracedAddress)
+				assert.Equal(t, key, tracedKey)
+				assert.Equal(t, value, tracedValue)
+			},
+		},
+	)
+
+	readValue, err := hook.GetStorageData(address, key)
+	assert.Nil(t, err)
+	assert.Equal(t, value, readValue)
+	assert.Equal(t, 1, numTracedReads)
+
+	readErr = expectedErr
+	_, err = hook.GetStorageData(address, key)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numTracedReads)
+}
+
+func TestTracingBlockChainHook_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	input := &vmcommon.ContractCallInput{Function: "ESDTTransfer"}
+	output := &vmcommon.VMOutput{GasRemaining: 37}
+	expectedErr := errors.New("expected error")
+
+	tracedCall := false
+	hook, _ := hooks.NewTracingBlockChainHook(
+		&testscommon.BlockChainHookStub{
+			ProcessBuiltInFunctionCalled: func(callInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return output, expectedErr
+			},
+		},
+		&testscommon.ExecutionTracerStub{
+			TraceBuiltInFunctionCallCalled: func(tracedInput *vmcommon.ContractCallInput, tracedOutput *vmcommon.VMOutput, err error) {
+				tracedCall = true
+				assert.Equal(t, input, tracedInput)
+				assert.Equal(t, output, tracedOutput)
+				assert.Equal(t, expectedErr, err)
+			},
+		},
+	)
+
+	vmOutput, err := hook.ProcessBuiltInFunction(input)
+	require.Equal(t, expectedErr, err)
+	assert.Equal(t, output, vmOutput)
+	assert.True(t, tracedCall)
+}
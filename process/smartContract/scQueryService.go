@@ -13,6 +13,7 @@ import (
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
@@ -31,6 +32,7 @@ type SCQueryService struct {
 	arwenChangeLocker        common.Locker
 	bootstrapper             process.Bootstrapper
 	allowExternalQueriesChan chan struct{}
+	executionTracer          process.ExecutionTraceRecorder
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -42,6 +44,7 @@ type ArgsNewSCQueryService struct {
 	ArwenChangeLocker        common.Locker
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
+	ExecutionTracer          process.ExecutionTraceRecorder
 	MaxGasLimitPerQuery      uint64
 }
 
@@ -70,6 +73,9 @@ func NewSCQueryService(
 	if args.AllowExternalQueriesChan == nil {
 		return nil, process.ErrNilAllowExternalQueriesChan
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 
 	gasForQuery := uint64(math.MaxUint64)
 	if args.MaxGasLimitPerQuery > 0 {
@@ -84,26 +90,57 @@ func NewSCQueryService(
 		bootstrapper:             args.Bootstrapper,
 		gasForQuery:              gasForQuery,
 		allowExternalQueriesChan: args.AllowExternalQueriesChan,
+		executionTracer:          args.ExecutionTracer,
 	}, nil
 }
 
 // ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
 func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	err := service.checkQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	return service.executeScCall(query, 0)
+}
+
+// ExecuteQueryWithTrace returns the VMOutput resulted upon running the function on the smart contract, together with
+// the trace of the execution: the nested calls, the storage reads and writes and the emitted events
+func (service *SCQueryService) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	err := service.checkQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	service.executionTracer.StartRecording()
+	vmOutput, err := service.executeScCall(query, 0)
+	trace := service.executionTracer.StopRecording(service.createVMCallInput(query, 0), vmOutput)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vmOutput, trace, nil
+}
+
+func (service *SCQueryService) checkQuery(query *process.SCQuery) error {
 	if !service.shouldAllowQueriesExecution() {
-		return nil, process.ErrQueriesNotAllowedYet
+		return process.ErrQueriesNotAllowedYet
 	}
 
 	if query.ScAddress == nil {
-		return nil, process.ErrNilScAddress
+		return process.ErrNilScAddress
 	}
 	if len(query.FuncName) == 0 {
-		return nil, process.ErrEmptyFunctionName
+		return process.ErrEmptyFunctionName
 	}
 
-	service.mutRunSc.Lock()
-	defer service.mutRunSc.Unlock()
-
-	return service.executeScCall(query, 0)
+	return nil
 }
 
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
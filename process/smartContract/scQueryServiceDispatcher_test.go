@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 )
@@ -80,6 +81,36 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 	assert.Equal(t, 1, calledElement2)
 }
 
+func TestScQueryServiceDispatcher_ExecuteQueryWithTraceShouldCallInRoundRobinFashion(t *testing.T) {
+	t.Parallel()
+
+	calledElement1 := 0
+	calledElement2 := 0
+	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+		&mock.ScQueryStub{
+			ExecuteQueryWithTraceCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+				calledElement1++
+
+				return nil, nil, nil
+			},
+		},
+		&mock.ScQueryStub{
+			ExecuteQueryWithTraceCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+				calledElement2++
+
+				return nil, nil, nil
+			},
+		},
+	})
+
+	_, _, _ = sqsd.ExecuteQueryWithTrace(nil)
+	_, _, _ = sqsd.ExecuteQueryWithTrace(nil)
+	_, _, _ = sqsd.ExecuteQueryWithTrace(nil)
+
+	assert.Equal(t, 2, calledElement1)
+	assert.Equal(t, 1, calledElement2)
+}
+
 func TestScQueryServiceDispatcher_ComputeScCallGasLimitShouldCallInRoundRobinFashion(t *testing.T) {
 	t.Parallel()
 
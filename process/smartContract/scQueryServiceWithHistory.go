@@ -0,0 +1,287 @@
+package smartContract
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgsNewSCQueryServiceWithHistory holds the arguments needed to create a new instance of scQueryServiceWithHistory
+type ArgsNewSCQueryServiceWithHistory struct {
+	CurrentQueryService          process.SCQueryService
+	HistoricalQueryService       process.SCQueryService
+	HistoricalBlockChain         data.ChainHandler
+	MainBlockChain               data.ChainHandler
+	StorageService               dataRetriever.StorageService
+	Marshaller                   marshal.Marshalizer
+	Uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
+	HistoryRepository            dblookupext.HistoryRepository
+	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	ShardID                      uint32
+}
+
+type scQueryServiceWithHistory struct {
+	currentQueryService          process.SCQueryService
+	historicalQueryService       process.SCQueryService
+	historicalBlockChain         data.ChainHandler
+	mainBlockChain               data.ChainHandler
+	storageService               dataRetriever.StorageService
+	marshaller                   marshal.Marshalizer
+	uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
+	historyRepository            dblookupext.HistoryRepository
+	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	shardID                      uint32
+	mutHistoricalExecution       sync.Mutex
+}
+
+// NewSCQueryServiceWithHistory returns a smart contract query service that forwards the queries without block coordinates
+// towards the current query service, while the other ones are executed by the historical query service, after its
+// blockchain has been moved to the selected block
+func NewSCQueryServiceWithHistory(args ArgsNewSCQueryServiceWithHistory) (*scQueryServiceWithHistory, error) {
+	if check.IfNil(args.CurrentQueryService) {
+		return nil, fmt.Errorf("%w for the current query service", process.ErrNilScQueryElement)
+	}
+	if check.IfNil(args.HistoricalQueryService) {
+		return nil, fmt.Errorf("%w for the historical query service", process.ErrNilScQueryElement)
+	}
+	if check.IfNil(args.HistoricalBlockChain) {
+		return nil, fmt.Errorf("%w for the historical query service", process.ErrNilBlockChain)
+	}
+	if check.IfNil(args.MainBlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.HistoryRepository) {
+		return nil, process.ErrNilHistoryRepository
+	}
+	if check.IfNil(args.ScheduledTxsExecutionHandler) {
+		return nil, process.ErrNilScheduledTxsExecutionHandler
+	}
+
+	return &scQueryServiceWithHistory{
+		currentQueryService:          args.CurrentQueryService,
+		historicalQueryService:       args.HistoricalQueryService,
+		historicalBlockChain:         args.HistoricalBlockChain,
+		mainBlockChain:               args.MainBlockChain,
+		storageService:               args.StorageService,
+		marshaller:                   args.Marshaller,
+		uint64ByteSliceConverter:     args.Uint64ByteSliceConverter,
+		historyRepository:            args.HistoryRepository,
+		scheduledTxsExecutionHandler: args.ScheduledTxsExecutionHandler,
+		shardID:                      args.ShardID,
+	}, nil
+}
+
+// ExecuteQuery executes the query on the state of the block selected by the query's coordinates or, if none is provided,
+// on the current state
+func (service *scQueryServiceWithHistory) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if query == nil || !query.BlockCoordinates.IsSet() {
+		return service.currentQueryService.ExecuteQuery(query)
+	}
+
+	service.mutHistoricalExecution.Lock()
+	defer service.mutHistoricalExecution.Unlock()
+
+	err := service.moveHistoricalBlockChain(query.BlockCoordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.historicalQueryService.ExecuteQuery(query)
+}
+
+// ExecuteQueryWithTrace executes the query, recording its execution trace, on the state of the block selected by the
+// query's coordinates or, if none is provided, on the current state
+func (service *scQueryServiceWithHistory) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if query == nil || !query.BlockCoordinates.IsSet() {
+		return service.currentQueryService.ExecuteQueryWithTrace(query)
+	}
+
+	service.mutHistoricalExecution.Lock()
+	defer service.mutHistoricalExecution.Unlock()
+
+	err := service.moveHistoricalBlockChain(query.BlockCoordinates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return service.historicalQueryService.ExecuteQueryWithTrace(query)
+}
+
+// ComputeScCallGasLimit computes the gas limit of the smart contract call on the current state
+func (service *scQueryServiceWithHistory) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	return service.currentQueryService.ComputeScCallGasLimit(tx)
+}
+
+// ComputeScCallGasLimitInBlock computes the gas limit of the smart contract call on the state of the block selected
+// by the provided coordinates or, if none is provided, on the current state
+func (service *scQueryServiceWithHistory) ComputeScCallGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (uint64, error) {
+	if !coordinates.IsSet() {
+		return service.currentQueryService.ComputeScCallGasLimit(tx)
+	}
+
+	service.mutHistoricalExecution.Lock()
+	defer service.mutHistoricalExecution.Unlock()
+
+	err := service.moveHistoricalBlockChain(coordinates)
+	if err != nil {
+		return 0, err
+	}
+
+	return service.historicalQueryService.ComputeScCallGasLimit(tx)
+}
+
+func (service *scQueryServiceWithHistory) moveHistoricalBlockChain(coordinates process.BlockCoordinates) error {
+	header, headerHash, rootHash, err := service.resolveBlock(coordinates)
+	if err != nil {
+		return err
+	}
+
+	err = service.historicalBlockChain.SetCurrentBlockHeaderAndRootHash(header, rootHash)
+	if err != nil {
+		return err
+	}
+	service.historicalBlockChain.SetCurrentBlockHeaderHash(headerHash)
+
+	return nil
+}
+
+func (service *scQueryServiceWithHistory) resolveBlock(coordinates process.BlockCoordinates) (data.HeaderHandler, []byte, []byte, error) {
+	err := checkBlockCoordinates(coordinates)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(coordinates.RootHash) > 0 {
+		// the state is selected only by its root hash, so the VM will see the current block as the one being processed
+		header := service.mainBlockChain.GetCurrentBlockHeader()
+		if check.IfNil(header) {
+			return nil, nil, nil, process.ErrNilHeaderHandler
+		}
+
+		return header, service.mainBlockChain.GetCurrentBlockHeaderHash(), coordinates.RootHash, nil
+	}
+
+	headerHash := coordinates.Hash
+	if coordinates.Nonce.HasValue {
+		headerHash, err = service.getBlockHashByNonce(coordinates.Nonce.Value)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	header, err := service.getBlockHeaderByHash(headerHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return header, headerHash, service.getBlockRootHash(headerHash, header), nil
+}
+
+func checkBlockCoordinates(coordinates process.BlockCoordinates) error {
+	numSetCoordinates := 0
+	if coordinates.Nonce.HasValue {
+		numSetCoordinates++
+	}
+	if len(coordinates.Hash) > 0 {
+		numSetCoordinates++
+	}
+	if len(coordinates.RootHash) > 0 {
+		numSetCoordinates++
+	}
+	if numSetCoordinates > 1 {
+		return process.ErrInvalidBlockCoordinates
+	}
+
+	return nil
+}
+
+func (service *scQueryServiceWithHistory) getBlockHashByNonce(nonce uint64) ([]byte, error) {
+	return process.GetHeaderHashFromStorageWithNonce(
+		nonce,
+		service.storageService,
+		service.uint64ByteSliceConverter,
+		service.marshaller,
+		dataRetriever.GetHdrNonceHashDataUnit(service.shardID),
+	)
+}
+
+func (service *scQueryServiceWithHistory) getBlockHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	epoch, err := service.getOptionalEpochByHash(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	storer := service.storageService.GetStorer(dataRetriever.GetHeadersDataUnit(service.shardID))
+
+	var headerBuffer []byte
+	if epoch.HasValue {
+		headerBuffer, err = storer.GetFromEpoch(headerHash, epoch.Value)
+	} else {
+		headerBuffer, err = storer.Get(headerHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(service.shardID, service.marshaller, headerBuffer)
+}
+
+func (service *scQueryServiceWithHistory) getOptionalEpochByHash(hash []byte) (core.OptionalUint32, error) {
+	if !service.historyRepository.IsEnabled() {
+		return core.OptionalUint32{}, nil
+	}
+
+	epoch, err := service.historyRepository.GetEpochByHash(hash)
+	if err != nil {
+		return core.OptionalUint32{}, err
+	}
+
+	return core.OptionalUint32{Value: epoch, HasValue: true}, nil
+}
+
+func (service *scQueryServiceWithHistory) getBlockRootHash(headerHash []byte, header data.HeaderHandler) []byte {
+	blockRootHash, err := service.scheduledTxsExecutionHandler.GetScheduledRootHashForHeaderWithEpoch(
+		headerHash,
+		header.GetEpoch())
+	if err != nil {
+		blockRootHash = header.GetRootHash()
+	}
+
+	return blockRootHash
+}
+
+// Close closes both the current and the historical query services
+func (service *scQueryServiceWithHistory) Close() error {
+	errCurrent := service.currentQueryService.Close()
+	errHistorical := service.historicalQueryService.Close()
+	if errCurrent != nil {
+		return errCurrent
+	}
+
+	return errHistorical
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (service *scQueryServiceWithHistory) IsInterfaceNil() bool {
+	return service == nil
+}
@@ -0,0 +1,381 @@
+package smartContract
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsNewSCQueryServiceWithHistory() ArgsNewSCQueryServiceWithHistory {
+	return ArgsNewSCQueryServiceWithHistory{
+		CurrentQueryService:          &mock.ScQueryStub{},
+		HistoricalQueryService:       &mock.ScQueryStub{},
+		HistoricalBlockChain:         &testscommon.ChainHandlerStub{},
+		MainBlockChain:               &testscommon.ChainHandlerStub{},
+		StorageService:               genericMocks.NewChainStorerMock(0),
+		Marshaller:                   &testscommon.MarshalizerMock{},
+		Uint64ByteSliceConverter:     mock.NewNonceHashConverterMock(),
+		HistoryRepository:            &dblookupext.HistoryRepositoryStub{},
+		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
+		ShardID:                      0,
+	}
+}
+
+func TestNewSCQueryServiceWithHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil current query service should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.CurrentQueryService = nil
+
+		service, err := NewSCQueryServiceWithHistory(args)
+		require.Nil(t, service)
+		require.ErrorIs(t, err, process.ErrNilScQueryElement)
+	})
+	t.Run("nil historical query service should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.HistoricalQueryService = nil
+
+		service, err := NewSCQueryServiceWithHistory(args)
+		require.Nil(t, service)
+		require.ErrorIs(t, err, process.ErrNilScQueryElement)
+	})
+	t.Run("nil blockchains should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.HistoricalBlockChain = nil
+		_, err := NewSCQueryServiceWithHistory(args)
+		require.ErrorIs(t, err, process.ErrNilBlockChain)
+
+		args = createMockArgsNewSCQueryServiceWithHistory()
+		args.MainBlockChain = nil
+		_, err = NewSCQueryServiceWithHistory(args)
+		require.Equal(t, process.ErrNilBlockChain, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.StorageService = nil
+
+		_, err := NewSCQueryServiceWithHistory(args)
+		require.Equal(t, process.ErrNilStore, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.Marshaller = nil
+
+		_, err := NewSCQueryServiceWithHistory(args)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.Uint64ByteSliceConverter = nil
+
+		_, err := NewSCQueryServiceWithHistory(args)
+		require.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("nil history repository should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.HistoryRepository = nil
+
+		_, err := NewSCQueryServiceWithHistory(args)
+		require.Equal(t, process.ErrNilHistoryRepository, err)
+	})
+	t.Run("nil scheduled txs execution handler should error", func(t *testing.T) {
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.ScheduledTxsExecutionHandler = nil
+
+		_, err := NewSCQueryServiceWithHistory(args)
+		require.Equal(t, process.ErrNilScheduledTxsExecutionHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		service, err := NewSCQueryServiceWithHistory(createMockArgsNewSCQueryServiceWithHistory())
+		require.Nil(t, err)
+		require.False(t, service.IsInterfaceNil())
+	})
+}
+
+func TestScQueryServiceWithHistory_ExecuteQueryWithoutCoordinatesShouldUseTheCurrentService(t *testing.T) {
+	t.Parallel()
+
+	currentOutput := &vmcommon.VMOutput{ReturnMessage: "current"}
+	args := createMockArgsNewSCQueryServiceWithHistory()
+	args.CurrentQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return currentOutput, nil
+		},
+	}
+	args.HistoricalQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+	args.HistoricalBlockChain = &testscommon.ChainHandlerStub{
+		SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+			require.Fail(t, "should not have been called")
+			return nil
+		},
+	}
+	service, _ := NewSCQueryServiceWithHistory(args)
+
+	vmOutput, err := service.ExecuteQuery(&process.SCQuery{FuncName: "get"})
+	require.Nil(t, err)
+	require.Equal(t, currentOutput, vmOutput)
+}
+
+func TestScQueryServiceWithHistory_ExecuteQueryWithCoordinates(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &testscommon.MarshalizerMock{}
+	uint64Converter := mock.NewNonceHashConverterMock()
+	headerHash := []byte("headerHash")
+	header := &block.Header{Nonce: 42, Epoch: 3, RootHash: []byte("rootHash")}
+	headerBytes, _ := marshaller.Marshal(header)
+
+	createArgs := func(historicalChain *testscommon.ChainHandlerStub) ArgsNewSCQueryServiceWithHistory {
+		storer := genericMocks.NewChainStorerMock(3)
+		_ = storer.BlockHeaders.PutInEpoch(headerHash, headerBytes, 3)
+		_ = storer.ShardHdrNonce.PutInEpoch(uint64Converter.ToByteSlice(42), headerHash, 3)
+
+		args := createMockArgsNewSCQueryServiceWithHistory()
+		args.StorageService = storer
+		args.Marshaller = marshaller
+		args.Uint64ByteSliceConverter = uint64Converter
+		args.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+				return 3, nil
+			},
+		}
+		args.CurrentQueryService = &mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		args.HistoricalQueryService = &mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnMessage: "historical"}, nil
+			},
+		}
+		args.HistoricalBlockChain = historicalChain
+
+		return args
+	}
+
+	t.Run("by nonce should select the block and its scheduled root hash", func(t *testing.T) {
+		var selectedHeader data.HeaderHandler
+		var selectedRootHash, selectedHeaderHash []byte
+		historicalChain := &testscommon.ChainHandlerStub{
+			SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				selectedHeader = header
+				selectedRootHash = rootHash
+				return nil
+			},
+			SetCurrentBlockHeaderHashCalled: func(hash []byte) {
+				selectedHeaderHash = hash
+			},
+		}
+		args := createArgs(historicalChain)
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(hash []byte, epoch uint32) ([]byte, error) {
+				require.Equal(t, headerHash, hash)
+				require.Equal(t, uint32(3), epoch)
+				return []byte("scheduledRootHash"), nil
+			},
+		}
+		service, _ := NewSCQueryServiceWithHistory(args)
+
+		query := &process.SCQuery{
+			FuncName:         "get",
+			BlockCoordinates: process.BlockCoordinates{Nonce: core.OptionalUint64{Value: 42, HasValue: true}},
+		}
+		vmOutput, err := service.ExecuteQuery(query)
+		require.Nil(t, err)
+		require.Equal(t, "historical", vmOutput.ReturnMessage)
+		require.Equal(t, uint64(42), selectedHeader.GetNonce())
+		require.Equal(t, []byte("scheduledRootHash"), selectedRootHash)
+		require.Equal(t, headerHash, selectedHeaderHash)
+	})
+	t.Run("by hash should select the block and its root hash", func(t *testing.T) {
+		var selectedRootHash []byte
+		historicalChain := &testscommon.ChainHandlerStub{
+			SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				selectedRootHash = rootHash
+				return nil
+			},
+		}
+		args := createArgs(historicalChain)
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(hash []byte, epoch uint32) ([]byte, error) {
+				return nil, errors.New("missing")
+			},
+		}
+		service, _ := NewSCQueryServiceWithHistory(args)
+
+		query := &process.SCQuery{BlockCoordinates: process.BlockCoordinates{Hash: headerHash}}
+		_, err := service.ExecuteQuery(query)
+		require.Nil(t, err)
+		require.Equal(t, header.RootHash, selectedRootHash)
+	})
+	t.Run("by root hash should use the current block", func(t *testing.T) {
+		currentHeader := &block.Header{Nonce: 100}
+		var selectedHeader data.HeaderHandler
+		var selectedRootHash []byte
+		historicalChain := &testscommon.ChainHandlerStub{
+			SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				selectedHeader = header
+				selectedRootHash = rootHash
+				return nil
+			},
+		}
+		args := createArgs(historicalChain)
+		args.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+		}
+		service, _ := NewSCQueryServiceWithHistory(args)
+
+		query := &process.SCQuery{BlockCoordinates: process.BlockCoordinates{RootHash: []byte("oldRootHash")}}
+		_, err := service.ExecuteQuery(query)
+		require.Nil(t, err)
+		require.Equal(t, currentHeader, selectedHeader)
+		require.Equal(t, []byte("oldRootHash"), selectedRootHash)
+	})
+	t.Run("unknown block should error", func(t *testing.T) {
+		service, _ := NewSCQueryServiceWithHistory(createArgs(&testscommon.ChainHandlerStub{}))
+
+		query := &process.SCQuery{BlockCoordinates: process.BlockCoordinates{Nonce: core.OptionalUint64{Value: 43, HasValue: true}}}
+		vmOutput, err := service.ExecuteQuery(query)
+		require.Nil(t, vmOutput)
+		require.NotNil(t, err)
+	})
+	t.Run("more than one coordinate should error", func(t *testing.T) {
+		service, _ := NewSCQueryServiceWithHistory(createArgs(&testscommon.ChainHandlerStub{}))
+
+		query := &process.SCQuery{BlockCoordinates: process.BlockCoordinates{Hash: headerHash, RootHash: []byte("rootHash")}}
+		vmOutput, err := service.ExecuteQuery(query)
+		require.Nil(t, vmOutput)
+		require.Equal(t, process.ErrInvalidBlockCoordinates, err)
+	})
+}
+
+func TestScQueryServiceWithHistory_ExecuteQueryWithTrace(t *testing.T) {
+	t.Parallel()
+
+	currentTrace := &tracing.ExecutionTrace{Root: &tracing.CallFrame{ReturnMessage: "current"}}
+	historicalTrace := &tracing.ExecutionTrace{Root: &tracing.CallFrame{ReturnMessage: "historical"}}
+	header := &block.Header{Nonce: 42, RootHash: []byte("rootHash")}
+	setRootHash := make([]byte, 0)
+
+	args := createMockArgsNewSCQueryServiceWithHistory()
+	args.CurrentQueryService = &mock.ScQueryStub{
+		ExecuteQueryWithTraceCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+			return &vmcommon.VMOutput{}, currentTrace, nil
+		},
+	}
+	args.HistoricalQueryService = &mock.ScQueryStub{
+		ExecuteQueryWithTraceCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+			return &vmcommon.VMOutput{}, historicalTrace, nil
+		},
+	}
+	args.MainBlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return header
+		},
+	}
+	args.HistoricalBlockChain = &testscommon.ChainHandlerStub{
+		SetCurrentBlockHeaderAndRootHashCalled: func(_ data.HeaderHandler, rootHash []byte) error {
+			setRootHash = rootHash
+			return nil
+		},
+	}
+	service, _ := NewSCQueryServiceWithHistory(args)
+
+	_, trace, err := service.ExecuteQueryWithTrace(&process.SCQuery{FuncName: "get"})
+	require.Nil(t, err)
+	require.Equal(t, currentTrace, trace)
+	require.Empty(t, setRootHash)
+
+	query := &process.SCQuery{
+		FuncName:         "get",
+		BlockCoordinates: process.BlockCoordinates{RootHash: []byte("oldRootHash")},
+	}
+	_, trace, err = service.ExecuteQueryWithTrace(query)
+	require.Nil(t, err)
+	require.Equal(t, historicalTrace, trace)
+	require.Equal(t, []byte("oldRootHash"), setRootHash)
+}
+
+func TestScQueryServiceWithHistory_ComputeScCallGasLimitInBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewSCQueryServiceWithHistory()
+	args.CurrentQueryService = &mock.ScQueryStub{
+		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+			return 10, nil
+		},
+	}
+	args.HistoricalQueryService = &mock.ScQueryStub{
+		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+			return 20, nil
+		},
+	}
+	args.MainBlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+	}
+	service, _ := NewSCQueryServiceWithHistory(args)
+
+	gasLimit, err := service.ComputeScCallGasLimitInBlock(&transaction.Transaction{}, process.BlockCoordinates{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), gasLimit)
+
+	gasLimit, err = service.ComputeScCallGasLimitInBlock(&transaction.Transaction{}, process.BlockCoordinates{RootHash: []byte("rootHash")})
+	require.Nil(t, err)
+	require.Equal(t, uint64(20), gasLimit)
+
+	gasLimit, err = service.ComputeScCallGasLimit(&transaction.Transaction{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), gasLimit)
+}
+
+func TestScQueryServiceWithHistory_CloseShouldCloseBothServices(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numClosed := 0
+	args := createMockArgsNewSCQueryServiceWithHistory()
+	args.CurrentQueryService = &mock.ScQueryStub{
+		CloseCalled: func() error {
+			numClosed++
+			return nil
+		},
+	}
+	args.HistoricalQueryService = &mock.ScQueryStub{
+		CloseCalled: func() error {
+			numClosed++
+			return expectedErr
+		},
+	}
+	service, _ := NewSCQueryServiceWithHistory(args)
+
+	err := service.Close()
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, 2, numClosed)
+}
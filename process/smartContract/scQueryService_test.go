@@ -16,6 +16,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	disabledTracing "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +35,7 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		ExecutionTracer:          disabledTracing.NewDisabledExecutionTracer(),
 	}
 }
 
@@ -102,6 +105,17 @@ func TestNewSCQueryService_NilBootstrapperShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBootstrapper, err)
 }
 
+func TestNewSCQueryService_NilExecutionTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.ExecutionTracer = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -308,6 +322,111 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func TestExecuteQueryWithTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			StartRecordingCalled: func() {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, trace, err := target.ExecuteQueryWithTrace(&process.SCQuery{FuncName: "function"})
+		assert.Equal(t, process.ErrNilScAddress, err)
+		assert.Nil(t, vmOutput)
+		assert.Nil(t, trace)
+	})
+	t.Run("should record the trace of the execution", func(t *testing.T) {
+		t.Parallel()
+
+		expectedVMOutput := &vmcommon.VMOutput{
+			ReturnCode: vmcommon.Ok,
+			ReturnData: [][]byte{[]byte("90")},
+		}
+		expectedTrace := &tracing.ExecutionTrace{}
+		isRecording := false
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						assert.True(t, isRecording)
+						return expectedVMOutput, nil
+					},
+				}, nil
+			},
+		}
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			StartRecordingCalled: func() {
+				isRecording = true
+			},
+			StopRecordingCalled: func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace {
+				isRecording = false
+				assert.Equal(t, "function", input.Function)
+				assert.Equal(t, []byte(DummyScAddress), input.RecipientAddr)
+				assert.Equal(t, expectedVMOutput, output)
+
+				return expectedTrace
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		query := process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			Arguments: [][]byte{},
+		}
+		vmOutput, trace, err := target.ExecuteQueryWithTrace(&query)
+
+		assert.Nil(t, err)
+		assert.False(t, isRecording)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+		assert.True(t, expectedTrace == trace)
+	})
+	t.Run("execution error should stop recording and error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		isRecording := false
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						return nil, expectedErr
+					},
+				}, nil
+			},
+		}
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			StartRecordingCalled: func() {
+				isRecording = true
+			},
+			StopRecordingCalled: func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace {
+				isRecording = false
+				return &tracing.ExecutionTrace{}
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		query := process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+		}
+		vmOutput, trace, err := target.ExecuteQueryWithTrace(&query)
+
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, isRecording)
+		assert.Nil(t, vmOutput)
+		assert.Nil(t, trace)
+	})
+}
+
 func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 	t.Parallel()
 
@@ -745,6 +864,7 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		ExecutionTracer:          disabledTracing.NewDisabledExecutionTracer(),
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
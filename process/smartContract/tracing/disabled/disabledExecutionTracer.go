@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type disabledExecutionTracer struct {
+}
+
+// NewDisabledExecutionTracer returns a new instance of disabledExecutionTracer
+func NewDisabledExecutionTracer() *disabledExecutionTracer {
+	return &disabledExecutionTracer{}
+}
+
+// StartRecording won't do anything as this is a disabled component
+func (d *disabledExecutionTracer) StartRecording() {
+}
+
+// StopRecording returns nil as this is a disabled component
+func (d *disabledExecutionTracer) StopRecording(_ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput) *tracing.ExecutionTrace {
+	return nil
+}
+
+// TraceStorageRead won't do anything as this is a disabled component
+func (d *disabledExecutionTracer) TraceStorageRead(_ []byte, _ []byte, _ []byte) {
+}
+
+// TraceBuiltInFunctionCall won't do anything as this is a disabled component
+func (d *disabledExecutionTracer) TraceBuiltInFunctionCall(_ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledExecutionTracer) IsInterfaceNil() bool {
+	return d == nil
+}
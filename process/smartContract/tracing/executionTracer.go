@@ -0,0 +1,343 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+const callTypeBuiltInFunction = "BuiltInFunction"
+
+type executionTracer struct {
+	mutRecording     sync.Mutex
+	isRecording      bool
+	pubkeyConverter  core.PubkeyConverter
+	argsParser       callArgsParser
+	storageReads     []*StorageAccess
+	readKeys         map[string]struct{}
+	builtInFuncCalls []*CallFrame
+}
+
+// NewExecutionTracer creates a new execution tracer. The tracer records the storage reads and the built-in function
+// calls reported by the blockchain hook only between StartRecording and StopRecording calls
+func NewExecutionTracer(pubkeyConverter core.PubkeyConverter) (*executionTracer, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	tracer := &executionTracer{
+		pubkeyConverter: pubkeyConverter,
+		argsParser:      parsers.NewCallArgsParser(),
+	}
+	tracer.reset()
+
+	return tracer, nil
+}
+
+// StartRecording starts recording the storage reads and the built-in function calls, dropping the previous records
+func (et *executionTracer) StartRecording() {
+	et.mutRecording.Lock()
+	et.isRecording = true
+	et.reset()
+	et.mutRecording.Unlock()
+}
+
+// StopRecording stops the recording and builds the execution trace from the provided call input and VM output,
+// together with the recorded storage reads and built-in function calls
+func (et *executionTracer) StopRecording(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *ExecutionTrace {
+	et.mutRecording.Lock()
+	defer et.mutRecording.Unlock()
+
+	trace := &ExecutionTrace{
+		Root:            et.createRootFrame(input, output),
+		TouchedAccounts: et.createTouchedAccounts(input, output),
+		StorageReads:    et.storageReads,
+		StorageWrites:   et.createStorageWrites(output),
+		Events:          et.createEvents(output),
+	}
+
+	et.isRecording = false
+	et.reset()
+
+	return trace
+}
+
+// TraceStorageRead records the value read from the storage of an account. Only the first read of a key is kept
+func (et *executionTracer) TraceStorageRead(address []byte, key []byte, value []byte) {
+	et.mutRecording.Lock()
+	defer et.mutRecording.Unlock()
+
+	if !et.isRecording {
+		return
+	}
+
+	readKey := string(address) + string(key)
+	_, alreadyRead := et.readKeys[readKey]
+	if alreadyRead {
+		return
+	}
+
+	et.readKeys[readKey] = struct{}{}
+	et.storageReads = append(et.storageReads, et.createStorageAccess(address, key, value))
+}
+
+// TraceBuiltInFunctionCall records a built-in function call, together with its output or error
+func (et *executionTracer) TraceBuiltInFunctionCall(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	if input == nil {
+		return
+	}
+
+	et.mutRecording.Lock()
+	defer et.mutRecording.Unlock()
+
+	if !et.isRecording {
+		return
+	}
+
+	frame := et.createFrame(callTypeBuiltInFunction, input.CallerAddr, input.RecipientAddr, input.Function, input.Arguments, input.CallValue)
+	frame.GasProvided = input.GasProvided
+	if err != nil {
+		frame.ReturnCode = vmcommon.ExecutionFailed.String()
+		frame.ReturnMessage = err.Error()
+	}
+	if output != nil {
+		frame.GasUsed = computeGasUsed(input.GasProvided, output.GasRemaining)
+		frame.ReturnCode = output.ReturnCode.String()
+		frame.ReturnMessage = output.ReturnMessage
+	}
+
+	et.builtInFuncCalls = append(et.builtInFuncCalls, frame)
+}
+
+func (et *executionTracer) reset() {
+	et.storageReads = make([]*StorageAccess, 0)
+	et.readKeys = make(map[string]struct{})
+	et.builtInFuncCalls = make([]*CallFrame, 0)
+}
+
+func (et *executionTracer) createRootFrame(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *CallFrame {
+	if input == nil {
+		return nil
+	}
+
+	root := et.createFrame(callTypeToString(input.CallType), input.CallerAddr, input.RecipientAddr, input.Function, input.Arguments, input.CallValue)
+	root.GasProvided = input.GasProvided
+	root.Calls = append(root.Calls, et.builtInFuncCalls...)
+	if output == nil {
+		return root
+	}
+
+	root.GasUsed = computeGasUsed(input.GasProvided, output.GasRemaining)
+	root.ReturnCode = output.ReturnCode.String()
+	root.ReturnMessage = output.ReturnMessage
+
+	return root
+}
+
+// createTouchedAccounts lists the accounts from the VM output, sorted by address, with the gas each of them consumed
+// and the transfers each of them received
+func (et *executionTracer) createTouchedAccounts(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) []*TouchedAccount {
+	touchedAccounts := make([]*TouchedAccount, 0)
+	if input == nil || output == nil {
+		return touchedAccounts
+	}
+
+	for _, address := range sortedOutputAccountsAddresses(output) {
+		outputAccount := output.OutputAccounts[address]
+		if outputAccount == nil {
+			continue
+		}
+
+		touchedAccount := &TouchedAccount{
+			Address: et.encodeAddress(outputAccount.Address),
+			GasUsed: outputAccount.GasUsed,
+		}
+		for _, outputTransfer := range outputAccount.OutputTransfers {
+			touchedAccount.Transfers = append(touchedAccount.Transfers, et.createTransfer(input.RecipientAddr, outputTransfer))
+		}
+		touchedAccounts = append(touchedAccounts, touchedAccount)
+	}
+
+	return touchedAccounts
+}
+
+func (et *executionTracer) createTransfer(calleeAddress []byte, outputTransfer vmcommon.OutputTransfer) *Transfer {
+	sender := outputTransfer.SenderAddress
+	if len(sender) == 0 {
+		sender = calleeAddress
+	}
+
+	transfer := &Transfer{
+		CallType: callTypeToString(outputTransfer.CallType),
+		Sender:   et.encodeAddress(sender),
+		Value:    "0",
+		GasLimit: outputTransfer.GasLimit,
+	}
+	if outputTransfer.Value != nil {
+		transfer.Value = outputTransfer.Value.String()
+	}
+	if len(outputTransfer.Data) == 0 {
+		return transfer
+	}
+
+	function, arguments, err := et.argsParser.ParseData(string(outputTransfer.Data))
+	if err == nil {
+		transfer.Function = function
+		transfer.Arguments = encodeToHex(arguments)
+	}
+
+	return transfer
+}
+
+func (et *executionTracer) createFrame(
+	callType string,
+	caller []byte,
+	callee []byte,
+	function string,
+	arguments [][]byte,
+	value *big.Int,
+) *CallFrame {
+	frame := &CallFrame{
+		CallType:  callType,
+		Caller:    et.encodeAddress(caller),
+		Callee:    et.encodeAddress(callee),
+		Function:  function,
+		Arguments: encodeToHex(arguments),
+		Value:     "0",
+		Calls:     make([]*CallFrame, 0),
+	}
+	if value != nil {
+		frame.Value = value.String()
+	}
+
+	return frame
+}
+
+func (et *executionTracer) createStorageWrites(output *vmcommon.VMOutput) []*StorageAccess {
+	writes := make([]*StorageAccess, 0)
+	if output == nil {
+		return writes
+	}
+
+	for _, address := range sortedOutputAccountsAddresses(output) {
+		outputAccount := output.OutputAccounts[address]
+		if outputAccount == nil {
+			continue
+		}
+
+		keys := make([]string, 0, len(outputAccount.StorageUpdates))
+		for key, update := range outputAccount.StorageUpdates {
+			if update == nil || !update.Written {
+				continue
+			}
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			update := outputAccount.StorageUpdates[key]
+			writes = append(writes, et.createStorageAccess(outputAccount.Address, update.Offset, update.Data))
+		}
+	}
+
+	return writes
+}
+
+func (et *executionTracer) createEvents(output *vmcommon.VMOutput) []*Event {
+	events := make([]*Event, 0)
+	if output == nil {
+		return events
+	}
+
+	for _, logEntry := range output.Logs {
+		if logEntry == nil {
+			continue
+		}
+
+		events = append(events, &Event{
+			Address:    et.encodeAddress(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     encodeToHex(logEntry.Topics),
+			Data:       hex.EncodeToString(logEntry.Data),
+		})
+	}
+
+	return events
+}
+
+func (et *executionTracer) createStorageAccess(address []byte, key []byte, value []byte) *StorageAccess {
+	return &StorageAccess{
+		Address: et.encodeAddress(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+	}
+}
+
+func (et *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return et.pubkeyConverter.Encode(address)
+}
+
+func callTypeToString(callType vmData.CallType) string {
+	switch callType {
+	case vmData.DirectCall:
+		return "DirectCall"
+	case vmData.AsynchronousCall:
+		return "AsynchronousCall"
+	case vmData.AsynchronousCallBack:
+		return "AsynchronousCallBack"
+	case vmData.ESDTTransferAndExecute:
+		return "ESDTTransferAndExecute"
+	case vmData.ExecOnDestByCaller:
+		return "ExecOnDestByCaller"
+	default:
+		return fmt.Sprintf("CallType(%d)", callType)
+	}
+}
+
+func sortedOutputAccountsAddresses(output *vmcommon.VMOutput) []string {
+	addresses := make([]string, 0, len(output.OutputAccounts))
+	for address := range output.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+func computeGasUsed(gasProvided uint64, gasRemaining uint64) uint64 {
+	if gasRemaining > gasProvided {
+		return 0
+	}
+
+	return gasProvided - gasRemaining
+}
+
+func encodeToHex(values [][]byte) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString(value))
+	}
+
+	return encoded
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (et *executionTracer) IsInterfaceNil() bool {
+	return et == nil
+}
@@ -0,0 +1,209 @@
+package tracing
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	callerAddress = []byte("caller")
+	scAddress     = []byte("contract")
+	otherAddress  = []byte("other")
+)
+
+func createTracer(t *testing.T) *executionTracer {
+	converter, err := pubkeyConverter.NewHexPubkeyConverter(4)
+	require.Nil(t, err)
+
+	tracer, err := NewExecutionTracer(converter)
+	require.Nil(t, err)
+
+	return tracer
+}
+
+func createCallInput() *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  callerAddress,
+			CallValue:   big.NewInt(10),
+			GasProvided: 1000,
+			Arguments:   [][]byte{[]byte("arg")},
+			CallType:    vmData.DirectCall,
+		},
+		RecipientAddr: scAddress,
+		Function:      "function",
+	}
+}
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(nil)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+		assert.True(t, tracer.IsInterfaceNil())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := createTracer(t)
+		assert.False(t, tracer.IsInterfaceNil())
+	})
+}
+
+func TestExecutionTracer_ShouldRecordOnlyWhileRecording(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t)
+	tracer.TraceStorageRead(scAddress, []byte("key"), []byte("value"))
+	tracer.TraceBuiltInFunctionCall(createCallInput(), &vmcommon.VMOutput{}, nil)
+
+	tracer.StartRecording()
+	trace := tracer.StopRecording(nil, nil)
+	assert.Nil(t, trace.Root)
+	assert.Empty(t, trace.TouchedAccounts)
+	assert.Empty(t, trace.StorageReads)
+	assert.Empty(t, trace.StorageWrites)
+	assert.Empty(t, trace.Events)
+
+	tracer.TraceStorageRead(scAddress, []byte("key"), []byte("value"))
+	tracer.StartRecording()
+	trace = tracer.StopRecording(nil, nil)
+	assert.Empty(t, trace.StorageReads)
+}
+
+func TestExecutionTracer_TraceStorageReadShouldKeepTheFirstReadOfAKey(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t)
+	tracer.StartRecording()
+	tracer.TraceStorageRead(scAddress, []byte("key"), []byte("value"))
+	tracer.TraceStorageRead(scAddress, []byte("key"), []byte("value"))
+	tracer.TraceStorageRead(otherAddress, []byte("key"), []byte("other value"))
+	trace := tracer.StopRecording(nil, nil)
+
+	expectedReads := []*StorageAccess{
+		{Address: "636f6e7472616374", Key: "6b6579", Value: "76616c7565"},
+		{Address: "6f74686572", Key: "6b6579", Value: "6f746865722076616c7565"},
+	}
+	assert.Equal(t, expectedReads, trace.StorageReads)
+}
+
+func TestExecutionTracer_StopRecordingShouldBuildTheTrace(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t)
+	tracer.StartRecording()
+
+	builtInFuncInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  scAddress,
+			GasProvided: 300,
+		},
+		RecipientAddr: otherAddress,
+		Function:      "ESDTTransfer",
+	}
+	tracer.TraceBuiltInFunctionCall(builtInFuncInput, &vmcommon.VMOutput{GasRemaining: 200, ReturnCode: vmcommon.Ok}, nil)
+	tracer.TraceBuiltInFunctionCall(builtInFuncInput, nil, errors.New("built-in function error"))
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining:  400,
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: "user error",
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(scAddress): {
+				Address: scAddress,
+				GasUsed: 100,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"b": {Offset: []byte("b"), Data: []byte("2"), Written: true},
+					"a": {Offset: []byte("a"), Data: []byte("1"), Written: true},
+					"c": {Offset: []byte("c"), Data: []byte("3"), Written: false},
+				},
+			},
+			string(otherAddress): {
+				Address: otherAddress,
+				GasUsed: 50,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{Value: big.NewInt(0), GasLimit: 70, Data: []byte("callBack@01"), CallType: vmData.AsynchronousCall},
+					{Value: big.NewInt(5)},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{Address: scAddress, Identifier: []byte("event"), Topics: [][]byte{[]byte("t")}, Data: []byte("d")},
+		},
+	}
+	trace := tracer.StopRecording(createCallInput(), vmOutput)
+
+	require.NotNil(t, trace.Root)
+	assert.Equal(t, "DirectCall", trace.Root.CallType)
+	assert.Equal(t, "63616c6c6572", trace.Root.Caller)
+	assert.Equal(t, "636f6e7472616374", trace.Root.Callee)
+	assert.Equal(t, "function", trace.Root.Function)
+	assert.Equal(t, []string{"617267"}, trace.Root.Arguments)
+	assert.Equal(t, "10", trace.Root.Value)
+	assert.Equal(t, uint64(1000), trace.Root.GasProvided)
+	assert.Equal(t, uint64(600), trace.Root.GasUsed)
+	assert.Equal(t, vmcommon.UserError.String(), trace.Root.ReturnCode)
+	assert.Equal(t, "user error", trace.Root.ReturnMessage)
+
+	require.Equal(t, 2, len(trace.Root.Calls))
+	assert.Equal(t, callTypeBuiltInFunction, trace.Root.Calls[0].CallType)
+	assert.Equal(t, "ESDTTransfer", trace.Root.Calls[0].Function)
+	assert.Equal(t, uint64(100), trace.Root.Calls[0].GasUsed)
+	assert.Equal(t, vmcommon.Ok.String(), trace.Root.Calls[0].ReturnCode)
+	assert.Equal(t, vmcommon.ExecutionFailed.String(), trace.Root.Calls[1].ReturnCode)
+	assert.Equal(t, "built-in function error", trace.Root.Calls[1].ReturnMessage)
+
+	expectedTouchedAccounts := []*TouchedAccount{
+		{
+			Address: "636f6e7472616374",
+			GasUsed: 100,
+		},
+		{
+			Address: "6f74686572",
+			GasUsed: 50,
+			Transfers: []*Transfer{
+				{
+					CallType:  "AsynchronousCall",
+					Sender:    "636f6e7472616374",
+					Function:  "callBack",
+					Arguments: []string{"01"},
+					Value:     "0",
+					GasLimit:  70,
+				},
+				{
+					CallType: "DirectCall",
+					Sender:   "636f6e7472616374",
+					Value:    "5",
+				},
+			},
+		},
+	}
+	assert.Equal(t, expectedTouchedAccounts, trace.TouchedAccounts)
+
+	expectedWrites := []*StorageAccess{
+		{Address: "636f6e7472616374", Key: "61", Value: "31"},
+		{Address: "636f6e7472616374", Key: "62", Value: "32"},
+	}
+	assert.Equal(t, expectedWrites, trace.StorageWrites)
+
+	expectedEvents := []*Event{
+		{Address: "636f6e7472616374", Identifier: "event", Topics: []string{"74"}, Data: "64"},
+	}
+	assert.Equal(t, expectedEvents, trace.Events)
+
+	tracer.StartRecording()
+	trace = tracer.StopRecording(createCallInput(), &vmcommon.VMOutput{})
+	assert.Empty(t, trace.Root.Calls)
+	assert.Empty(t, trace.TouchedAccounts)
+}
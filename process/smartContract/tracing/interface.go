@@ -0,0 +1,5 @@
+package tracing
+
+type callArgsParser interface {
+	ParseData(data string) (string, [][]byte, error)
+}
@@ -0,0 +1,62 @@
+package tracing
+
+// ExecutionTrace holds the information recorded while executing a smart contract call. The root frame holds the
+// executed call and, as nested calls, the built-in function calls observed while executing it. The calls executed by
+// the VM on the destination context are not observable, so they are not part of the call frames: the accounts they
+// touched are only listed, from the VM output, without their order, depth or called functions
+type ExecutionTrace struct {
+	Root            *CallFrame        `json:"root,omitempty"`
+	TouchedAccounts []*TouchedAccount `json:"touchedAccounts"`
+	StorageReads    []*StorageAccess  `json:"storageReads"`
+	StorageWrites   []*StorageAccess  `json:"storageWrites"`
+	Events          []*Event          `json:"events"`
+}
+
+// CallFrame holds the information about a single call done during the execution, together with the built-in function
+// calls done by it, in the order they were done
+type CallFrame struct {
+	CallType      string       `json:"callType"`
+	Caller        string       `json:"caller"`
+	Callee        string       `json:"callee"`
+	Function      string       `json:"function,omitempty"`
+	Arguments     []string     `json:"arguments,omitempty"`
+	Value         string       `json:"value"`
+	GasProvided   uint64       `json:"gasProvided"`
+	GasUsed       uint64       `json:"gasUsed"`
+	ReturnCode    string       `json:"returnCode,omitempty"`
+	ReturnMessage string       `json:"returnMessage,omitempty"`
+	Calls         []*CallFrame `json:"calls,omitempty"`
+}
+
+// TouchedAccount holds an account modified by the execution, as reported by the VM output, with the gas consumed
+// while executing code on it and the transfers it received
+type TouchedAccount struct {
+	Address   string      `json:"address"`
+	GasUsed   uint64      `json:"gasUsed"`
+	Transfers []*Transfer `json:"transfers,omitempty"`
+}
+
+// Transfer holds a value or a call transferred to a touched account, to be executed after the current execution
+type Transfer struct {
+	CallType  string   `json:"callType"`
+	Sender    string   `json:"sender"`
+	Function  string   `json:"function,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+	Value     string   `json:"value"`
+	GasLimit  uint64   `json:"gasLimit"`
+}
+
+// StorageAccess holds a storage key of an account, together with the value read or written
+type StorageAccess struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// Event holds an event emitted during the execution
+type Event struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+}
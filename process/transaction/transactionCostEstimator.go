@@ -29,6 +29,7 @@ type transactionCostEstimator struct {
 	txTypeHandler    process.TxTypeHandler
 	feeHandler       process.FeeHandler
 	txSimulator      facade.TransactionSimulatorProcessor
+	historicalGas    process.HistoricalScCallGasLimitComputer
 	mutExecution     sync.RWMutex
 
 	flagTooMuchGasV2Msg        atomicFlag.Flag
@@ -44,6 +45,7 @@ func NewTransactionCostEstimator(
 	shardCoordinator sharding.Coordinator,
 	epochNotifier process.EpochNotifier,
 	tooMuchGasMessageV2EnableEpoch uint32,
+	historicalGas process.HistoricalScCallGasLimitComputer,
 ) (*transactionCostEstimator, error) {
 	if check.IfNil(txTypeHandler) {
 		return nil, process.ErrNilTxTypeHandler
@@ -63,11 +65,15 @@ func NewTransactionCostEstimator(
 	if check.IfNil(epochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(historicalGas) {
+		return nil, process.ErrNilHistoricalScCallGasLimitComputer
+	}
 
 	tce := &transactionCostEstimator{
 		txTypeHandler:              txTypeHandler,
 		feeHandler:                 feeHandler,
 		txSimulator:                txSimulator,
+		historicalGas:              historicalGas,
 		accounts:                   accounts,
 		shardCoordinator:           shardCoordinator,
 		tooMuchGasV2MsgEnableEpoch: tooMuchGasMessageV2EnableEpoch,
@@ -106,6 +112,40 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 	}
 }
 
+// ComputeTransactionGasLimitInBlock will calculate how many gas units a transaction would have consumed on the state of
+// the block selected by the provided coordinates. At a past block, only the move balance transactions and the smart
+// contract calls can be estimated
+func (tce *transactionCostEstimator) ComputeTransactionGasLimitInBlock(tx *transaction.Transaction, coordinates process.BlockCoordinates) (*transaction.CostResponse, error) {
+	if !coordinates.IsSet() {
+		return tce.ComputeTransactionGasLimit(tx)
+	}
+
+	txTypeOnSender, txTypeOnDestination := tce.txTypeHandler.ComputeTransactionType(tx)
+	if txTypeOnSender == process.MoveBalance && txTypeOnDestination == process.MoveBalance {
+		return tce.computeMoveBalanceCost(tx), nil
+	}
+
+	if txTypeOnSender != process.SCInvoking {
+		return &transaction.CostResponse{
+			GasUnits:      0,
+			ReturnMessage: "cannot compute the cost of this transaction type at a past block",
+		}, nil
+	}
+
+	gasUnits, err := tce.historicalGas.ComputeScCallGasLimitInBlock(tx, coordinates)
+	if err != nil {
+		return &transaction.CostResponse{
+			GasUnits:      0,
+			ReturnMessage: err.Error(),
+		}, nil
+	}
+
+	return &transaction.CostResponse{
+		GasUnits:      gasUnits,
+		ReturnMessage: "",
+	}, nil
+}
+
 func (tce *transactionCostEstimator) computeMoveBalanceCost(tx *transaction.Transaction) *transaction.CostResponse {
 	gasUnits := tce.feeHandler.ComputeGasLimit(tx)
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -31,7 +32,8 @@ func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 		&stateMock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilTxTypeHandler, err)
@@ -47,7 +49,8 @@ func TestTransactionCostEstimator_NilFeeHandlerShouldErr(t *testing.T) {
 		&stateMock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -63,7 +66,8 @@ func TestTransactionCostEstimator_NilTransactionSimulatorShouldErr(t *testing.T)
 		&stateMock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	require.Nil(t, tce)
 	require.Equal(t, txsimulator.ErrNilTxSimulatorProcessor, err)
@@ -79,7 +83,8 @@ func TestTransactionCostEstimator_NilEpochNotifierShouldErr(t *testing.T) {
 		&stateMock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		nil,
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilEpochNotifier, err)
@@ -95,12 +100,30 @@ func TestTransactionCostEstimator_Ok(t *testing.T) {
 		&stateMock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	require.Nil(t, err)
 	require.False(t, check.IfNil(tce))
 }
 
+func TestTransactionCostEstimator_NilHistoricalScCallGasLimitComputer(t *testing.T) {
+	t.Parallel()
+
+	tce, err := NewTransactionCostEstimator(
+		&testscommon.TxTypeHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.TransactionSimulatorStub{},
+		&stateMock.AccountsStub{},
+		&mock.ShardCoordinatorStub{},
+		&epochNotifier.EpochNotifierStub{},
+		0,
+		nil)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilHistoricalScCallGasLimitComputer, err)
+}
+
 func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 	t.Parallel()
 
@@ -126,7 +149,8 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 		},
 	}, &mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -160,7 +184,8 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 		},
 	}, &mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -194,7 +219,8 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 			},
 		}, &mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -223,7 +249,8 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 			},
 		}, &mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -251,7 +278,8 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 			},
 		}, &mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -283,7 +311,8 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 			},
 		}, &mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -305,7 +334,8 @@ func TestTransactionCostEstimator_RelayedTxShouldErr(t *testing.T) {
 		&stateMock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		&epochNotifier.EpochNotifierStub{},
-		0)
+		0,
+		&mock.ScQueryStub{})
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -328,3 +358,76 @@ func TestExtractGasUsedFromMessage(t *testing.T) {
 	require.Equal(t, uint64(0), extractGasRemainedFromMessage("", gasRemainedSplitString))
 	require.Equal(t, uint64(0), extractGasRemainedFromMessage("too much gas provided, gas needed = 10000, gas used = wrong", gasUsedSlitString))
 }
+
+func TestComputeTransactionGasLimitInBlock(t *testing.T) {
+	t.Parallel()
+
+	coordinates := process.BlockCoordinates{Nonce: core.OptionalUint64{Value: 7, HasValue: true}}
+	createEstimator := func(txType process.TransactionType, historicalGas process.HistoricalScCallGasLimitComputer) *transactionCostEstimator {
+		tce, _ := NewTransactionCostEstimator(
+			&testscommon.TxTypeHandlerMock{
+				ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+					return txType, txType
+				},
+			},
+			&mock.FeeHandlerStub{
+				ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+					return 50000
+				},
+			},
+			&mock.TransactionSimulatorStub{
+				ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+					require.Fail(t, "should not have simulated the transaction")
+					return nil, nil
+				},
+			},
+			&stateMock.AccountsStub{},
+			&mock.ShardCoordinatorStub{},
+			&epochNotifier.EpochNotifierStub{},
+			0,
+			historicalGas)
+
+		return tce
+	}
+
+	t.Run("move balance should use the fee handler", func(t *testing.T) {
+		tce := createEstimator(process.MoveBalance, &mock.ScQueryStub{})
+
+		cost, err := tce.ComputeTransactionGasLimitInBlock(&transaction.Transaction{}, coordinates)
+		require.Nil(t, err)
+		require.Equal(t, uint64(50000), cost.GasUnits)
+	})
+	t.Run("smart contract call should use the historical gas computer", func(t *testing.T) {
+		tce := createEstimator(process.SCInvoking, &mock.ScQueryStub{
+			ComputeScCallGasLimitInBlockHandler: func(tx *transaction.Transaction, providedCoordinates process.BlockCoordinates) (uint64, error) {
+				require.Equal(t, coordinates, providedCoordinates)
+				return 1234, nil
+			},
+		})
+
+		cost, err := tce.ComputeTransactionGasLimitInBlock(&transaction.Transaction{}, coordinates)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1234), cost.GasUnits)
+		require.Empty(t, cost.ReturnMessage)
+	})
+	t.Run("smart contract call error should be returned as message", func(t *testing.T) {
+		tce := createEstimator(process.SCInvoking, &mock.ScQueryStub{
+			ComputeScCallGasLimitInBlockHandler: func(tx *transaction.Transaction, providedCoordinates process.BlockCoordinates) (uint64, error) {
+				return 0, errors.New("trie not found")
+			},
+		})
+
+		cost, err := tce.ComputeTransactionGasLimitInBlock(&transaction.Transaction{}, coordinates)
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), cost.GasUnits)
+		require.Equal(t, "trie not found", cost.ReturnMessage)
+	})
+	t.Run("other transaction types are not supported", func(t *testing.T) {
+		tce := createEstimator(process.SCDeployment, &mock.ScQueryStub{})
+
+		cost, err := tce.ComputeTransactionGasLimitInBlock(&transaction.Transaction{}, coordinates)
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), cost.GasUnits)
+		require.Contains(t, cost.ReturnMessage, "at a past block")
+	})
+}
@@ -1,16 +1,77 @@
 package data
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
-	Status     transaction.TxStatus                           `json:"status,omitempty"`
-	FailReason string                                         `json:"failReason,omitempty"`
-	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
-	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
-	Hash       string                                         `json:"hash,omitempty"`
-	VMOutput   *vmcommon.VMOutput                             `json:"-"`
+	Status       transaction.TxStatus                           `json:"status,omitempty"`
+	FailReason   string                                         `json:"failReason,omitempty"`
+	ScResults    map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts     map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
+	Logs         *transaction.ApiLogs                           `json:"logs,omitempty"`
+	GasUsed      uint64                                         `json:"gasUsed,omitempty"`
+	StateChanges []*AccountStateChanges                         `json:"stateChanges,omitempty"`
+	Trace        *tracing.ExecutionTrace                        `json:"trace,omitempty"`
+	Hash         string                                         `json:"hash,omitempty"`
+	VMOutput     *vmcommon.VMOutput                             `json:"-"`
+}
+
+// AccountStateChanges holds the changes a simulated transaction would make on an account, as before/after pairs
+type AccountStateChanges struct {
+	Address string               `json:"address"`
+	Balance *BalanceChange       `json:"balance,omitempty"`
+	Nonce   *NonceChange         `json:"nonce,omitempty"`
+	ESDT    []*ESDTBalanceChange `json:"esdt,omitempty"`
+	Storage []*StorageChange     `json:"storage,omitempty"`
+}
+
+// BalanceChange holds the balance of an account before and after the simulated transaction
+type BalanceChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// NonceChange holds the nonce of an account before and after the simulated transaction
+type NonceChange struct {
+	Before uint64 `json:"before"`
+	After  uint64 `json:"after"`
+}
+
+// ESDTBalanceChange holds the balance of an ESDT token (or of a token's nonce) before and after the simulated transaction
+type ESDTBalanceChange struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	Before          string `json:"before"`
+	After           string `json:"after"`
+}
+
+// StorageChange holds the hex encoded value of a storage key before and after the simulated transaction
+type StorageChange struct {
+	Key    string `json:"key"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// RecordedAccountChanges holds the raw values of an account, as recorded by the accounts adapter, before and after
+// a simulated transaction
+type RecordedAccountChanges struct {
+	Address       []byte
+	BalanceBefore *big.Int
+	BalanceAfter  *big.Int
+	NonceBefore   uint64
+	NonceAfter    uint64
+	DataChanges   []*RecordedDataChange
+}
+
+// RecordedDataChange holds the raw value of a data trie key before and after a simulated transaction
+type RecordedDataChange struct {
+	Key    []byte
+	Before []byte
+	After  []byte
 }
@@ -25,3 +25,24 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilScratchAccountsAdapter signals that a nil scratch accounts adapter has been provided
+var ErrNilScratchAccountsAdapter = errors.New("trying to set nil scratch accounts adapter")
+
+// ErrSimulationSessionsNotSupported signals that the accounts adapter does not support simulation sessions
+var ErrSimulationSessionsNotSupported = errors.New("simulation sessions are not supported")
+
+// ErrSimulationSessionAlreadyStarted signals that a simulation session has already been started
+var ErrSimulationSessionAlreadyStarted = errors.New("simulation session already started")
+
+// ErrNilSessionHandler signals that a nil simulation session handler has been provided
+var ErrNilSessionHandler = errors.New("nil simulation session handler")
+
+// ErrNilFeeHandler signals that a nil fee handler has been provided
+var ErrNilFeeHandler = errors.New("nil fee handler")
+
+// ErrNoTransactionsToSimulate signals that an empty list of transactions has been provided for simulation
+var ErrNoTransactionsToSimulate = errors.New("no transactions to simulate")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
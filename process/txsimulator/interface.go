@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -11,3 +12,13 @@ type TransactionProcessor interface {
 	VerifyTransaction(transaction *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
+
+// SimulationSessionHandler defines a component able to keep the state changes between the transactions simulated
+// during the same session and to record, for each transaction, the changes done on the accounts
+type SimulationSessionHandler interface {
+	StartSession() error
+	EndSession()
+	StartRecordingStateChanges()
+	StopRecordingStateChanges() ([]*txSimData.RecordedAccountChanges, error)
+	IsInterfaceNil() bool
+}
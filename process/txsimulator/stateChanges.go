@@ -0,0 +1,111 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+func (ts *transactionSimulator) adaptStateChanges(recordedChanges []*txSimData.RecordedAccountChanges) []*txSimData.AccountStateChanges {
+	if len(recordedChanges) == 0 {
+		return nil
+	}
+
+	stateChanges := make([]*txSimData.AccountStateChanges, 0, len(recordedChanges))
+	for _, recorded := range recordedChanges {
+		stateChanges = append(stateChanges, ts.adaptAccountStateChanges(recorded))
+	}
+
+	return stateChanges
+}
+
+func (ts *transactionSimulator) adaptAccountStateChanges(recorded *txSimData.RecordedAccountChanges) *txSimData.AccountStateChanges {
+	accountChanges := &txSimData.AccountStateChanges{
+		Address: ts.addressPubKeyConverter.Encode(recorded.Address),
+	}
+
+	if recorded.BalanceBefore.Cmp(recorded.BalanceAfter) != 0 {
+		accountChanges.Balance = &txSimData.BalanceChange{
+			Before: recorded.BalanceBefore.String(),
+			After:  recorded.BalanceAfter.String(),
+		}
+	}
+	if recorded.NonceBefore != recorded.NonceAfter {
+		accountChanges.Nonce = &txSimData.NonceChange{
+			Before: recorded.NonceBefore,
+			After:  recorded.NonceAfter,
+		}
+	}
+
+	for _, dataChange := range recorded.DataChanges {
+		esdtChange, isESDT := ts.adaptESDTBalanceChange(dataChange)
+		if isESDT {
+			if esdtChange != nil {
+				accountChanges.ESDT = append(accountChanges.ESDT, esdtChange)
+			}
+			continue
+		}
+
+		accountChanges.Storage = append(accountChanges.Storage, &txSimData.StorageChange{
+			Key:    hex.EncodeToString(dataChange.Key),
+			Before: hex.EncodeToString(dataChange.Before),
+			After:  hex.EncodeToString(dataChange.After),
+		})
+	}
+
+	return accountChanges
+}
+
+// adaptESDTBalanceChange returns false if the data change is not done on an ESDT key or if the stored values can not
+// be decoded as ESDT tokens, in which case the change should be treated as a generic storage change. A nil change is
+// returned when only the token's properties or metadata changed, but not its balance
+func (ts *transactionSimulator) adaptESDTBalanceChange(dataChange *txSimData.RecordedDataChange) (*txSimData.ESDTBalanceChange, bool) {
+	if !bytes.HasPrefix(dataChange.Key, esdtKeyPrefix) {
+		return nil, false
+	}
+
+	balanceBefore, err := ts.getESDTBalance(dataChange.Before)
+	if err != nil {
+		return nil, false
+	}
+	balanceAfter, err := ts.getESDTBalance(dataChange.After)
+	if err != nil {
+		return nil, false
+	}
+	if balanceBefore.Cmp(balanceAfter) == 0 {
+		return nil, true
+	}
+
+	tokenID, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey(dataChange.Key[len(esdtKeyPrefix):])
+
+	return &txSimData.ESDTBalanceChange{
+		TokenIdentifier: string(tokenID),
+		Nonce:           nonce,
+		Before:          balanceBefore.String(),
+		After:           balanceAfter.String(),
+	}, true
+}
+
+func (ts *transactionSimulator) getESDTBalance(marshalledToken []byte) (*big.Int, error) {
+	if len(marshalledToken) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	token := &esdt.ESDigitalToken{}
+	err := ts.marshalizer.Unmarshal(token, marshalledToken)
+	if err != nil {
+		return nil, err
+	}
+	if token.Value == nil {
+		return big.NewInt(0), nil
+	}
+
+	return token.Value, nil
+}
@@ -0,0 +1,112 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionSimulator_AdaptStateChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no recorded changes", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+		require.Nil(t, ts.adaptStateChanges(nil))
+	})
+	t.Run("balance and nonce changes", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+		stateChanges := ts.adaptStateChanges([]*txSimData.RecordedAccountChanges{
+			{
+				Address:       []byte("sender"),
+				BalanceBefore: big.NewInt(1000),
+				BalanceAfter:  big.NewInt(900),
+				NonceBefore:   5,
+				NonceAfter:    6,
+			},
+			{
+				Address:       []byte("receiver"),
+				BalanceBefore: big.NewInt(0),
+				BalanceAfter:  big.NewInt(100),
+				NonceBefore:   3,
+				NonceAfter:    3,
+			},
+		})
+
+		require.Equal(t, []*txSimData.AccountStateChanges{
+			{
+				Address: hex.EncodeToString([]byte("sender")),
+				Balance: &txSimData.BalanceChange{Before: "1000", After: "900"},
+				Nonce:   &txSimData.NonceChange{Before: 5, After: 6},
+			},
+			{
+				Address: hex.EncodeToString([]byte("receiver")),
+				Balance: &txSimData.BalanceChange{Before: "0", After: "100"},
+			},
+		}, stateChanges)
+	})
+	t.Run("esdt and storage changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := getTxSimulatorArgs()
+		ts, _ := NewTransactionSimulator(args)
+
+		marshalToken := func(value int64) []byte {
+			buff, _ := args.Marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(value)})
+			return buff
+		}
+		esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+		nftKey := append([]byte(esdtPrefix+"NFT-0a1b2c"), big.NewInt(7).Bytes()...)
+
+		stateChanges := ts.adaptStateChanges([]*txSimData.RecordedAccountChanges{
+			{
+				Address:       []byte("contract"),
+				BalanceBefore: big.NewInt(10),
+				BalanceAfter:  big.NewInt(10),
+				DataChanges: []*txSimData.RecordedDataChange{
+					{
+						Key:    []byte(esdtPrefix + "TKN-1q2w3e"),
+						Before: marshalToken(50),
+						After:  marshalToken(30),
+					},
+					{
+						Key:    nftKey,
+						Before: nil,
+						After:  marshalToken(1),
+					},
+					{
+						Key:    []byte(esdtPrefix + "SAME-1q2w3e"),
+						Before: marshalToken(1),
+						After:  marshalToken(1),
+					},
+					{
+						Key:    []byte("counter"),
+						Before: []byte{1},
+						After:  []byte{2},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, []*txSimData.AccountStateChanges{
+			{
+				Address: hex.EncodeToString([]byte("contract")),
+				ESDT: []*txSimData.ESDTBalanceChange{
+					{TokenIdentifier: "TKN-1q2w3e", Before: "50", After: "30"},
+					{TokenIdentifier: "NFT-0a1b2c", Nonce: 7, Before: "0", After: "1"},
+				},
+				Storage: []*txSimData.StorageChange{
+					{Key: hex.EncodeToString([]byte("counter")), Before: "01", After: "02"},
+				},
+			},
+		}, stateChanges)
+	})
+}
@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"errors"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -10,13 +11,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
 
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
@@ -28,6 +32,9 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	SessionHandler            SimulationSessionHandler
+	FeeHandler                process.FeeHandler
+	ExecutionTracer           process.ExecutionTraceRecorder
 }
 
 type transactionSimulator struct {
@@ -39,6 +46,10 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	sessionHandler         SimulationSessionHandler
+	feeHandler             process.FeeHandler
+	executionTracer        process.ExecutionTraceRecorder
+	argsParser             process.CallArgumentsParser
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -64,6 +75,15 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.SessionHandler) {
+		return nil, ErrNilSessionHandler
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, ErrNilFeeHandler
+	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, ErrNilExecutionTracer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -73,18 +93,76 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		sessionHandler:         args.SessionHandler,
+		feeHandler:             args.FeeHandler,
+		executionTracer:        args.ExecutionTracer,
+		argsParser:             parsers.NewCallArgsParser(),
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. If the
+// session handler supports it, the transaction is processed in its own session, so that its state changes can be
+// recorded and returned
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
+	err := ts.sessionHandler.StartSession()
+	if err != nil && !errors.Is(err, ErrSimulationSessionsNotSupported) {
+		return nil, err
+	}
+	if err == nil {
+		defer ts.sessionHandler.EndSession()
+	}
+
+	return ts.processTx(tx)
+}
+
+// ProcessTxs will process the provided transactions, in order, in a special environment where the state changes of
+// each transaction are visible to the following ones, but are discarded at the end. If stopOnFailure is set, the
+// processing stops after the first transaction that did not succeed
+func (ts *transactionSimulator) ProcessTxs(txs []*transaction.Transaction, stopOnFailure bool) ([]*txSimData.SimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrNoTransactionsToSimulate
+	}
+
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	err := ts.sessionHandler.StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ts.sessionHandler.EndSession()
+
+	allResults := make([]*txSimData.SimulationResults, 0, len(txs))
+	for _, tx := range txs {
+		results, errProcess := ts.processTx(tx)
+		if errProcess != nil {
+			return nil, errProcess
+		}
+
+		allResults = append(allResults, results)
+		if stopOnFailure && results.Status != transaction.TxStatusSuccess {
+			break
+		}
+	}
+
+	return allResults, nil
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
+	ts.sessionHandler.StartRecordingStateChanges()
+	ts.executionTracer.StartRecording()
 	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+	recordedChanges, errRecording := ts.sessionHandler.StopRecordingStateChanges()
+	if errRecording != nil {
+		return nil, errRecording
+	}
+
 	if err != nil {
 		failReason = err.Error()
 		txStatus = transaction.TxStatusFail
@@ -107,11 +185,86 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 	vmOutput, ok := ts.getVMOutputOfTx(tx)
 	if ok {
 		results.VMOutput = vmOutput
+		results.Logs = ts.adaptLogs(tx, vmOutput.Logs)
 	}
+	results.Trace = ts.stopTracing(tx, vmOutput)
+	results.GasUsed = ts.computeGasUsed(tx, vmOutput, txStatus)
+	results.StateChanges = ts.adaptStateChanges(recordedChanges)
 
 	return results, nil
 }
 
+// stopTracing stops the execution tracer and returns the trace of the transaction. Transactions that did not reach
+// the virtual machines, such as the move balance ones, have no trace
+func (ts *transactionSimulator) stopTracing(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) *tracing.ExecutionTrace {
+	if vmOutput == nil {
+		_ = ts.executionTracer.StopRecording(nil, nil)
+		return nil
+	}
+
+	return ts.executionTracer.StopRecording(ts.createTracedCallInput(tx), vmOutput)
+}
+
+func (ts *transactionSimulator) createTracedCallInput(tx *transaction.Transaction) *vmcommon.ContractCallInput {
+	callInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  tx.SndAddr,
+			CallValue:   tx.Value,
+			GasPrice:    tx.GasPrice,
+			GasProvided: tx.GasLimit,
+			CallType:    vmData.DirectCall,
+		},
+		RecipientAddr: tx.RcvAddr,
+	}
+	if core.IsEmptyAddress(tx.RcvAddr) {
+		return callInput
+	}
+
+	function, arguments, err := ts.argsParser.ParseData(string(tx.Data))
+	if err == nil {
+		callInput.Function = function
+		callInput.Arguments = arguments
+	}
+
+	return callInput
+}
+
+func (ts *transactionSimulator) computeGasUsed(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput, txStatus transaction.TxStatus) uint64 {
+	if vmOutput != nil {
+		if vmOutput.GasRemaining > tx.GasLimit {
+			return 0
+		}
+
+		return tx.GasLimit - vmOutput.GasRemaining
+	}
+	if txStatus == transaction.TxStatusFail {
+		return 0
+	}
+
+	return ts.feeHandler.ComputeGasLimit(tx)
+}
+
+func (ts *transactionSimulator) adaptLogs(tx *transaction.Transaction, logEntries []*vmcommon.LogEntry) *transaction.ApiLogs {
+	if len(logEntries) == 0 {
+		return nil
+	}
+
+	events := make([]*transaction.Events, 0, len(logEntries))
+	for _, logEntry := range logEntries {
+		events = append(events, &transaction.Events{
+			Address:    ts.addressPubKeyConverter.Encode(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     logEntry.Topics,
+			Data:       logEntry.Data,
+		})
+	}
+
+	return &transaction.ApiLogs{
+		Address: ts.addressPubKeyConverter.Encode(tx.RcvAddr),
+		Events:  events,
+	}
+}
+
 func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
 	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
 	if err != nil {
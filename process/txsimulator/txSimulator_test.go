@@ -3,6 +3,7 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -93,6 +96,33 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilSessionHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SessionHandler = nil
+				return args
+			},
+			exError: ErrNilSessionHandler,
+		},
+		{
+			name: "NilFeeHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.FeeHandler = nil
+				return args
+			},
+			exError: ErrNilFeeHandler,
+		},
+		{
+			name: "NilExecutionTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.ExecutionTracer = nil
+				return args
+			},
+			exError: ErrNilExecutionTracer,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -229,6 +259,9 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &hashingMocks.HasherMock{},
+		SessionHandler:            &testscommon.SimulationSessionHandlerStub{},
+		FeeHandler:                &mock.FeeHandlerStub{},
+		ExecutionTracer:           &testscommon.ExecutionTracerStub{},
 	}
 }
 
@@ -262,3 +295,386 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	wg.Wait()
 	assert.Equal(t, numCalls, numTransactionProcessorCalls)
 }
+
+func TestTransactionSimulator_ProcessTxShouldComputeGasUsedAndLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with vm output", func(t *testing.T) {
+		t.Parallel()
+
+		args := getTxSimulatorArgs()
+		args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+			Type:     storageUnit.LRUCache,
+			Capacity: 100,
+		})
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.Ok, nil
+			},
+		}
+		args.FeeHandler = &mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(_ data.TransactionWithFeeHandler) uint64 {
+				require.Fail(t, "should have not been called")
+				return 0
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		tx := &transaction.Transaction{Nonce: 37, GasLimit: 1000, RcvAddr: []byte("receiver")}
+		txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+		args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{
+			GasRemaining: 400,
+			Logs: []*vmcommon.LogEntry{
+				{
+					Identifier: []byte("transfer"),
+					Address:    []byte("contract"),
+					Topics:     [][]byte{[]byte("topic")},
+					Data:       []byte("data"),
+				},
+			},
+		}, 0)
+
+		results, err := ts.ProcessTx(tx)
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+		require.Equal(t, uint64(600), results.GasUsed)
+		require.Equal(t, hex.EncodeToString([]byte("receiver")), results.Logs.Address)
+		require.Len(t, results.Logs.Events, 1)
+		require.Equal(t, "transfer", results.Logs.Events[0].Identifier)
+		require.Equal(t, hex.EncodeToString([]byte("contract")), results.Logs.Events[0].Address)
+	})
+	t.Run("move balance", func(t *testing.T) {
+		t.Parallel()
+
+		args := getTxSimulatorArgs()
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.Ok, nil
+			},
+		}
+		args.FeeHandler = &mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(_ data.TransactionWithFeeHandler) uint64 {
+				return 50000
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37, GasLimit: 70000})
+		require.NoError(t, err)
+		require.Equal(t, uint64(50000), results.GasUsed)
+		require.Nil(t, results.Logs)
+	})
+	t.Run("failed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		args := getTxSimulatorArgs()
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.UserError, errors.New("insufficient funds")
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37, GasLimit: 70000})
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusFail, results.Status)
+		require.Zero(t, results.GasUsed)
+	})
+}
+
+func TestTransactionSimulator_ProcessTxShouldRecordTheTrace(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func(startRecording func(), stopRecording func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace) ArgsTxSimulator {
+		args := getTxSimulatorArgs()
+		args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+			Type:     storageUnit.LRUCache,
+			Capacity: 100,
+		})
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			StartRecordingCalled: startRecording,
+			StopRecordingCalled:  stopRecording,
+		}
+
+		return args
+	}
+
+	t.Run("with vm output", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTrace := &tracing.ExecutionTrace{}
+		isRecording := false
+		args := createArgs(
+			func() {
+				isRecording = true
+			},
+			func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace {
+				isRecording = false
+				assert.Equal(t, []byte("sender"), input.CallerAddr)
+				assert.Equal(t, []byte("receiver"), input.RecipientAddr)
+				assert.Equal(t, "function", input.Function)
+				assert.Equal(t, [][]byte{{1}, {2}}, input.Arguments)
+				assert.Equal(t, big.NewInt(10), input.CallValue)
+				assert.Equal(t, uint64(1000), input.GasProvided)
+				assert.Equal(t, uint64(400), output.GasRemaining)
+
+				return expectedTrace
+			},
+		)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				assert.True(t, isRecording)
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		tx := &transaction.Transaction{
+			Value:    big.NewInt(10),
+			GasLimit: 1000,
+			SndAddr:  []byte("sender"),
+			RcvAddr:  []byte("receiver"),
+			Data:     []byte("function@01@02"),
+		}
+		txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+		args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{GasRemaining: 400}, 0)
+
+		results, err := ts.ProcessTx(tx)
+		require.NoError(t, err)
+		require.False(t, isRecording)
+		require.True(t, expectedTrace == results.Trace)
+	})
+	t.Run("without vm output should not return a trace", func(t *testing.T) {
+		t.Parallel()
+
+		isRecording := false
+		args := createArgs(
+			func() {
+				isRecording = true
+			},
+			func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace {
+				isRecording = false
+				assert.Nil(t, input)
+				assert.Nil(t, output)
+
+				return &tracing.ExecutionTrace{}
+			},
+		)
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Value: big.NewInt(10), RcvAddr: []byte("receiver")})
+		require.NoError(t, err)
+		require.False(t, isRecording)
+		require.Nil(t, results.Trace)
+	})
+}
+
+func TestTransactionSimulator_ProcessTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+		results, err := ts.ProcessTxs(nil, false)
+		require.Nil(t, results)
+		require.Equal(t, ErrNoTransactionsToSimulate, err)
+	})
+	t.Run("start session fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			StartSessionCalled: func() error {
+				return expectedErr
+			},
+			EndSessionCalled: func() {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.Fail(t, "should have not been called")
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTxs([]*transaction.Transaction{{Nonce: 1}}, false)
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should process all transactions in the same session", func(t *testing.T) {
+		t.Parallel()
+
+		sessionActive := false
+		numStartSessionCalls := 0
+		numEndSessionCalls := 0
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			StartSessionCalled: func() error {
+				numStartSessionCalls++
+				sessionActive = true
+				return nil
+			},
+			EndSessionCalled: func() {
+				numEndSessionCalls++
+				sessionActive = false
+			},
+		}
+		processedNonces := make([]uint64, 0)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.True(t, sessionActive)
+				processedNonces = append(processedNonces, tx.Nonce)
+				if tx.Nonce == 2 {
+					return vmcommon.UserError, errors.New("execution failed")
+				}
+
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		txs := []*transaction.Transaction{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}}
+		results, err := ts.ProcessTxs(txs, false)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.Equal(t, transaction.TxStatusSuccess, results[0].Status)
+		require.Equal(t, transaction.TxStatusFail, results[1].Status)
+		require.Equal(t, transaction.TxStatusSuccess, results[2].Status)
+		require.Equal(t, []uint64{1, 2, 3}, processedNonces)
+		require.Equal(t, 1, numStartSessionCalls)
+		require.Equal(t, 1, numEndSessionCalls)
+	})
+	t.Run("stop on failure should not process the following transactions", func(t *testing.T) {
+		t.Parallel()
+
+		numEndSessionCalls := 0
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			EndSessionCalled: func() {
+				numEndSessionCalls++
+			},
+		}
+		processedNonces := make([]uint64, 0)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				processedNonces = append(processedNonces, tx.Nonce)
+				if tx.Nonce == 2 {
+					return vmcommon.UserError, errors.New("execution failed")
+				}
+
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		txs := []*transaction.Transaction{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}}
+		results, err := ts.ProcessTxs(txs, true)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, "execution failed", results[1].FailReason)
+		require.Equal(t, []uint64{1, 2}, processedNonces)
+		require.Equal(t, 1, numEndSessionCalls)
+	})
+}
+
+func TestTransactionSimulator_ProcessTxSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sessions not supported should process the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			StartSessionCalled: func() error {
+				return ErrSimulationSessionsNotSupported
+			},
+			EndSessionCalled: func() {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+		require.NoError(t, err)
+		require.NotNil(t, results)
+	})
+	t.Run("start session fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			StartSessionCalled: func() error {
+				return expectedErr
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("stop recording fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numEndSessionCalls := 0
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			EndSessionCalled: func() {
+				numEndSessionCalls++
+			},
+			StopRecordingStateChangesCalled: func() ([]*txSimData.RecordedAccountChanges, error) {
+				return nil, expectedErr
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+		require.Equal(t, 1, numEndSessionCalls)
+	})
+	t.Run("should return the recorded state changes", func(t *testing.T) {
+		t.Parallel()
+
+		isRecording := false
+		args := getTxSimulatorArgs()
+		args.SessionHandler = &testscommon.SimulationSessionHandlerStub{
+			StartRecordingStateChangesCalled: func() {
+				isRecording = true
+			},
+			StopRecordingStateChangesCalled: func() ([]*txSimData.RecordedAccountChanges, error) {
+				isRecording = false
+				return []*txSimData.RecordedAccountChanges{
+					{
+						Address:       []byte("sender"),
+						BalanceBefore: big.NewInt(100),
+						BalanceAfter:  big.NewInt(90),
+						NonceBefore:   37,
+						NonceAfter:    38,
+					},
+				}, nil
+			},
+		}
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.True(t, isRecording)
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+		require.NoError(t, err)
+		require.Len(t, results.StateChanges, 1)
+		require.Equal(t, hex.EncodeToString([]byte("sender")), results.StateChanges[0].Address)
+		require.Equal(t, &txSimData.BalanceChange{Before: "100", After: "90"}, results.StateChanges[0].Balance)
+		require.Equal(t, &txSimData.NonceChange{Before: 37, After: 38}, results.StateChanges[0].Nonce)
+	})
+}
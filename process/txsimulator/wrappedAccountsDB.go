@@ -1,18 +1,41 @@
 package txsimulator
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled, except
+// during a simulation session, when they are applied on a scratch accounts db, discarded when the session ends
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+	scratchAccounts  state.AccountsAdapter
+	mutSession       sync.RWMutex
+	sessionActive    bool
+
+	mutRecording     sync.Mutex
+	isRecording      bool
+	recordedAccounts []*recordedAccount
+	recordedIndex    map[string]*recordedAccount
+}
+
+// recordedAccount holds the values of an account, as they were before the first write done on it while recording
+type recordedAccount struct {
+	address       []byte
+	balanceBefore *big.Int
+	nonceBefore   uint64
+	dataBefore    map[string][]byte
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
@@ -24,38 +47,311 @@ func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsD
 	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
 }
 
+// NewReadOnlyAccountsDBWithSessions returns a new instance of readOnlyAccountsDB able to chain the state changes of
+// multiple transactions, during a simulation session, on the provided scratch accounts db
+func NewReadOnlyAccountsDBWithSessions(accountsDB state.AccountsAdapter, scratchAccountsDB state.AccountsAdapter) (*readOnlyAccountsDB, error) {
+	if check.IfNil(scratchAccountsDB) {
+		return nil, ErrNilScratchAccountsAdapter
+	}
+
+	readOnlyAccounts, err := NewReadOnlyAccountsDB(accountsDB)
+	if err != nil {
+		return nil, err
+	}
+	readOnlyAccounts.scratchAccounts = scratchAccountsDB
+
+	return readOnlyAccounts, nil
+}
+
+// StartSession moves the scratch accounts db on the current state of the original accounts db and routes all the
+// following operations towards it, until EndSession is called
+func (r *readOnlyAccountsDB) StartSession() error {
+	if check.IfNil(r.scratchAccounts) {
+		return ErrSimulationSessionsNotSupported
+	}
+
+	r.mutSession.Lock()
+	defer r.mutSession.Unlock()
+
+	if r.sessionActive {
+		return ErrSimulationSessionAlreadyStarted
+	}
+
+	rootHash, err := r.originalAccounts.RootHash()
+	if err != nil {
+		return err
+	}
+
+	err = r.scratchAccounts.RecreateTrie(rootHash)
+	if err != nil {
+		return err
+	}
+
+	r.sessionActive = true
+
+	return nil
+}
+
+// EndSession discards all the changes done during the current session and routes the following operations
+// back towards the original accounts db
+func (r *readOnlyAccountsDB) EndSession() {
+	r.mutSession.Lock()
+	r.sessionActive = false
+	r.mutSession.Unlock()
+
+	r.mutRecording.Lock()
+	r.resetRecording()
+	r.mutRecording.Unlock()
+}
+
+// StartRecordingStateChanges starts recording the accounts written during the current session, so that their state
+// changes can be computed by StopRecordingStateChanges. The recording is done only during a session
+func (r *readOnlyAccountsDB) StartRecordingStateChanges() {
+	r.mutRecording.Lock()
+	defer r.mutRecording.Unlock()
+
+	r.resetRecording()
+	r.isRecording = r.isSessionActive()
+}
+
+// StopRecordingStateChanges stops the recording and returns, for each recorded account, the values before the first
+// write and the current values, as seen on the scratch accounts db. Unchanged accounts and data keys are omitted
+func (r *readOnlyAccountsDB) StopRecordingStateChanges() ([]*txSimData.RecordedAccountChanges, error) {
+	r.mutRecording.Lock()
+	defer r.mutRecording.Unlock()
+
+	if !r.isRecording {
+		return nil, nil
+	}
+
+	recordedAccounts := r.recordedAccounts
+	r.resetRecording()
+
+	allChanges := make([]*txSimData.RecordedAccountChanges, 0, len(recordedAccounts))
+	for _, recorded := range recordedAccounts {
+		changes, err := r.computeAccountChanges(recorded)
+		if err != nil {
+			return nil, err
+		}
+		if changes == nil {
+			continue
+		}
+
+		allChanges = append(allChanges, changes)
+	}
+
+	return allChanges, nil
+}
+
+func (r *readOnlyAccountsDB) resetRecording() {
+	r.isRecording = false
+	r.recordedAccounts = make([]*recordedAccount, 0)
+	r.recordedIndex = make(map[string]*recordedAccount)
+}
+
+// recordAccount must be called before writing the account on the scratch accounts db, as to capture the values
+// of the account and of the dirty data keys as they were before the write
+func (r *readOnlyAccountsDB) recordAccount(address []byte, dirtyData map[string][]byte) error {
+	r.mutRecording.Lock()
+	defer r.mutRecording.Unlock()
+
+	if !r.isRecording {
+		return nil
+	}
+
+	previousAccount, err := r.getExistingUserAccount(address)
+	if err != nil {
+		return err
+	}
+
+	recorded, found := r.recordedIndex[string(address)]
+	if !found {
+		recorded = &recordedAccount{
+			address:       address,
+			balanceBefore: big.NewInt(0),
+			dataBefore:    make(map[string][]byte),
+		}
+		if !check.IfNil(previousAccount) {
+			recorded.balanceBefore = big.NewInt(0).Set(previousAccount.GetBalance())
+			recorded.nonceBefore = previousAccount.GetNonce()
+		}
+
+		r.recordedIndex[string(address)] = recorded
+		r.recordedAccounts = append(r.recordedAccounts, recorded)
+	}
+
+	for key := range dirtyData {
+		_, alreadyRecorded := recorded.dataBefore[key]
+		if alreadyRecorded {
+			// the first write on this key is the one holding the value before the transaction
+			continue
+		}
+
+		recorded.dataBefore[key] = retrieveDataValue(previousAccount, []byte(key))
+	}
+
+	return nil
+}
+
+func (r *readOnlyAccountsDB) computeAccountChanges(recorded *recordedAccount) (*txSimData.RecordedAccountChanges, error) {
+	currentAccount, err := r.getExistingUserAccount(recorded.address)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &txSimData.RecordedAccountChanges{
+		Address:       recorded.address,
+		BalanceBefore: recorded.balanceBefore,
+		BalanceAfter:  big.NewInt(0),
+		NonceBefore:   recorded.nonceBefore,
+		DataChanges:   make([]*txSimData.RecordedDataChange, 0),
+	}
+	if !check.IfNil(currentAccount) {
+		changes.BalanceAfter = big.NewInt(0).Set(currentAccount.GetBalance())
+		changes.NonceAfter = currentAccount.GetNonce()
+	}
+
+	keys := make([]string, 0, len(recorded.dataBefore))
+	for key := range recorded.dataBefore {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		before := recorded.dataBefore[key]
+		after := retrieveDataValue(currentAccount, []byte(key))
+		if bytes.Equal(before, after) {
+			continue
+		}
+
+		changes.DataChanges = append(changes.DataChanges, &txSimData.RecordedDataChange{
+			Key:    []byte(key),
+			Before: before,
+			After:  after,
+		})
+	}
+
+	isUnchanged := changes.BalanceBefore.Cmp(changes.BalanceAfter) == 0 &&
+		changes.NonceBefore == changes.NonceAfter &&
+		len(changes.DataChanges) == 0
+	if isUnchanged {
+		return nil, nil
+	}
+
+	return changes, nil
+}
+
+func (r *readOnlyAccountsDB) getExistingUserAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := r.scratchAccounts.GetExistingAccount(address)
+	if errors.Is(err, state.ErrAccNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, nil
+	}
+
+	return userAccount, nil
+}
+
+func retrieveDataValue(account state.UserAccountHandler, key []byte) []byte {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	value, err := account.RetrieveValueFromDataTrieTracker(key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func (r *readOnlyAccountsDB) activeAccounts() state.AccountsAdapter {
+	r.mutSession.RLock()
+	defer r.mutSession.RUnlock()
+
+	if r.sessionActive {
+		return r.scratchAccounts
+	}
+
+	return r.originalAccounts
+}
+
+func (r *readOnlyAccountsDB) isSessionActive() bool {
+	r.mutSession.RLock()
+	defer r.mutSession.RUnlock()
+
+	return r.sessionActive
+}
+
 // StartSnapshotIfNeeded does nothing for this implementation
 func (r *readOnlyAccountsDB) StartSnapshotIfNeeded() {
 }
 
+func getDirtyData(account vmcommon.AccountHandler) map[string][]byte {
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok || check.IfNil(userAccount.DataTrieTracker()) {
+		return nil
+	}
+
+	return userAccount.DataTrieTracker().DirtyData()
+}
+
 // GetCode returns the code for the given account
 func (r *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
-	return r.originalAccounts.GetCode(codeHash)
+	return r.activeAccounts().GetCode(codeHash)
 }
 
 // GetExistingAccount will call the original accounts' function with the same name
 func (r *readOnlyAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetExistingAccount(address)
+	return r.activeAccounts().GetExistingAccount(address)
 }
 
 // GetAccountFromBytes will call the original accounts' function with the same name
 func (r *readOnlyAccountsDB) GetAccountFromBytes(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
+	return r.activeAccounts().GetAccountFromBytes(address, accountBytes)
 }
 
 // LoadAccount will call the original accounts' function with the same name
 func (r *readOnlyAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.LoadAccount(address)
+	return r.activeAccounts().LoadAccount(address)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) SaveAccount(_ vmcommon.AccountHandler) error {
-	return nil
+// SaveAccount won't do anything outside a simulation session as write operations are disabled on this component
+func (r *readOnlyAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	if !r.isSessionActive() {
+		return nil
+	}
+
+	if check.IfNil(account) {
+		return r.scratchAccounts.SaveAccount(account)
+	}
+
+	err := r.recordAccount(account.AddressBytes(), getDirtyData(account))
+	if err != nil {
+		return err
+	}
+
+	return r.scratchAccounts.SaveAccount(account)
 }
 
-// RemoveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
-	return nil
+// RemoveAccount won't do anything outside a simulation session as write operations are disabled on this component
+func (r *readOnlyAccountsDB) RemoveAccount(address []byte) error {
+	if !r.isSessionActive() {
+		return nil
+	}
+
+	err := r.recordAccount(address, nil)
+	if err != nil {
+		return err
+	}
+
+	return r.scratchAccounts.RemoveAccount(address)
 }
 
 // Commit won't do anything as write operations are disabled on this component
@@ -65,17 +361,21 @@ func (r *readOnlyAccountsDB) Commit() ([]byte, error) {
 
 // JournalLen will call the original accounts' function with the same name
 func (r *readOnlyAccountsDB) JournalLen() int {
-	return r.originalAccounts.JournalLen()
+	return r.activeAccounts().JournalLen()
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
-	return nil
+// RevertToSnapshot won't do anything outside a simulation session as write operations are disabled on this component
+func (r *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	if !r.isSessionActive() {
+		return nil
+	}
+
+	return r.scratchAccounts.RevertToSnapshot(snapshot)
 }
 
 // RootHash will call the original accounts' function with the same name
 func (r *readOnlyAccountsDB) RootHash() ([]byte, error) {
-	return r.originalAccounts.RootHash()
+	return r.activeAccounts().RootHash()
 }
 
 // RecreateTrie won't do anything as write operations are disabled on this component
@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -153,3 +154,215 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = roAccDb.GetAllLeaves(allLeaves, context.Background(), nil)
 	require.NoError(t, err)
 }
+
+func TestNewReadOnlyAccountsDBWithSessions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil scratch accounts db should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, err := NewReadOnlyAccountsDBWithSessions(&stateMock.AccountsStub{}, nil)
+		require.True(t, check.IfNil(roAccDb))
+		require.Equal(t, ErrNilScratchAccountsAdapter, err)
+	})
+	t.Run("nil original accounts db should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, err := NewReadOnlyAccountsDBWithSessions(nil, &stateMock.AccountsStub{})
+		require.True(t, check.IfNil(roAccDb))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, err := NewReadOnlyAccountsDBWithSessions(&stateMock.AccountsStub{}, &stateMock.AccountsStub{})
+		require.False(t, check.IfNil(roAccDb))
+		require.NoError(t, err)
+	})
+}
+
+func TestReadOnlyAccountsDB_StartSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without scratch accounts db should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+
+		err := roAccDb.StartSession()
+		require.Equal(t, ErrSimulationSessionsNotSupported, err)
+	})
+	t.Run("recreate trie fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scratchAccDb := &stateMock.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return expectedErr
+			},
+		}
+		roAccDb, _ := NewReadOnlyAccountsDBWithSessions(createOriginalAccountsStub(), scratchAccDb)
+
+		err := roAccDb.StartSession()
+		require.Equal(t, expectedErr, err)
+		require.False(t, roAccDb.isSessionActive())
+	})
+	t.Run("session already started should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDBWithSessions(createOriginalAccountsStub(), createScratchAccountsStub())
+
+		err := roAccDb.StartSession()
+		require.NoError(t, err)
+
+		err = roAccDb.StartSession()
+		require.Equal(t, ErrSimulationSessionAlreadyStarted, err)
+	})
+	t.Run("should recreate the scratch trie on the original root hash", func(t *testing.T) {
+		t.Parallel()
+
+		originalRootHash := []byte("original root hash")
+		var recreatedRootHash []byte
+		originalAccDb := &stateMock.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return originalRootHash, nil
+			},
+		}
+		scratchAccDb := &stateMock.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				recreatedRootHash = rootHash
+				return nil
+			},
+		}
+		roAccDb, _ := NewReadOnlyAccountsDBWithSessions(originalAccDb, scratchAccDb)
+
+		err := roAccDb.StartSession()
+		require.NoError(t, err)
+		require.Equal(t, originalRootHash, recreatedRootHash)
+		require.True(t, roAccDb.isSessionActive())
+
+		roAccDb.EndSession()
+		require.False(t, roAccDb.isSessionActive())
+	})
+}
+
+func TestReadOnlyAccountsDB_OperationsDuringSessionShouldUseScratchAccountsDB(t *testing.T) {
+	t.Parallel()
+
+	failErrMsg := "this function should have not be called"
+	originalAcc := &mock.AccountWrapMock{}
+	scratchAcc := &mock.AccountWrapMock{}
+	originalAccDb := createOriginalAccountsStub()
+	originalAccDb.LoadAccountCalled = func(_ []byte) (vmcommon.AccountHandler, error) {
+		return originalAcc, nil
+	}
+	originalAccDb.SaveAccountCalled = func(_ vmcommon.AccountHandler) error {
+		t.Errorf(failErrMsg)
+		return nil
+	}
+	savedAccounts := make([]vmcommon.AccountHandler, 0)
+	revertedSnapshots := make([]int, 0)
+	scratchAccDb := createScratchAccountsStub()
+	scratchAccDb.LoadAccountCalled = func(_ []byte) (vmcommon.AccountHandler, error) {
+		return scratchAcc, nil
+	}
+	scratchAccDb.GetExistingAccountCalled = func(_ []byte) (vmcommon.AccountHandler, error) {
+		return scratchAcc, nil
+	}
+	scratchAccDb.SaveAccountCalled = func(account vmcommon.AccountHandler) error {
+		savedAccounts = append(savedAccounts, account)
+		return nil
+	}
+	scratchAccDb.RevertToSnapshotCalled = func(snapshot int) error {
+		revertedSnapshots = append(revertedSnapshots, snapshot)
+		return nil
+	}
+	scratchAccDb.JournalLenCalled = func() int {
+		return 7
+	}
+	roAccDb, _ := NewReadOnlyAccountsDBWithSessions(originalAccDb, scratchAccDb)
+
+	err := roAccDb.SaveAccount(originalAcc)
+	require.NoError(t, err)
+	require.Empty(t, savedAccounts)
+
+	_ = roAccDb.StartSession()
+
+	acc, err := roAccDb.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	require.True(t, acc == scratchAcc)
+
+	acc, err = roAccDb.GetExistingAccount([]byte("address"))
+	require.NoError(t, err)
+	require.True(t, acc == scratchAcc)
+
+	err = roAccDb.SaveAccount(scratchAcc)
+	require.NoError(t, err)
+	require.Len(t, savedAccounts, 1)
+
+	require.Equal(t, 7, roAccDb.JournalLen())
+	err = roAccDb.RevertToSnapshot(3)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, revertedSnapshots)
+
+	roAccDb.EndSession()
+
+	acc, err = roAccDb.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	require.True(t, acc == originalAcc)
+}
+
+func createOriginalAccountsStub() *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+	}
+}
+
+func createScratchAccountsStub() *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		RecreateTrieCalled: func(_ []byte) error {
+			return nil
+		},
+	}
+}
+
+func TestReadOnlyAccountsDB_RecordingStateChangesOutsideSessionShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	scratchAccDb := createScratchAccountsStub()
+	scratchAccDb.GetExistingAccountCalled = func(_ []byte) (vmcommon.AccountHandler, error) {
+		require.Fail(t, "should have not been called")
+		return nil, nil
+	}
+	roAccDb, _ := NewReadOnlyAccountsDBWithSessions(createOriginalAccountsStub(), scratchAccDb)
+
+	roAccDb.StartRecordingStateChanges()
+	err := roAccDb.SaveAccount(&mock.AccountWrapMock{})
+	require.NoError(t, err)
+
+	changes, err := roAccDb.StopRecordingStateChanges()
+	require.NoError(t, err)
+	require.Nil(t, changes)
+}
+
+func TestReadOnlyAccountsDB_RecordingStateChangesGetAccountFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	scratchAccDb := createScratchAccountsStub()
+	scratchAccDb.GetExistingAccountCalled = func(_ []byte) (vmcommon.AccountHandler, error) {
+		return nil, expectedErr
+	}
+	scratchAccDb.SaveAccountCalled = func(_ vmcommon.AccountHandler) error {
+		require.Fail(t, "should have not been called")
+		return nil
+	}
+	roAccDb, _ := NewReadOnlyAccountsDBWithSessions(createOriginalAccountsStub(), scratchAccDb)
+
+	_ = roAccDb.StartSession()
+	roAccDb.StartRecordingStateChanges()
+	err := roAccDb.SaveAccount(mock.NewAccountWrapMock([]byte("address")))
+	require.Equal(t, expectedErr, err)
+}
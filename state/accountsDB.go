@@ -1186,7 +1186,15 @@ func (adb *AccountsDB) snapshotUserAccountDataTrie(
 	stats common.SnapshotStatisticsHandler,
 	epoch uint32,
 ) {
+	dataTriesStats := newDataTriesSnapshotStatistics(stats)
 	for leaf := range leavesChannel {
+		barrier, isBarrier := leaf.(common.SnapshotLeavesBarrier)
+		if isBarrier {
+			dataTriesStats.waitForDataTriesSnapshots()
+			barrier.Release()
+			continue
+		}
+
 		account := &userAccount{}
 		err := adb.marshaller.Unmarshal(account, leaf.Value())
 		if err != nil {
@@ -1198,15 +1206,15 @@ func (adb *AccountsDB) snapshotUserAccountDataTrie(
 			continue
 		}
 
-		stats.NewSnapshotStarted()
-		stats.NewDataTrie()
+		dataTriesStats.NewSnapshotStarted()
+		dataTriesStats.NewDataTrie()
 
 		if isSnapshot {
-			adb.mainTrie.GetStorageManager().TakeSnapshot(account.RootHash, mainTrieRootHash, nil, errChan, stats, epoch)
+			adb.mainTrie.GetStorageManager().TakeSnapshot(account.RootHash, mainTrieRootHash, nil, errChan, dataTriesStats, epoch)
 			continue
 		}
 
-		adb.mainTrie.GetStorageManager().SetCheckpoint(account.RootHash, mainTrieRootHash, nil, errChan, stats)
+		adb.mainTrie.GetStorageManager().SetCheckpoint(account.RootHash, mainTrieRootHash, nil, errChan, dataTriesStats)
 	}
 }
 
@@ -0,0 +1,180 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// DataTriesSortCriterion defines the criterion used to rank the data tries
+type DataTriesSortCriterion string
+
+const (
+	// SortByNumNodes ranks the data tries by their number of nodes, which drives the trie sync time
+	SortByNumNodes DataTriesSortCriterion = "numNodes"
+	// SortBySizeInBytes ranks the data tries by the size of their serialized nodes, which drives the snapshot size
+	SortBySizeInBytes DataTriesSortCriterion = "sizeInBytes"
+)
+
+// DataTrieStatistics holds the statistics of the data trie of an account
+type DataTrieStatistics struct {
+	Address  []byte
+	RootHash []byte
+	common.TrieStatisticsDTO
+}
+
+// NumNodes returns the total number of nodes of the data trie
+func (dts *DataTrieStatistics) NumNodes() uint64 {
+	return uint64(dts.Leaves + dts.Extensions + dts.Branches)
+}
+
+// DataTriesAnalysis holds the result of a data tries analysis
+type DataTriesAnalysis struct {
+	NumAccounts      uint64
+	NumDataTries     uint64
+	TotalNumNodes    uint64
+	TotalSizeInBytes uint64
+	Largest          []DataTrieStatistics
+}
+
+// ArgsDataTriesAnalysis holds the arguments needed to analyze the data tries of a state
+type ArgsDataTriesAnalysis struct {
+	Accounts    AccountsAdapter
+	Marshalizer marshal.Marshalizer
+	RootHash    []byte
+	NumLargest  int
+	SortBy      DataTriesSortCriterion
+}
+
+// AnalyzeDataTries walks all the accounts of the state identified by the root hash and computes, for each data trie,
+// the number of nodes, the depth and the size of the serialized nodes. Only the largest NumLargest data tries, ranked
+// by the provided criterion, are kept
+func AnalyzeDataTries(ctx context.Context, args ArgsDataTriesAnalysis) (*DataTriesAnalysis, error) {
+	err := checkArgsDataTriesAnalysis(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	mainTrie, err := args.Accounts.GetTrie(args.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	leavesChannel := make(chan core.KeyValueHolder, leavesChannelSize)
+	err = mainTrie.GetAllLeavesOnChannel(leavesChannel, walkCtx, args.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &DataTriesAnalysis{
+		Largest: make([]DataTrieStatistics, 0, args.NumLargest),
+	}
+	isLarger := getDataTriesComparer(args.SortBy)
+	for leaf := range leavesChannel {
+		analysis.NumAccounts++
+
+		account := &UserAccountData{}
+		err = args.Marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			return nil, fmt.Errorf("%w for account %x", err, leaf.Key())
+		}
+		if len(account.RootHash) == 0 {
+			continue
+		}
+
+		stats, err := mainTrie.GetTrieStatistics(walkCtx, account.RootHash)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the data trie of account %x", err, leaf.Key())
+		}
+
+		dataTrieStats := DataTrieStatistics{
+			Address:           leaf.Key(),
+			RootHash:          account.RootHash,
+			TrieStatisticsDTO: stats,
+		}
+		analysis.NumDataTries++
+		analysis.TotalNumNodes += dataTrieStats.NumNodes()
+		analysis.TotalSizeInBytes += stats.SizeInBytes
+		analysis.Largest = insertIfLarger(analysis.Largest, dataTrieStats, args.NumLargest, isLarger)
+	}
+
+	// the leaves channel is closed without any error when the context is done
+	err = ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return analysis, nil
+}
+
+func checkArgsDataTriesAnalysis(ctx context.Context, args ArgsDataTriesAnalysis) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if check.IfNil(args.Accounts) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if len(args.RootHash) == 0 {
+		return ErrNilRootHash
+	}
+	if args.NumLargest < 1 {
+		return ErrInvalidNumDataTries
+	}
+	if args.SortBy != SortByNumNodes && args.SortBy != SortBySizeInBytes {
+		return fmt.Errorf("%w: %s", ErrInvalidDataTriesSortCriterion, args.SortBy)
+	}
+
+	return nil
+}
+
+func getDataTriesComparer(sortBy DataTriesSortCriterion) func(a *DataTrieStatistics, b *DataTrieStatistics) bool {
+	if sortBy == SortBySizeInBytes {
+		return func(a *DataTrieStatistics, b *DataTrieStatistics) bool {
+			if a.SizeInBytes == b.SizeInBytes {
+				return a.NumNodes() > b.NumNodes()
+			}
+			return a.SizeInBytes > b.SizeInBytes
+		}
+	}
+
+	return func(a *DataTrieStatistics, b *DataTrieStatistics) bool {
+		if a.NumNodes() == b.NumNodes() {
+			return a.SizeInBytes > b.SizeInBytes
+		}
+		return a.NumNodes() > b.NumNodes()
+	}
+}
+
+// insertIfLarger keeps the provided slice sorted descending and at most maxLen long
+func insertIfLarger(
+	largest []DataTrieStatistics,
+	stats DataTrieStatistics,
+	maxLen int,
+	isLarger func(a *DataTrieStatistics, b *DataTrieStatistics) bool,
+) []DataTrieStatistics {
+	index := sort.Search(len(largest), func(i int) bool {
+		return isLarger(&stats, &largest[i])
+	})
+	if index >= maxLen {
+		return largest
+	}
+
+	if len(largest) < maxLen {
+		largest = append(largest, DataTrieStatistics{})
+	}
+	copy(largest[index+1:], largest[index:])
+	largest[index] = stats
+
+	return largest
+}
@@ -0,0 +1,160 @@
+package state_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsDataTriesAnalysis() state.ArgsDataTriesAnalysis {
+	return state.ArgsDataTriesAnalysis{
+		Accounts:    &stateMock.AccountsStub{},
+		Marshalizer: &testscommon.MarshalizerMock{},
+		RootHash:    []byte("root hash"),
+		NumLargest:  10,
+		SortBy:      state.SortByNumNodes,
+	}
+}
+
+func generateDataTrieValues(numValues int) map[string]string {
+	keysValues := make(map[string]string, numValues)
+	for i := 0; i < numValues; i++ {
+		keysValues[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
+	}
+
+	return keysValues
+}
+
+func TestAnalyzeDataTries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil context should error", func(t *testing.T) {
+		t.Parallel()
+
+		analysis, err := state.AnalyzeDataTries(nil, createArgsDataTriesAnalysis())
+		assert.Nil(t, analysis)
+		assert.Equal(t, state.ErrNilContext, err)
+	})
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDataTriesAnalysis()
+		args.Accounts = nil
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		assert.Nil(t, analysis)
+		assert.Equal(t, state.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDataTriesAnalysis()
+		args.Marshalizer = nil
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		assert.Nil(t, analysis)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDataTriesAnalysis()
+		args.RootHash = nil
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		assert.Nil(t, analysis)
+		assert.Equal(t, state.ErrNilRootHash, err)
+	})
+	t.Run("invalid number of data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDataTriesAnalysis()
+		args.NumLargest = 0
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		assert.Nil(t, analysis)
+		assert.Equal(t, state.ErrInvalidNumDataTries, err)
+	})
+	t.Run("invalid sort criterion should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDataTriesAnalysis()
+		args.SortBy = "invalid"
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		assert.Nil(t, analysis)
+		assert.True(t, errors.Is(err, state.ErrInvalidDataTriesSortCriterion))
+	})
+	t.Run("get trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgsDataTriesAnalysis()
+		args.Accounts = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		assert.Nil(t, analysis)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		addresses := generateAccounts(t, 2, adb)
+		saveDataTrieValues(t, adb, addresses[0], generateDataTrieValues(10))
+		rootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		args := createArgsDataTriesAnalysis()
+		args.Accounts = adb
+		args.RootHash = rootHash
+		analysis, err := state.AnalyzeDataTries(ctx, args)
+		assert.Nil(t, analysis)
+		assert.Equal(t, context.Canceled, err)
+	})
+	t.Run("should return the largest data tries", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		addresses := generateAccounts(t, 5, adb)
+		saveDataTrieValues(t, adb, addresses[0], generateDataTrieValues(5))
+		saveDataTrieValues(t, adb, addresses[1], generateDataTrieValues(50))
+		saveDataTrieValues(t, adb, addresses[2], generateDataTrieValues(1))
+		saveDataTrieValues(t, adb, addresses[3], generateDataTrieValues(20))
+		rootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		args := createArgsDataTriesAnalysis()
+		args.Accounts = adb
+		args.RootHash = rootHash
+		args.NumLargest = 2
+		analysis, err := state.AnalyzeDataTries(context.Background(), args)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(5), analysis.NumAccounts)
+		assert.Equal(t, uint64(4), analysis.NumDataTries)
+		require.Equal(t, 2, len(analysis.Largest))
+		assert.Equal(t, addresses[1], analysis.Largest[0].Address)
+		assert.Equal(t, 50, analysis.Largest[0].Leaves)
+		assert.Equal(t, addresses[3], analysis.Largest[1].Address)
+		assert.Equal(t, 20, analysis.Largest[1].Leaves)
+		assert.True(t, analysis.Largest[0].MaxLevel >= analysis.Largest[1].MaxLevel)
+		assert.True(t, analysis.TotalNumNodes > analysis.Largest[0].NumNodes()+analysis.Largest[1].NumNodes())
+
+		args.SortBy = state.SortBySizeInBytes
+		analysis, err = state.AnalyzeDataTries(context.Background(), args)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(analysis.Largest))
+		assert.Equal(t, addresses[1], analysis.Largest[0].Address)
+		assert.True(t, analysis.Largest[0].SizeInBytes > analysis.Largest[1].SizeInBytes)
+	})
+}
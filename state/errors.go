@@ -152,3 +152,15 @@ var ErrNilBlockInfoProvider = errors.New("nil block info provider")
 
 // ErrFunctionalityNotImplemented signals that the functionality has not been implemented yet
 var ErrFunctionalityNotImplemented = errors.New("functionality not implemented yet")
+
+// ErrNilStateDiffHandler signals that a nil state diff handler was provided
+var ErrNilStateDiffHandler = errors.New("nil state diff handler")
+
+// ErrNilContext signals that a nil context was provided
+var ErrNilContext = errors.New("nil context")
+
+// ErrInvalidNumDataTries signals that an invalid number of data tries to be reported was provided
+var ErrInvalidNumDataTries = errors.New("invalid number of data tries")
+
+// ErrInvalidDataTriesSortCriterion signals that an unknown data tries sort criterion was provided
+var ErrInvalidDataTriesSortCriterion = errors.New("invalid data tries sort criterion")
@@ -0,0 +1,60 @@
+package portableSnapshot
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/disabled"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	disabledPruning "github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	disabledHashesHolder "github.com/ElrondNetwork/elrond-go/trie/hashesHolder/disabled"
+)
+
+const maxTrieLevelInMemory = 5
+
+// ArgsAccountsAdapter holds the arguments needed to create an accounts adapter over offline trie storers
+type ArgsAccountsAdapter struct {
+	MainStorer        common.DBWriteCacher
+	CheckpointsStorer common.DBWriteCacher
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+}
+
+// CreateAccountsAdapter creates an accounts adapter able to read the user accounts tries from the provided storers.
+// Pruning, snapshots and checkpoints are disabled since the storers belong to a stopped node
+func CreateAccountsAdapter(args ArgsAccountsAdapter) (state.AccountsAdapter, error) {
+	storageManagerArgs := trie.NewTrieStorageManagerArgs{
+		MainStorer:        args.MainStorer,
+		CheckpointsStorer: args.CheckpointsStorer,
+		Marshalizer:       args.Marshalizer,
+		Hasher:            args.Hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsBufferLen:    1,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: disabledHashesHolder.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           disabled.NewProcessStatusHandler(),
+	}
+	storageManager, err := trie.NewTrieStorageManager(storageManagerArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  tr,
+		Hasher:                args.Hasher,
+		Marshaller:            args.Marshalizer,
+		AccountFactory:        factory.NewAccountCreator(),
+		StoragePruningManager: disabledPruning.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  disabled.NewProcessStatusHandler(),
+	})
+}
@@ -0,0 +1,45 @@
+package portableSnapshot
+
+import "errors"
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidNumNodesPerChunk signals that an invalid number of trie nodes per chunk has been provided
+var ErrInvalidNumNodesPerChunk = errors.New("invalid number of trie nodes per chunk")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrInvalidSnapshotFile signals that the snapshot file is not a valid state snapshot
+var ErrInvalidSnapshotFile = errors.New("invalid state snapshot file")
+
+// ErrUnsupportedSnapshotVersion signals that the snapshot file was written with an unsupported format version
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported state snapshot version")
+
+// ErrInvalidChunkSize signals that a chunk of the snapshot file has an invalid size
+var ErrInvalidChunkSize = errors.New("invalid chunk size")
+
+// ErrChunkHashMismatch signals that the content of a chunk does not match its hash
+var ErrChunkHashMismatch = errors.New("chunk hash mismatch")
+
+// ErrNodeHashMismatch signals that the content of a trie node does not match its hash
+var ErrNodeHashMismatch = errors.New("trie node hash mismatch")
+
+// ErrNumNodesMismatch signals that the number of trie nodes from the snapshot file does not match the expected one
+var ErrNumNodesMismatch = errors.New("number of trie nodes mismatch")
+
+// ErrRootHashMismatch signals that the snapshot file does not hold the expected root hash
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrNilPersisterFactory signals that a nil persister factory has been provided
+var ErrNilPersisterFactory = errors.New("nil persister factory")
+
+// ErrEmptyStagingPath signals that an empty staging path has been provided
+var ErrEmptyStagingPath = errors.New("empty staging path")
+
+// ErrMissingTrieNode signals that a trie node reachable from the root hash is missing from the snapshot file
+var ErrMissingTrieNode = errors.New("trie node missing from the snapshot")
@@ -0,0 +1,148 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("state/portableSnapshot")
+
+const progressLogInterval = 1000000
+
+// ArgsSnapshotExporter holds the arguments needed to create a snapshot exporter
+type ArgsSnapshotExporter struct {
+	Accounts         state.AccountsAdapter
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	NumNodesPerChunk int
+}
+
+type snapshotExporter struct {
+	accounts         state.AccountsAdapter
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	numNodesPerChunk int
+}
+
+// NewSnapshotExporter creates a component able to write all the trie nodes of a state into a portable snapshot file
+func NewSnapshotExporter(args ArgsSnapshotExporter) (*snapshotExporter, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if args.NumNodesPerChunk < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumNodesPerChunk, args.NumNodesPerChunk)
+	}
+
+	return &snapshotExporter{
+		accounts:         args.Accounts,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		numNodesPerChunk: args.NumNodesPerChunk,
+	}, nil
+}
+
+// Export writes the main trie identified by the root hash and all the data tries of its accounts in the provided
+// writer. It returns the number of written trie nodes
+func (se *snapshotExporter) Export(rootHash []byte, writer io.Writer) (uint64, error) {
+	if len(rootHash) == 0 {
+		return 0, ErrEmptyRootHash
+	}
+
+	tries, err := se.accounts.RecreateAllTries(rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	sw, err := newSnapshotWriter(writer, rootHash, se.marshalizer, se.hasher, se.numNodesPerChunk)
+	if err != nil {
+		return 0, err
+	}
+
+	writtenHashes := make(map[string]struct{})
+	for _, trieRootHash := range sortTriesRootHashes(tries, rootHash) {
+		err = se.exportTrie(tries[trieRootHash], sw, writtenHashes)
+		if err != nil {
+			return 0, fmt.Errorf("%w while exporting trie with root hash %x", err, trieRootHash)
+		}
+	}
+
+	err = sw.finish()
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info("state snapshot exported", "root hash", rootHash, "num tries", len(tries), "num trie nodes", sw.numNodes)
+
+	return sw.numNodes, nil
+}
+
+func (se *snapshotExporter) exportTrie(tr common.Trie, sw *snapshotWriter, writtenHashes map[string]struct{}) error {
+	hashes, err := tr.GetAllHashes()
+	if err != nil {
+		return err
+	}
+
+	storageManager := tr.GetStorageManager()
+	for _, hash := range hashes {
+		_, alreadyWritten := writtenHashes[string(hash)]
+		if alreadyWritten {
+			continue
+		}
+
+		encodedNode, errGet := storageManager.Get(hash)
+		if errGet != nil {
+			return fmt.Errorf("%w for trie node %x", errGet, hash)
+		}
+		if !bytes.Equal(se.hasher.Compute(string(encodedNode)), hash) {
+			return fmt.Errorf("%w for trie node %x", ErrNodeHashMismatch, hash)
+		}
+
+		err = sw.addNode(hash, encodedNode)
+		if err != nil {
+			return err
+		}
+		writtenHashes[string(hash)] = struct{}{}
+
+		if sw.numNodes%progressLogInterval == 0 {
+			log.Info("exporting state snapshot", "num trie nodes", sw.numNodes)
+		}
+	}
+
+	return nil
+}
+
+// sortTriesRootHashes returns the main trie root hash followed by the sorted data tries root hashes, so that the
+// exported file is deterministic
+func sortTriesRootHashes(tries map[string]common.Trie, mainTrieRootHash []byte) []string {
+	rootHashes := make([]string, 0, len(tries))
+	for rootHash := range tries {
+		if rootHash == string(mainTrieRootHash) {
+			continue
+		}
+		rootHashes = append(rootHashes, rootHash)
+	}
+	sort.Strings(rootHashes)
+
+	return append([]string{string(mainTrieRootHash)}, rootHashes...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *snapshotExporter) IsInterfaceNil() bool {
+	return se == nil
+}
@@ -0,0 +1,286 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+const (
+	importedNodesDirectory = "Imported"
+	verifiedNodesDirectory = "Verified"
+)
+
+var errAlreadyVerified = errors.New("trie node already verified")
+
+// ArgsSnapshotImporter holds the arguments needed to create a snapshot importer
+type ArgsSnapshotImporter struct {
+	Storer           common.DBWriteCacher
+	PersisterFactory storage.PersisterFactory
+	StagingPath      string
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+}
+
+type snapshotImporter struct {
+	storer           common.DBWriteCacher
+	persisterFactory storage.PersisterFactory
+	stagingPath      string
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+}
+
+// NewSnapshotImporter creates a component able to load a portable snapshot file into the trie storer of a node
+func NewSnapshotImporter(args ArgsSnapshotImporter) (*snapshotImporter, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.PersisterFactory) {
+		return nil, ErrNilPersisterFactory
+	}
+	if len(args.StagingPath) == 0 {
+		return nil, ErrEmptyStagingPath
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+
+	return &snapshotImporter{
+		storer:           args.Storer,
+		persisterFactory: args.PersisterFactory,
+		stagingPath:      args.StagingPath,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+	}, nil
+}
+
+// Import loads all the trie nodes from the provided reader into the storer. The trie nodes are first loaded into a
+// staging storer, each of them being checked against its hash. Then, all the tries are walked starting from the
+// snapshot root hash and the reachable trie nodes are copied into a second staging storer and counted, in order to
+// verify that every imported node is part of the state identified by that root hash. Only after the verification
+// passes the trie nodes are written into the storer, so a failed import leaves the storer untouched. The staging
+// storers are destroyed in all cases. If the expected root hash is provided, it must match the one of the snapshot.
+// It returns the snapshot root hash
+func (si *snapshotImporter) Import(reader io.Reader, expectedRootHash []byte) ([]byte, error) {
+	sr, err := newSnapshotReader(reader, si.marshalizer, si.hasher)
+	if err != nil {
+		return nil, err
+	}
+	if len(expectedRootHash) > 0 && !bytes.Equal(expectedRootHash, sr.rootHash) {
+		return nil, fmt.Errorf("%w: expected %x, snapshot has %x", ErrRootHashMismatch, expectedRootHash, sr.rootHash)
+	}
+
+	importedStorer, err := si.createStagingStorer(importedNodesDirectory)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(importedStorer.Destroy())
+	}()
+
+	verifiedStorer, err := si.createStagingStorer(verifiedNodesDirectory)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(verifiedStorer.Destroy())
+	}()
+
+	numImported, err := si.importNodes(sr, importedStorer)
+	if err != nil {
+		return nil, err
+	}
+	if sr.numNodes != numImported {
+		return nil, fmt.Errorf("%w: snapshot declares %d trie nodes, imported %d",
+			ErrNumNodesMismatch, sr.numNodes, numImported)
+	}
+
+	numVerified, err := si.verifyTries(sr.rootHash, importedStorer, verifiedStorer)
+	if err != nil {
+		return nil, err
+	}
+	if numVerified != numImported {
+		return nil, fmt.Errorf("%w: %d imported trie nodes are duplicated or not reachable from the root hash",
+			ErrNumNodesMismatch, numImported-numVerified)
+	}
+
+	err = si.promoteVerifiedNodes(verifiedStorer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("state snapshot imported", "root hash", sr.rootHash, "num trie nodes", numImported)
+
+	return sr.rootHash, nil
+}
+
+func (si *snapshotImporter) createStagingStorer(directory string) (storage.Persister, error) {
+	persister, err := si.persisterFactory.Create(filepath.Join(si.stagingPath, directory))
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the %s staging storer", err, directory)
+	}
+
+	return persister, nil
+}
+
+func (si *snapshotImporter) importNodes(sr *snapshotReader, importedStorer storage.Persister) (uint64, error) {
+	numImported := uint64(0)
+	for {
+		entries, err := sr.readChunk()
+		if err == io.EOF {
+			return numImported, nil
+		}
+		if err != nil {
+			return numImported, err
+		}
+
+		for i := 0; i < len(entries); i += numEntriesPerNode {
+			hash := entries[i]
+			encodedNode := entries[i+1]
+			if !bytes.Equal(si.hasher.Compute(string(encodedNode)), hash) {
+				return numImported, fmt.Errorf("%w for trie node %x", ErrNodeHashMismatch, hash)
+			}
+
+			err = importedStorer.Put(hash, encodedNode)
+			if err != nil {
+				return numImported, err
+			}
+
+			numImported++
+			if numImported%progressLogInterval == 0 {
+				log.Info("importing state snapshot", "num trie nodes", numImported)
+			}
+		}
+	}
+}
+
+// verifyTries walks all the tries from the root hash and copies each reachable trie node, once, from the imported
+// nodes storer into the verified nodes storer. It returns the number of verified trie nodes
+func (si *snapshotImporter) verifyTries(rootHash []byte, importedStorer storage.Persister, verifiedStorer storage.Persister) (uint64, error) {
+	sv := &stagingVerifier{
+		importedStorer: importedStorer,
+		verifiedStorer: verifiedStorer,
+	}
+	checker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		DB:          sv,
+		Marshalizer: si.marshalizer,
+		Hasher:      si.hasher,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := context.Background()
+	noOpLeafHandler := func(_ []byte) error {
+		return nil
+	}
+	dataTrieHandler := func(accountBytes []byte) error {
+		account := &state.UserAccountData{}
+		errUnmarshal := si.marshalizer.Unmarshal(account, accountBytes)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+		if len(account.RootHash) == 0 {
+			return nil
+		}
+
+		_, errCheck := checker.Check(ctx, account.RootHash, noOpLeafHandler, sv.missingNodeHandler)
+		return errCheck
+	}
+
+	_, err = checker.Check(ctx, rootHash, dataTrieHandler, sv.missingNodeHandler)
+	if err != nil {
+		return 0, err
+	}
+
+	return sv.numVerified, nil
+}
+
+// promoteVerifiedNodes writes all the verified trie nodes into the storer
+func (si *snapshotImporter) promoteVerifiedNodes(verifiedStorer storage.Persister) error {
+	var err error
+	verifiedStorer.RangeKeys(func(key []byte, val []byte) bool {
+		err = si.storer.Put(key, val)
+		return err == nil
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *snapshotImporter) IsInterfaceNil() bool {
+	return si == nil
+}
+
+// stagingVerifier is the storer the trie integrity checker reads the imported trie nodes from. Each trie node read
+// for the first time is copied into the verified nodes storer and counted, while a trie node already verified, being
+// part of a subtree shared by several tries, is reported as missing, so that its subtree is not walked again
+type stagingVerifier struct {
+	importedStorer storage.Persister
+	verifiedStorer storage.Persister
+	numVerified    uint64
+}
+
+// Get returns the imported trie node, if it was not already verified
+func (sv *stagingVerifier) Get(key []byte) ([]byte, error) {
+	if sv.verifiedStorer.Has(key) == nil {
+		return nil, errAlreadyVerified
+	}
+
+	val, err := sv.importedStorer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sv.verifiedStorer.Put(key, val)
+	if err != nil {
+		return nil, err
+	}
+
+	sv.numVerified++
+
+	return val, nil
+}
+
+// Put writes the trie node into the verified nodes storer
+func (sv *stagingVerifier) Put(key, val []byte) error {
+	return sv.verifiedStorer.Put(key, val)
+}
+
+// Remove does nothing as the staged trie nodes are only read
+func (sv *stagingVerifier) Remove(_ []byte) error {
+	return nil
+}
+
+// Close does nothing as the staging storers are destroyed by the importer
+func (sv *stagingVerifier) Close() error {
+	return nil
+}
+
+func (sv *stagingVerifier) missingNodeHandler(hash []byte) error {
+	if sv.verifiedStorer.Has(hash) == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %x", ErrMissingTrieNode, hash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sv *stagingVerifier) IsInterfaceNil() bool {
+	return sv == nil
+}
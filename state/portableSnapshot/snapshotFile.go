@@ -0,0 +1,267 @@
+package portableSnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+)
+
+// The snapshot file has the following layout, all integers being big endian encoded:
+//   header:  magic (8 bytes) | version (uint32) | root hash length (uint32) | root hash
+//   chunks:  compressed length (uint32) | hash of the uncompressed chunk | gzip compressed chunk
+//   trailer: 0 (uint32) | total number of trie nodes (uint64)
+// Each chunk is a marshalled batch holding the trie nodes as consecutive (hash, encoded node) pairs.
+const (
+	snapshotMagic     = "ERDSTATE"
+	snapshotVersion   = uint32(1)
+	maxChunkSize      = 256 * 1024 * 1024
+	maxRootHashLength = 1024
+	uint32Size        = 4
+	uint64Size        = 8
+	defaultBufferSize = 1024 * 1024
+	numEntriesPerNode = 2
+	endOfChunksMarker = uint32(0)
+)
+
+type snapshotWriter struct {
+	writer           *bufio.Writer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	numNodesPerChunk int
+	pending          *batch.Batch
+	numNodes         uint64
+}
+
+func newSnapshotWriter(
+	writer io.Writer,
+	rootHash []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	numNodesPerChunk int,
+) (*snapshotWriter, error) {
+	sw := &snapshotWriter{
+		writer:           bufio.NewWriterSize(writer, defaultBufferSize),
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		numNodesPerChunk: numNodesPerChunk,
+		pending:          &batch.Batch{},
+	}
+
+	header := make([]byte, 0, len(snapshotMagic)+2*uint32Size+len(rootHash))
+	header = append(header, snapshotMagic...)
+	header = appendUint32(header, snapshotVersion)
+	header = appendUint32(header, uint32(len(rootHash)))
+	header = append(header, rootHash...)
+
+	_, err := sw.writer.Write(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+func (sw *snapshotWriter) addNode(hash []byte, encodedNode []byte) error {
+	sw.pending.Data = append(sw.pending.Data, hash, encodedNode)
+	sw.numNodes++
+
+	if len(sw.pending.Data) < sw.numNodesPerChunk*numEntriesPerNode {
+		return nil
+	}
+
+	return sw.writeChunk()
+}
+
+func (sw *snapshotWriter) writeChunk() error {
+	if len(sw.pending.Data) == 0 {
+		return nil
+	}
+
+	chunk, err := sw.marshalizer.Marshal(sw.pending)
+	if err != nil {
+		return err
+	}
+	sw.pending = &batch.Batch{}
+
+	compressed := bytes.NewBuffer(make([]byte, 0, len(chunk)))
+	gzipWriter := gzip.NewWriter(compressed)
+	_, err = gzipWriter.Write(chunk)
+	if err != nil {
+		return err
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return err
+	}
+	if compressed.Len() > maxChunkSize {
+		return fmt.Errorf("%w: compressed chunk has %d bytes", ErrInvalidChunkSize, compressed.Len())
+	}
+
+	frame := make([]byte, 0, uint32Size+sw.hasher.Size())
+	frame = appendUint32(frame, uint32(compressed.Len()))
+	frame = append(frame, sw.hasher.Compute(string(chunk))...)
+	_, err = sw.writer.Write(frame)
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.writer.Write(compressed.Bytes())
+
+	return err
+}
+
+// finish writes the remaining trie nodes and the file trailer
+func (sw *snapshotWriter) finish() error {
+	err := sw.writeChunk()
+	if err != nil {
+		return err
+	}
+
+	trailer := make([]byte, 0, uint32Size+uint64Size)
+	trailer = appendUint32(trailer, endOfChunksMarker)
+	trailer = appendUint64(trailer, sw.numNodes)
+	_, err = sw.writer.Write(trailer)
+	if err != nil {
+		return err
+	}
+
+	return sw.writer.Flush()
+}
+
+type snapshotReader struct {
+	reader      *bufio.Reader
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	rootHash    []byte
+	numNodes    uint64
+}
+
+func newSnapshotReader(reader io.Reader, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*snapshotReader, error) {
+	sr := &snapshotReader{
+		reader:      bufio.NewReaderSize(reader, defaultBufferSize),
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}
+
+	magic := make([]byte, len(snapshotMagic))
+	_, err := io.ReadFull(sr.reader, magic)
+	if err != nil || string(magic) != snapshotMagic {
+		return nil, ErrInvalidSnapshotFile
+	}
+
+	version, err := sr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSnapshotVersion, version)
+	}
+
+	rootHashLength, err := sr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if rootHashLength == 0 || rootHashLength > maxRootHashLength {
+		return nil, fmt.Errorf("%w: invalid root hash length %d", ErrInvalidSnapshotFile, rootHashLength)
+	}
+
+	sr.rootHash = make([]byte, rootHashLength)
+	_, err = io.ReadFull(sr.reader, sr.rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+
+	return sr, nil
+}
+
+// readChunk returns the (hash, encoded node) pairs of the next chunk, in order. It returns io.EOF after the trailer
+// was read, moment in which the total number of trie nodes written in the file is available
+func (sr *snapshotReader) readChunk() ([][]byte, error) {
+	compressedSize, err := sr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if compressedSize == endOfChunksMarker {
+		sr.numNodes, err = sr.readUint64()
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, io.EOF
+	}
+	if compressedSize > maxChunkSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidChunkSize, compressedSize)
+	}
+
+	expectedHash := make([]byte, sr.hasher.Size())
+	_, err = io.ReadFull(sr.reader, expectedHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+
+	gzipReader, err := gzip.NewReader(io.LimitReader(sr.reader, int64(compressedSize)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+	chunk, err := ioutil.ReadAll(gzipReader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+
+	if !bytes.Equal(sr.hasher.Compute(string(chunk)), expectedHash) {
+		return nil, ErrChunkHashMismatch
+	}
+
+	b := &batch.Batch{}
+	err = sr.marshalizer.Unmarshal(b, chunk)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+	if len(b.Data)%numEntriesPerNode != 0 {
+		return nil, fmt.Errorf("%w: odd number of chunk entries", ErrInvalidSnapshotFile)
+	}
+
+	return b.Data, nil
+}
+
+func (sr *snapshotReader) readUint32() (uint32, error) {
+	buff := make([]byte, uint32Size)
+	_, err := io.ReadFull(sr.reader, buff)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+
+	return binary.BigEndian.Uint32(buff), nil
+}
+
+func (sr *snapshotReader) readUint64() (uint64, error) {
+	buff := make([]byte, uint64Size)
+	_, err := io.ReadFull(sr.reader, buff)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func appendUint32(buff []byte, value uint32) []byte {
+	encoded := make([]byte, uint32Size)
+	binary.BigEndian.PutUint32(encoded, value)
+
+	return append(buff, encoded...)
+}
+
+func appendUint64(buff []byte, value uint64) []byte {
+	encoded := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(encoded, value)
+
+	return append(buff, encoded...)
+}
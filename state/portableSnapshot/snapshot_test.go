@@ -0,0 +1,386 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	storageMock "github.com/ElrondNetwork/elrond-go/storage/mock"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshalizer = &marshal.GogoProtoMarshalizer{}
+	testHasher      = sha256.NewSha256()
+)
+
+func createAccountsWithState(t *testing.T) (state.AccountsAdapter, []byte) {
+	accounts, err := CreateAccountsAdapter(ArgsAccountsAdapter{
+		MainStorer:        memorydb.New(),
+		CheckpointsStorer: memorydb.New(),
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+	})
+	require.Nil(t, err)
+
+	for i := 0; i < 20; i++ {
+		address := testHasher.Compute(fmt.Sprintf("address%d", i))
+		account, errLoad := accounts.LoadAccount(address)
+		require.Nil(t, errLoad)
+
+		userAccount := account.(state.UserAccountHandler)
+		userAccount.IncreaseNonce(uint64(i))
+		if i%2 == 0 {
+			for j := 0; j < 10; j++ {
+				key := []byte(fmt.Sprintf("key%d", j))
+				require.Nil(t, userAccount.DataTrieTracker().SaveKeyValue(key, []byte(fmt.Sprintf("value%d-%d", i, j))))
+			}
+		}
+		require.Nil(t, accounts.SaveAccount(userAccount))
+	}
+
+	rootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	return accounts, rootHash
+}
+
+func exportState(t *testing.T, numNodesPerChunk int) ([]byte, []byte, uint64) {
+	accounts, rootHash := createAccountsWithState(t)
+	exporter, err := NewSnapshotExporter(ArgsSnapshotExporter{
+		Accounts:         accounts,
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		NumNodesPerChunk: numNodesPerChunk,
+	})
+	require.Nil(t, err)
+
+	buff := bytes.NewBuffer(nil)
+	numNodes, err := exporter.Export(rootHash, buff)
+	require.Nil(t, err)
+
+	return buff.Bytes(), rootHash, numNodes
+}
+
+func createImporterArgs(storer common.DBWriteCacher) ArgsSnapshotImporter {
+	return ArgsSnapshotImporter{
+		Storer: storer,
+		PersisterFactory: &storageMock.PersisterFactoryStub{
+			CreateCalled: func(_ string) (storage.Persister, error) {
+				return memorydb.New(), nil
+			},
+		},
+		StagingPath: "staging",
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+	}
+}
+
+func countKeys(persister storage.Persister) int {
+	numKeys := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys
+}
+
+func createImporter(storer *memorydb.DB) *snapshotImporter {
+	importer, _ := NewSnapshotImporter(createImporterArgs(storer))
+
+	return importer
+}
+
+func TestNewSnapshotExporter(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func() ArgsSnapshotExporter {
+		return ArgsSnapshotExporter{
+			Accounts:         &stateMock.AccountsStub{},
+			Marshalizer:      testMarshalizer,
+			Hasher:           testHasher,
+			NumNodesPerChunk: 10,
+		}
+	}
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Accounts = nil
+		exporter, err := NewSnapshotExporter(args)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Marshalizer = nil
+		exporter, err := NewSnapshotExporter(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Hasher = nil
+		exporter, err := NewSnapshotExporter(args)
+		assert.Equal(t, core.ErrNilHasher, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("invalid number of nodes per chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.NumNodesPerChunk = 0
+		exporter, err := NewSnapshotExporter(args)
+		assert.True(t, errors.Is(err, ErrInvalidNumNodesPerChunk))
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewSnapshotExporter(createArgs())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(exporter))
+	})
+}
+
+func TestNewSnapshotImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewSnapshotImporter(createImporterArgs(nil))
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("nil persister factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createImporterArgs(memorydb.New())
+		args.PersisterFactory = nil
+		importer, err := NewSnapshotImporter(args)
+		assert.Equal(t, ErrNilPersisterFactory, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("empty staging path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createImporterArgs(memorydb.New())
+		args.StagingPath = ""
+		importer, err := NewSnapshotImporter(args)
+		assert.Equal(t, ErrEmptyStagingPath, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createImporterArgs(memorydb.New())
+		args.Marshalizer = nil
+		importer, err := NewSnapshotImporter(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createImporterArgs(memorydb.New())
+		args.Hasher = nil
+		importer, err := NewSnapshotImporter(args)
+		assert.Equal(t, core.ErrNilHasher, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer := createImporter(memorydb.New())
+		assert.False(t, check.IfNil(importer))
+	})
+}
+
+func TestSnapshot_ExportImportShouldRecreateTheState(t *testing.T) {
+	t.Parallel()
+
+	snapshot, rootHash, numNodes := exportState(t, 7)
+	assert.True(t, numNodes > 7)
+
+	storer := memorydb.New()
+	importedRootHash, err := createImporter(storer).Import(bytes.NewReader(snapshot), rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, rootHash, importedRootHash)
+
+	accounts, err := CreateAccountsAdapter(ArgsAccountsAdapter{
+		MainStorer:        storer,
+		CheckpointsStorer: memorydb.New(),
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+	})
+	require.Nil(t, err)
+	require.Nil(t, accounts.RecreateTrie(rootHash))
+
+	account, err := accounts.GetExistingAccount(testHasher.Compute("address4"))
+	require.Nil(t, err)
+	userAccount := account.(state.UserAccountHandler)
+	assert.Equal(t, uint64(4), userAccount.GetNonce())
+	value, err := userAccount.DataTrieTracker().RetrieveValue([]byte("key3"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value4-3"), value)
+
+	exportedAgain := bytes.NewBuffer(nil)
+	exporter, _ := NewSnapshotExporter(ArgsSnapshotExporter{
+		Accounts:         accounts,
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		NumNodesPerChunk: 7,
+	})
+	_, err = exporter.Export(rootHash, exportedAgain)
+	require.Nil(t, err)
+	assert.Equal(t, snapshot, exportedAgain.Bytes())
+}
+
+func TestSnapshotImporter_ImportErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid file should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := createImporter(memorydb.New()).Import(bytes.NewReader([]byte("not a snapshot")), nil)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+	})
+	t.Run("root hash mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot, _, _ := exportState(t, 100)
+		_, err := createImporter(memorydb.New()).Import(bytes.NewReader(snapshot), []byte("other root hash"))
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	})
+	t.Run("truncated file should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot, rootHash, _ := exportState(t, 100)
+		_, err := createImporter(memorydb.New()).Import(bytes.NewReader(snapshot[:len(snapshot)-5]), rootHash)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+	})
+	t.Run("tampered chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot, rootHash, _ := exportState(t, 100)
+		headerSize := len(snapshotMagic) + 2*uint32Size + len(rootHash)
+		hashPosition := headerSize + uint32Size
+		snapshot[hashPosition] ^= 0xFF
+
+		_, err := createImporter(memorydb.New()).Import(bytes.NewReader(snapshot), rootHash)
+		assert.Equal(t, ErrChunkHashMismatch, err)
+	})
+	t.Run("tampered trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		rootHash := testHasher.Compute("node")
+		sw, _ := newSnapshotWriter(buff, rootHash, testMarshalizer, testHasher, 10)
+		require.Nil(t, sw.addNode(rootHash, []byte("other node")))
+		require.Nil(t, sw.finish())
+
+		_, err := createImporter(memorydb.New()).Import(buff, rootHash)
+		assert.True(t, errors.Is(err, ErrNodeHashMismatch))
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot, rootHash, _ := exportState(t, 1)
+		sr, err := newSnapshotReader(bytes.NewReader(snapshot), testMarshalizer, testHasher)
+		require.Nil(t, err)
+
+		buff := bytes.NewBuffer(nil)
+		sw, _ := newSnapshotWriter(buff, rootHash, testMarshalizer, testHasher, 10)
+		entries, err := sr.readChunk()
+		for ; err == nil; entries, err = sr.readChunk() {
+			if bytes.Equal(entries[0], rootHash) {
+				continue
+			}
+			require.Nil(t, sw.addNode(entries[0], entries[1]))
+		}
+		require.Nil(t, sw.finish())
+
+		storer := memorydb.New()
+		_, err = createImporter(storer).Import(buff, rootHash)
+		assert.True(t, errors.Is(err, ErrMissingTrieNode))
+		assert.Equal(t, 0, countKeys(storer))
+	})
+	t.Run("unreachable trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot, rootHash, _ := exportState(t, 1)
+		sr, err := newSnapshotReader(bytes.NewReader(snapshot), testMarshalizer, testHasher)
+		require.Nil(t, err)
+
+		buff := bytes.NewBuffer(nil)
+		sw, _ := newSnapshotWriter(buff, rootHash, testMarshalizer, testHasher, 10)
+		entries, err := sr.readChunk()
+		for ; err == nil; entries, err = sr.readChunk() {
+			require.Nil(t, sw.addNode(entries[0], entries[1]))
+		}
+		extraNode := []byte("extra node")
+		require.Nil(t, sw.addNode(testHasher.Compute(string(extraNode)), extraNode))
+		require.Nil(t, sw.finish())
+
+		storer := memorydb.New()
+		_, err = createImporter(storer).Import(buff, rootHash)
+		assert.True(t, errors.Is(err, ErrNumNodesMismatch))
+		assert.Equal(t, 0, countKeys(storer))
+	})
+}
+
+func TestSnapshotImporter_ImportShouldUseStagingStorers(t *testing.T) {
+	t.Parallel()
+
+	snapshot, rootHash, numNodes := exportState(t, 7)
+
+	storer := memorydb.New()
+	args := createImporterArgs(storer)
+	stagingPersisters := make(map[string]*memorydb.DB)
+	args.PersisterFactory = &storageMock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			persister := memorydb.New()
+			stagingPersisters[path] = persister
+			return persister, nil
+		},
+	}
+	importer, _ := NewSnapshotImporter(args)
+
+	t.Run("failed import should leave the storer empty", func(t *testing.T) {
+		truncatedSnapshot := snapshot[:len(snapshot)-5]
+		_, err := importer.Import(bytes.NewReader(truncatedSnapshot), rootHash)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+		assert.Equal(t, 0, countKeys(storer))
+		require.Equal(t, 2, len(stagingPersisters))
+		for _, persister := range stagingPersisters {
+			assert.Equal(t, 0, countKeys(persister))
+		}
+	})
+	t.Run("successful import should promote the staged trie nodes", func(t *testing.T) {
+		_, err := importer.Import(bytes.NewReader(snapshot), rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, int(numNodes), countKeys(storer))
+		assert.Equal(t, 2, len(stagingPersisters))
+		for _, persister := range stagingPersisters {
+			assert.Equal(t, 0, countKeys(persister))
+		}
+	})
+}
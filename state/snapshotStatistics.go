@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 type snapshotStatistics struct {
@@ -74,3 +75,33 @@ func (ss *snapshotStatistics) PrintStats(identifier string, rootHash []byte) {
 		"rootHash", rootHash,
 	)
 }
+
+// dataTriesSnapshotStatistics wraps the statistics of a state snapshot and tracks the data tries snapshots started
+// by it, so that the leaves consumer can wait for them when the main trie snapshot requires it
+type dataTriesSnapshotStatistics struct {
+	common.SnapshotStatisticsHandler
+	wg sync.WaitGroup
+}
+
+func newDataTriesSnapshotStatistics(stats common.SnapshotStatisticsHandler) *dataTriesSnapshotStatistics {
+	return &dataTriesSnapshotStatistics{
+		SnapshotStatisticsHandler: stats,
+	}
+}
+
+// SnapshotFinished marks the ending of a data trie snapshot goroutine
+func (dss *dataTriesSnapshotStatistics) SnapshotFinished() {
+	dss.SnapshotStatisticsHandler.SnapshotFinished()
+	dss.wg.Done()
+}
+
+// NewSnapshotStarted marks the starting of a new data trie snapshot goroutine
+func (dss *dataTriesSnapshotStatistics) NewSnapshotStarted() {
+	dss.wg.Add(1)
+	dss.SnapshotStatisticsHandler.NewSnapshotStarted()
+}
+
+// waitForDataTriesSnapshots will wait until all the data tries snapshots started so far have finished
+func (dss *dataTriesSnapshotStatistics) waitForDataTriesSnapshots() {
+	dss.wg.Wait()
+}
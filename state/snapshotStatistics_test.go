@@ -40,3 +40,25 @@ func TestSnapshotStatistics_Concurrency(t *testing.T) {
 	assert.Equal(t, uint64(1000), ss.trieSize)
 	assert.Equal(t, uint64(100), ss.numDataTries)
 }
+
+func TestDataTriesSnapshotStatistics_WaitForDataTriesSnapshots(t *testing.T) {
+	ss := newSnapshotStatistics(0)
+	dss := newDataTriesSnapshotStatistics(ss)
+
+	numRuns := 10
+	finished := make(chan struct{}, numRuns)
+	for i := 0; i < numRuns; i++ {
+		dss.NewSnapshotStarted()
+		go func() {
+			dss.AddSize(10)
+			finished <- struct{}{}
+			dss.SnapshotFinished()
+		}()
+	}
+
+	dss.waitForDataTriesSnapshots()
+	assert.Equal(t, numRuns, len(finished))
+	assert.Equal(t, uint64(numRuns), ss.numNodes)
+
+	ss.WaitForSnapshotsToFinish()
+}
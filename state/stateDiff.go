@@ -0,0 +1,117 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// StateDiffHandler is called for each account or account storage key that differs between two states
+type StateDiffHandler func(entry common.StateDiffEntry) error
+
+// ComputeStateDiff compares the accounts states identified by the two root hashes and calls the handler for each
+// added, removed or modified account. The data tries of the changed accounts are compared as well, the handler being
+// called for each changed storage key right after the account that owns it. The subtrees that are identical in the two
+// states are skipped without being loaded
+func ComputeStateDiff(
+	ctx context.Context,
+	accounts AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	fromRootHash []byte,
+	toRootHash []byte,
+	handler StateDiffHandler,
+) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if check.IfNil(accounts) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if handler == nil {
+		return ErrNilStateDiffHandler
+	}
+
+	mainTrie, err := accounts.GetTrie(toRootHash)
+	if err != nil {
+		return err
+	}
+
+	return mainTrie.GetLeavesDiff(ctx, fromRootHash, toRootHash, func(address []byte, oldValue []byte, newValue []byte) error {
+		errHandler := handler(common.StateDiffEntry{
+			Address:  address,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+		if errHandler != nil {
+			return errHandler
+		}
+
+		return computeDataTrieDiff(ctx, mainTrie, marshalizer, address, oldValue, newValue, handler)
+	})
+}
+
+func computeDataTrieDiff(
+	ctx context.Context,
+	mainTrie common.Trie,
+	marshalizer marshal.Marshalizer,
+	address []byte,
+	oldAccountBytes []byte,
+	newAccountBytes []byte,
+	handler StateDiffHandler,
+) error {
+	oldDataTrieRootHash, err := getDataTrieRootHash(marshalizer, oldAccountBytes)
+	if err != nil {
+		return fmt.Errorf("%w for old account %x", err, address)
+	}
+	newDataTrieRootHash, err := getDataTrieRootHash(marshalizer, newAccountBytes)
+	if err != nil {
+		return fmt.Errorf("%w for new account %x", err, address)
+	}
+	if bytes.Equal(oldDataTrieRootHash, newDataTrieRootHash) {
+		return nil
+	}
+
+	return mainTrie.GetLeavesDiff(ctx, oldDataTrieRootHash, newDataTrieRootHash, func(key []byte, oldValue []byte, newValue []byte) error {
+		return handler(common.StateDiffEntry{
+			Address:  address,
+			Key:      key,
+			OldValue: trimDataTrieValue(oldValue, key, address),
+			NewValue: trimDataTrieValue(newValue, key, address),
+		})
+	})
+}
+
+func getDataTrieRootHash(marshalizer marshal.Marshalizer, accountBytes []byte) ([]byte, error) {
+	if len(accountBytes) == 0 {
+		return nil, nil
+	}
+
+	account := &UserAccountData{}
+	err := marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.RootHash, nil
+}
+
+// trimDataTrieValue removes the key and the address appended to the values saved in a data trie
+func trimDataTrieValue(value []byte, key []byte, address []byte) []byte {
+	if len(value) == 0 {
+		return value
+	}
+
+	trimmedValue, err := trimValue(value, len(key)+len(address))
+	if err != nil {
+		return value
+	}
+
+	return trimmedValue
+}
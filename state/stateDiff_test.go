@@ -0,0 +1,126 @@
+package state_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveDataTrieValues(t *testing.T, adb state.AccountsAdapter, address []byte, keysValues map[string]string) {
+	acc, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	for key, value := range keysValues {
+		err = acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte(key), []byte(value))
+		require.Nil(t, err)
+	}
+
+	err = adb.SaveAccount(acc)
+	require.Nil(t, err)
+}
+
+func TestComputeStateDiff(t *testing.T) {
+	t.Parallel()
+
+	noOpHandler := func(_ common.StateDiffEntry) error {
+		return nil
+	}
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.ComputeStateDiff(context.Background(), nil, &testscommon.MarshalizerMock{}, nil, nil, noOpHandler)
+		assert.Equal(t, state.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.ComputeStateDiff(context.Background(), &stateMock.AccountsStub{}, nil, nil, nil, noOpHandler)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.ComputeStateDiff(context.Background(), &stateMock.AccountsStub{}, &testscommon.MarshalizerMock{}, nil, nil, nil)
+		assert.Equal(t, state.ErrNilStateDiffHandler, err)
+	})
+	t.Run("get trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		accounts := &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		err := state.ComputeStateDiff(context.Background(), accounts, &testscommon.MarshalizerMock{}, nil, nil, noOpHandler)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should return the changed accounts and storage keys", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		addresses := generateAccounts(t, 4, adb)
+		saveDataTrieValues(t, adb, addresses[0], map[string]string{"key1": "value1", "key2": "value2"})
+		saveDataTrieValues(t, adb, addresses[1], map[string]string{"key1": "value1"})
+		fromRootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		saveDataTrieValues(t, adb, addresses[0], map[string]string{"key1": "", "key2": "new value2", "key3": "value3"})
+		err = adb.RemoveAccount(addresses[1])
+		require.Nil(t, err)
+		newAddress := generateAccounts(t, 1, adb)[0]
+		saveDataTrieValues(t, adb, newAddress, map[string]string{"key": "value"})
+		toRootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		accountsEntries := make(map[string]common.StateDiffEntry)
+		storageEntries := make(map[string]map[string]common.StateDiffEntry)
+		lastAddress := ""
+		handler := func(entry common.StateDiffEntry) error {
+			if len(entry.Key) == 0 {
+				accountsEntries[string(entry.Address)] = entry
+				lastAddress = string(entry.Address)
+				return nil
+			}
+
+			assert.Equal(t, lastAddress, string(entry.Address))
+			if storageEntries[string(entry.Address)] == nil {
+				storageEntries[string(entry.Address)] = make(map[string]common.StateDiffEntry)
+			}
+			storageEntries[string(entry.Address)][string(entry.Key)] = entry
+			return nil
+		}
+
+		err = state.ComputeStateDiff(context.Background(), adb, &testscommon.MarshalizerMock{}, fromRootHash, toRootHash, handler)
+		require.Nil(t, err)
+
+		require.Equal(t, 3, len(accountsEntries))
+		assert.NotEmpty(t, accountsEntries[string(addresses[0])].OldValue)
+		assert.NotEmpty(t, accountsEntries[string(addresses[0])].NewValue)
+		assert.Empty(t, accountsEntries[string(addresses[1])].NewValue)
+		assert.Empty(t, accountsEntries[string(newAddress)].OldValue)
+
+		expectedStorageEntries := map[string]map[string]common.StateDiffEntry{
+			string(addresses[0]): {
+				"key1": {Address: addresses[0], Key: []byte("key1"), OldValue: []byte("value1")},
+				"key2": {Address: addresses[0], Key: []byte("key2"), OldValue: []byte("value2"), NewValue: []byte("new value2")},
+				"key3": {Address: addresses[0], Key: []byte("key3"), NewValue: []byte("value3")},
+			},
+			string(addresses[1]): {
+				"key1": {Address: addresses[1], Key: []byte("key1"), OldValue: []byte("value1")},
+			},
+			string(newAddress): {
+				"key": {Address: newAddress, Key: []byte("key"), NewValue: []byte("value")},
+			},
+		}
+		assert.Equal(t, expectedStorageEntries, storageEntries)
+	})
+}
@@ -0,0 +1,247 @@
+package badgerdb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) *badgerdb.DB {
+	db, err := badgerdb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid batch delay should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := badgerdb.NewDB(t.TempDir(), 0, 1)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidBatchDelay, err)
+	})
+	t.Run("invalid batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := badgerdb.NewDB(t.TempDir(), 1, 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidBatchSize, err)
+	})
+	t.Run("double open should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := badgerdb.NewDB(dir, 1, 1)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_, err = badgerdb.NewDB(dir, 1, 1)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("from batch", func(t *testing.T) {
+		t.Parallel()
+
+		testPutGetHasRemove(t, createBadgerDb(t, 100, 100))
+	})
+	t.Run("from disk", func(t *testing.T) {
+		t.Parallel()
+
+		testPutGetHasRemove(t, createBadgerDb(t, 100, 1))
+	})
+}
+
+func testPutGetHasRemove(t *testing.T, db *badgerdb.DB) {
+	defer func() {
+		_ = db.Close()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	err := db.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, db.Has(key))
+
+	err = db.Remove(key)
+	require.Nil(t, err)
+
+	recovered, err = db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Nil(t, recovered)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err = db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_CloseShouldWriteTheBatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := badgerdb.NewDB(dir, 100, 100)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), []byte("value")
+	_ = db.Put(key, val)
+	_ = db.Put([]byte("removed key"), val)
+	_ = db.Remove([]byte("removed key"))
+	err = db.Close()
+	require.Nil(t, err)
+
+	db, err = badgerdb.NewDB(dir, 100, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("removed key")))
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, 100, 1)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+	}
+	for key, val := range keysVals {
+		_ = db.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+	assert.Equal(t, keysVals, recovered)
+
+	numCalls := 0
+	db.RangeKeys(func(_ []byte, _ []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := badgerdb.NewDB(dir, 100, 1)
+	require.Nil(t, err)
+	_ = db.Put([]byte("key"), []byte("value"))
+
+	err = db.Destroy()
+	require.Nil(t, err)
+
+	db, err = badgerdb.NewDB(dir, 100, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("key")))
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when closing", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *badgerdb.DB) {
+			_ = db.Close()
+		})
+	})
+	t.Run("when destroying", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *badgerdb.DB) {
+			_ = db.Destroy()
+		})
+	})
+}
+
+func TestDB_WritesAfterCloseShouldError(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := badgerdb.NewDB(dir, 1, 100)
+	require.Nil(t, err)
+	require.Nil(t, db.Put([]byte("key1"), []byte("val1")))
+	require.Nil(t, db.Close())
+
+	// the batch is large enough not to be flushed on these writes, so they would have been silently lost
+	assert.Equal(t, errors.ErrDBIsClosed, db.Put([]byte("key2"), []byte("val2")))
+	assert.Equal(t, errors.ErrDBIsClosed, db.Remove([]byte("key1")))
+
+	reopened, err := badgerdb.NewDB(dir, 1, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Close()
+	}()
+
+	val, err := reopened.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("val1"), val)
+	assert.Equal(t, storage.ErrKeyNotFound, reopened.Has([]byte("key2")))
+}
+
+func testDbAllMethodsShouldNotPanic(t *testing.T, closeHandler func(db *badgerdb.DB)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panic %v", r))
+		}
+	}()
+
+	db := createBadgerDb(t, 1, 1)
+	closeHandler(db)
+
+	err := db.Put([]byte("key1"), []byte("val1"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	_, err = db.Get([]byte("key2"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	err = db.Has([]byte("key3"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		require.Fail(t, "should have not called range")
+		return false
+	})
+
+	err = db.Remove([]byte("key4"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+}
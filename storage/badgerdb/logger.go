@@ -0,0 +1,38 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// badgerLogger redirects the badger internal logs to the node logger. The badger info logs are quite verbose, so
+// they are logged at debug level
+type badgerLogger struct {
+	log logger.Logger
+}
+
+// Errorf logs an error message
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	bl.log.Error(formatMessage(format, args...))
+}
+
+// Warningf logs a warning message
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	bl.log.Warn(formatMessage(format, args...))
+}
+
+// Infof logs an info message
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	bl.log.Debug(formatMessage(format, args...))
+}
+
+// Debugf logs a debug message
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	bl.log.Trace(formatMessage(format, args...))
+}
+
+func formatMessage(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,9 @@
+package dbmigration
+
+import "errors"
+
+// ErrNilPersister signals that a nil persister was provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrValueMismatch signals that a copied value differs from the source value
+var ErrValueMismatch = errors.New("copied value mismatch")
@@ -0,0 +1,76 @@
+package dbmigration
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const logProgressInterval = 1000000
+
+var log = logger.GetOrCreate("storage/dbmigration")
+
+// CopyPersister copies all the key-value pairs of the source persister in the destination persister, returning the
+// number of copied pairs. The destination persister should be closed afterwards, so that its pending batch is written
+func CopyPersister(source storage.Persister, destination storage.Persister) (int, error) {
+	if check.IfNil(source) {
+		return 0, fmt.Errorf("%w for the source", ErrNilPersister)
+	}
+	if check.IfNil(destination) {
+		return 0, fmt.Errorf("%w for the destination", ErrNilPersister)
+	}
+
+	numCopied := 0
+	var errPut error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		errPut = destination.Put(key, val)
+		if errPut != nil {
+			return false
+		}
+
+		numCopied++
+		if numCopied%logProgressInterval == 0 {
+			log.Info("copying persister", "num copied keys", numCopied)
+		}
+
+		return true
+	})
+	if errPut != nil {
+		return numCopied, errPut
+	}
+
+	return numCopied, nil
+}
+
+// VerifyCopy checks that all the key-value pairs of the source persister are found in the destination persister,
+// returning the number of verified pairs
+func VerifyCopy(source storage.Persister, destination storage.Persister) (int, error) {
+	if check.IfNil(source) {
+		return 0, fmt.Errorf("%w for the source", ErrNilPersister)
+	}
+	if check.IfNil(destination) {
+		return 0, fmt.Errorf("%w for the destination", ErrNilPersister)
+	}
+
+	numVerified := 0
+	var errVerify error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		copiedVal, err := destination.Get(key)
+		if err != nil {
+			errVerify = fmt.Errorf("%w for key %x", err, key)
+			return false
+		}
+		if !bytes.Equal(val, copiedVal) {
+			errVerify = fmt.Errorf("%w for key %x", ErrValueMismatch, key)
+			return false
+		}
+
+		numVerified++
+		return true
+	})
+
+	return numVerified, errVerify
+}
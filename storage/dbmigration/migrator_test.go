@@ -0,0 +1,94 @@
+package dbmigration_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/dbmigration"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPersisterWithKeys(numKeys int) storage.Persister {
+	persister := memorydb.New()
+	for i := 0; i < numKeys; i++ {
+		_ = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	return persister
+}
+
+func TestCopyPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil source should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dbmigration.CopyPersister(nil, memorydb.New())
+		assert.True(t, errors.Is(err, dbmigration.ErrNilPersister))
+	})
+	t.Run("nil destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dbmigration.CopyPersister(memorydb.New(), nil)
+		assert.True(t, errors.Is(err, dbmigration.ErrNilPersister))
+	})
+	t.Run("put error should stop the copy", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		destination := &mock.PersisterStub{
+			PutCalled: func(_, _ []byte) error {
+				return expectedErr
+			},
+		}
+
+		numCopied, err := dbmigration.CopyPersister(createPersisterWithKeys(10), destination)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, numCopied)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		source := createPersisterWithKeys(100)
+		destination := memorydb.New()
+
+		numCopied, err := dbmigration.CopyPersister(source, destination)
+		require.Nil(t, err)
+		assert.Equal(t, 100, numCopied)
+
+		numVerified, err := dbmigration.VerifyCopy(source, destination)
+		require.Nil(t, err)
+		assert.Equal(t, 100, numVerified)
+	})
+}
+
+func TestVerifyCopy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		source := createPersisterWithKeys(10)
+		destination := createPersisterWithKeys(10)
+		_ = destination.Remove([]byte("key5"))
+
+		numVerified, err := dbmigration.VerifyCopy(source, destination)
+		assert.NotNil(t, err)
+		assert.True(t, numVerified < 10)
+	})
+	t.Run("different value should error", func(t *testing.T) {
+		t.Parallel()
+
+		source := createPersisterWithKeys(10)
+		destination := createPersisterWithKeys(10)
+		_ = destination.Put([]byte("key5"), []byte("other value"))
+
+		_, err := dbmigration.VerifyCopy(source, destination)
+		assert.True(t, errors.Is(err, dbmigration.ErrValueMismatch))
+	})
+}
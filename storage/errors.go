@@ -8,6 +8,12 @@ import (
 // ErrNilPersister is raised when a nil persister is provided
 var ErrNilPersister = errors.New("expected not nil persister")
 
+// ErrReadOnlyStorer signals that a write operation was attempted on a read only storer
+var ErrReadOnlyStorer = errors.New("the storer is read only")
+
+// ErrMissingDatabase signals that the database directory does not exist
+var ErrMissingDatabase = errors.New("missing database")
+
 // ErrNilCacher is raised when a nil cacher is provided
 var ErrNilCacher = errors.New("expected not nil cacher")
 
@@ -29,6 +35,12 @@ var ErrInvalidBatch = errors.New("batch is invalid")
 // ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
 var ErrInvalidNumOpenFiles = errors.New("maxOpenFiles is invalid")
 
+// ErrInvalidBatchDelay is raised when the batch delay in seconds is less than 1
+var ErrInvalidBatchDelay = errors.New("batchDelaySeconds is invalid")
+
+// ErrInvalidBatchSize is raised when the max batch size is less than 1
+var ErrInvalidBatchSize = errors.New("maxBatchSize is invalid")
+
 // ErrEmptyKey is raised when a key is empty
 var ErrEmptyKey = errors.New("key is empty")
 
@@ -152,6 +164,12 @@ var ErrInvalidDefaultSpan = errors.New("invalid default span")
 // ErrInvalidCacheExpiry signals that an invalid cache expiry was provided
 var ErrInvalidCacheExpiry = errors.New("invalid cache expiry")
 
+// ErrNilEmptyTxCreator signals that a nil empty transaction creator was provided
+var ErrNilEmptyTxCreator = errors.New("nil empty transaction creator")
+
+// ErrNilOverflowHandler signals that a nil overflow handler was provided
+var ErrNilOverflowHandler = errors.New("nil overflow handler")
+
 // IsNotFoundInStorageErr returns whether an error is a "not found in storage" error.
 // Currently, "item not found" storage errors are untyped (thus not distinguishable from others). E.g. see "pruningStorer.go".
 // As a workaround, we test the error message for a match.
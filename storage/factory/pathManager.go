@@ -2,7 +2,11 @@ package factory
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
@@ -35,3 +39,31 @@ func CreatePathManagerFromSinglePathString(dbPathWithChainID string) (*pathmanag
 
 	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer, dbPathWithChainID)
 }
+
+// GetEpochsFromDbPath returns the epochs that have a database directory in the provided path, sorted descending
+func GetEpochsFromDbPath(dbPath string) ([]uint32, error) {
+	dirs, err := ioutil.ReadDir(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := common.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir() || !strings.HasPrefix(dir.Name(), prefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(dir.Name(), prefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+
+	return epochs, nil
+}
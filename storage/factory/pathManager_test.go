@@ -0,0 +1,36 @@
+package factory
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEpochsFromDbPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing path should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochs, err := GetEpochsFromDbPath(filepath.Join(t.TempDir(), "missing"))
+		assert.Nil(t, epochs)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the epochs sorted descending", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := t.TempDir()
+		for _, dir := range []string{"Epoch_0", "Epoch_12", "Epoch_3", "Epoch_invalid", "Static"} {
+			require.Nil(t, os.Mkdir(filepath.Join(dbPath, dir), os.ModePerm))
+		}
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dbPath, "Epoch_7"), []byte("not a directory"), os.ModePerm))
+
+		epochs, err := GetEpochsFromDbPath(dbPath)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{12, 3, 0}, epochs)
+	})
+}
@@ -239,6 +239,11 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	equivocationEvidenceUnit, err := psf.createEquivocationEvidenceStorer()
+	if err != nil {
+		return nil, err
+	}
+
 	bootstrapUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage, disabledCustomDatabaseRemover)
 	bootstrapUnit, err = psf.createPruningPersister(bootstrapUnitArgs)
 	if err != nil {
@@ -271,6 +276,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.EquivocationEvidenceUnit, equivocationEvidenceUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
 	store.AddStorer(dataRetriever.UserAccountsUnit, userAccountsUnit)
@@ -410,6 +416,11 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	equivocationEvidenceUnit, err := psf.createEquivocationEvidenceStorer()
+	if err != nil {
+		return nil, err
+	}
+
 	txUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxStorage, disabledCustomDatabaseRemover)
 	txUnit, err = psf.createPruningPersister(txUnitArgs)
 	if err != nil {
@@ -467,6 +478,7 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.EquivocationEvidenceUnit, equivocationEvidenceUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
 	store.AddStorer(dataRetriever.UserAccountsUnit, userAccountsUnit)
@@ -515,6 +527,16 @@ func (psf *StorageServiceFactory) setupLogsAndEventsStorer(chainStorer *dataRetr
 	return nil
 }
 
+func (psf *StorageServiceFactory) createEquivocationEvidenceStorer() (storage.Storer, error) {
+	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	equivocationEvidenceDbConfig := GetDBFromConfig(psf.generalConfig.EquivocationEvidenceStorage.DB)
+	equivocationEvidenceDbConfig.FilePath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.EquivocationEvidenceStorage.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.EquivocationEvidenceStorage.Cache),
+		equivocationEvidenceDbConfig)
+}
+
 func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetriever.ChainStorer) error {
 	if !psf.generalConfig.DbLookupExtensions.Enabled {
 		return nil
@@ -589,6 +611,22 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if !psf.generalConfig.DbLookupExtensions.TransactionsByAddressEnabled {
+		return nil
+	}
+
+	// Create the transactionsByAddress (STATIC) storer
+	txsByAddressConfig := psf.generalConfig.DbLookupExtensions.TransactionsByAddressStorageConfig
+	txsByAddressDbConfig := GetDBFromConfig(txsByAddressConfig.DB)
+	txsByAddressDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, txsByAddressConfig.DB.FilePath)
+	txsByAddressCacherConfig := GetCacherFromConfig(txsByAddressConfig.Cache)
+	txsByAddressUnit, err := storageUnit.NewStorageUnitFromConf(txsByAddressCacherConfig, txsByAddressDbConfig)
+	if err != nil {
+		return err
+	}
+
+	chainStorer.AddStorer(dataRetriever.TransactionsByAddressUnit, txsByAddressUnit)
+
 	return nil
 }
 
@@ -0,0 +1,207 @@
+package factory
+
+import (
+	"fmt"
+	"os"
+
+	coreStorage "github.com/ElrondNetwork/elrond-go-core/storage"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ReadOnlyEpochsStorer is a read only storer over the epoch databases of a pruning storer of a stopped node. The keys
+// are searched in all the epoch databases, starting with the newest one. A static database is handled as a storer
+// without epochs, its keys being found only through Get and SearchFirst
+type ReadOnlyEpochsStorer struct {
+	persisters []storage.Persister
+	epochs     []uint32
+}
+
+// NewReadOnlyEpochsStorer opens the databases of the provided epochs, sorted descending, skipping the missing ones
+func NewReadOnlyEpochsStorer(
+	pathManager storage.PathManagerHandler,
+	shardID string,
+	epochs []uint32,
+	dbConfig config.DBConfig,
+) (*ReadOnlyEpochsStorer, error) {
+	es := &ReadOnlyEpochsStorer{
+		persisters: make([]storage.Persister, 0, len(epochs)),
+		epochs:     make([]uint32, 0, len(epochs)),
+	}
+
+	persisterFactory := NewPersisterFactory(dbConfig)
+	for _, epoch := range epochs {
+		path := pathManager.PathForEpoch(shardID, epoch, dbConfig.FilePath)
+		if !directoryExists(path) {
+			continue
+		}
+
+		persister, err := persisterFactory.Create(path)
+		if err != nil {
+			_ = es.Close()
+			return nil, err
+		}
+
+		es.persisters = append(es.persisters, persister)
+		es.epochs = append(es.epochs, epoch)
+	}
+
+	return es, nil
+}
+
+// NewReadOnlyStaticStorer opens the static database found in the provided path
+func NewReadOnlyStaticStorer(path string, dbConfig config.DBConfig) (*ReadOnlyEpochsStorer, error) {
+	if !directoryExists(path) {
+		return nil, fmt.Errorf("%w: %s", storage.ErrMissingDatabase, path)
+	}
+
+	persister, err := NewPersisterFactory(dbConfig).Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadOnlyEpochsStorer{
+		persisters: []storage.Persister{persister},
+	}, nil
+}
+
+// Put returns an error as the storer is read only
+func (es *ReadOnlyEpochsStorer) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyStorer
+}
+
+// PutInEpoch returns an error as the storer is read only
+func (es *ReadOnlyEpochsStorer) PutInEpoch(_, _ []byte, _ uint32) error {
+	return storage.ErrReadOnlyStorer
+}
+
+// Get returns the value from the newest database that holds the key
+func (es *ReadOnlyEpochsStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range es.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Has returns nil if the key is found in any of the databases
+func (es *ReadOnlyEpochsStorer) Has(key []byte) error {
+	_, err := es.Get(key)
+	return err
+}
+
+// SearchFirst returns the value from the newest database that holds the key
+func (es *ReadOnlyEpochsStorer) SearchFirst(key []byte) ([]byte, error) {
+	return es.Get(key)
+}
+
+// Remove returns an error as the storer is read only
+func (es *ReadOnlyEpochsStorer) Remove(_ []byte) error {
+	return storage.ErrReadOnlyStorer
+}
+
+// RemoveFromCurrentEpoch returns an error as the storer is read only
+func (es *ReadOnlyEpochsStorer) RemoveFromCurrentEpoch(_ []byte) error {
+	return storage.ErrReadOnlyStorer
+}
+
+// ClearCache does nothing as the storer has no cache
+func (es *ReadOnlyEpochsStorer) ClearCache() {
+}
+
+// DestroyUnit returns an error as the storer is read only
+func (es *ReadOnlyEpochsStorer) DestroyUnit() error {
+	return storage.ErrReadOnlyStorer
+}
+
+// GetFromEpoch returns the value from the database of the provided epoch
+func (es *ReadOnlyEpochsStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
+	persister, found := es.getPersister(epoch)
+	if !found {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return persister.Get(key)
+}
+
+// GetBulkFromEpoch returns the keys found in the database of the provided epoch
+func (es *ReadOnlyEpochsStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) ([]coreStorage.KeyValuePair, error) {
+	persister, found := es.getPersister(epoch)
+	if !found {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	results := make([]coreStorage.KeyValuePair, 0, len(keys))
+	for _, key := range keys {
+		value, err := persister.Get(key)
+		if err != nil {
+			continue
+		}
+
+		results = append(results, coreStorage.KeyValuePair{Key: key, Value: value})
+	}
+
+	return results, nil
+}
+
+func (es *ReadOnlyEpochsStorer) getPersister(epoch uint32) (storage.Persister, bool) {
+	for i, persisterEpoch := range es.epochs {
+		if persisterEpoch == epoch {
+			return es.persisters[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// GetOldestEpoch returns the oldest epoch that has a database
+func (es *ReadOnlyEpochsStorer) GetOldestEpoch() (uint32, error) {
+	if len(es.epochs) == 0 {
+		return 0, storage.ErrKeyNotFound
+	}
+
+	return es.epochs[len(es.epochs)-1], nil
+}
+
+// RangeKeys iterates over the keys of all the databases, starting with the newest one
+func (es *ReadOnlyEpochsStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	for _, persister := range es.persisters {
+		shouldContinue := true
+		persister.RangeKeys(func(key []byte, val []byte) bool {
+			shouldContinue = handler(key, val)
+			return shouldContinue
+		})
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Close closes all the opened databases
+func (es *ReadOnlyEpochsStorer) Close() error {
+	var lastError error
+	for _, persister := range es.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
+	es.persisters = nil
+	es.epochs = nil
+
+	return lastError
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *ReadOnlyEpochsStorer) IsInterfaceNil() bool {
+	return es == nil
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.IsDir()
+}
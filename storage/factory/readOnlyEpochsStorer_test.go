@@ -0,0 +1,126 @@
+package factory
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDBConfig() config.DBConfig {
+	return config.DBConfig{
+		FilePath:          "Test",
+		Type:              "LvlDBSerial",
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func writeTestDatabase(t *testing.T, path string, dbConfig config.DBConfig, pairs map[string]string) {
+	persister, err := NewPersisterFactory(dbConfig).Create(path)
+	require.Nil(t, err)
+
+	for key, value := range pairs {
+		require.Nil(t, persister.Put([]byte(key), []byte(value)))
+	}
+	require.Nil(t, persister.Close())
+}
+
+func TestReadOnlyEpochsStorer(t *testing.T) {
+	t.Parallel()
+
+	dbConfig := createTestDBConfig()
+	dbPath := t.TempDir()
+	pathManager, err := CreatePathManagerFromSinglePathString(dbPath)
+	require.Nil(t, err)
+
+	writeTestDatabase(t, pathManager.PathForEpoch("0", 2, dbConfig.FilePath), dbConfig, map[string]string{
+		"key":    "new value",
+		"newKey": "new",
+	})
+	writeTestDatabase(t, pathManager.PathForEpoch("0", 1, dbConfig.FilePath), dbConfig, map[string]string{
+		"key":    "old value",
+		"oldKey": "old",
+	})
+
+	// epoch 3 has no database and should be skipped
+	storer, err := NewReadOnlyEpochsStorer(pathManager, "0", []uint32{3, 2, 1}, dbConfig)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(storer))
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	value, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), value)
+	value, err = storer.SearchFirst([]byte("oldKey"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old"), value)
+	assert.Equal(t, storage.ErrKeyNotFound, storer.Has([]byte("missing")))
+
+	value, err = storer.GetFromEpoch([]byte("key"), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old value"), value)
+	_, err = storer.GetFromEpoch([]byte("key"), 3)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	pairs, err := storer.GetBulkFromEpoch([][]byte{[]byte("newKey"), []byte("oldKey")}, 2)
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(pairs))
+	assert.Equal(t, []byte("newKey"), pairs[0].Key)
+
+	oldestEpoch, err := storer.GetOldestEpoch()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), oldestEpoch)
+
+	numKeys := 0
+	storer.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+	assert.Equal(t, 4, numKeys)
+
+	assert.Equal(t, storage.ErrReadOnlyStorer, storer.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrReadOnlyStorer, storer.PutInEpoch([]byte("key"), []byte("value"), 1))
+	assert.Equal(t, storage.ErrReadOnlyStorer, storer.Remove([]byte("key")))
+	assert.Equal(t, storage.ErrReadOnlyStorer, storer.RemoveFromCurrentEpoch([]byte("key")))
+	assert.Equal(t, storage.ErrReadOnlyStorer, storer.DestroyUnit())
+}
+
+func TestNewReadOnlyStaticStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing database should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewReadOnlyStaticStorer(filepath.Join(t.TempDir(), "missing"), createTestDBConfig())
+		assert.Nil(t, storer)
+		assert.True(t, errors.Is(err, storage.ErrMissingDatabase))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createTestDBConfig()
+		path := filepath.Join(t.TempDir(), "Static")
+		writeTestDatabase(t, path, dbConfig, map[string]string{"key": "value"})
+
+		storer, err := NewReadOnlyStaticStorer(path, dbConfig)
+		require.Nil(t, err)
+		defer func() {
+			_ = storer.Close()
+		}()
+
+		value, err := storer.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+		_, err = storer.GetOldestEpoch()
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	})
+}
@@ -15,12 +15,16 @@ var log = logger.GetOrCreate("storage/immunitycache")
 const hospitalityWarnThreshold = -10000
 const hospitalityUpperLimit = 10000
 
+// EvictionHandler is notified about the items evicted from the cache due to capacity constraints
+type EvictionHandler func(key []byte, value interface{})
+
 // ImmunityCache is a cache-like structure
 type ImmunityCache struct {
-	config      CacheConfig
-	chunks      []*immunityChunk
-	hospitality atomic.Counter
-	mutex       sync.RWMutex
+	config          CacheConfig
+	chunks          []*immunityChunk
+	hospitality     atomic.Counter
+	evictionHandler EvictionHandler
+	mutex           sync.RWMutex
 }
 
 // NewImmunityCache creates a new cache
@@ -51,6 +55,19 @@ func (ic *ImmunityCache) initializeChunksWithLock() {
 	ic.chunks = make([]*immunityChunk, config.NumChunks)
 	for i := uint32(0); i < config.NumChunks; i++ {
 		ic.chunks[i] = newImmunityChunk(chunkConfig)
+		ic.chunks[i].evictionHandler = ic.evictionHandler
+	}
+}
+
+// RegisterEvictionHandler sets the handler to be called for each item evicted due to capacity constraints.
+// The handler is called outside the cache's locks.
+func (ic *ImmunityCache) RegisterEvictionHandler(handler EvictionHandler) {
+	ic.mutex.Lock()
+	defer ic.mutex.Unlock()
+
+	ic.evictionHandler = handler
+	for _, chunk := range ic.chunks {
+		chunk.SetEvictionHandler(handler)
 	}
 }
 
@@ -76,6 +76,34 @@ func TestImmunityCache_ImmunizeAgainstEviction(t *testing.T) {
 	require.Equal(t, 2, cache.CountImmune())
 }
 
+func TestImmunityCache_RegisterEvictionHandler(t *testing.T) {
+	cache := newCacheToTest(1, 4, maxNumBytesUpperBound)
+
+	evicted := make(map[string]interface{})
+	cache.RegisterEvictionHandler(func(key []byte, value interface{}) {
+		// Accessing the cache from within the handler should not deadlock
+		_ = cache.Len()
+		evicted[string(key)] = value
+	})
+
+	cache.addTestItems("a", "b", "c", "d")
+	cache.ImmunizeKeys(keysAsBytes([]string{"a"}))
+	require.Len(t, evicted, 0)
+
+	cache.addTestItems("e", "f")
+	require.Equal(t, map[string]interface{}{"b": "foo-b", "c": "foo-c"}, evicted)
+
+	// Explicit removals are not reported
+	cache.Remove([]byte("d"))
+	cache.addTestItems("g")
+	require.Len(t, evicted, 2)
+
+	// The handler survives a Clear()
+	cache.Clear()
+	cache.addTestItems("h", "i", "j", "k", "l")
+	require.Contains(t, evicted, "h")
+}
+
 func TestImmunityCache_ImmunizeDoesNothingIfCapacityReached(t *testing.T) {
 	cache := newCacheToTest(1, 4, maxNumBytesUpperBound)
 
@@ -17,6 +17,9 @@ type immunityChunk struct {
 	immuneKeys  map[string]struct{}
 	numBytes    int
 	mutex       sync.RWMutex
+
+	evictionHandler EvictionHandler
+	evictedItems    []*cacheItem
 }
 
 type chunkItemWrapper struct {
@@ -68,26 +71,50 @@ func (chunk *immunityChunk) getItemNoLock(key string) (*cacheItem, bool) {
 	return wrapper.item, true
 }
 
+// SetEvictionHandler sets the handler to be notified about the evicted items
+func (chunk *immunityChunk) SetEvictionHandler(handler EvictionHandler) {
+	chunk.mutex.Lock()
+	chunk.evictionHandler = handler
+	chunk.mutex.Unlock()
+}
+
 // AddItem add an item to the chunk
 func (chunk *immunityChunk) AddItem(item *cacheItem) (has, added bool) {
+	has, added, evictedItems, handler := chunk.addItemWithLock(item)
+
+	// The eviction handler is called outside the chunk's lock, so that it is free to access the cache
+	for _, evictedItem := range evictedItems {
+		handler([]byte(evictedItem.key), evictedItem.payload)
+	}
+
+	return has, added
+}
+
+func (chunk *immunityChunk) addItemWithLock(item *cacheItem) (has, added bool, evictedItems []*cacheItem, handler EvictionHandler) {
 	chunk.mutex.Lock()
 	defer chunk.mutex.Unlock()
 
+	defer func() {
+		evictedItems = chunk.evictedItems
+		handler = chunk.evictionHandler
+		chunk.evictedItems = nil
+	}()
+
 	err := chunk.evictItemsIfCapacityExceededNoLock()
 	if err != nil {
 		// No more room for the new item
-		return false, false
+		return false, false, nil, nil
 	}
 
 	// Discard duplicates
 	if chunk.itemExistsNoLock(item) {
-		return true, false
+		return true, false, nil, nil
 	}
 
 	chunk.addItemNoLock(item)
 	chunk.immunizeItemOnAddNoLock(item)
 	chunk.trackNumBytesOnAddNoLock(item)
-	return false, true
+	return false, true, nil, nil
 }
 
 func (chunk *immunityChunk) evictItemsIfCapacityExceededNoLock() error {
@@ -141,12 +168,21 @@ func (chunk *immunityChunk) removeOldestNoLock(numToRemove int) int {
 		element = element.Next()
 
 		chunk.removeNoLock(elementToRemove)
+		chunk.trackEvictedItemNoLock(item)
 		numRemoved++
 	}
 
 	return numRemoved
 }
 
+func (chunk *immunityChunk) trackEvictedItemNoLock(item *cacheItem) {
+	if chunk.evictionHandler == nil {
+		return
+	}
+
+	chunk.evictedItems = append(chunk.evictedItems, item)
+}
+
 func (chunk *immunityChunk) removeNoLock(element *list.Element) {
 	item := element.Value.(*cacheItem)
 	delete(chunk.items, item.key)
@@ -217,7 +253,12 @@ func (chunk *immunityChunk) trackNumBytesOnRemoveNoLock(item *cacheItem) {
 func (chunk *immunityChunk) RemoveOldest(numToRemove int) int {
 	chunk.mutex.Lock()
 	defer chunk.mutex.Unlock()
-	return chunk.removeOldestNoLock(numToRemove)
+
+	numRemoved := chunk.removeOldestNoLock(numToRemove)
+	// Explicit removals are not reported as evictions
+	chunk.evictedItems = nil
+
+	return numRemoved
 }
 
 // Count counts the items
@@ -103,6 +103,12 @@ type Storer interface {
 	IsInterfaceNil() bool
 }
 
+// StatisticsProvider defines a storer able to provide its usage statistics. Counting the keys iterates over all the
+// keys of the databases, so it should be requested only on demand
+type StatisticsProvider interface {
+	GetStatistics(withNumKeys bool) StorerStatistics
+}
+
 // StorerWithPutInEpoch is an extended storer with the ability to set the epoch which will be used for put operations
 type StorerWithPutInEpoch interface {
 	Storer
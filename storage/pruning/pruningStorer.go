@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"runtime/debug"
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -100,6 +101,7 @@ type PruningStorer struct {
 	numOfActivePersisters  uint32
 	epochForPutOperation   uint32
 	pruningEnabled         bool
+	counters               storage.StorerCounters
 }
 
 // NewPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -333,6 +335,7 @@ func (ps *PruningStorer) getPersisterToUse() *persisterData {
 }
 
 func (ps *PruningStorer) doPutInPersister(key, data []byte, persister storage.Persister) error {
+	ps.counters.AddPut()
 	err := persister.Put(key, data)
 	if err != nil {
 		ps.cacher.Remove(key)
@@ -407,6 +410,7 @@ func (ps *PruningStorer) createAndInitPersister(pd *persisterData) (storage.Pers
 // Get searches the key in the cache. In case it is not found, the key may be in the db.
 func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 	v, ok := ps.cacher.Get(key)
+	ps.counters.AddCacheLookup(ok)
 	if ok {
 		return v.([]byte), nil
 	}
@@ -468,6 +472,7 @@ func (ps *PruningStorer) Close() error {
 func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
 	// TODO: this will be used when requesting from resolvers
 	v, ok := ps.cacher.Get(key)
+	ps.counters.AddCacheLookup(ok)
 	if ok {
 		return v.([]byte), nil
 	}
@@ -547,6 +552,7 @@ func (ps *PruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) ([]storag
 // SearchFirst will search a given key in all the active persisters, from the newest to the oldest
 func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
 	v, ok := ps.cacher.Get(key)
+	ps.counters.AddCacheLookup(ok)
 	if ok {
 		return v.([]byte), nil
 	}
@@ -658,6 +664,40 @@ func (ps *PruningStorer) GetOldestEpoch() (uint32, error) {
 	return oldestEpoch, nil
 }
 
+// GetStatistics returns the usage statistics of the storer, with a database entry for each known epoch, starting with
+// the newest one. The keys are counted only for the opened databases
+func (ps *PruningStorer) GetStatistics(withNumKeys bool) storage.StorerStatistics {
+	stats := storage.StorerStatistics{}
+	ps.counters.FillStatistics(&stats)
+
+	ps.lock.RLock()
+	persisters := make([]*persisterData, 0, len(ps.persistersMapByEpoch))
+	for _, pd := range ps.persistersMapByEpoch {
+		persisters = append(persisters, pd)
+	}
+	ps.lock.RUnlock()
+
+	sort.Slice(persisters, func(i, j int) bool {
+		return persisters[i].epoch > persisters[j].epoch
+	})
+
+	stats.Databases = make([]storage.DatabaseStatistics, 0, len(persisters))
+	for _, pd := range persisters {
+		dbStats := storage.DatabaseStatistics{
+			Epoch:       pd.epoch,
+			Path:        pd.path,
+			SizeInBytes: storage.ComputeDirectorySize(pd.path),
+		}
+		if withNumKeys && !pd.getIsClosed() {
+			dbStats.NumKeys = storage.CountKeys(pd.getPersister())
+		}
+
+		stats.Databases = append(stats.Databases, dbStats)
+	}
+
+	return stats
+}
+
 // DestroyUnit cleans up the cache and the dbs
 func (ps *PruningStorer) DestroyUnit() error {
 	ps.lock.Lock()
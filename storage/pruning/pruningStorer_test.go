@@ -230,6 +230,43 @@ func TestPruningStorer_PutAndGetShouldWork(t *testing.T) {
 	assert.Equal(t, testVal, res)
 }
 
+func TestPruningStorer_GetStatistics(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.PathManager = &testscommon.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return fmt.Sprintf("Epoch_%d/Shard_%s/%s", epoch, shardId, identifier)
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+
+	_ = ps.Put([]byte("key1"), []byte("value1"))
+	_ = ps.Put([]byte("key2"), []byte("value2"))
+	_, _ = ps.Get([]byte("key1"))
+	ps.ClearCache()
+	_, _ = ps.Get([]byte("key1"))
+
+	_ = ps.ChangeEpochSimple(1)
+	ps.SetEpochForPutOperation(1)
+	_ = ps.Put([]byte("key3"), []byte("value3"))
+
+	stats := ps.GetStatistics(true)
+	assert.False(t, stats.IsStatic)
+	assert.Equal(t, uint64(3), stats.NumPuts)
+	assert.Equal(t, uint64(1), stats.NumCacheHits)
+	assert.Equal(t, uint64(1), stats.NumCacheMisses)
+	require.Equal(t, 2, len(stats.Databases))
+	assert.Equal(t, uint32(1), stats.Databases[0].Epoch)
+	assert.Equal(t, "Epoch_1/Shard_0/id", stats.Databases[0].Path)
+	assert.Equal(t, uint64(1), stats.Databases[0].NumKeys)
+	assert.Equal(t, uint32(0), stats.Databases[1].Epoch)
+	assert.Equal(t, uint64(2), stats.Databases[1].NumKeys)
+
+	stats = ps.GetStatistics(false)
+	assert.Equal(t, uint64(0), stats.Databases[0].NumKeys)
+}
+
 func TestPruningStorer_Put_EpochWhichWasSetDoesNotExistShouldNotFind(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+)
+
+// DatabaseStatistics holds the usage statistics of one database of a storer
+type DatabaseStatistics struct {
+	Epoch       uint32
+	Path        string
+	SizeInBytes uint64
+	NumKeys     uint64
+}
+
+// StorerStatistics holds the usage statistics of a storer
+type StorerStatistics struct {
+	IsStatic       bool
+	NumCacheHits   uint64
+	NumCacheMisses uint64
+	NumPuts        uint64
+	Databases      []DatabaseStatistics
+}
+
+// StorerCounters holds the counters of the operations done on a storer
+type StorerCounters struct {
+	numCacheHits   atomic.Counter
+	numCacheMisses atomic.Counter
+	numPuts        atomic.Counter
+}
+
+// AddCacheLookup increments the cache hits or the cache misses counter
+func (sc *StorerCounters) AddCacheLookup(found bool) {
+	if found {
+		sc.numCacheHits.Increment()
+		return
+	}
+
+	sc.numCacheMisses.Increment()
+}
+
+// AddPut increments the put operations counter
+func (sc *StorerCounters) AddPut() {
+	sc.numPuts.Increment()
+}
+
+// FillStatistics copies the counters values in the provided statistics
+func (sc *StorerCounters) FillStatistics(stats *StorerStatistics) {
+	stats.NumCacheHits = sc.numCacheHits.GetUint64()
+	stats.NumCacheMisses = sc.numCacheMisses.GetUint64()
+	stats.NumPuts = sc.numPuts.GetUint64()
+}
+
+// ComputeDirectorySize returns the cumulated size of all the files found under the provided path. A missing path
+// has the size 0, as memory databases do not have one
+func ComputeDirectorySize(path string) uint64 {
+	if len(path) == 0 {
+		return 0
+	}
+
+	size := uint64(0)
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files can be removed by compactions while walking, so these are not considered errors
+			return nil
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size
+}
+
+// CountKeys returns the number of keys held by the persister. It iterates over all the keys, so it should be used
+// only on demand
+func CountKeys(persister Persister) uint64 {
+	numKeys := uint64(0)
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys
+}
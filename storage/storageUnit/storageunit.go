@@ -16,6 +16,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -42,11 +43,11 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
-// More to be added
+// LvlDB, LvlDBSerial and BadgerDB are the supported on-disk DBs, while MemoryDB keeps the data only in memory
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	BadgerDB    DBType = "BadgerDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -103,6 +104,8 @@ type Unit struct {
 	lock      sync.RWMutex
 	persister storage.Persister
 	cacher    storage.Cacher
+	path      string
+	counters  storage.StorerCounters
 }
 
 // Put adds data to both cache and persistence medium
@@ -110,6 +113,7 @@ func (u *Unit) Put(key, data []byte) error {
 	u.lock.Lock()
 	defer u.lock.Unlock()
 
+	u.counters.AddPut()
 	u.cacher.Put(key, data, len(data))
 
 	err := u.persister.Put(key, data)
@@ -157,6 +161,7 @@ func (u *Unit) Get(key []byte) ([]byte, error) {
 	defer u.lock.Unlock()
 
 	v, ok := u.cacher.Get(key)
+	u.counters.AddCacheLookup(ok)
 	var err error
 
 	if !ok {
@@ -252,6 +257,25 @@ func (u *Unit) DestroyUnit() error {
 	return u.persister.Destroy()
 }
 
+// GetStatistics returns the usage statistics of the unit
+func (u *Unit) GetStatistics(withNumKeys bool) storage.StorerStatistics {
+	stats := storage.StorerStatistics{
+		IsStatic: true,
+	}
+	u.counters.FillStatistics(&stats)
+
+	dbStats := storage.DatabaseStatistics{
+		Path:        u.path,
+		SizeInBytes: storage.ComputeDirectorySize(u.path),
+	}
+	if withNumKeys {
+		dbStats.NumKeys = storage.CountKeys(u.persister)
+	}
+	stats.Databases = []storage.DatabaseStatistics{dbStats}
+
+	return stats
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (u *Unit) IsInterfaceNil() bool {
 	return u == nil
@@ -305,7 +329,13 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig) (*Unit, erro
 		return nil, err
 	}
 
-	return NewStorageUnit(cache, db)
+	unit, err := NewStorageUnit(cache, db)
+	if err != nil {
+		return nil, err
+	}
+	unit.path = dbConf.FilePath
+
+	return unit, nil
 }
 
 // NewCache creates a new cache from a cache config
@@ -374,6 +404,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
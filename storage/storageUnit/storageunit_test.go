@@ -3,6 +3,7 @@ package storageUnit_test
 import (
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func logError(err error) {
@@ -383,6 +385,57 @@ func TestNewStorageUnit_ShouldWorkLvlDB(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestUnit_GetStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("memory unit should count the operations", func(t *testing.T) {
+		t.Parallel()
+
+		sUnit := initStorageUnit(t, 10)
+		_ = sUnit.Put([]byte("key1"), []byte("value1"))
+		_ = sUnit.Put([]byte("key2"), []byte("value2"))
+		_, _ = sUnit.Get([]byte("key1"))
+		sUnit.ClearCache()
+		_, _ = sUnit.Get([]byte("key1"))
+		_, _ = sUnit.Get([]byte("missing key"))
+
+		stats := sUnit.GetStatistics(true)
+		assert.True(t, stats.IsStatic)
+		assert.Equal(t, uint64(2), stats.NumPuts)
+		assert.Equal(t, uint64(1), stats.NumCacheHits)
+		assert.Equal(t, uint64(2), stats.NumCacheMisses)
+		assert.Equal(t, 1, len(stats.Databases))
+		assert.Equal(t, uint64(2), stats.Databases[0].NumKeys)
+		assert.Equal(t, uint64(0), stats.Databases[0].SizeInBytes)
+	})
+	t.Run("leveldb unit should compute the size", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := filepath.Join(t.TempDir(), "Blocks")
+		sUnit, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
+			Capacity: 10,
+			Type:     storageUnit.LRUCache,
+		}, storageUnit.DBConfig{
+			FilePath:          dbPath,
+			Type:              storageUnit.LvlDBSerial,
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = sUnit.Close()
+		}()
+
+		_ = sUnit.Put([]byte("key"), []byte("value"))
+
+		stats := sUnit.GetStatistics(false)
+		assert.Equal(t, dbPath, stats.Databases[0].Path)
+		assert.True(t, stats.Databases[0].SizeInBytes > 0)
+		assert.Equal(t, uint64(0), stats.Databases[0].NumKeys)
+	})
+}
+
 const (
 	valuesInDb = 100000
 )
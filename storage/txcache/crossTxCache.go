@@ -1,6 +1,7 @@
 package txcache
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/immunitycache"
 )
@@ -10,7 +11,8 @@ var _ storage.Cacher = (*CrossTxCache)(nil)
 // CrossTxCache holds cross-shard transactions (where destination == me)
 type CrossTxCache struct {
 	*immunitycache.ImmunityCache
-	config ConfigDestinationMe
+	config   ConfigDestinationMe
+	overflow OverflowHandler
 }
 
 // NewCrossTxCache creates a new transactions cache
@@ -38,11 +40,33 @@ func NewCrossTxCache(config ConfigDestinationMe) (*CrossTxCache, error) {
 	cache := CrossTxCache{
 		ImmunityCache: immunityCache,
 		config:        config,
+		overflow:      NewDisabledOverflow(),
 	}
 
+	immunityCache.RegisterEvictionHandler(cache.onEvicted)
+
 	return &cache, nil
 }
 
+// SetOverflowHandler sets the tier where the evicted transactions are spilled, to be pulled back in as space frees up
+func (cache *CrossTxCache) SetOverflowHandler(handler OverflowHandler) error {
+	if check.IfNil(handler) {
+		return storage.ErrNilOverflowHandler
+	}
+
+	cache.overflow = handler
+	return nil
+}
+
+func (cache *CrossTxCache) onEvicted(_ []byte, value interface{}) {
+	tx, ok := value.(*WrappedTransaction)
+	if !ok {
+		return
+	}
+
+	_ = cache.overflow.AddTxs([]*WrappedTransaction{tx})
+}
+
 // ImmunizeTxsAgainstEviction marks items as non-evictable
 func (cache *CrossTxCache) ImmunizeTxsAgainstEviction(keys [][]byte) {
 	numNow, numFuture := cache.ImmunityCache.ImmunizeKeys(keys)
@@ -57,14 +81,21 @@ func (cache *CrossTxCache) ImmunizeTxsAgainstEviction(keys [][]byte) {
 
 // AddTx adds a transaction in the cache
 func (cache *CrossTxCache) AddTx(tx *WrappedTransaction) (has, added bool) {
-	return cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
+	has, added = cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
+
+	// A transaction received again while held by the overflow should not be accounted twice
+	if added && cache.overflow.HasTx(tx.TxHash) {
+		_ = cache.overflow.RemoveTxByHash(tx.TxHash)
+	}
+
+	return has, added
 }
 
-// GetByTxHash gets the transaction by hash
+// GetByTxHash gets the transaction by hash (the overflow is searched as well)
 func (cache *CrossTxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	item, ok := cache.ImmunityCache.Get(txHash)
 	if !ok {
-		return nil, false
+		return cache.overflow.GetByTxHash(txHash)
 	}
 	tx, ok := item.(*WrappedTransaction)
 	if !ok {
@@ -91,9 +122,50 @@ func (cache *CrossTxCache) Peek(key []byte) (value interface{}, ok bool) {
 	return cache.Get(key)
 }
 
+// Has checks if a transaction exists (in the cache or in its overflow)
+func (cache *CrossTxCache) Has(key []byte) bool {
+	return cache.ImmunityCache.Has(key) || cache.overflow.HasTx(key)
+}
+
+// Keys returns the tx hashes in the cache and in its overflow
+func (cache *CrossTxCache) Keys() [][]byte {
+	return append(cache.ImmunityCache.Keys(), cache.overflow.Keys()...)
+}
+
 // RemoveTxByHash removes tx by hash
 func (cache *CrossTxCache) RemoveTxByHash(txHash []byte) bool {
-	return cache.RemoveWithResult(txHash)
+	foundInOverflow := cache.overflow.RemoveTxByHash(txHash)
+	foundInCache := cache.RemoveWithResult(txHash)
+	return foundInCache || foundInOverflow
+}
+
+// Remove removes tx by hash
+func (cache *CrossTxCache) Remove(key []byte) {
+	_ = cache.RemoveTxByHash(key)
+}
+
+// RefillFromOverflow pulls transactions back from the overflow, as long as they fit within the capacity of the cache.
+// It returns the number of transactions pulled back.
+func (cache *CrossTxCache) RefillFromOverflow() int {
+	numTxsAvailable := int(cache.config.MaxNumItems) - cache.Len()
+	numBytesAvailable := int(cache.config.MaxNumBytes) - cache.NumBytes()
+	if numTxsAvailable <= 0 || numBytesAvailable <= 0 || cache.overflow.CountTx() == 0 {
+		return 0
+	}
+
+	txs := cache.overflow.PopTxs(numTxsAvailable, numBytesAvailable)
+	for _, tx := range txs {
+		_, _ = cache.AddTx(tx)
+	}
+
+	log.Trace("CrossTxCache.RefillFromOverflow()", "name", cache.config.Name, "numTxs", len(txs))
+	return len(txs)
+}
+
+// Clear clears the cache (and its overflow)
+func (cache *CrossTxCache) Clear() {
+	cache.ImmunityCache.Clear()
+	cache.overflow.Clear()
 }
 
 // ForEachTransaction iterates over the transactions in the cache
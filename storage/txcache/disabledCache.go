@@ -122,6 +122,16 @@ func (cache *DisabledCache) GetTransactionsPoolForSender(_ string) []*WrappedTra
 	return make([]*WrappedTransaction, 0)
 }
 
+// SetOverflowHandler does nothing
+func (cache *DisabledCache) SetOverflowHandler(_ OverflowHandler) error {
+	return nil
+}
+
+// RefillFromOverflow does nothing
+func (cache *DisabledCache) RefillFromOverflow() int {
+	return 0
+}
+
 // Close does nothing
 func (cache *DisabledCache) Close() error {
 	return nil
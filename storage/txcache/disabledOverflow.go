@@ -0,0 +1,65 @@
+package txcache
+
+var _ OverflowHandler = (*disabledOverflow)(nil)
+
+type disabledOverflow struct {
+}
+
+// NewDisabledOverflow creates a new disabled overflow (transactions evicted from the cache are simply dropped)
+func NewDisabledOverflow() *disabledOverflow {
+	return &disabledOverflow{}
+}
+
+// AddTxs does nothing
+func (overflow *disabledOverflow) AddTxs(_ []*WrappedTransaction) int {
+	return 0
+}
+
+// GetByTxHash returns no transaction
+func (overflow *disabledOverflow) GetByTxHash(_ []byte) (*WrappedTransaction, bool) {
+	return nil, false
+}
+
+// HasTx returns false
+func (overflow *disabledOverflow) HasTx(_ []byte) bool {
+	return false
+}
+
+// RemoveTxByHash does nothing
+func (overflow *disabledOverflow) RemoveTxByHash(_ []byte) bool {
+	return false
+}
+
+// GetTxsForSender returns an empty slice
+func (overflow *disabledOverflow) GetTxsForSender(_ string) []*WrappedTransaction {
+	return make([]*WrappedTransaction, 0)
+}
+
+// PopTxs returns an empty slice
+func (overflow *disabledOverflow) PopTxs(_ int, _ int) []*WrappedTransaction {
+	return make([]*WrappedTransaction, 0)
+}
+
+// Keys returns an empty slice
+func (overflow *disabledOverflow) Keys() [][]byte {
+	return make([][]byte, 0)
+}
+
+// CountTx returns zero
+func (overflow *disabledOverflow) CountTx() uint64 {
+	return 0
+}
+
+// NumBytes returns zero
+func (overflow *disabledOverflow) NumBytes() int {
+	return 0
+}
+
+// Clear does nothing
+func (overflow *disabledOverflow) Clear() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (overflow *disabledOverflow) IsInterfaceNil() bool {
+	return overflow == nil
+}
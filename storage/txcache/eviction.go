@@ -95,6 +95,7 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 		batchEndBounded := core.MinUint32(batchEnd, snapshotLength)
 		batch := snapshot[batchStart:batchEndBounded]
 
+		cache.spillToOverflow(batch)
 		numTxsEvictedInStep, numSendersEvictedInStep := cache.evictSendersAndTheirTxs(batch)
 
 		numTxs += numTxsEvictedInStep
@@ -114,6 +115,19 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 	return
 }
 
+// spillToOverflow hands the transactions about to be evicted to the overflow (if any), so that they are not lost
+func (cache *TxCache) spillToOverflow(listsToEvict []*txListForSender) {
+	txs := make([]*WrappedTransaction, 0, approximatelyCountTxInLists(listsToEvict))
+	for _, txList := range listsToEvict {
+		txs = append(txs, txList.getTxs()...)
+	}
+
+	numSpilled := cache.overflow.AddTxs(txs)
+	if numSpilled > 0 {
+		log.Trace("TxCache.spillToOverflow()", "name", cache.name, "numTxs", len(txs), "numSpilled", numSpilled)
+	}
+}
+
 // This is called concurrently by two goroutines: the eviction one and the sweeping one
 func (cache *TxCache) evictSendersAndTheirTxs(listsToEvict []*txListForSender) (uint32, uint32) {
 	sendersToEvict := make([]string, 0, len(listsToEvict))
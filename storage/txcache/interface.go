@@ -21,3 +21,18 @@ type TxGasHandler interface {
 
 // ForEachTransaction is an iterator callback
 type ForEachTransaction func(txHash []byte, value *WrappedTransaction)
+
+// OverflowHandler defines the behavior of a secondary (spillover) tier, holding the transactions evicted from a cache
+type OverflowHandler interface {
+	AddTxs(txs []*WrappedTransaction) int
+	GetByTxHash(txHash []byte) (*WrappedTransaction, bool)
+	HasTx(txHash []byte) bool
+	RemoveTxByHash(txHash []byte) bool
+	GetTxsForSender(sender string) []*WrappedTransaction
+	PopTxs(maxNumTxs int, maxNumBytes int) []*WrappedTransaction
+	Keys() [][]byte
+	CountTx() uint64
+	NumBytes() int
+	Clear()
+	IsInterfaceNil() bool
+}
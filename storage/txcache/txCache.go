@@ -1,6 +1,7 @@
 package txcache
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
@@ -27,6 +28,7 @@ type TxCache struct {
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 	mutTxOperation            sync.Mutex
+	overflow                  OverflowHandler
 }
 
 // NewTxCache creates a new transaction cache
@@ -54,6 +56,7 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
 		evictionJournal: evictionJournal{},
+		overflow:        NewDisabledOverflow(),
 	}
 
 	txCache.initSweepable()
@@ -89,15 +92,34 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.txByHash.RemoveTxsBulk(evicted)
 	}
 
+	// A transaction received again while held by the overflow should not be accounted twice
+	if cache.overflow.HasTx(tx.TxHash) {
+		_ = cache.overflow.RemoveTxByHash(tx.TxHash)
+	}
+
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
 	// This it to ensure that onAdded() notification is triggered.
 	return true, addedInByHash || addedInBySender
 }
 
-// GetByTxHash gets the transaction by hash
+// SetOverflowHandler sets the tier where the evicted transactions are spilled, to be pulled back in as space frees up
+func (cache *TxCache) SetOverflowHandler(handler OverflowHandler) error {
+	if check.IfNil(handler) {
+		return storage.ErrNilOverflowHandler
+	}
+
+	cache.overflow = handler
+	return nil
+}
+
+// GetByTxHash gets the transaction by hash (the overflow is searched as well)
 func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, ok := cache.txByHash.getTx(string(txHash))
-	return tx, ok
+	if ok {
+		return tx, true
+	}
+
+	return cache.overflow.GetByTxHash(txHash)
 }
 
 // SelectTransactionsWithBandwidth selects a reasonably fair list of transactions to be included in the next miniblock
@@ -167,9 +189,11 @@ func (cache *TxCache) RemoveTxByHash(txHash []byte) bool {
 	cache.mutTxOperation.Lock()
 	defer cache.mutTxOperation.Unlock()
 
+	foundInOverflow := cache.overflow.RemoveTxByHash(txHash)
+
 	tx, foundInByHash := cache.txByHash.removeTx(string(txHash))
 	if !foundInByHash {
-		return false
+		return foundInOverflow
 	}
 
 	foundInBySender := cache.txListBySender.removeTx(tx)
@@ -219,10 +243,17 @@ func (cache *TxCache) ForEachTransaction(function ForEachTransaction) {
 }
 
 // GetTransactionsPoolForSender returns the list of transaction hashes for the sender
+// The transactions held by the overflow are included as well, the result being sorted by nonce.
 func (cache *TxCache) GetTransactionsPoolForSender(sender string) []*WrappedTransaction {
+	overflowTxs := cache.overflow.GetTxsForSender(sender)
+
 	listForSender, ok := cache.txListBySender.getListForSender(sender)
 	if !ok {
-		return nil
+		if len(overflowTxs) == 0 {
+			return nil
+		}
+
+		return overflowTxs
 	}
 
 	wrappedTxs := make([]*WrappedTransaction, listForSender.items.Len())
@@ -231,15 +262,44 @@ func (cache *TxCache) GetTransactionsPoolForSender(sender string) []*WrappedTran
 		wrappedTxs[i] = tx
 	}
 
+	if len(overflowTxs) == 0 {
+		return wrappedTxs
+	}
+
+	wrappedTxs = append(wrappedTxs, overflowTxs...)
+	sort.SliceStable(wrappedTxs, func(i, j int) bool {
+		return wrappedTxs[i].Tx.GetNonce() < wrappedTxs[j].Tx.GetNonce()
+	})
+
 	return wrappedTxs
 }
 
-// Clear clears the cache
+// RefillFromOverflow pulls transactions back from the overflow, as long as they fit within the capacity of the cache.
+// It returns the number of transactions pulled back.
+func (cache *TxCache) RefillFromOverflow() int {
+	numTxsAvailable := int(cache.config.CountThreshold) - cache.Len()
+	numBytesAvailable := int(cache.config.NumBytesThreshold) - cache.NumBytes()
+	if numTxsAvailable <= 0 || numBytesAvailable <= 0 || cache.overflow.CountTx() == 0 {
+		return 0
+	}
+
+	txs := cache.overflow.PopTxs(numTxsAvailable, numBytesAvailable)
+	for _, tx := range txs {
+		_, _ = cache.AddTx(tx)
+	}
+
+	log.Trace("TxCache.RefillFromOverflow()", "name", cache.name, "numTxs", len(txs))
+	return len(txs)
+}
+
+// Clear clears the cache (and its overflow)
 func (cache *TxCache) Clear() {
 	cache.mutTxOperation.Lock()
 	cache.txListBySender.clear()
 	cache.txByHash.clear()
 	cache.mutTxOperation.Unlock()
+
+	cache.overflow.Clear()
 }
 
 // Put is not implemented
@@ -258,10 +318,10 @@ func (cache *TxCache) Get(key []byte) (value interface{}, ok bool) {
 	return nil, false
 }
 
-// Has checks if a transaction exists
+// Has checks if a transaction exists (in the cache or in its overflow)
 func (cache *TxCache) Has(key []byte) bool {
-	_, ok := cache.GetByTxHash(key)
-	return ok
+	_, ok := cache.txByHash.getTx(string(key))
+	return ok || cache.overflow.HasTx(key)
 }
 
 // Peek gets a transaction (unwrapped) by hash
@@ -285,9 +345,9 @@ func (cache *TxCache) Remove(key []byte) {
 	_ = cache.RemoveTxByHash(key)
 }
 
-// Keys returns the tx hashes in the cache
+// Keys returns the tx hashes in the cache and in its overflow
 func (cache *TxCache) Keys() [][]byte {
-	return cache.txByHash.keys()
+	return append(cache.txByHash.keys(), cache.overflow.Keys()...)
 }
 
 // MaxSize is not implemented
@@ -0,0 +1,379 @@
+package txcache
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ OverflowHandler = (*TxOverflow)(nil)
+
+const nonceSize = 8
+
+// ArgsTxOverflow holds the arguments needed to create a TxOverflow
+type ArgsTxOverflow struct {
+	Name        string
+	Persister   storage.Persister
+	Marshalizer marshal.Marshalizer
+	MaxNumTxs   uint32
+	NewEmptyTx  func() data.TransactionHandler
+}
+
+type overflowEntry struct {
+	key             []byte
+	txHash          string
+	sender          string
+	nonce           uint64
+	size            int64
+	senderShardID   uint32
+	receiverShardID uint32
+}
+
+// TxOverflow is a disk-backed tier holding the transactions evicted from a cache, until they can be pulled back in.
+// Only the transactions' metadata is held in memory, indexed by hash and by sender (sorted by nonce).
+type TxOverflow struct {
+	name        string
+	persister   storage.Persister
+	marshalizer marshal.Marshalizer
+	maxNumTxs   uint32
+	newEmptyTx  func() data.TransactionHandler
+
+	mutex    sync.RWMutex
+	byHash   map[string]*overflowEntry
+	bySender map[string][]*overflowEntry
+	numBytes int
+}
+
+// NewTxOverflow creates a new disk-backed transactions overflow
+func NewTxOverflow(args ArgsTxOverflow) (*TxOverflow, error) {
+	if len(args.Name) == 0 {
+		return nil, fmt.Errorf("%w: name is empty", storage.ErrInvalidConfig)
+	}
+	if check.IfNil(args.Persister) {
+		return nil, storage.ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, storage.ErrNilMarshalizer
+	}
+	if args.MaxNumTxs == 0 {
+		return nil, fmt.Errorf("%w: MaxNumTxs is zero", storage.ErrInvalidConfig)
+	}
+	if args.NewEmptyTx == nil {
+		return nil, storage.ErrNilEmptyTxCreator
+	}
+
+	return &TxOverflow{
+		name:        args.Name,
+		persister:   args.Persister,
+		marshalizer: args.Marshalizer,
+		maxNumTxs:   args.MaxNumTxs,
+		newEmptyTx:  args.NewEmptyTx,
+		byHash:      make(map[string]*overflowEntry),
+		bySender:    make(map[string][]*overflowEntry),
+	}, nil
+}
+
+// AddTxs writes the provided transactions to the persister. Once the maximum capacity is reached,
+// the remaining transactions are dropped. It returns the number of added transactions.
+func (overflow *TxOverflow) AddTxs(txs []*WrappedTransaction) int {
+	overflow.mutex.Lock()
+	defer overflow.mutex.Unlock()
+
+	numAdded := 0
+	for _, tx := range txs {
+		if len(overflow.byHash) >= int(overflow.maxNumTxs) {
+			log.Trace("TxOverflow.AddTxs(): capacity reached", "name", overflow.name, "numDropped", len(txs)-numAdded)
+			break
+		}
+		if tx == nil || check.IfNil(tx.Tx) {
+			continue
+		}
+		if _, exists := overflow.byHash[string(tx.TxHash)]; exists {
+			continue
+		}
+
+		err := overflow.addTxNoLock(tx)
+		if err != nil {
+			log.Debug("TxOverflow.AddTxs()", "name", overflow.name, "tx", tx.TxHash, "err", err)
+			continue
+		}
+
+		numAdded++
+	}
+
+	return numAdded
+}
+
+func (overflow *TxOverflow) addTxNoLock(tx *WrappedTransaction) error {
+	txBytes, err := overflow.marshalizer.Marshal(tx.Tx)
+	if err != nil {
+		return err
+	}
+
+	entry := &overflowEntry{
+		txHash:          string(tx.TxHash),
+		sender:          string(tx.Tx.GetSndAddr()),
+		nonce:           tx.Tx.GetNonce(),
+		size:            tx.Size,
+		senderShardID:   tx.SenderShardID,
+		receiverShardID: tx.ReceiverShardID,
+	}
+	entry.key = overflow.createKey(entry)
+
+	err = overflow.persister.Put(entry.key, txBytes)
+	if err != nil {
+		return err
+	}
+
+	overflow.byHash[entry.txHash] = entry
+	overflow.insertInSenderListNoLock(entry)
+	overflow.numBytes += int(entry.size)
+
+	return nil
+}
+
+// The key is made of: len(name) | name | len(sender) | sender | nonce (big endian) | txHash,
+// so that the persisted transactions are grouped by cache & sender and ordered by nonce
+func (overflow *TxOverflow) createKey(entry *overflowEntry) []byte {
+	key := make([]byte, 0, 2+len(overflow.name)+len(entry.sender)+nonceSize+len(entry.txHash))
+	key = append(key, byte(len(overflow.name)))
+	key = append(key, overflow.name...)
+	key = append(key, byte(len(entry.sender)))
+	key = append(key, entry.sender...)
+
+	nonceBytes := make([]byte, nonceSize)
+	binary.BigEndian.PutUint64(nonceBytes, entry.nonce)
+	key = append(key, nonceBytes...)
+
+	return append(key, entry.txHash...)
+}
+
+func (overflow *TxOverflow) insertInSenderListNoLock(entry *overflowEntry) {
+	entries := overflow.bySender[entry.sender]
+	index := sort.Search(len(entries), func(i int) bool {
+		return entries[i].nonce > entry.nonce
+	})
+
+	entries = append(entries, nil)
+	copy(entries[index+1:], entries[index:])
+	entries[index] = entry
+	overflow.bySender[entry.sender] = entries
+}
+
+// GetByTxHash loads a transaction from the persister
+func (overflow *TxOverflow) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
+	overflow.mutex.RLock()
+	defer overflow.mutex.RUnlock()
+
+	entry, ok := overflow.byHash[string(txHash)]
+	if !ok {
+		return nil, false
+	}
+
+	tx, err := overflow.loadTxNoLock(entry)
+	if err != nil {
+		log.Debug("TxOverflow.GetByTxHash()", "name", overflow.name, "tx", txHash, "err", err)
+		return nil, false
+	}
+
+	return tx, true
+}
+
+func (overflow *TxOverflow) loadTxNoLock(entry *overflowEntry) (*WrappedTransaction, error) {
+	txBytes, err := overflow.persister.Get(entry.key)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := overflow.newEmptyTx()
+	err = overflow.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WrappedTransaction{
+		Tx:              tx,
+		TxHash:          []byte(entry.txHash),
+		SenderShardID:   entry.senderShardID,
+		ReceiverShardID: entry.receiverShardID,
+		Size:            entry.size,
+	}, nil
+}
+
+// HasTx returns true if the transaction is held by the overflow
+func (overflow *TxOverflow) HasTx(txHash []byte) bool {
+	overflow.mutex.RLock()
+	defer overflow.mutex.RUnlock()
+
+	_, ok := overflow.byHash[string(txHash)]
+	return ok
+}
+
+// RemoveTxByHash removes a transaction from the overflow
+func (overflow *TxOverflow) RemoveTxByHash(txHash []byte) bool {
+	overflow.mutex.Lock()
+	defer overflow.mutex.Unlock()
+
+	entry, ok := overflow.byHash[string(txHash)]
+	if !ok {
+		return false
+	}
+
+	overflow.removeEntryNoLock(entry)
+	return true
+}
+
+func (overflow *TxOverflow) removeEntryNoLock(entry *overflowEntry) {
+	err := overflow.persister.Remove(entry.key)
+	if err != nil {
+		log.Debug("TxOverflow.removeEntryNoLock()", "name", overflow.name, "tx", []byte(entry.txHash), "err", err)
+	}
+
+	delete(overflow.byHash, entry.txHash)
+	overflow.numBytes -= int(entry.size)
+
+	entries := overflow.bySender[entry.sender]
+	for i, senderEntry := range entries {
+		if senderEntry == entry {
+			entries = append(entries[:i], entries[i+1:]...)
+			break
+		}
+	}
+
+	if len(entries) == 0 {
+		delete(overflow.bySender, entry.sender)
+		return
+	}
+
+	overflow.bySender[entry.sender] = entries
+}
+
+// GetTxsForSender loads the transactions of a sender, ordered by nonce
+func (overflow *TxOverflow) GetTxsForSender(sender string) []*WrappedTransaction {
+	overflow.mutex.RLock()
+	defer overflow.mutex.RUnlock()
+
+	entries := overflow.bySender[sender]
+	txs := make([]*WrappedTransaction, 0, len(entries))
+	for _, entry := range entries {
+		tx, err := overflow.loadTxNoLock(entry)
+		if err != nil {
+			log.Debug("TxOverflow.GetTxsForSender()", "name", overflow.name, "tx", []byte(entry.txHash), "err", err)
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+// PopTxs loads & removes at most "maxNumTxs" transactions, summing up to at most "maxNumBytes".
+// Senders are served in a round-robin fashion, lowest nonces first.
+func (overflow *TxOverflow) PopTxs(maxNumTxs int, maxNumBytes int) []*WrappedTransaction {
+	overflow.mutex.Lock()
+	defer overflow.mutex.Unlock()
+
+	txs := make([]*WrappedTransaction, 0)
+	numBytes := 0
+	senders := overflow.getSortedSendersNoLock()
+
+	for len(txs) < maxNumTxs && len(senders) > 0 {
+		sendersWithRemainingTxs := make([]string, 0, len(senders))
+
+		for _, sender := range senders {
+			if len(txs) >= maxNumTxs {
+				break
+			}
+
+			entry := overflow.bySender[sender][0]
+			if numBytes+int(entry.size) > maxNumBytes {
+				continue
+			}
+
+			tx, err := overflow.loadTxNoLock(entry)
+			if err != nil {
+				log.Debug("TxOverflow.PopTxs()", "name", overflow.name, "tx", []byte(entry.txHash), "err", err)
+			} else {
+				txs = append(txs, tx)
+				numBytes += int(entry.size)
+			}
+
+			overflow.removeEntryNoLock(entry)
+			if len(overflow.bySender[sender]) > 0 {
+				sendersWithRemainingTxs = append(sendersWithRemainingTxs, sender)
+			}
+		}
+
+		senders = sendersWithRemainingTxs
+	}
+
+	return txs
+}
+
+func (overflow *TxOverflow) getSortedSendersNoLock() []string {
+	senders := make([]string, 0, len(overflow.bySender))
+	for sender := range overflow.bySender {
+		senders = append(senders, sender)
+	}
+
+	sort.Strings(senders)
+	return senders
+}
+
+// Keys returns the hashes of the transactions held by the overflow
+func (overflow *TxOverflow) Keys() [][]byte {
+	overflow.mutex.RLock()
+	defer overflow.mutex.RUnlock()
+
+	keys := make([][]byte, 0, len(overflow.byHash))
+	for txHash := range overflow.byHash {
+		keys = append(keys, []byte(txHash))
+	}
+
+	return keys
+}
+
+// CountTx returns the number of transactions held by the overflow
+func (overflow *TxOverflow) CountTx() uint64 {
+	overflow.mutex.RLock()
+	defer overflow.mutex.RUnlock()
+
+	return uint64(len(overflow.byHash))
+}
+
+// NumBytes returns the approximate number of bytes held by the overflow
+func (overflow *TxOverflow) NumBytes() int {
+	overflow.mutex.RLock()
+	defer overflow.mutex.RUnlock()
+
+	return overflow.numBytes
+}
+
+// Clear removes all the transactions from the overflow
+func (overflow *TxOverflow) Clear() {
+	overflow.mutex.Lock()
+	defer overflow.mutex.Unlock()
+
+	for _, entry := range overflow.byHash {
+		err := overflow.persister.Remove(entry.key)
+		if err != nil {
+			log.Debug("TxOverflow.Clear()", "name", overflow.name, "tx", []byte(entry.txHash), "err", err)
+		}
+	}
+
+	overflow.byHash = make(map[string]*overflowEntry)
+	overflow.bySender = make(map[string][]*overflowEntry)
+	overflow.numBytes = 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (overflow *TxOverflow) IsInterfaceNil() bool {
+	return overflow == nil
+}
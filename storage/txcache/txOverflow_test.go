@@ -0,0 +1,266 @@
+package txcache
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsTxOverflow() ArgsTxOverflow {
+	return ArgsTxOverflow{
+		Name:        "test",
+		Persister:   memorydb.New(),
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		MaxNumTxs:   100,
+		NewEmptyTx: func() data.TransactionHandler {
+			return &transaction.Transaction{}
+		},
+	}
+}
+
+func newTxOverflowToTest(maxNumTxs uint32) *TxOverflow {
+	args := createArgsTxOverflow()
+	args.MaxNumTxs = maxNumTxs
+	overflow, _ := NewTxOverflow(args)
+	return overflow
+}
+
+func TestNewTxOverflow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		args := createArgsTxOverflow()
+		args.Name = ""
+		overflow, err := NewTxOverflow(args)
+		require.Nil(t, overflow)
+		require.True(t, errors.Is(err, storage.ErrInvalidConfig))
+	})
+	t.Run("nil persister should error", func(t *testing.T) {
+		args := createArgsTxOverflow()
+		args.Persister = nil
+		overflow, err := NewTxOverflow(args)
+		require.Nil(t, overflow)
+		require.Equal(t, storage.ErrNilPersister, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createArgsTxOverflow()
+		args.Marshalizer = nil
+		overflow, err := NewTxOverflow(args)
+		require.Nil(t, overflow)
+		require.Equal(t, storage.ErrNilMarshalizer, err)
+	})
+	t.Run("zero max num txs should error", func(t *testing.T) {
+		args := createArgsTxOverflow()
+		args.MaxNumTxs = 0
+		overflow, err := NewTxOverflow(args)
+		require.Nil(t, overflow)
+		require.True(t, errors.Is(err, storage.ErrInvalidConfig))
+	})
+	t.Run("nil empty tx creator should error", func(t *testing.T) {
+		args := createArgsTxOverflow()
+		args.NewEmptyTx = nil
+		overflow, err := NewTxOverflow(args)
+		require.Nil(t, overflow)
+		require.Equal(t, storage.ErrNilEmptyTxCreator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		overflow, err := NewTxOverflow(createArgsTxOverflow())
+		require.Nil(t, err)
+		require.False(t, overflow.IsInterfaceNil())
+	})
+}
+
+func TestTxOverflow_AddGetRemove(t *testing.T) {
+	t.Parallel()
+
+	overflow := newTxOverflowToTest(3)
+
+	numAdded := overflow.AddTxs([]*WrappedTransaction{
+		createTx([]byte("hash-alice-2"), "alice", 2),
+		createTx([]byte("hash-alice-1"), "alice", 1),
+		createTx([]byte("hash-alice-1"), "alice", 1),
+		createTx([]byte("hash-bob-5"), "bob", 5),
+		createTx([]byte("hash-carol-1"), "carol", 1),
+	})
+	require.Equal(t, 3, numAdded)
+	require.Equal(t, uint64(3), overflow.CountTx())
+	require.Equal(t, 3*int(estimatedSizeOfBoundedTxFields), overflow.NumBytes())
+	require.ElementsMatch(t, []string{"hash-alice-1", "hash-alice-2", "hash-bob-5"}, hashesAsStrings(overflow.Keys()))
+
+	tx, ok := overflow.GetByTxHash([]byte("hash-alice-2"))
+	require.True(t, ok)
+	require.Equal(t, []byte("hash-alice-2"), tx.TxHash)
+	require.Equal(t, uint64(2), tx.Tx.GetNonce())
+	require.Equal(t, []byte("alice"), tx.Tx.GetSndAddr())
+	require.False(t, overflow.HasTx([]byte("hash-carol-1")))
+
+	aliceTxs := overflow.GetTxsForSender("alice")
+	require.Len(t, aliceTxs, 2)
+	require.Equal(t, uint64(1), aliceTxs[0].Tx.GetNonce())
+	require.Equal(t, uint64(2), aliceTxs[1].Tx.GetNonce())
+
+	require.True(t, overflow.RemoveTxByHash([]byte("hash-alice-1")))
+	require.False(t, overflow.RemoveTxByHash([]byte("hash-alice-1")))
+	require.Len(t, overflow.GetTxsForSender("alice"), 1)
+	require.Equal(t, uint64(2), overflow.CountTx())
+
+	overflow.Clear()
+	require.Equal(t, uint64(0), overflow.CountTx())
+	require.Equal(t, 0, overflow.NumBytes())
+	_, ok = overflow.GetByTxHash([]byte("hash-bob-5"))
+	require.False(t, ok)
+}
+
+func TestTxOverflow_PopTxs(t *testing.T) {
+	t.Parallel()
+
+	overflow := newTxOverflowToTest(100)
+	overflow.AddTxs([]*WrappedTransaction{
+		createTx([]byte("hash-alice-3"), "alice", 3),
+		createTx([]byte("hash-alice-1"), "alice", 1),
+		createTx([]byte("hash-alice-2"), "alice", 2),
+		createTx([]byte("hash-bob-7"), "bob", 7),
+	})
+
+	// Round-robin, lowest nonces first
+	txs := overflow.PopTxs(3, math.MaxInt32)
+	require.Equal(t, []string{"hash-alice-1", "hash-bob-7", "hash-alice-2"}, hashesOfTxs(txs))
+	require.Equal(t, uint64(1), overflow.CountTx())
+
+	txs = overflow.PopTxs(10, int(estimatedSizeOfBoundedTxFields)-1)
+	require.Len(t, txs, 0)
+
+	txs = overflow.PopTxs(10, math.MaxInt32)
+	require.Equal(t, []string{"hash-alice-3"}, hashesOfTxs(txs))
+	require.Equal(t, uint64(0), overflow.CountTx())
+	require.Equal(t, 0, overflow.NumBytes())
+}
+
+func TestTxCache_SpillToOverflowAndRefill(t *testing.T) {
+	t.Parallel()
+
+	config := ConfigSourceMe{
+		Name:                          "untitled",
+		NumChunks:                     16,
+		EvictionEnabled:               true,
+		CountThreshold:                100,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 20,
+		NumBytesThreshold:             maxNumBytesUpperBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+	}
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(config, txGasHandler)
+	require.Nil(t, err)
+
+	require.Equal(t, storage.ErrNilOverflowHandler, cache.SetOverflowHandler(nil))
+	overflow := newTxOverflowToTest(1000)
+	require.Nil(t, cache.SetOverflowHandler(overflow))
+
+	for index := 0; index < 200; index++ {
+		sender := string(createFakeSenderAddress(index))
+		cache.AddTx(createTx([]byte{byte(index)}, sender, uint64(1)))
+	}
+
+	numInMemory := cache.CountTx()
+	require.True(t, numInMemory <= 101)
+	require.Equal(t, uint64(200), numInMemory+overflow.CountTx())
+	require.Len(t, cache.Keys(), 200)
+
+	// Spilled transactions are still reachable
+	spilledHash := overflow.Keys()[0]
+	require.True(t, cache.Has(spilledHash))
+	tx, ok := cache.GetByTxHash(spilledHash)
+	require.True(t, ok)
+	require.Equal(t, spilledHash, tx.TxHash)
+	require.Len(t, cache.GetTransactionsPoolForSender(string(tx.Tx.GetSndAddr())), 1)
+
+	require.True(t, cache.RemoveTxByHash(spilledHash))
+	require.False(t, cache.Has(spilledHash))
+
+	// Nothing is pulled back while the cache is full
+	require.Equal(t, 0, cache.RefillFromOverflow())
+
+	for _, txHash := range cache.txByHash.keys()[:50] {
+		cache.RemoveTxByHash(txHash)
+	}
+
+	numRefilled := cache.RefillFromOverflow()
+	require.True(t, numRefilled > 0)
+	require.Equal(t, uint64(100), cache.CountTx())
+	require.Equal(t, uint64(149), cache.CountTx()+overflow.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+
+	cache.Clear()
+	require.Equal(t, uint64(0), overflow.CountTx())
+}
+
+func TestTxCache_GetTransactionsPoolForSenderIncludesOverflow(t *testing.T) {
+	t.Parallel()
+
+	cache := newUnconstrainedCacheToTest()
+	overflow := newTxOverflowToTest(100)
+	_ = cache.SetOverflowHandler(overflow)
+
+	cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+	overflow.AddTxs([]*WrappedTransaction{
+		createTx([]byte("hash-alice-1"), "alice", 1),
+		createTx([]byte("hash-bob-1"), "bob", 1),
+	})
+
+	require.Equal(t, []string{"hash-alice-1", "hash-alice-3"}, hashesOfTxs(cache.GetTransactionsPoolForSender("alice")))
+	require.Equal(t, []string{"hash-bob-1"}, hashesOfTxs(cache.GetTransactionsPoolForSender("bob")))
+	require.Nil(t, cache.GetTransactionsPoolForSender("carol"))
+
+	// A transaction received again is no longer held by the overflow
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	require.False(t, overflow.HasTx([]byte("hash-alice-1")))
+	require.Len(t, cache.Keys(), 3)
+}
+
+func TestCrossTxCache_SpillToOverflowAndRefill(t *testing.T) {
+	t.Parallel()
+
+	cache := newCrossTxCacheToTest(1, 4, math.MaxUint16)
+	require.Equal(t, storage.ErrNilOverflowHandler, cache.SetOverflowHandler(nil))
+	overflow := newTxOverflowToTest(100)
+	require.Nil(t, cache.SetOverflowHandler(overflow))
+
+	cache.addTestTxs("a", "b", "c", "d", "e", "f")
+	require.Equal(t, 4, cache.Len())
+	require.ElementsMatch(t, []string{"a", "b"}, hashesAsStrings(overflow.Keys()))
+	require.ElementsMatch(t, []string{"a", "b", "c", "d", "e", "f"}, hashesAsStrings(cache.Keys()))
+
+	require.True(t, cache.Has([]byte("a")))
+	tx, ok := cache.Get([]byte("a"))
+	require.True(t, ok)
+	require.Equal(t, uint64(42), tx.(data.TransactionHandler).GetNonce())
+
+	require.Equal(t, 0, cache.RefillFromOverflow())
+
+	cache.Remove([]byte("c"))
+	cache.Remove([]byte("b"))
+	require.Equal(t, 3, cache.Len())
+	require.Equal(t, uint64(1), overflow.CountTx())
+
+	require.Equal(t, 1, cache.RefillFromOverflow())
+	require.Equal(t, 4, cache.Len())
+	require.Equal(t, uint64(0), overflow.CountTx())
+	require.ElementsMatch(t, []string{"a", "d", "e", "f"}, hashesAsStrings(cache.Keys()))
+}
+
+func hashesOfTxs(txs []*WrappedTransaction) []string {
+	hashes := make([]string, len(txs))
+	for i, tx := range txs {
+		hashes[i] = string(tx.TxHash)
+	}
+
+	return hashes
+}
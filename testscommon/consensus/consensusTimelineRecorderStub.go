@@ -0,0 +1,60 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// ConsensusTimelineRecorderStub -
+type ConsensusTimelineRecorderStub struct {
+	RecordSubroundStartCalled   func(round int64, subroundId int)
+	RecordSubroundEndCalled     func(round int64, subroundId int, isFinished bool)
+	RecordMessageCalled         func(cnsMsg *consensus.Message, pid core.PeerID, validationErr error)
+	RecordBlockProcessingCalled func(round int64, duration time.Duration, err error)
+	GetRoundsCalled             func() []*common.ConsensusRoundTimeline
+}
+
+// RecordSubroundStart -
+func (stub *ConsensusTimelineRecorderStub) RecordSubroundStart(round int64, subroundId int) {
+	if stub.RecordSubroundStartCalled != nil {
+		stub.RecordSubroundStartCalled(round, subroundId)
+	}
+}
+
+// RecordSubroundEnd -
+func (stub *ConsensusTimelineRecorderStub) RecordSubroundEnd(round int64, subroundId int, isFinished bool) {
+	if stub.RecordSubroundEndCalled != nil {
+		stub.RecordSubroundEndCalled(round, subroundId, isFinished)
+	}
+}
+
+// RecordMessage -
+func (stub *ConsensusTimelineRecorderStub) RecordMessage(cnsMsg *consensus.Message, pid core.PeerID, validationErr error) {
+	if stub.RecordMessageCalled != nil {
+		stub.RecordMessageCalled(cnsMsg, pid, validationErr)
+	}
+}
+
+// RecordBlockProcessing -
+func (stub *ConsensusTimelineRecorderStub) RecordBlockProcessing(round int64, duration time.Duration, err error) {
+	if stub.RecordBlockProcessingCalled != nil {
+		stub.RecordBlockProcessingCalled(round, duration, err)
+	}
+}
+
+// GetRounds -
+func (stub *ConsensusTimelineRecorderStub) GetRounds() []*common.ConsensusRoundTimeline {
+	if stub.GetRoundsCalled != nil {
+		return stub.GetRoundsCalled()
+	}
+
+	return make([]*common.ConsensusRoundTimeline, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ConsensusTimelineRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
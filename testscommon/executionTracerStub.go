@@ -0,0 +1,49 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ExecutionTracerStub -
+type ExecutionTracerStub struct {
+	StartRecordingCalled           func()
+	StopRecordingCalled            func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace
+	TraceStorageReadCalled         func(address []byte, key []byte, value []byte)
+	TraceBuiltInFunctionCallCalled func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error)
+}
+
+// StartRecording -
+func (stub *ExecutionTracerStub) StartRecording() {
+	if stub.StartRecordingCalled != nil {
+		stub.StartRecordingCalled()
+	}
+}
+
+// StopRecording -
+func (stub *ExecutionTracerStub) StopRecording(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *tracing.ExecutionTrace {
+	if stub.StopRecordingCalled != nil {
+		return stub.StopRecordingCalled(input, output)
+	}
+
+	return nil
+}
+
+// TraceStorageRead -
+func (stub *ExecutionTracerStub) TraceStorageRead(address []byte, key []byte, value []byte) {
+	if stub.TraceStorageReadCalled != nil {
+		stub.TraceStorageReadCalled(address, key, value)
+	}
+}
+
+// TraceBuiltInFunctionCall -
+func (stub *ExecutionTracerStub) TraceBuiltInFunctionCall(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	if stub.TraceBuiltInFunctionCallCalled != nil {
+		stub.TraceBuiltInFunctionCallCalled(input, output, err)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
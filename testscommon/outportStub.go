@@ -3,15 +3,18 @@ package testscommon
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
 // OutportStub is a mock implementation fot the OutportHandler interface
 type OutportStub struct {
-	SaveBlockCalled             func(args *indexer.ArgsSaveBlockData)
-	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
-	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
-	HasDriversCalled            func() bool
+	SaveBlockCalled                func(args *indexer.ArgsSaveBlockData)
+	SaveValidatorsRatingCalled     func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
+	SaveValidatorsPubKeysCalled    func(shardPubKeys map[uint32][][]byte, epoch uint32)
+	HasDriversCalled               func() bool
+	FinalizedBlockCalled           func(headerHash []byte)
+	SaveEquivocationEvidenceCalled func(evidence *common.EquivocationEvidence)
 }
 
 // SaveBlock -
@@ -73,6 +76,16 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// SaveEquivocationEvidence -
+func (as *OutportStub) SaveEquivocationEvidence(evidence *common.EquivocationEvidence) {
+	if as.SaveEquivocationEvidenceCalled != nil {
+		as.SaveEquivocationEvidenceCalled(evidence)
+	}
+}
+
 // FinalizedBlock -
-func (as *OutportStub) FinalizedBlock(_ []byte) {
+func (as *OutportStub) FinalizedBlock(headerHash []byte) {
+	if as.FinalizedBlockCalled != nil {
+		as.FinalizedBlockCalled(headerHash)
+	}
 }
@@ -0,0 +1,45 @@
+package p2pmocks
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// FaultsRulesHandlerStub -
+type FaultsRulesHandlerStub struct {
+	SetRulesCalled func(rules []common.P2PFaultRule) error
+	GetRulesCalled func() []common.P2PFaultRuleStatus
+	FaultForCalled func(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool)
+}
+
+// SetRules -
+func (stub *FaultsRulesHandlerStub) SetRules(rules []common.P2PFaultRule) error {
+	if stub.SetRulesCalled != nil {
+		return stub.SetRulesCalled(rules)
+	}
+
+	return nil
+}
+
+// GetRules -
+func (stub *FaultsRulesHandlerStub) GetRules() []common.P2PFaultRuleStatus {
+	if stub.GetRulesCalled != nil {
+		return stub.GetRulesCalled()
+	}
+
+	return nil
+}
+
+// FaultFor -
+func (stub *FaultsRulesHandlerStub) FaultFor(topic string, pid core.PeerID, direction string) (common.P2PFaultRule, bool) {
+	if stub.FaultForCalled != nil {
+		return stub.FaultForCalled(topic, pid, direction)
+	}
+
+	return common.P2PFaultRule{}, false
+}
+
+// IsInterfaceNil -
+func (stub *FaultsRulesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,41 @@
+package p2pmocks
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// TrafficCounterStub -
+type TrafficCounterStub struct {
+	AddInboundCalled       func(topic string, pid core.PeerID, size uint64)
+	AddOutboundCalled      func(topic string, pid core.PeerID, size uint64)
+	GetTrafficReportCalled func() *common.P2PTrafficReport
+}
+
+// AddInbound -
+func (stub *TrafficCounterStub) AddInbound(topic string, pid core.PeerID, size uint64) {
+	if stub.AddInboundCalled != nil {
+		stub.AddInboundCalled(topic, pid, size)
+	}
+}
+
+// AddOutbound -
+func (stub *TrafficCounterStub) AddOutbound(topic string, pid core.PeerID, size uint64) {
+	if stub.AddOutboundCalled != nil {
+		stub.AddOutboundCalled(topic, pid, size)
+	}
+}
+
+// GetTrafficReport -
+func (stub *TrafficCounterStub) GetTrafficReport() *common.P2PTrafficReport {
+	if stub.GetTrafficReportCalled != nil {
+		return stub.GetTrafficReportCalled()
+	}
+
+	return &common.P2PTrafficReport{}
+}
+
+// IsInterfaceNil -
+func (stub *TrafficCounterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
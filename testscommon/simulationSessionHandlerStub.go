@@ -0,0 +1,48 @@
+package testscommon
+
+import txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+
+// SimulationSessionHandlerStub -
+type SimulationSessionHandlerStub struct {
+	StartSessionCalled               func() error
+	EndSessionCalled                 func()
+	StartRecordingStateChangesCalled func()
+	StopRecordingStateChangesCalled  func() ([]*txSimData.RecordedAccountChanges, error)
+}
+
+// StartSession -
+func (stub *SimulationSessionHandlerStub) StartSession() error {
+	if stub.StartSessionCalled != nil {
+		return stub.StartSessionCalled()
+	}
+
+	return nil
+}
+
+// EndSession -
+func (stub *SimulationSessionHandlerStub) EndSession() {
+	if stub.EndSessionCalled != nil {
+		stub.EndSessionCalled()
+	}
+}
+
+// StartRecordingStateChanges -
+func (stub *SimulationSessionHandlerStub) StartRecordingStateChanges() {
+	if stub.StartRecordingStateChangesCalled != nil {
+		stub.StartRecordingStateChangesCalled()
+	}
+}
+
+// StopRecordingStateChanges -
+func (stub *SimulationSessionHandlerStub) StopRecordingStateChanges() ([]*txSimData.RecordedAccountChanges, error) {
+	if stub.StopRecordingStateChangesCalled != nil {
+		return stub.StopRecordingStateChangesCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *SimulationSessionHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
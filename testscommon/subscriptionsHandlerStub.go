@@ -0,0 +1,30 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+
+// SubscriptionsHandlerStub -
+type SubscriptionsHandlerStub struct {
+	SubscribeCalled   func(filter subscriptions.Filter) (subscriptions.Subscription, error)
+	UnsubscribeCalled func(id uint64)
+}
+
+// Subscribe -
+func (shs *SubscriptionsHandlerStub) Subscribe(filter subscriptions.Filter) (subscriptions.Subscription, error) {
+	if shs.SubscribeCalled != nil {
+		return shs.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// Unsubscribe -
+func (shs *SubscriptionsHandlerStub) Unsubscribe(id uint64) {
+	if shs.UnsubscribeCalled != nil {
+		shs.UnsubscribeCalled(id)
+	}
+}
+
+// IsInterfaceNil -
+func (shs *SubscriptionsHandlerStub) IsInterfaceNil() bool {
+	return shs == nil
+}
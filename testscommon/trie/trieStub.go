@@ -26,6 +26,10 @@ type TrieStub struct {
 	GetAllLeavesOnChannelCalled       func(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error
 	GetProofCalled                    func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled                 func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProofCalled               func(keys [][]byte) ([][]byte, map[string][]byte, error)
+	GetRangeProofCalled               func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error)
+	GetLeavesDiffCalled               func(ctx context.Context, fromRootHash []byte, toRootHash []byte, handler common.TrieLeavesDiffHandler) error
+	GetTrieStatisticsCalled           func(ctx context.Context, rootHash []byte) (common.TrieStatisticsDTO, error)
 	GetStorageManagerCalled           func() common.StorageManager
 	GetSerializedNodeCalled           func(bytes []byte) ([]byte, error)
 	GetNumNodesCalled                 func() common.NumNodesDTO
@@ -61,6 +65,42 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, map[string][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (ts *TrieStub) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error) {
+	if ts.GetRangeProofCalled != nil {
+		return ts.GetRangeProofCalled(startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// GetLeavesDiff -
+func (ts *TrieStub) GetLeavesDiff(ctx context.Context, fromRootHash []byte, toRootHash []byte, handler common.TrieLeavesDiffHandler) error {
+	if ts.GetLeavesDiffCalled != nil {
+		return ts.GetLeavesDiffCalled(ctx, fromRootHash, toRootHash, handler)
+	}
+
+	return nil
+}
+
+// GetTrieStatistics -
+func (ts *TrieStub) GetTrieStatistics(ctx context.Context, rootHash []byte) (common.TrieStatisticsDTO, error) {
+	if ts.GetTrieStatisticsCalled != nil {
+		return ts.GetTrieStatisticsCalled(ctx, rootHash)
+	}
+
+	return common.TrieStatisticsDTO{}, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
 	if ts.GetAllLeavesOnChannelCalled != nil {
@@ -108,3 +108,21 @@ var ErrNilIdleNodeProvider = errors.New("nil idle node provider")
 
 // ErrNilRootHashHolder signals that a nil root hash holder was provided
 var ErrNilRootHashHolder = errors.New("nil root hash holder provided")
+
+// ErrInvalidKeyRange signals that the start key of a range is after its end key
+var ErrInvalidKeyRange = errors.New("invalid key range: the start key is after the end key")
+
+// ErrInvalidMaxNumLeaves signals that an invalid maximum number of leaves was provided
+var ErrInvalidMaxNumLeaves = errors.New("invalid maximum number of leaves")
+
+// ErrNilLeavesDiffHandler signals that a nil leaves diff handler has been provided
+var ErrNilLeavesDiffHandler = errors.New("nil leaves diff handler")
+
+// ErrNilIntegrityHandler signals that a nil trie integrity handler has been provided
+var ErrNilIntegrityHandler = errors.New("nil trie integrity handler")
+
+// ErrNodeHashMismatch signals that the content of a trie node does not match its hash
+var ErrNodeHashMismatch = errors.New("trie node hash mismatch")
+
+// ErrInvalidSnapshotProgress signals that the persisted snapshot progress does not match the trie being snapshotted
+var ErrInvalidSnapshotProgress = errors.New("invalid snapshot progress")
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 )
 
@@ -93,3 +94,13 @@ func IsTrieStorageManagerInEpoch(tsm common.StorageManager) bool {
 	_, ok := tsm.(*trieStorageManagerInEpoch)
 	return ok
 }
+
+// SetMaxCheckedHashesToKeep -
+func (ic *integrityChecker) SetMaxCheckedHashesToKeep(maxCheckedHashes int) {
+	ic.checkedHashes, _ = lrucache.NewCache(maxCheckedHashes)
+}
+
+// NumCheckedHashes -
+func (ic *integrityChecker) NumCheckedHashes() int {
+	return ic.checkedHashes.Len()
+}
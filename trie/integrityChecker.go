@@ -0,0 +1,153 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+// maxCheckedHashesToKeep bounds the number of checked node hashes remembered between the checks, so that the memory
+// used does not grow with the state size
+const maxCheckedHashesToKeep = 100000
+
+// ArgsIntegrityChecker holds the arguments needed to create a trie integrity checker
+type ArgsIntegrityChecker struct {
+	DB          common.DBWriteCacher
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type integrityChecker struct {
+	db            common.DBWriteCacher
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+	checkedHashes storage.Cacher
+}
+
+// NewIntegrityChecker creates a component able to find the trie nodes missing from the storage. The hashes of the
+// most recently found nodes are remembered, so that the subtrees shared by several root hashes are usually checked
+// only once. As the remembered hashes are bounded, a shared subtree can be checked again
+func NewIntegrityChecker(args ArgsIntegrityChecker) (*integrityChecker, error) {
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	checkedHashes, err := lrucache.NewCache(maxCheckedHashesToKeep)
+	if err != nil {
+		return nil, err
+	}
+
+	return &integrityChecker{
+		db:            args.DB,
+		marshalizer:   args.Marshalizer,
+		hasher:        args.Hasher,
+		checkedHashes: checkedHashes,
+	}, nil
+}
+
+// Check walks all the trie nodes reachable from the root hash. The missingHandler is called for each node that can
+// not be loaded from the storage or whose content does not match its hash, the subtree of such a node being skipped.
+// The leafHandler is called with the value of each found leaf. It returns the number of trie nodes checked, the
+// subtrees of the remembered nodes being skipped
+func (ic *integrityChecker) Check(
+	ctx context.Context,
+	rootHash []byte,
+	leafHandler func(value []byte) error,
+	missingHandler func(hash []byte) error,
+) (uint64, error) {
+	if ctx == nil {
+		return 0, ErrNilContext
+	}
+	if leafHandler == nil || missingHandler == nil {
+		return 0, ErrNilIntegrityHandler
+	}
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return 0, nil
+	}
+
+	numChecked := uint64(0)
+	hashesToCheck := [][]byte{rootHash}
+	for len(hashesToCheck) > 0 {
+		if ic.isContextDone(ctx) {
+			return numChecked, errors.ErrContextClosing
+		}
+
+		lastIndex := len(hashesToCheck) - 1
+		hash := hashesToCheck[lastIndex]
+		hashesToCheck = hashesToCheck[:lastIndex]
+
+		if ic.checkedHashes.Has(hash) {
+			continue
+		}
+
+		n, err := ic.getNode(hash)
+		if err != nil {
+			log.Trace("trie integrity check: missing node", "hash", hash, "error", err)
+			err = missingHandler(hash)
+			if err != nil {
+				return numChecked, err
+			}
+			continue
+		}
+
+		ic.checkedHashes.Put(hash, struct{}{}, 0)
+		numChecked++
+
+		switch element := n.(type) {
+		case *branchNode:
+			for _, childHash := range element.EncodedChildren {
+				if len(childHash) > 0 {
+					hashesToCheck = append(hashesToCheck, childHash)
+				}
+			}
+		case *extensionNode:
+			hashesToCheck = append(hashesToCheck, element.EncodedChild)
+		case *leafNode:
+			err = leafHandler(element.Value)
+			if err != nil {
+				return numChecked, err
+			}
+		}
+	}
+
+	return numChecked, nil
+}
+
+func (ic *integrityChecker) getNode(hash []byte) (node, error) {
+	encodedNode, err := ic.db.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(ic.hasher.Compute(string(encodedNode)), hash) {
+		return nil, ErrNodeHashMismatch
+	}
+
+	return decodeNode(encodedNode, ic.marshalizer, ic.hasher)
+}
+
+func (ic *integrityChecker) isContextDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ic *integrityChecker) IsInterfaceNil() bool {
+	return ic == nil
+}
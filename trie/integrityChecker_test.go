@@ -0,0 +1,216 @@
+package trie_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	elrondErrors "github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createIntegrityCheckerArgs(db common.DBWriteCacher) trie.ArgsIntegrityChecker {
+	return trie.ArgsIntegrityChecker{
+		DB:          db,
+		Marshalizer: &testscommon.ProtobufMarshalizerMock{},
+		Hasher:      &testscommon.KeccakMock{},
+	}
+}
+
+// copyTrieNodes returns a database holding all the nodes of the trie, so that some of them can be removed
+func copyTrieNodes(t *testing.T, tr common.Trie) (*testscommon.MemDbMock, [][]byte) {
+	hashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+
+	db := testscommon.NewMemDbMock()
+	for _, hash := range hashes {
+		encodedNode, errGet := tr.GetStorageManager().Get(hash)
+		require.Nil(t, errGet)
+		_ = db.Put(hash, encodedNode)
+	}
+
+	return db, hashes
+}
+
+func noOpLeafHandler(_ []byte) error {
+	return nil
+}
+
+func TestNewIntegrityChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil db should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := trie.NewIntegrityChecker(createIntegrityCheckerArgs(nil))
+		assert.Nil(t, checker)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createIntegrityCheckerArgs(testscommon.NewMemDbMock())
+		args.Marshalizer = nil
+		checker, err := trie.NewIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createIntegrityCheckerArgs(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		checker, err := trie.NewIntegrityChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := trie.NewIntegrityChecker(createIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, checker.IsInterfaceNil())
+	})
+}
+
+func TestIntegrityChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handlers should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		_, err := checker.Check(context.Background(), []byte("root hash"), nil, nil)
+		assert.Equal(t, trie.ErrNilIntegrityHandler, err)
+	})
+	t.Run("empty trie should not call the handlers", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		numChecked, err := checker.Check(context.Background(), trie.EmptyTrieHash, noOpLeafHandler, func(_ []byte) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), numChecked)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		_, err := checker.Check(ctx, []byte("root hash"), noOpLeafHandler, func(_ []byte) error {
+			return nil
+		})
+		assert.Equal(t, elrondErrors.ErrContextClosing, err)
+	})
+	t.Run("complete trie should find all the leaves", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		db, hashes := copyTrieNodes(t, tr)
+
+		checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(db))
+		leaves := make(map[string]struct{})
+		numChecked, err := checker.Check(context.Background(), rootHash, func(value []byte) error {
+			leaves[string(value)] = struct{}{}
+			return nil
+		}, func(hash []byte) error {
+			assert.Fail(t, "should have not found a missing node")
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(len(hashes)), numChecked)
+		assert.Equal(t, len(values), len(leaves))
+
+		numChecked, err = checker.Check(context.Background(), rootHash, noOpLeafHandler, func(_ []byte) error {
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), numChecked, "the nodes should be checked only once")
+	})
+	t.Run("remembered hashes should be bounded", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		db, hashes := copyTrieNodes(t, tr)
+
+		maxCheckedHashes := 10
+		checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(db))
+		checker.SetMaxCheckedHashesToKeep(maxCheckedHashes)
+		numChecked, err := checker.Check(context.Background(), rootHash, noOpLeafHandler, func(_ []byte) error {
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(len(hashes)), numChecked)
+		assert.Equal(t, maxCheckedHashes, checker.NumCheckedHashes())
+
+		numChecked, err = checker.Check(context.Background(), rootHash, noOpLeafHandler, func(_ []byte) error {
+			return nil
+		})
+		require.Nil(t, err)
+		assert.True(t, numChecked > 0, "the forgotten nodes should be checked again")
+		assert.Equal(t, maxCheckedHashes, checker.NumCheckedHashes())
+	})
+	t.Run("missing node should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		db, hashes := copyTrieNodes(t, tr)
+
+		missingHash := hashes[len(hashes)/2]
+		_ = db.Remove(missingHash)
+
+		testOnlyNodeReported(t, db, rootHash, missingHash)
+	})
+	t.Run("corrupted node should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		db, hashes := copyTrieNodes(t, tr)
+
+		corruptedHash := hashes[len(hashes)-1]
+		_ = db.Put(corruptedHash, []byte("corrupted node"))
+
+		testOnlyNodeReported(t, db, rootHash, corruptedHash)
+	})
+	t.Run("handler error should stop the check", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		expectedErr := errors.New("expected error")
+		checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		_, err := checker.Check(context.Background(), rootHash, noOpLeafHandler, func(_ []byte) error {
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func testOnlyNodeReported(t *testing.T, db common.DBWriteCacher, rootHash []byte, expectedHash []byte) {
+	checker, _ := trie.NewIntegrityChecker(createIntegrityCheckerArgs(db))
+	reported := make([][]byte, 0)
+	_, err := checker.Check(context.Background(), rootHash, noOpLeafHandler, func(hash []byte) error {
+		reported = append(reported, hash)
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{expectedHash}, reported)
+}
@@ -0,0 +1,204 @@
+package trie
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// proofWalker walks the trie nodes that prove the presence or the absence of keys in a trie. When generating a proof,
+// the walked nodes are collected only once, no matter how many keys share them. When verifying a proof, the nodes are
+// resolved from a storer that holds only the proof nodes, so any missing node makes the verification fail.
+type proofWalker struct {
+	db           common.DBWriteCacher
+	collectNodes bool
+	proofHashes  map[string]struct{}
+	proof        [][]byte
+}
+
+func newProofWalker(db common.DBWriteCacher, collectNodes bool) *proofWalker {
+	return &proofWalker{
+		db:           db,
+		collectNodes: collectNodes,
+		proofHashes:  make(map[string]struct{}),
+		proof:        make([][]byte, 0),
+	}
+}
+
+func (pw *proofWalker) visit(n node) error {
+	if !pw.collectNodes {
+		return nil
+	}
+
+	hash := n.getHash()
+	_, alreadyCollected := pw.proofHashes[string(hash)]
+	if alreadyCollected {
+		return nil
+	}
+
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	pw.proofHashes[string(hash)] = struct{}{}
+	pw.proof = append(pw.proof, encodedNode)
+
+	return nil
+}
+
+// walkKey follows the given hex key starting from the provided node. It returns the value and true if the key is
+// present in the trie, or false if the walked nodes prove that the key is missing.
+func (pw *proofWalker) walkKey(n node, hexKey []byte) ([]byte, bool, error) {
+	for {
+		err := pw.visit(n)
+		if err != nil {
+			return nil, false, err
+		}
+
+		switch currentNode := n.(type) {
+		case *leafNode:
+			if bytes.Equal(hexKey, currentNode.Key) {
+				return currentNode.Value, true, nil
+			}
+			return nil, false, nil
+		case *extensionNode:
+			if !bytes.HasPrefix(hexKey, currentNode.Key) {
+				return nil, false, nil
+			}
+			err = resolveIfCollapsed(currentNode, 0, pw.db)
+			if err != nil {
+				return nil, false, err
+			}
+			if check.IfNil(currentNode.child) {
+				return nil, false, ErrNilNode
+			}
+
+			n = currentNode.child
+			hexKey = hexKey[len(currentNode.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 {
+				return nil, false, ErrValueTooShort
+			}
+			childPos := hexKey[firstByte]
+			if childPosOutOfRange(childPos) {
+				return nil, false, ErrChildPosOutOfRange
+			}
+			err = resolveIfCollapsed(currentNode, childPos, pw.db)
+			if err != nil {
+				return nil, false, err
+			}
+			if currentNode.children[childPos] == nil {
+				return nil, false, nil
+			}
+
+			n = currentNode.children[childPos]
+			hexKey = hexKey[1:]
+		default:
+			return nil, false, ErrInvalidNode
+		}
+	}
+}
+
+// walkRange visits all the nodes of the trie and collects the leaves whose keys are between the start key, included,
+// and the end key, excluded, in key bytes order. A nil end key means that the range is not bounded. The trie paths hold
+// the key nibbles in reverse order, so the keys of a range are spread over the whole trie and no subtree can be skipped
+// without losing the proof that it holds no key from the range.
+func (pw *proofWalker) walkRange(
+	n node,
+	path []byte,
+	startKey []byte,
+	endKey []byte,
+	leaves *[]core.KeyValueHolder,
+) error {
+	err := pw.visit(n)
+	if err != nil {
+		return err
+	}
+
+	switch currentNode := n.(type) {
+	case *leafNode:
+		return addLeafIfInRange(currentNode, path, startKey, endKey, leaves)
+	case *extensionNode:
+		err = resolveIfCollapsed(currentNode, 0, pw.db)
+		if err != nil {
+			return err
+		}
+		if check.IfNil(currentNode.child) {
+			return ErrNilNode
+		}
+
+		return pw.walkRange(currentNode.child, concat(path, currentNode.Key...), startKey, endKey, leaves)
+	case *branchNode:
+		for i := 0; i < nrOfChildren; i++ {
+			if currentNode.children[i] == nil && len(currentNode.EncodedChildren[i]) == 0 {
+				continue
+			}
+
+			err = resolveIfCollapsed(currentNode, byte(i), pw.db)
+			if err != nil {
+				return err
+			}
+			if currentNode.children[i] == nil {
+				continue
+			}
+
+			err = pw.walkRange(currentNode.children[i], concat(path, byte(i)), startKey, endKey, leaves)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrInvalidNode
+	}
+}
+
+func addLeafIfInRange(ln *leafNode, path []byte, startKey []byte, endKey []byte, leaves *[]core.KeyValueHolder) error {
+	key, err := hexToKeyBytes(concat(path, ln.Key...))
+	if err != nil {
+		return err
+	}
+	if !isKeyInRange(key, startKey, endKey) {
+		return nil
+	}
+
+	*leaves = append(*leaves, keyValStorage.NewKeyValStorage(key, ln.Value))
+
+	return nil
+}
+
+func isKeyInRange(key []byte, startKey []byte, endKey []byte) bool {
+	if bytes.Compare(key, startKey) < 0 {
+		return false
+	}
+
+	return len(endKey) == 0 || bytes.Compare(key, endKey) < 0
+}
+
+// sortAndLimitLeaves sorts the leaves in key bytes order and keeps the first maxLeaves of them, if maxLeaves is
+// greater than 0
+func sortAndLimitLeaves(leaves []core.KeyValueHolder, maxLeaves int) []core.KeyValueHolder {
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].Key(), leaves[j].Key()) < 0
+	})
+	if maxLeaves > 0 && len(leaves) > maxLeaves {
+		return leaves[:maxLeaves]
+	}
+
+	return leaves
+}
+
+// checkKeyRange returns an error if the start key is after the end key. An empty end key means the end of the trie
+func checkKeyRange(startKey []byte, endKey []byte) error {
+	if len(endKey) > 0 && bytes.Compare(startKey, endKey) > 0 {
+		return ErrInvalidKeyRange
+	}
+
+	return nil
+}
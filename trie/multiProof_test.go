@@ -0,0 +1,294 @@
+package trie_test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createProofVerifier() common.MerkleProofVerifier {
+	mpv, _ := trie.NewMerkleProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+
+	return mpv
+}
+
+func removeProofNode(proof [][]byte, index int) [][]byte {
+	newProof := make([][]byte, 0, len(proof)-1)
+	newProof = append(newProof, proof[:index]...)
+
+	return append(newProof, proof[index+1:]...)
+}
+
+func getAllLeavesInOrder(t *testing.T, tr common.Trie) []core.KeyValueHolder {
+	_, leaves, err := tr.GetRangeProof(nil, nil, 0)
+	require.Nil(t, err)
+
+	return leaves
+}
+
+func TestPatriciaMerkleTrie_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		proof, values, err := tr.GetMultiProof([][]byte{[]byte("dog")})
+		assert.Equal(t, trie.ErrNilNode, err)
+		assert.Nil(t, proof)
+		assert.Nil(t, values)
+	})
+	t.Run("should prove existing and missing keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		missingKey := []byte("missing key")
+		keys := [][]byte{values[3], values[50], missingKey, values[99]}
+		proof, provenValues, err := tr.GetMultiProof(keys)
+		require.Nil(t, err)
+		assert.Equal(t, 3, len(provenValues))
+		assert.Equal(t, values[50], provenValues[string(values[50])])
+
+		existingKeys := [][]byte{values[3], values[50], values[99]}
+		numSingleProofsNodes := 0
+		for _, key := range existingKeys {
+			singleProof, _, _ := tr.GetProof(key)
+			numSingleProofsNodes += len(singleProof)
+		}
+		existingKeysProof, _, _ := tr.GetMultiProof(existingKeys)
+		assert.True(t, len(existingKeysProof) < numSingleProofsNodes)
+		assert.True(t, len(existingKeysProof) < len(proof))
+
+		verifiedValues, err := createProofVerifier().VerifyMultiProof(rootHash, keys, proof)
+		require.Nil(t, err)
+		assert.Equal(t, provenValues, verifiedValues)
+		_, found := verifiedValues[string(missingKey)]
+		assert.False(t, found)
+	})
+	t.Run("dirty trie should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		rootHash, _ := tr.RootHash()
+
+		keys := [][]byte{[]byte("doe"), []byte("ddog"), []byte("cat")}
+		proof, _, err := tr.GetMultiProof(keys)
+		require.Nil(t, err)
+
+		verifiedValues, err := createProofVerifier().VerifyMultiProof(rootHash, keys, proof)
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(verifiedValues))
+		assert.Equal(t, []byte("reindeer"), verifiedValues["doe"])
+		assert.Equal(t, []byte("cat"), verifiedValues["ddog"])
+	})
+}
+
+func TestMerkleProofVerifier_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(50)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	keys := [][]byte{values[1], values[2], []byte("missing key")}
+	proof, _, _ := tr.GetMultiProof(keys)
+
+	t.Run("missing proof node should error", func(t *testing.T) {
+		t.Parallel()
+
+		for i := range proof {
+			verifiedValues, err := createProofVerifier().VerifyMultiProof(rootHash, keys, removeProofNode(proof, i))
+			assert.NotNil(t, err)
+			assert.Nil(t, verifiedValues)
+		}
+	})
+	t.Run("tampered proof node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tamperedProof := make([][]byte, len(proof))
+		copy(tamperedProof, proof)
+		tamperedProof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+		tamperedProof[len(proof)-1][0]++
+
+		_, err := createProofVerifier().VerifyMultiProof(rootHash, keys, tamperedProof)
+		assert.NotNil(t, err)
+	})
+	t.Run("different root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := createProofVerifier().VerifyMultiProof([]byte("other root hash"), keys, proof)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := emptyTrie().GetRangeProof(nil, nil, 0)
+		assert.Equal(t, trie.ErrNilNode, err)
+	})
+	t.Run("invalid max number of leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := initTrie().GetRangeProof(nil, nil, -1)
+		assert.Equal(t, trie.ErrInvalidMaxNumLeaves, err)
+	})
+	t.Run("start key after end key should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		leaves := getAllLeavesInOrder(t, tr)
+		_, _, err := tr.GetRangeProof(leaves[5].Key(), leaves[4].Key(), 0)
+		assert.Equal(t, trie.ErrInvalidKeyRange, err)
+	})
+	t.Run("whole trie should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		proof, leaves, err := tr.GetRangeProof(nil, nil, 0)
+		require.Nil(t, err)
+		assert.Equal(t, len(values), len(leaves))
+		allHashes, _ := tr.GetAllHashes()
+		assert.Equal(t, len(allHashes), len(proof))
+
+		verifiedLeaves, err := createProofVerifier().VerifyRangeProof(rootHash, nil, nil, proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, verifiedLeaves)
+	})
+	t.Run("sub range should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		allLeaves := getAllLeavesInOrder(t, tr)
+		startKey := allLeaves[10].Key()
+		endKey := allLeaves[20].Key()
+
+		proof, leaves, err := tr.GetRangeProof(startKey, endKey, 0)
+		require.Nil(t, err)
+		assert.Equal(t, allLeaves[10:20], leaves)
+
+		verifiedLeaves, err := createProofVerifier().VerifyRangeProof(rootHash, startKey, endKey, proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, verifiedLeaves)
+	})
+	t.Run("range bounded by missing keys should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		rootHash, _ := tr.RootHash()
+
+		proof, leaves, err := tr.GetRangeProof([]byte("a"), []byte("zz"), 0)
+		require.Nil(t, err)
+
+		verifiedLeaves, err := createProofVerifier().VerifyRangeProof(rootHash, []byte("a"), []byte("zz"), proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, verifiedLeaves)
+	})
+	t.Run("max number of leaves should truncate the range", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		allLeaves := getAllLeavesInOrder(t, tr)
+
+		proof, leaves, err := tr.GetRangeProof(allLeaves[30].Key(), nil, 15)
+		require.Nil(t, err)
+		assert.Equal(t, allLeaves[30:45], leaves)
+
+		verifiedLeaves, err := createProofVerifier().VerifyRangeProof(rootHash, allLeaves[30].Key(), allLeaves[45].Key(), proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, verifiedLeaves)
+
+		verifiedLeaves, err = createProofVerifier().VerifyRangeProof(rootHash, allLeaves[30].Key(), nil, proof)
+		require.Nil(t, err)
+		assert.Equal(t, allLeaves[30:], verifiedLeaves)
+	})
+	t.Run("key prefix range should return only the keys with that prefix", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		esdtKeys := make([][]byte, 0)
+		for i := 0; i < 20; i++ {
+			esdtKey := []byte(fmt.Sprintf("ELRONDesdtTKN%d-abcdef", i))
+			esdtKeys = append(esdtKeys, esdtKey)
+			require.Nil(t, tr.Update(esdtKey, []byte(fmt.Sprintf("balance%d", i))))
+			require.Nil(t, tr.Update([]byte(fmt.Sprintf("ELRONDroleesdtTKN%d-abcdef", i)), []byte("role")))
+			require.Nil(t, tr.Update([]byte(fmt.Sprintf("counter%d", i)), []byte("value")))
+		}
+		require.Nil(t, tr.Update([]byte("ELRONDesds"), []byte("before the prefix")))
+		require.Nil(t, tr.Update([]byte("ELRONDesdu"), []byte("after the prefix")))
+		require.Nil(t, tr.Commit())
+		rootHash, _ := tr.RootHash()
+		sort.Slice(esdtKeys, func(i, j int) bool {
+			return bytes.Compare(esdtKeys[i], esdtKeys[j]) < 0
+		})
+
+		startKey := []byte("ELRONDesdt")
+		endKey := []byte("ELRONDesdu")
+		proof, leaves, err := tr.GetRangeProof(startKey, endKey, 0)
+		require.Nil(t, err)
+		require.Equal(t, len(esdtKeys), len(leaves))
+		for i, leaf := range leaves {
+			assert.Equal(t, esdtKeys[i], leaf.Key())
+		}
+
+		verifiedLeaves, err := createProofVerifier().VerifyRangeProof(rootHash, startKey, endKey, proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, verifiedLeaves)
+	})
+}
+
+func TestMerkleProofVerifier_VerifyRangeProofMissingNodeShouldError(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(50)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	allLeaves := getAllLeavesInOrder(t, tr)
+	startKey := allLeaves[5].Key()
+	endKey := allLeaves[25].Key()
+	proof, _, _ := tr.GetRangeProof(startKey, endKey, 0)
+
+	for i := range proof {
+		leaves, err := createProofVerifier().VerifyRangeProof(rootHash, startKey, endKey, removeProofNode(proof, i))
+		assert.NotNil(t, err)
+		assert.Nil(t, leaves)
+	}
+}
+
+func TestPatriciaMerkleTrie_RangeProofKeysAreInKeyBytesOrder(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(50)
+	allLeaves := getAllLeavesInOrder(t, tr)
+
+	for i := 1; i < len(allLeaves); i++ {
+		previous := allLeaves[i-1].Key()
+		current := allLeaves[i].Key()
+		assert.True(t, bytes.Compare(previous, current) < 0)
+
+		_, leaves, err := tr.GetRangeProof(previous, current, 0)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(leaves))
+		assert.Equal(t, previous, leaves[0].Key())
+	}
+}
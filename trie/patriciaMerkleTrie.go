@@ -600,6 +600,150 @@ func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][
 	return false, nil
 }
 
+// GetMultiProof computes a single Merkle proof for all the given keys. Each trie node is added only once in the
+// proof, which proves both the presence of the existing keys and the absence of the missing ones. It also returns
+// the values of the existing keys
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, map[string][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pw := newProofWalker(tr.trieStorage, true)
+	values := make(map[string][]byte)
+	for _, key := range keys {
+		value, found, errWalk := pw.walkKey(tr.root, keyBytesToHex(key))
+		if errWalk != nil {
+			return nil, nil, errWalk
+		}
+		if found {
+			values[string(key)] = value
+		}
+	}
+
+	return pw.proof, values, nil
+}
+
+// GetRangeProof computes a Merkle proof for all the leaves whose keys are between the start key, included, and the end
+// key, excluded, in key bytes order, so all the keys starting with a prefix are found between the prefix and the
+// prefix incremented by one. An empty end key means the end of the trie. The leaves are returned in key bytes order
+// and, if maxLeaves is greater than 0, only the first maxLeaves of them are returned. Since the trie paths hold the key
+// nibbles in reverse order, the keys of a range are spread over the whole trie, so the proof holds all the trie nodes
+func (tr *patriciaMerkleTrie) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+	if maxLeaves < 0 {
+		return nil, nil, ErrInvalidMaxNumLeaves
+	}
+
+	err := checkKeyRange(startKey, endKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pw := newProofWalker(tr.trieStorage, true)
+	leaves := make([]core.KeyValueHolder, 0)
+	err = pw.walkRange(tr.root, make([]byte, 0), startKey, endKey, &leaves)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pw.proof, sortAndLimitLeaves(leaves, maxLeaves), nil
+}
+
+// GetLeavesDiff compares the tries identified by the two root hashes and calls the handler, in trie order, for each key
+// that was added, removed or modified. The subtrees that have the same hash in both tries are not loaded
+func (tr *patriciaMerkleTrie) GetLeavesDiff(
+	ctx context.Context,
+	fromRootHash []byte,
+	toRootHash []byte,
+	handler common.TrieLeavesDiffHandler,
+) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if handler == nil {
+		return ErrNilLeavesDiffHandler
+	}
+
+	tr.mutOperation.RLock()
+	fromTrie, err := tr.recreate(fromRootHash, tr.trieStorage)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+	toTrie, err := tr.recreate(toRootHash, tr.trieStorage)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	dw := &diffWalker{
+		ctx:     ctx,
+		fromDb:  tr.trieStorage,
+		toDb:    tr.trieStorage,
+		handler: handler,
+	}
+
+	return dw.diff(fromTrie.root, toTrie.root, make([]byte, 0))
+}
+
+// GetTrieStatistics loads from the storage, one by one, all the nodes of the trie identified by the provided root hash
+// and returns their number, the trie depth and the cumulated size of the serialized nodes. Unlike GetNumNodes, the
+// nodes are not kept in memory
+func (tr *patriciaMerkleTrie) GetTrieStatistics(ctx context.Context, rootHash []byte) (common.TrieStatisticsDTO, error) {
+	if ctx == nil {
+		return common.TrieStatisticsDTO{}, ErrNilContext
+	}
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return common.TrieStatisticsDTO{}, nil
+	}
+
+	tr.mutOperation.RLock()
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	sw := &statisticsWalker{
+		ctx:         ctx,
+		db:          tr.trieStorage,
+		marshalizer: tr.marshalizer,
+		hasher:      tr.hasher,
+	}
+
+	return sw.walk(rootHash)
+}
+
 // GetNumNodes will return the trie nodes statistics DTO
 func (tr *patriciaMerkleTrie) GetNumNodes() common.NumNodesDTO {
 	tr.mutOperation.Lock()
@@ -1,9 +1,12 @@
 package trie
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 )
 
 type merkleProofVerifier struct {
@@ -31,3 +34,76 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies a Merkle proof computed for several keys. It returns the values of the keys proven to
+// exist, the keys that are missing from the result being proven absent. An error is returned if the proof does not
+// contain all the trie nodes needed for any of the keys
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (map[string][]byte, error) {
+	root, db, err := mpv.loadProof(rootHash, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	pw := newProofWalker(db, false)
+	values := make(map[string][]byte)
+	for _, key := range keys {
+		value, found, errWalk := pw.walkKey(root, keyBytesToHex(key))
+		if errWalk != nil {
+			return nil, errWalk
+		}
+		if found {
+			values[string(key)] = value
+		}
+	}
+
+	return values, nil
+}
+
+// VerifyRangeProof verifies a Merkle proof computed for a range of keys. It returns all the leaves proven to be between
+// the start key, included, and the end key, excluded, in key bytes order. An error is returned if the proof does not
+// contain all the trie nodes
+func (mpv *merkleProofVerifier) VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]core.KeyValueHolder, error) {
+	err := checkKeyRange(startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	root, db, err := mpv.loadProof(rootHash, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	pw := newProofWalker(db, false)
+	leaves := make([]core.KeyValueHolder, 0)
+	err = pw.walkRange(root, make([]byte, 0), startKey, endKey, &leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortAndLimitLeaves(leaves, 0), nil
+}
+
+// loadProof stores the proof nodes by their computed hashes, so that a node can only be resolved if it matches the
+// hash held by its parent
+func (mpv *merkleProofVerifier) loadProof(rootHash []byte, proof [][]byte) (node, common.DBWriteCacher, error) {
+	db := memorydb.New()
+	for _, encodedNode := range proof {
+		err := db.Put(mpv.trie.hasher.Compute(string(encodedNode)), encodedNode)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	root, err := getNodeFromDBAndDecode(rootHash, db, mpv.trie.marshalizer, mpv.trie.hasher)
+	if err != nil {
+		return nil, nil, err
+	}
+	root.setGivenHash(rootHash)
+
+	return root, db, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mpv *merkleProofVerifier) IsInterfaceNil() bool {
+	return mpv == nil
+}
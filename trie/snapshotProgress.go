@@ -0,0 +1,461 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+)
+
+const (
+	snapshotProgressKey = "snapshotProgress"
+
+	// snapshotProgressMaxDepth is the number of trie levels walked by the resumable snapshot. The subtrees found at
+	// this depth are copied as a whole, so they are the finest granularity at which the progress is persisted
+	snapshotProgressMaxDepth = 2
+
+	maxPercent = 100
+)
+
+// snapshotProgressRecord is the persisted frontier of a resumable snapshot: all the subtrees placed before the one
+// found at Path, including this one, have been fully copied
+type snapshotProgressRecord struct {
+	RootHash []byte
+	Epoch    uint32
+	Path     []byte
+	NodeHash []byte
+}
+
+type snapshotLeavesBarrier struct {
+	chRelease   chan struct{}
+	releaseOnce sync.Once
+}
+
+func newSnapshotLeavesBarrier() *snapshotLeavesBarrier {
+	return &snapshotLeavesBarrier{
+		chRelease: make(chan struct{}),
+	}
+}
+
+// Key returns nil as the barrier does not hold a trie leaf
+func (slb *snapshotLeavesBarrier) Key() []byte {
+	return nil
+}
+
+// Value returns nil as the barrier does not hold a trie leaf
+func (slb *snapshotLeavesBarrier) Value() []byte {
+	return nil
+}
+
+// ValueWithoutSuffix returns nil as the barrier does not hold a trie leaf
+func (slb *snapshotLeavesBarrier) ValueWithoutSuffix(_ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+// Release signals the snapshot that all the work started for the previous leaves has finished
+func (slb *snapshotLeavesBarrier) Release() {
+	slb.releaseOnce.Do(func() {
+		close(slb.chRelease)
+	})
+}
+
+type snapshotProgressTracker struct {
+	mutProgress sync.RWMutex
+	progress    common.SnapshotProgress
+}
+
+func (spt *snapshotProgressTracker) start(rootHash []byte, resumed bool) {
+	spt.mutProgress.Lock()
+	spt.progress = common.SnapshotProgress{
+		InProgress: true,
+		Resumed:    resumed,
+		RootHash:   rootHash,
+	}
+	spt.mutProgress.Unlock()
+}
+
+func (spt *snapshotProgressTracker) addCopiedNode() {
+	spt.mutProgress.Lock()
+	spt.progress.NumNodesCopied++
+	spt.mutProgress.Unlock()
+}
+
+func (spt *snapshotProgressTracker) setPercent(percent float64) {
+	spt.mutProgress.Lock()
+	spt.progress.Percent = uint64(percent)
+	if spt.progress.Percent > maxPercent {
+		spt.progress.Percent = maxPercent
+	}
+	spt.mutProgress.Unlock()
+}
+
+func (spt *snapshotProgressTracker) finish() {
+	spt.mutProgress.Lock()
+	spt.progress.InProgress = false
+	spt.mutProgress.Unlock()
+}
+
+func (spt *snapshotProgressTracker) get() common.SnapshotProgress {
+	spt.mutProgress.RLock()
+	defer spt.mutProgress.RUnlock()
+
+	return spt.progress
+}
+
+type snapshotProgressStats struct {
+	common.SnapshotStatisticsHandler
+	tracker *snapshotProgressTracker
+}
+
+// AddSize will add the given size to the wrapped statistics handler and count the copied node
+func (sps *snapshotProgressStats) AddSize(size uint64) {
+	sps.SnapshotStatisticsHandler.AddSize(size)
+	sps.tracker.addCopiedNode()
+}
+
+// resumableSnapshot copies the first levels of the main trie one subtree at a time and persists, after each copied
+// subtree, the frontier reached so far. If the node is stopped, the snapshot of the same root hash in the same epoch
+// will skip the subtrees that were already copied.
+type resumableSnapshot struct {
+	tsm                 *trieStorageManager
+	db                  common.DBWriteCacher
+	entry               *snapshotsQueueEntry
+	ctx                 context.Context
+	goRoutinesThrottler core.Throttler
+	stats               common.SnapshotStatisticsHandler
+}
+
+func isResumableSnapshot(entry *snapshotsQueueEntry) bool {
+	return bytes.Equal(entry.rootHash, entry.mainTrieRootHash)
+}
+
+func (tsm *trieStorageManager) takeResumableSnapshot(
+	root snapshotNode,
+	db common.DBWriteCacher,
+	entry *snapshotsQueueEntry,
+	msh marshal.Marshalizer,
+	hsh hashing.Hasher,
+	ctx context.Context,
+	goRoutinesThrottler core.Throttler,
+) error {
+	rootNode, ok := root.(node)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	var resumePath []byte
+	record, err := tsm.loadSnapshotProgress(entry, msh, hsh)
+	if err != nil {
+		log.Debug("trie snapshot progress can not be used, snapshot starts from scratch",
+			"rootHash", entry.rootHash, "epoch", entry.epoch, "reason", err)
+	} else {
+		resumePath = record.Path
+		log.Debug("resuming trie snapshot", "rootHash", entry.rootHash, "epoch", entry.epoch, "path", record.Path)
+	}
+
+	tsm.snapshotProgress.start(entry.rootHash, len(resumePath) != 0)
+	defer tsm.snapshotProgress.finish()
+
+	rs := &resumableSnapshot{
+		tsm:                 tsm,
+		db:                  db,
+		entry:               entry,
+		ctx:                 ctx,
+		goRoutinesThrottler: goRoutinesThrottler,
+		stats: &snapshotProgressStats{
+			SnapshotStatisticsHandler: entry.stats,
+			tracker:                   tsm.snapshotProgress,
+		},
+	}
+
+	err = rs.walk(rootNode, make([]byte, 0), resumePath, 0, maxPercent)
+	if err != nil {
+		return err
+	}
+
+	if hasErrors(entry.errChan) {
+		return nil
+	}
+
+	err = tsm.Remove([]byte(snapshotProgressKey))
+	if err != nil {
+		log.Debug("could not remove the trie snapshot progress", "rootHash", entry.rootHash, "error", err)
+	}
+
+	return nil
+}
+
+func (tsm *trieStorageManager) loadSnapshotProgress(
+	entry *snapshotsQueueEntry,
+	msh marshal.Marshalizer,
+	hsh hashing.Hasher,
+) (*snapshotProgressRecord, error) {
+	val, err := tsm.Get([]byte(snapshotProgressKey))
+	if err != nil {
+		return nil, err
+	}
+
+	record := &snapshotProgressRecord{}
+	err = json.Unmarshal(val, record)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifySnapshotProgress(record, entry, tsm, msh, hsh)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// verifySnapshotProgress checks that the persisted progress belongs to the snapshot that is started and that the
+// node found by following its path from the root hash is the one recorded when the progress was saved
+func verifySnapshotProgress(
+	record *snapshotProgressRecord,
+	entry *snapshotsQueueEntry,
+	db common.DBWriteCacher,
+	msh marshal.Marshalizer,
+	hsh hashing.Hasher,
+) error {
+	if !bytes.Equal(record.RootHash, entry.rootHash) {
+		return fmt.Errorf("%w, progress saved for root hash %x", ErrInvalidSnapshotProgress, record.RootHash)
+	}
+	if record.Epoch != entry.epoch {
+		return fmt.Errorf("%w, progress saved for epoch %d", ErrInvalidSnapshotProgress, record.Epoch)
+	}
+	if len(record.Path) == 0 || len(record.Path) > snapshotProgressMaxDepth {
+		return fmt.Errorf("%w, invalid path length %d", ErrInvalidSnapshotProgress, len(record.Path))
+	}
+
+	hash := entry.rootHash
+	for _, pos := range record.Path {
+		n, err := getNodeFromDBAndDecode(hash, db, msh, hsh)
+		if err != nil {
+			return err
+		}
+
+		hash, err = getProgressPathChildHash(n, pos)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bytes.Equal(hash, record.NodeHash) {
+		return fmt.Errorf("%w, node hash mismatch for path %v", ErrInvalidSnapshotProgress, record.Path)
+	}
+
+	return nil
+}
+
+func getProgressPathChildHash(n node, pos byte) ([]byte, error) {
+	switch nodeWithChildren := n.(type) {
+	case *branchNode:
+		if childPosOutOfRange(pos) || len(nodeWithChildren.EncodedChildren[pos]) == 0 {
+			return nil, fmt.Errorf("%w, missing branch child at position %d", ErrInvalidSnapshotProgress, pos)
+		}
+		return nodeWithChildren.EncodedChildren[pos], nil
+	case *extensionNode:
+		if pos != 0 {
+			return nil, fmt.Errorf("%w, invalid extension child position %d", ErrInvalidSnapshotProgress, pos)
+		}
+		return nodeWithChildren.EncodedChild, nil
+	default:
+		return nil, fmt.Errorf("%w, path goes below a leaf node", ErrInvalidSnapshotProgress)
+	}
+}
+
+// getChildResumePath returns the resume path that should be used for the child found at the given position, or
+// true if the child subtree has already been copied
+func getChildResumePath(resumePath []byte, pos byte) ([]byte, bool) {
+	if len(resumePath) == 0 {
+		return nil, false
+	}
+	if pos < resumePath[0] {
+		return nil, true
+	}
+	if pos > resumePath[0] {
+		return nil, false
+	}
+	if len(resumePath) == 1 {
+		return nil, true
+	}
+
+	return resumePath[1:], false
+}
+
+func (rs *resumableSnapshot) walk(n node, path []byte, resumePath []byte, startPercent float64, widthPercent float64) error {
+	if len(path) >= snapshotProgressMaxDepth {
+		return rs.commitSubtree(n, path, startPercent+widthPercent)
+	}
+
+	switch nodeWithChildren := n.(type) {
+	case *branchNode:
+		err := rs.walkChildren(nodeWithChildren, nrOfChildren, path, resumePath, startPercent, widthPercent)
+		if err != nil {
+			return err
+		}
+
+		err = nodeWithChildren.saveToStorage(rs.db, rs.stats)
+		if err != nil {
+			return err
+		}
+	case *extensionNode:
+		err := rs.walkChildren(nodeWithChildren, 1, path, resumePath, startPercent, widthPercent)
+		if err != nil {
+			return err
+		}
+
+		err = nodeWithChildren.saveToStorage(rs.db, rs.stats)
+		if err != nil {
+			return err
+		}
+	default:
+		return rs.commitSubtree(n, path, startPercent+widthPercent)
+	}
+
+	return rs.saveProgress(n, path, startPercent+widthPercent)
+}
+
+func (rs *resumableSnapshot) walkChildren(
+	n node,
+	numChildren int,
+	path []byte,
+	resumePath []byte,
+	startPercent float64,
+	widthPercent float64,
+) error {
+	if shouldStopIfContextDone(rs.ctx, rs.tsm.idleProvider) {
+		return errors.ErrContextClosing
+	}
+
+	err := n.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("commit snapshot error %w", err)
+	}
+
+	childWidthPercent := widthPercent / float64(numChildren)
+	for i := 0; i < numChildren; i++ {
+		childStartPercent := startPercent + float64(i)*childWidthPercent
+
+		childResumePath, isCopied := getChildResumePath(resumePath, byte(i))
+		if isCopied {
+			rs.tsm.snapshotProgress.setPercent(childStartPercent + childWidthPercent)
+			continue
+		}
+
+		err = resolveIfCollapsed(n, byte(i), rs.db)
+		if err != nil {
+			return err
+		}
+
+		child := getSnapshotChild(n, byte(i))
+		if child == nil {
+			continue
+		}
+
+		err = rs.walk(child, concat(path, byte(i)), childResumePath, childStartPercent, childWidthPercent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getSnapshotChild(n node, pos byte) node {
+	switch nodeWithChildren := n.(type) {
+	case *branchNode:
+		return nodeWithChildren.children[pos]
+	case *extensionNode:
+		return nodeWithChildren.child
+	default:
+		return nil
+	}
+}
+
+func (rs *resumableSnapshot) commitSubtree(n node, path []byte, donePercent float64) error {
+	err := n.commitSnapshot(rs.db, rs.entry.leavesChan, rs.ctx, rs.stats, rs.tsm.idleProvider)
+	if err != nil {
+		return err
+	}
+
+	return rs.saveProgress(n, path, donePercent)
+}
+
+func (rs *resumableSnapshot) saveProgress(n node, path []byte, donePercent float64) error {
+	rs.tsm.snapshotProgress.setPercent(donePercent)
+	if len(path) == 0 {
+		// the whole trie has been copied, the progress will be removed
+		return nil
+	}
+
+	err := rs.waitForLeavesConsumer()
+	if err != nil {
+		return err
+	}
+	if hasErrors(rs.entry.errChan) {
+		// a previous error means that some copied data might be incomplete, so the last valid progress is kept
+		return nil
+	}
+
+	record := &snapshotProgressRecord{
+		RootHash: rs.entry.rootHash,
+		Epoch:    rs.entry.epoch,
+		Path:     path,
+		NodeHash: n.getHash(),
+	}
+	val, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	err = rs.tsm.Put([]byte(snapshotProgressKey), val)
+	if err != nil {
+		log.Debug("could not save the trie snapshot progress", "rootHash", rs.entry.rootHash, "path", path, "error", err)
+	}
+
+	return nil
+}
+
+// waitForLeavesConsumer writes a barrier on the leaves channel and waits until the consumer has finished the work
+// triggered by the previous leaves (e.g. the data tries snapshots). The throttler slot is given back while waiting,
+// as the consumer might need it to finish its work
+func (rs *resumableSnapshot) waitForLeavesConsumer() error {
+	if rs.entry.leavesChan == nil {
+		return nil
+	}
+
+	barrier := newSnapshotLeavesBarrier()
+	select {
+	case rs.entry.leavesChan <- barrier:
+	case <-rs.ctx.Done():
+		return errors.ErrContextClosing
+	}
+
+	rs.goRoutinesThrottler.EndProcessing()
+	defer rs.goRoutinesThrottler.StartProcessing()
+
+	select {
+	case <-barrier.chRelease:
+		return nil
+	case <-rs.ctx.Done():
+		return errors.ErrContextClosing
+	}
+}
+
+func hasErrors(errChan chan error) bool {
+	return len(errChan) != 0
+}
+
+// GetSnapshotProgress returns the progress of the resumable trie snapshot
+func (tsm *trieStorageManager) GetSnapshotProgress() common.SnapshotProgress {
+	return tsm.snapshotProgress.get()
+}
@@ -0,0 +1,243 @@
+package trie
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrieForSnapshotProgress(t *testing.T, numLeaves int) (*trieStorageManager, []byte, [][]byte) {
+	marsh, hsh := getTestMarshalizerAndHasher()
+	args := NewTrieStorageManagerArgs{
+		MainStorer:             testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer:      createMemUnit(),
+		Marshalizer:            marsh,
+		Hasher:                 hsh,
+		GeneralConfig:          config.TrieStorageManagerConfig{SnapshotsBufferLen: 10, SnapshotsGoroutineNum: 1},
+		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(hsh.Size())),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+	}
+	tsm, err := NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := NewTrie(tsm, marsh, hsh, 5)
+	require.Nil(t, err)
+
+	keys := make([][]byte, 0, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		key := hsh.Compute(strconv.Itoa(i))
+		keys = append(keys, key)
+		_ = tr.Update(key, []byte(strconv.Itoa(i)))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+
+	return tsm, rootHash, keys
+}
+
+func takeSnapshotAndCountLeaves(tsm *trieStorageManager, rootHash []byte) (int, int) {
+	leavesChan := make(chan core.KeyValueHolder, 10)
+	errChan := make(chan error, 1)
+	tsm.TakeSnapshot(rootHash, rootHash, leavesChan, errChan, &trieMock.MockStatistics{}, 0)
+
+	numLeaves := 0
+	numBarriers := 0
+	for leaf := range leavesChan {
+		barrier, isBarrier := leaf.(common.SnapshotLeavesBarrier)
+		if isBarrier {
+			numBarriers++
+			barrier.Release()
+			continue
+		}
+
+		numLeaves++
+	}
+
+	return numLeaves, numBarriers
+}
+
+func putSnapshotProgress(t *testing.T, tsm *trieStorageManager, record *snapshotProgressRecord) {
+	val, err := json.Marshal(record)
+	require.Nil(t, err)
+	require.Nil(t, tsm.Put([]byte(snapshotProgressKey), val))
+}
+
+func getFirstRootChild(t *testing.T, tsm *trieStorageManager, rootHash []byte) (byte, []byte) {
+	marsh, hsh := getTestMarshalizerAndHasher()
+	root, err := getNodeFromDBAndDecode(rootHash, tsm, marsh, hsh)
+	require.Nil(t, err)
+
+	bn, ok := root.(*branchNode)
+	require.True(t, ok)
+	for i := range bn.EncodedChildren {
+		if len(bn.EncodedChildren[i]) != 0 {
+			return byte(i), bn.EncodedChildren[i]
+		}
+	}
+
+	require.Fail(t, "root node has no children")
+	return 0, nil
+}
+
+func TestGetChildResumePath(t *testing.T) {
+	t.Parallel()
+
+	path, isCopied := getChildResumePath(nil, 3)
+	assert.Nil(t, path)
+	assert.False(t, isCopied)
+
+	path, isCopied = getChildResumePath([]byte{3, 5}, 2)
+	assert.Nil(t, path)
+	assert.True(t, isCopied)
+
+	path, isCopied = getChildResumePath([]byte{3, 5}, 3)
+	assert.Equal(t, []byte{5}, path)
+	assert.False(t, isCopied)
+
+	path, isCopied = getChildResumePath([]byte{3, 5}, 4)
+	assert.Nil(t, path)
+	assert.False(t, isCopied)
+
+	path, isCopied = getChildResumePath([]byte{3}, 3)
+	assert.Nil(t, path)
+	assert.True(t, isCopied)
+}
+
+func TestVerifySnapshotProgress(t *testing.T) {
+	t.Parallel()
+
+	tsm, rootHash, _ := createTrieForSnapshotProgress(t, 100)
+	pos, childHash := getFirstRootChild(t, tsm, rootHash)
+	marsh, hsh := getTestMarshalizerAndHasher()
+	entry := &snapshotsQueueEntry{rootHash: rootHash, mainTrieRootHash: rootHash, epoch: 2}
+
+	t.Run("different root hash should error", func(t *testing.T) {
+		record := &snapshotProgressRecord{RootHash: []byte("other root hash"), Epoch: 2, Path: []byte{pos}, NodeHash: childHash}
+		err := verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotProgress))
+	})
+	t.Run("different epoch should error", func(t *testing.T) {
+		record := &snapshotProgressRecord{RootHash: rootHash, Epoch: 3, Path: []byte{pos}, NodeHash: childHash}
+		err := verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotProgress))
+	})
+	t.Run("invalid path length should error", func(t *testing.T) {
+		record := &snapshotProgressRecord{RootHash: rootHash, Epoch: 2, Path: []byte{}, NodeHash: childHash}
+		err := verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotProgress))
+
+		record.Path = []byte{pos, 0, 0}
+		err = verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotProgress))
+	})
+	t.Run("node hash mismatch should error", func(t *testing.T) {
+		record := &snapshotProgressRecord{RootHash: rootHash, Epoch: 2, Path: []byte{pos}, NodeHash: []byte("other hash")}
+		err := verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotProgress))
+	})
+	t.Run("path to a missing child should error", func(t *testing.T) {
+		record := &snapshotProgressRecord{RootHash: rootHash, Epoch: 2, Path: []byte{nrOfChildren}, NodeHash: childHash}
+		err := verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotProgress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		record := &snapshotProgressRecord{RootHash: rootHash, Epoch: 2, Path: []byte{pos}, NodeHash: childHash}
+		err := verifySnapshotProgress(record, entry, tsm, marsh, hsh)
+		assert.Nil(t, err)
+	})
+}
+
+func TestTrieStorageManager_TakeSnapshotResumable(t *testing.T) {
+	t.Parallel()
+
+	numLeaves := 100
+
+	t.Run("full snapshot should copy all the leaves and remove the progress", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, rootHash, _ := createTrieForSnapshotProgress(t, numLeaves)
+
+		numCopiedLeaves, numBarriers := takeSnapshotAndCountLeaves(tsm, rootHash)
+		assert.Equal(t, numLeaves, numCopiedLeaves)
+		assert.True(t, numBarriers > 0)
+
+		_, err := tsm.Get([]byte(snapshotProgressKey))
+		assert.NotNil(t, err)
+
+		progress := tsm.GetSnapshotProgress()
+		assert.False(t, progress.InProgress)
+		assert.False(t, progress.Resumed)
+		assert.Equal(t, rootHash, progress.RootHash)
+		assert.Equal(t, uint64(maxPercent), progress.Percent)
+		assert.True(t, progress.NumNodesCopied > uint64(numLeaves))
+	})
+	t.Run("valid progress should skip the copied subtrees", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, rootHash, keys := createTrieForSnapshotProgress(t, numLeaves)
+		pos, childHash := getFirstRootChild(t, tsm, rootHash)
+		putSnapshotProgress(t, tsm, &snapshotProgressRecord{
+			RootHash: rootHash,
+			Path:     []byte{pos},
+			NodeHash: childHash,
+		})
+
+		numSkippedLeaves := 0
+		for _, key := range keys {
+			if keyBytesToHex(key)[0] == pos {
+				numSkippedLeaves++
+			}
+		}
+		require.True(t, numSkippedLeaves > 0)
+
+		numCopiedLeaves, _ := takeSnapshotAndCountLeaves(tsm, rootHash)
+		assert.Equal(t, numLeaves-numSkippedLeaves, numCopiedLeaves)
+		assert.True(t, tsm.GetSnapshotProgress().Resumed)
+
+		_, err := tsm.Get([]byte(snapshotProgressKey))
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid progress should start from scratch", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, rootHash, _ := createTrieForSnapshotProgress(t, numLeaves)
+		pos, _ := getFirstRootChild(t, tsm, rootHash)
+		putSnapshotProgress(t, tsm, &snapshotProgressRecord{
+			RootHash: rootHash,
+			Path:     []byte{pos},
+			NodeHash: []byte("wrong hash"),
+		})
+
+		numCopiedLeaves, _ := takeSnapshotAndCountLeaves(tsm, rootHash)
+		assert.Equal(t, numLeaves, numCopiedLeaves)
+		assert.False(t, tsm.GetSnapshotProgress().Resumed)
+	})
+	t.Run("data trie snapshot should not persist progress", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, rootHash, _ := createTrieForSnapshotProgress(t, numLeaves)
+
+		leavesChan := make(chan core.KeyValueHolder, 10)
+		tsm.TakeSnapshot(rootHash, []byte("main trie root hash"), leavesChan, make(chan error, 1), &trieMock.MockStatistics{}, 0)
+		numCopiedLeaves := 0
+		for leaf := range leavesChan {
+			_, isBarrier := leaf.(common.SnapshotLeavesBarrier)
+			assert.False(t, isBarrier)
+			numCopiedLeaves++
+		}
+
+		assert.Equal(t, numLeaves, numCopiedLeaves)
+		assert.False(t, tsm.GetSnapshotProgress().InProgress)
+		assert.Nil(t, tsm.GetSnapshotProgress().RootHash)
+	})
+}
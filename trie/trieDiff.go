@@ -0,0 +1,286 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+)
+
+type leafWithPath struct {
+	hexPath []byte
+	value   []byte
+}
+
+// diffWalker walks two tries in parallel, descending only in the subtrees that have different hashes. The two tries
+// can have different shapes at the same path, so an extension node is seen as a chain of single child nodes, one for
+// each nibble of its key.
+type diffWalker struct {
+	ctx     context.Context
+	fromDb  common.DBWriteCacher
+	toDb    common.DBWriteCacher
+	handler common.TrieLeavesDiffHandler
+}
+
+func (dw *diffWalker) diff(from node, to node, path []byte) error {
+	if dw.isContextDone() {
+		return errors.ErrContextClosing
+	}
+	if check.IfNil(from) && check.IfNil(to) {
+		return nil
+	}
+	if haveSameHash(from, to) {
+		return nil
+	}
+
+	_, isFromLeaf := from.(*leafNode)
+	_, isToLeaf := to.(*leafNode)
+	if check.IfNil(from) || check.IfNil(to) || isFromLeaf || isToLeaf {
+		return dw.diffLeaves(from, to, path)
+	}
+
+	fromEn, isFromExtension := from.(*extensionNode)
+	toEn, isToExtension := to.(*extensionNode)
+	if isFromExtension && isToExtension && bytes.Equal(fromEn.Key, toEn.Key) {
+		return dw.diffExtensionChildren(fromEn, toEn, path)
+	}
+
+	for i := byte(0); i < nrOfChildren; i++ {
+		fromHash := getChildHash(from, i)
+		if len(fromHash) != 0 && bytes.Equal(fromHash, getChildHash(to, i)) {
+			continue
+		}
+
+		fromChild, err := getChildAt(from, i, dw.fromDb)
+		if err != nil {
+			return err
+		}
+		toChild, err := getChildAt(to, i, dw.toDb)
+		if err != nil {
+			return err
+		}
+
+		err = dw.diff(fromChild, toChild, concat(path, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dw *diffWalker) diffExtensionChildren(from *extensionNode, to *extensionNode, path []byte) error {
+	if len(from.EncodedChild) != 0 && bytes.Equal(from.EncodedChild, to.EncodedChild) {
+		return nil
+	}
+
+	err := resolveIfCollapsed(from, 0, dw.fromDb)
+	if err != nil {
+		return err
+	}
+	err = resolveIfCollapsed(to, 0, dw.toDb)
+	if err != nil {
+		return err
+	}
+
+	return dw.diff(from.child, to.child, concat(path, from.Key...))
+}
+
+// diffLeaves handles the case when at least one of the subtrees is a leaf or is missing, by comparing all the leaves
+// found in the two subtrees. The subtree that is not a leaf holds the only leaves that can differ. The leaves of the two
+// subtrees are read one by one, in key order, so the walk stops as soon as the handler returns an error
+func (dw *diffWalker) diffLeaves(from node, to node, path []byte) error {
+	fromIterator := dw.newLeavesIterator(from, path, dw.fromDb)
+	toIterator := dw.newLeavesIterator(to, path, dw.toDb)
+
+	fromLeaf, err := fromIterator.next()
+	if err != nil {
+		return err
+	}
+	toLeaf, err := toIterator.next()
+	if err != nil {
+		return err
+	}
+
+	for fromLeaf != nil || toLeaf != nil {
+		var hexPath, oldValue, newValue []byte
+		isFromLeafConsumed, isToLeafConsumed, isChanged := false, false, true
+		switch {
+		case toLeaf == nil || (fromLeaf != nil && bytes.Compare(fromLeaf.hexPath, toLeaf.hexPath) < 0):
+			hexPath, oldValue = fromLeaf.hexPath, fromLeaf.value
+			isFromLeafConsumed = true
+		case fromLeaf == nil || bytes.Compare(fromLeaf.hexPath, toLeaf.hexPath) > 0:
+			hexPath, newValue = toLeaf.hexPath, toLeaf.value
+			isToLeafConsumed = true
+		default:
+			hexPath, oldValue, newValue = toLeaf.hexPath, fromLeaf.value, toLeaf.value
+			isFromLeafConsumed, isToLeafConsumed = true, true
+			isChanged = !bytes.Equal(oldValue, newValue)
+		}
+
+		if isChanged {
+			err = dw.callHandler(hexPath, oldValue, newValue)
+			if err != nil {
+				return err
+			}
+		}
+
+		if isFromLeafConsumed {
+			fromLeaf, err = fromIterator.next()
+			if err != nil {
+				return err
+			}
+		}
+		if isToLeafConsumed {
+			toLeaf, err = toIterator.next()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dw *diffWalker) callHandler(hexPath []byte, oldValue []byte, newValue []byte) error {
+	key, err := hexToKeyBytes(hexPath)
+	if err != nil {
+		return err
+	}
+
+	return dw.handler(key, oldValue, newValue)
+}
+
+type nodeToIterate struct {
+	node      node
+	path      []byte
+	nextChild byte
+}
+
+// leavesIterator returns, one by one and in key order, the leaves of a subtree. Only the nodes on the path towards the
+// next leaf are loaded
+type leavesIterator struct {
+	dw    *diffWalker
+	db    common.DBWriteCacher
+	stack []*nodeToIterate
+}
+
+func (dw *diffWalker) newLeavesIterator(n node, path []byte, db common.DBWriteCacher) *leavesIterator {
+	iterator := &leavesIterator{
+		dw:    dw,
+		db:    db,
+		stack: make([]*nodeToIterate, 0),
+	}
+	if !check.IfNil(n) {
+		iterator.stack = append(iterator.stack, &nodeToIterate{node: n, path: path})
+	}
+
+	return iterator
+}
+
+// next returns the next leaf of the subtree or nil if all the leaves were returned
+func (li *leavesIterator) next() (*leafWithPath, error) {
+	for len(li.stack) > 0 {
+		if li.dw.isContextDone() {
+			return nil, errors.ErrContextClosing
+		}
+
+		current := li.stack[len(li.stack)-1]
+		if ln, ok := current.node.(*leafNode); ok {
+			li.stack = li.stack[:len(li.stack)-1]
+			return &leafWithPath{
+				hexPath: concat(current.path, ln.Key...),
+				value:   ln.Value,
+			}, nil
+		}
+		if current.nextChild == nrOfChildren {
+			li.stack = li.stack[:len(li.stack)-1]
+			continue
+		}
+
+		pos := current.nextChild
+		current.nextChild++
+		child, err := getChildAt(current.node, pos, li.db)
+		if err != nil {
+			return nil, err
+		}
+		if check.IfNil(child) {
+			continue
+		}
+
+		li.stack = append(li.stack, &nodeToIterate{node: child, path: concat(current.path, pos)})
+	}
+
+	return nil, nil
+}
+
+func (dw *diffWalker) isContextDone() bool {
+	select {
+	case <-dw.ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func haveSameHash(from node, to node) bool {
+	if check.IfNil(from) || check.IfNil(to) {
+		return false
+	}
+
+	fromHash := from.getHash()
+
+	return len(fromHash) != 0 && !from.isDirty() && !to.isDirty() && bytes.Equal(fromHash, to.getHash())
+}
+
+// getChildHash returns the hash of the child found at the given position, without loading the child
+func getChildHash(n node, pos byte) []byte {
+	switch currentNode := n.(type) {
+	case *branchNode:
+		return currentNode.EncodedChildren[pos]
+	case *extensionNode:
+		if len(currentNode.Key) == 1 && currentNode.Key[0] == pos {
+			return currentNode.EncodedChild
+		}
+	}
+
+	return nil
+}
+
+// getChildAt returns the child found at the given position. For an extension node with a longer key, the child is an
+// extension node without a hash, holding the rest of the key
+func getChildAt(n node, pos byte, db common.DBWriteCacher) (node, error) {
+	switch currentNode := n.(type) {
+	case *branchNode:
+		err := resolveIfCollapsed(currentNode, pos, db)
+		if err != nil {
+			return nil, err
+		}
+
+		return currentNode.children[pos], nil
+	case *extensionNode:
+		if len(currentNode.Key) == 0 || currentNode.Key[0] != pos {
+			return nil, nil
+		}
+		err := resolveIfCollapsed(currentNode, 0, db)
+		if err != nil {
+			return nil, err
+		}
+		if len(currentNode.Key) == 1 {
+			return currentNode.child, nil
+		}
+
+		return &extensionNode{
+			CollapsedEn: CollapsedEn{
+				Key:          currentNode.Key[1:],
+				EncodedChild: currentNode.EncodedChild,
+			},
+			child:    currentNode.child,
+			baseNode: &baseNode{},
+		}, nil
+	default:
+		return nil, ErrInvalidNode
+	}
+}
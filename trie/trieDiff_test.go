@@ -0,0 +1,209 @@
+package trie_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	elrondErrors "github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type leafDiff struct {
+	oldValue string
+	newValue string
+}
+
+func getAllLeaves(t *testing.T, tr common.Trie, rootHash []byte) map[string]string {
+	leavesChannel := make(chan core.KeyValueHolder, 100)
+	err := tr.GetAllLeavesOnChannel(leavesChannel, context.Background(), rootHash)
+	require.Nil(t, err)
+
+	leaves := make(map[string]string)
+	for leaf := range leavesChannel {
+		leaves[string(leaf.Key())] = string(leaf.Value())
+	}
+
+	return leaves
+}
+
+func computeExpectedDiff(fromLeaves map[string]string, toLeaves map[string]string) map[string]leafDiff {
+	expectedDiff := make(map[string]leafDiff)
+	for key, oldValue := range fromLeaves {
+		newValue := toLeaves[key]
+		if oldValue != newValue {
+			expectedDiff[key] = leafDiff{oldValue: oldValue, newValue: newValue}
+		}
+	}
+	for key, newValue := range toLeaves {
+		_, found := fromLeaves[key]
+		if !found {
+			expectedDiff[key] = leafDiff{newValue: newValue}
+		}
+	}
+
+	return expectedDiff
+}
+
+func getLeavesDiff(t *testing.T, tr common.Trie, fromRootHash []byte, toRootHash []byte) map[string]leafDiff {
+	diff := make(map[string]leafDiff)
+	err := tr.GetLeavesDiff(context.Background(), fromRootHash, toRootHash, func(key []byte, oldValue []byte, newValue []byte) error {
+		_, found := diff[string(key)]
+		assert.False(t, found)
+		diff[string(key)] = leafDiff{oldValue: string(oldValue), newValue: string(newValue)}
+		return nil
+	})
+	require.Nil(t, err)
+
+	return diff
+}
+
+func TestPatriciaMerkleTrie_GetLeavesDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := emptyTrie().GetLeavesDiff(context.Background(), nil, nil, nil)
+		assert.Equal(t, trie.ErrNilLeavesDiffHandler, err)
+	})
+	t.Run("same root hash should not call the handler", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		diff := getLeavesDiff(t, tr, rootHash, rootHash)
+		assert.Equal(t, 0, len(diff))
+	})
+	t.Run("empty from trie should add all the keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		diff := getLeavesDiff(t, tr, nil, rootHash)
+		assert.Equal(t, len(values), len(diff))
+		for _, value := range values {
+			assert.Equal(t, leafDiff{newValue: string(value)}, diff[string(value)])
+		}
+
+		diff = getLeavesDiff(t, tr, rootHash, trie.EmptyTrieHash)
+		assert.Equal(t, len(values), len(diff))
+	})
+	t.Run("missing root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(10)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		err := tr.GetLeavesDiff(context.Background(), rootHash, []byte("missing root hash"), func(_ []byte, _ []byte, _ []byte) error {
+			return nil
+		})
+		assert.NotNil(t, err)
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := tr.GetLeavesDiff(context.Background(), nil, rootHash, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should return added, removed and modified keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(200)
+		_ = tr.Commit()
+		fromRootHash, _ := tr.RootHash()
+
+		for i := 0; i < 20; i++ {
+			_ = tr.Delete(values[i])
+		}
+		for i := 50; i < 60; i++ {
+			_ = tr.Update(values[i], []byte(fmt.Sprintf("new value %d", i)))
+		}
+		for i := 0; i < 30; i++ {
+			_ = tr.Update([]byte(fmt.Sprintf("new key %d", i)), []byte("value"))
+		}
+		_ = tr.Commit()
+		toRootHash, _ := tr.RootHash()
+
+		expectedDiff := computeExpectedDiff(getAllLeaves(t, tr, fromRootHash), getAllLeaves(t, tr, toRootHash))
+		assert.Equal(t, 60, len(expectedDiff))
+		assert.Equal(t, expectedDiff, getLeavesDiff(t, tr, fromRootHash, toRootHash))
+		assert.Equal(t, computeExpectedDiff(getAllLeaves(t, tr, toRootHash), getAllLeaves(t, tr, fromRootHash)),
+			getLeavesDiff(t, tr, toRootHash, fromRootHash))
+	})
+	t.Run("tries with different shapes should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		_ = tr.Update([]byte("doe"), []byte("reindeer"))
+		_ = tr.Update([]byte("dog"), []byte("puppy"))
+		_ = tr.Commit()
+		fromRootHash, _ := tr.RootHash()
+
+		_ = tr.Update([]byte("ddog"), []byte("cat"))
+		_ = tr.Update([]byte("d"), []byte("short key"))
+		_ = tr.Update([]byte("dog"), []byte("dog"))
+		_ = tr.Delete([]byte("doe"))
+		_ = tr.Commit()
+		toRootHash, _ := tr.RootHash()
+
+		expectedDiff := map[string]leafDiff{
+			"doe":  {oldValue: "reindeer"},
+			"dog":  {oldValue: "puppy", newValue: "dog"},
+			"ddog": {newValue: "cat"},
+			"d":    {newValue: "short key"},
+		}
+		assert.Equal(t, expectedDiff, getLeavesDiff(t, tr, fromRootHash, toRootHash))
+	})
+	t.Run("context closed during the walk should stop it", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		numCalls := 0
+		err := tr.GetLeavesDiff(ctx, nil, rootHash, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			cancel()
+			return nil
+		})
+		assert.Equal(t, elrondErrors.ErrContextClosing, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(10)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := tr.GetLeavesDiff(ctx, nil, rootHash, func(_ []byte, _ []byte, _ []byte) error {
+			return nil
+		})
+		assert.NotNil(t, err)
+	})
+}
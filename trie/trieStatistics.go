@@ -0,0 +1,78 @@
+package trie
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+)
+
+type hashWithLevel struct {
+	hash  []byte
+	level int
+}
+
+// statisticsWalker walks a trie depth first, loading each node from the storage and dropping it after its children
+// hashes are collected, so that the memory used does not depend on the trie size
+type statisticsWalker struct {
+	ctx         context.Context
+	db          common.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+func (sw *statisticsWalker) walk(rootHash []byte) (common.TrieStatisticsDTO, error) {
+	stats := common.TrieStatisticsDTO{}
+	hashesToWalk := []hashWithLevel{{hash: rootHash, level: 1}}
+	for len(hashesToWalk) > 0 {
+		if sw.isContextDone() {
+			return common.TrieStatisticsDTO{}, errors.ErrContextClosing
+		}
+
+		lastIndex := len(hashesToWalk) - 1
+		current := hashesToWalk[lastIndex]
+		hashesToWalk = hashesToWalk[:lastIndex]
+
+		encodedNode, err := sw.db.Get(current.hash)
+		if err != nil {
+			return common.TrieStatisticsDTO{}, err
+		}
+		n, err := decodeNode(encodedNode, sw.marshalizer, sw.hasher)
+		if err != nil {
+			return common.TrieStatisticsDTO{}, err
+		}
+
+		stats.SizeInBytes += uint64(len(encodedNode))
+		if current.level > stats.MaxLevel {
+			stats.MaxLevel = current.level
+		}
+
+		switch element := n.(type) {
+		case *branchNode:
+			stats.Branches++
+			for _, childHash := range element.EncodedChildren {
+				if len(childHash) > 0 {
+					hashesToWalk = append(hashesToWalk, hashWithLevel{hash: childHash, level: current.level + 1})
+				}
+			}
+		case *extensionNode:
+			stats.Extensions++
+			hashesToWalk = append(hashesToWalk, hashWithLevel{hash: element.EncodedChild, level: current.level + 1})
+		case *leafNode:
+			stats.Leaves++
+		}
+	}
+
+	return stats, nil
+}
+
+func (sw *statisticsWalker) isContextDone() bool {
+	select {
+	case <-sw.ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,84 @@
+package trie_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil context should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := emptyTrie().GetTrieStatistics(nil, []byte("root hash"))
+		assert.Equal(t, trie.ErrNilContext, err)
+	})
+	t.Run("empty root hash should return empty statistics", func(t *testing.T) {
+		t.Parallel()
+
+		stats, err := emptyTrie().GetTrieStatistics(context.Background(), trie.EmptyTrieHash)
+		assert.Nil(t, err)
+		assert.Equal(t, common.TrieStatisticsDTO{}, stats)
+	})
+	t.Run("missing root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := emptyTrie().GetTrieStatistics(context.Background(), []byte("missing root hash"))
+		assert.NotNil(t, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(10)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := tr.GetTrieStatistics(ctx, rootHash)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the same number of nodes as the trie held in memory", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		_ = tr.Update([]byte("eod"), []byte("reindeer"))
+		_ = tr.Update([]byte("god"), []byte("puppy"))
+		_ = tr.Update([]byte("eggod"), []byte("cat"))
+		expectedNumNodes := tr.GetNumNodes()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		stats, err := tr.GetTrieStatistics(context.Background(), rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, expectedNumNodes, stats.NumNodesDTO)
+	})
+	t.Run("should sum the size of all the serialized nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(200)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		hashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+		expectedSize := uint64(0)
+		for _, hash := range hashes {
+			serializedNode, errGet := tr.GetSerializedNode(hash)
+			require.Nil(t, errGet)
+			expectedSize += uint64(len(serializedNode))
+		}
+
+		stats, err := tr.GetTrieStatistics(context.Background(), rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, len(values), stats.Leaves)
+		assert.Equal(t, len(hashes), stats.Leaves+stats.Extensions+stats.Branches)
+		assert.Equal(t, expectedSize, stats.SizeInBytes)
+	})
+}
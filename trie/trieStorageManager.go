@@ -31,6 +31,7 @@ type trieStorageManager struct {
 	closer                 core.SafeCloser
 	closed                 bool
 	idleProvider           IdleNodeProvider
+	snapshotProgress       *snapshotProgressTracker
 }
 
 type snapshotsQueueEntry struct {
@@ -86,6 +87,7 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 		checkpointHashesHolder: args.CheckpointHashesHolder,
 		closer:                 closing.NewSafeChanCloser(),
 		idleProvider:           args.IdleProvider,
+		snapshotProgress:       &snapshotProgressTracker{},
 	}
 	goRoutinesThrottler, err := throttler.NewNumGoRoutinesThrottler(int32(args.GeneralConfig.SnapshotsGoroutineNum))
 	if err != nil {
@@ -315,7 +317,9 @@ func (tsm *trieStorageManager) GetLatestStorageEpoch() (uint32, error) {
 }
 
 // TakeSnapshot creates a new snapshot, or if there is another snapshot or checkpoint in progress,
-// it adds this snapshot in the queue.
+// it adds this snapshot in the queue. The snapshot of a main trie (rootHash equal to mainTrieRootHash) persists its
+// progress and continues from it if restarted. For such a snapshot, the leaves channel will also receive
+// common.SnapshotLeavesBarrier items that must be released by the channel consumer.
 func (tsm *trieStorageManager) TakeSnapshot(
 	rootHash []byte,
 	mainTrieRootHash []byte,
@@ -452,7 +456,11 @@ func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry,
 		return
 	}
 
-	err = newRoot.commitSnapshot(stsm, snapshotEntry.leavesChan, ctx, snapshotEntry.stats, tsm.idleProvider)
+	if isResumableSnapshot(snapshotEntry) {
+		err = tsm.takeResumableSnapshot(newRoot, stsm, snapshotEntry, msh, hsh, ctx, goRoutinesThrottler)
+	} else {
+		err = newRoot.commitSnapshot(stsm, snapshotEntry.leavesChan, ctx, snapshotEntry.stats, tsm.idleProvider)
+	}
 	if err != nil {
 		writeInChanNonBlocking(snapshotEntry.errChan, err)
 		treatSnapshotError(err,